@@ -203,6 +203,11 @@ module.exports = {
                   directory: false,
                   path: "/apps/interchain-accounts/transactions.html",
                 },
+                {
+                  title: "Metrics",
+                  directory: false,
+                  path: "/apps/interchain-accounts/metrics.html",
+                },
               ],
             },
             {
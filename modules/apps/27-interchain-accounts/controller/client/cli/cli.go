@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
@@ -15,8 +16,32 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
+		GetCmdQueryMsgsExecuted(),
+		GetCmdQueryPendingPackets(),
+		GetCmdQueryChannelMetadata(),
 		GetCmdParams(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd creates and returns the tx command
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "ica controller transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewRegisterInterchainAccountCmd(),
+		NewReopenChannelCmd(),
+		NewCloseChannelCmd(),
+		NewSendTxCmd(),
+		NewUpdateChannelVersionCmd(),
+	)
+
+	return cmd
+}
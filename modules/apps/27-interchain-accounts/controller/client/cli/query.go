@@ -45,6 +45,108 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryMsgsExecuted returns the command handler for the controller submodule message count querying.
+func GetCmdQueryMsgsExecuted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "msgs-executed [owner] [connection-id]",
+		Short:   "Query the number of messages sent by type URL for a given owner on a particular connection",
+		Long:    "Query the controller submodule for the number of messages sent by type URL for a given owner on a particular connection. Messages are only counted if the msg counters param is enabled",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller msgs-executed cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryMsgsExecutedRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.MsgsExecuted(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingPackets returns the command handler for querying the pending packets of an interchain account.
+func GetCmdQueryPendingPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-packets [owner] [connection-id]",
+		Short:   "Query the packets which have not yet been acknowledged or timed out for a given owner on a particular connection",
+		Long:    "Query the controller submodule for the packets sent on the active channel of a given owner on a particular connection which have not yet been acknowledged or timed out, in order of sequence",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller pending-packets cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPendingPacketsRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.PendingPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelMetadata returns the command handler for querying the ICS27 metadata of the active channel of a controller port.
+func GetCmdQueryChannelMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-metadata [connection-id] [port-id]",
+		Short:   "Query the ICS27 metadata of the active channel of a controller port on a particular connection",
+		Long:    "Query the controller submodule for the ICS27 metadata agreed during the handshake of the active channel of a controller port on a particular connection. The channel version is returned without metadata if it cannot be parsed",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller channel-metadata connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryChannelMetadataRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			}
+
+			res, err := queryClient.ChannelMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for the controller submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,330 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	// flagRelativePacketTimeout is the flag used to set the relative packet timeout in nanoseconds
+	flagRelativePacketTimeout = "relative-packet-timeout"
+	// flagVersion is the flag used to set the channel version for interchain account registration
+	flagVersion = "version"
+	// flagMsgs is the flag used to provide the messages to be executed instead of pre-built packet data
+	flagMsgs = "msgs"
+	// flagPacketMemo is the flag used to set the memo of the packet data built from the provided messages
+	flagPacketMemo = "packet-memo"
+	// flagEncoding is the flag used to set the encoding of the packet data built from the provided messages
+	flagEncoding = "encoding"
+	// flagAlias is the flag used to set the alias of the interchain account, used to derive the controller port identifier
+	flagAlias = "alias"
+)
+
+// DefaultRelativePacketTimeout is the default packet timeout relative to the current block time, in nanoseconds (10 minutes)
+var DefaultRelativePacketTimeout = uint64((10 * time.Minute).Nanoseconds())
+
+// NewRegisterInterchainAccountCmd returns the command handler for registering an interchain account using MsgRegisterInterchainAccount.
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the provided connection",
+		Long: `Register an interchain account on the provided connection, owned by the transaction signer.
+The channel version may be provided using the --version flag, for example an ICS29 fee middleware version
+wrapping the ICS27 metadata. The default interchain accounts metadata is used if no version is provided.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller register connection-0 --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			channelVersion, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			alias, err := cmd.Flags().GetString(flagAlias)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, channelVersion)
+			msg.Alias = alias
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
+	cmd.Flags().String(flagAlias, "", "Alias of the interchain account, allowing the signer to control multiple interchain accounts on the same connection")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReopenChannelCmd returns the command handler for reopening a closed interchain account channel using MsgReopenChannel.
+func NewReopenChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen-channel [connection-id]",
+		Short: "Reopen the closed interchain account channel on the provided connection",
+		Long: `Initiate a new channel handshake for the interchain account owned by the transaction signer,
+whose previous channel on the provided connection has been closed. The version of the previous channel is reused.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller reopen-channel connection-0 --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			alias, err := cmd.Flags().GetString(flagAlias)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReopenChannel(args[0], clientCtx.GetFromAddress().String())
+			msg.Alias = alias
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAlias, "", "Alias of the interchain account, allowing the signer to control multiple interchain accounts on the same connection")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCloseChannelCmd returns the command handler for closing an interchain account channel using MsgCloseChannel.
+func NewCloseChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-channel [connection-id]",
+		Short: "Close the active interchain account channel on the provided connection",
+		Long: `Initiate the closing of the open active channel of the interchain account owned by the transaction signer
+on the provided connection. The interchain account may be controlled again after reopening the channel.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller close-channel connection-0 --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			alias, err := cmd.Flags().GetString(flagAlias)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseChannel(args[0], clientCtx.GetFromAddress().String())
+			msg.Alias = alias
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAlias, "", "Alias of the interchain account, allowing the signer to control multiple interchain accounts on the same connection")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command handler for sending interchain accounts packet data using MsgSendTx.
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_data.json]",
+		Short: "Send an interchain account tx on the provided connection",
+		Long: `Submits pre-built interchain accounts packet data containing messages to be executed on the host chain
+by the interchain account of the transaction signer. The packet data may be provided as a path to a JSON file
+or as a raw JSON string, for example:
+
+{
+  "type": "TYPE_EXECUTE_TX",
+  "data": "CqIBChwvY29zbW9zLmJhbmsudjFiZXRhMS5Nc2dTZW5kEoEBCkFjb3Ntb3Mx...",
+  "memo": "memo"
+}
+
+Alternatively, if the --msgs flag is set, a single message or an array of messages may be provided instead,
+which are serialized into the packet data using the encoding provided by the --encoding flag, for example:
+
+[
+  {
+    "@type": "/cosmos.bank.v1beta1.MsgSend",
+    "from_address": "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+    "to_address": "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+    "amount": [{"denom": "stake", "amount": "1000"}]
+  }
+]`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller send-tx connection-0 packet_data.json --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			isMsgs, err := cmd.Flags().GetBool(flagMsgs)
+			if err != nil {
+				return err
+			}
+
+			var packetData icatypes.InterchainAccountPacketData
+			if isMsgs {
+				packetData, err = packetDataFromMsgs(cmd, clientCtx, args[1])
+				if err != nil {
+					return err
+				}
+			} else if err := clientCtx.Codec.UnmarshalJSON([]byte(args[1]), &packetData); err != nil {
+				// attempt to read the packet data from file
+				contents, err := os.ReadFile(args[1])
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for packet data were provided: %w", err)
+				}
+
+				if err := clientCtx.Codec.UnmarshalJSON(contents, &packetData); err != nil {
+					return fmt.Errorf("error unmarshalling packet data file: %w", err)
+				}
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			alias, err := cmd.Flags().GetString(flagAlias)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeout, packetData)
+			msg.Alias = alias
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, DefaultRelativePacketTimeout, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagMsgs, false, "Build the packet data from the provided messages instead of pre-built packet data")
+	cmd.Flags().String(flagPacketMemo, "", "Memo of the packet data built from the provided messages")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("Encoding of the packet data built from the provided messages, either %s or %s", icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON))
+	cmd.Flags().String(flagAlias, "", "Alias of the interchain account, allowing the signer to control multiple interchain accounts on the same connection")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// packetDataFromMsgs parses the messages provided as a raw JSON string or as a path to a JSON file and returns the
+// interchain accounts packet data executing them, using the encoding and memo provided by the command flags.
+func packetDataFromMsgs(cmd *cobra.Command, clientCtx client.Context, input string) (icatypes.InterchainAccountPacketData, error) {
+	msgs, err := icatypes.ParseMsgsFromJSON(clientCtx.Codec, []byte(input))
+	if err != nil {
+		// attempt to read the messages from file
+		contents, readErr := os.ReadFile(input)
+		if readErr != nil {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf("neither JSON input nor path to .json file for messages were provided: %w", err)
+		}
+
+		if msgs, err = icatypes.ParseMsgsFromJSON(clientCtx.Codec, contents); err != nil {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf("error parsing messages file: %w", err)
+		}
+	}
+
+	encoding, err := cmd.Flags().GetString(flagEncoding)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	memo, err := cmd.Flags().GetString(flagPacketMemo)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(clientCtx.Codec, msgs, encoding)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}, nil
+}
+
+// NewUpdateChannelVersionCmd returns the command handler for updating the version of an interchain account channel using
+// MsgUpdateChannelVersion.
+func NewUpdateChannelVersionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-channel-version [connection-id] [version]",
+		Short: "Update the version of the interchain account channel on the provided connection",
+		Long: `Propose a new version for the active channel of the interchain account owned by the transaction signer on
+the provided connection. Only the ICS29 fee version may be added to the current channel version, wrapping the
+unchanged ICS27 metadata, for example:
+
+{"fee_version":"ics29-1","app_version":"{\"version\":\"ics27-1\",...}"}
+
+The channel version is updated once the host chain successfully acknowledges the proposed version.`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller update-channel-version connection-0 '{\"fee_version\":\"ics29-1\",\"app_version\":\"...\"}' --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			alias, err := cmd.Flags().GetString(flagAlias)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateChannelVersion(owner, args[0], args[1], relativeTimeout)
+			msg.Alias = alias
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, DefaultRelativePacketTimeout, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().String(flagAlias, "", "Alias of the interchain account, allowing the signer to control multiple interchain accounts on the same connection")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,11 +8,12 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
-	fee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
@@ -115,13 +116,17 @@ func SetupICAPath(path *ibctesting.Path, owner string) error {
 func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 	var channel *channeltypes.Channel
 
+	// the callbacks are passed down the controller stack to the ICA auth module
+	expCallbacks := []string{"icacontroller/OnChanOpenInit", "icaauth/OnChanOpenInit"}
+
 	testCases := []struct {
-		name     string
-		malleate func()
-		expPass  bool
+		name         string
+		malleate     func()
+		expPass      bool
+		expCallbacks []string
 	}{
 		{
-			"success", func() {}, true,
+			"success", func() {}, true, expCallbacks,
 		},
 		{
 			"ICA auth module modification of channel version is ignored", func() {
@@ -133,27 +138,22 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 				) (string, error) {
 					return "invalid-version", nil
 				}
-			}, true,
+			}, true, expCallbacks,
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
-			}, false,
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
+			}, false, []string{"icacontroller/OnChanOpenInit"},
 		},
 		{
 			"ICA OnChanOpenInit fails - UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
-			}, false,
+			}, false, []string{"icacontroller/OnChanOpenInit"},
 		},
 		{
 			"ICA auth module callback fails", func() {
-				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenInit = func(ctx sdk.Context, order channeltypes.Order, connectionHops []string,
-					portID, channelID string, chanCap *capabilitytypes.Capability,
-					counterparty channeltypes.Counterparty, version string,
-				) (string, error) {
-					return "", fmt.Errorf("mock ica auth fails")
-				}
-			}, false,
+				suite.chainA.GetSimApp().ICAAuthMiddleware.FailOnChanOpenInit = true
+			}, false, expCallbacks,
 		},
 	}
 
@@ -200,6 +200,8 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
 			suite.Require().True(ok)
 
+			suite.chainA.GetSimApp().ICACallbackRecorder.Reset()
+
 			version, err := cbs.OnChanOpenInit(suite.chainA.GetContext(), channel.Ordering, channel.GetConnectionHops(),
 				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, chanCap, channel.Counterparty, channel.GetVersion(),
 			)
@@ -210,6 +212,8 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 			} else {
 				suite.Require().Error(err)
 			}
+
+			suite.Require().Equal(tc.expCallbacks, suite.chainA.GetSimApp().ICACallbackRecorder.Callbacks())
 		})
 	}
 }
@@ -266,32 +270,32 @@ func (suite *InterchainAccountsTestSuite) TestChanOpenTry() {
 func (suite *InterchainAccountsTestSuite) TestOnChanOpenAck() {
 	var path *ibctesting.Path
 
+	// the callbacks are passed down the controller stack to the ICA auth module
+	expCallbacks := []string{"icacontroller/OnChanOpenAck", "icaauth/OnChanOpenAck"}
+
 	testCases := []struct {
-		name     string
-		malleate func()
-		expPass  bool
+		name         string
+		malleate     func()
+		expPass      bool
+		expCallbacks []string
 	}{
 		{
-			"success", func() {}, true,
+			"success", func() {}, true, expCallbacks,
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
-			}, false,
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
+			}, false, []string{"icacontroller/OnChanOpenAck"},
 		},
 		{
 			"ICA OnChanOpenACK fails - invalid version", func() {
 				path.EndpointB.ChannelConfig.Version = "invalid|version"
-			}, false,
+			}, false, []string{"icacontroller/OnChanOpenAck"},
 		},
 		{
 			"ICA auth module callback fails", func() {
-				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenAck = func(
-					ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string,
-				) error {
-					return fmt.Errorf("mock ica auth fails")
-				}
-			}, false,
+				suite.chainA.GetSimApp().ICAAuthMiddleware.FailOnChanOpenAck = true
+			}, false, expCallbacks,
 		},
 	}
 
@@ -318,6 +322,8 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenAck() {
 			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
 			suite.Require().True(ok)
 
+			suite.chainA.GetSimApp().ICACallbackRecorder.Reset()
+
 			err = cbs.OnChanOpenAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelID, path.EndpointB.ChannelConfig.Version)
 
 			if tc.expPass {
@@ -325,6 +331,8 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenAck() {
 			} else {
 				suite.Require().Error(err)
 			}
+
+			suite.Require().Equal(tc.expCallbacks, suite.chainA.GetSimApp().ICACallbackRecorder.Callbacks())
 		})
 	}
 }
@@ -372,10 +380,15 @@ func (suite *InterchainAccountsTestSuite) TestChanOpenConfirm() {
 	cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
 	suite.Require().True(ok)
 
+	suite.chainA.GetSimApp().ICACallbackRecorder.Reset()
+
 	err = cbs.OnChanOpenConfirm(
 		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
 	)
 	suite.Require().Error(err)
+
+	// the controller submodule rejects the callback, it is not passed down to the ICA auth module
+	suite.Require().Equal([]string{"icacontroller/OnChanOpenConfirm"}, suite.chainA.GetSimApp().ICACallbackRecorder.Callbacks())
 }
 
 // OnChanCloseInit on controller (chainA)
@@ -441,6 +454,30 @@ func (suite *InterchainAccountsTestSuite) TestOnChanCloseConfirm() {
 	}
 }
 
+// TestHandshakeCallbackOrdering asserts the order in which the channel handshake callbacks travel through the interchain
+// accounts stacks of the controller (chainA) and host (chainB) chains
+func (suite *InterchainAccountsTestSuite) TestHandshakeCallbackOrdering() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	expControllerCallbacks := []string{"icacontroller/OnChanOpenInit", "icaauth/OnChanOpenInit", "icacontroller/OnChanOpenAck", "icaauth/OnChanOpenAck"}
+	suite.Require().Equal(expControllerCallbacks, suite.chainA.GetSimApp().ICACallbackRecorder.Callbacks())
+
+	expHostCallbacks := []string{"icahost/OnChanOpenTry", "icahost/OnChanOpenConfirm"}
+	suite.Require().Equal(expHostCallbacks, suite.chainB.GetSimApp().ICACallbackRecorder.Callbacks())
+
+	// the host stack receives the arguments provided by core IBC
+	onChanOpenTry := suite.chainB.GetSimApp().ICACallbackRecorder.Invocations()[0]
+	suite.Require().Equal(channeltypes.ORDERED, onChanOpenTry.Order)
+	suite.Require().Equal([]string{path.EndpointB.ConnectionID}, onChanOpenTry.ConnectionHops)
+	suite.Require().Equal(path.EndpointB.ChannelID, onChanOpenTry.ChannelID)
+	suite.Require().Equal(channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), onChanOpenTry.Counterparty)
+	suite.Require().Equal(TestVersion, onChanOpenTry.Version)
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	testCases := []struct {
 		name     string
@@ -504,7 +541,7 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
 			}, false,
 		},
 		{
@@ -573,7 +610,7 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
 			}, false,
 		},
 		{
@@ -627,6 +664,97 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnChanOpenInitNilUnderlyingApp() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	ctx := suite.chainA.GetContext()
+	channelID := channeltypes.FormatChannelIdentifier(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(ctx))
+	chanCap, err := suite.chainA.GetSimApp().ScopedIBCKeeper.NewCapability(ctx, host.ChannelCapabilityPath(TestPortID, channelID))
+	suite.Require().NoError(err)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	cbs := controller.NewIBCMiddleware(nil, controllerKeeper)
+
+	counterparty := channeltypes.NewCounterparty(icatypes.PortID, "")
+	version, err := cbs.OnChanOpenInit(ctx, channeltypes.ORDERED, []string{path.EndpointA.ConnectionID}, TestPortID, channelID, chanCap, counterparty, TestVersion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(TestVersion, version)
+
+	// the middleware is disabled and the channel capability is claimed by the controller submodule
+	suite.Require().False(controllerKeeper.IsMiddlewareEnabled(ctx, TestPortID, path.EndpointA.ConnectionID))
+	suite.Require().True(suite.chainA.GetSimApp().ScopedICAControllerKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(TestPortID, channelID)))
+}
+
+func (suite *InterchainAccountsTestSuite) TestPacketCallbacksUnderlyingAppWiring() {
+	testCases := []struct {
+		msg     string
+		nilApp  bool
+		expPass bool
+	}{
+		{
+			"underlying app is called for channels with the middleware enabled", false, false,
+		},
+		{
+			"nil underlying app is not called for channels with the middleware enabled", true, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			// register an interchain account through the authentication module such that the middleware is enabled
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnAcknowledgementPacket = func(
+				ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress,
+			) error {
+				return fmt.Errorf("mock ica auth fails")
+			}
+
+			suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnTimeoutPacket = func(
+				ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+			) error {
+				return fmt.Errorf("mock ica auth fails")
+			}
+
+			packet := channeltypes.NewPacket(
+				[]byte("empty packet data"),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			cbs := controller.NewIBCMiddleware(suite.chainA.GetSimApp().ICAAuthModule, suite.chainA.GetSimApp().ICAControllerKeeper)
+			if tc.nilApp {
+				cbs = controller.NewIBCMiddleware(nil, suite.chainA.GetSimApp().ICAControllerKeeper)
+			}
+
+			ackErr := cbs.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, []byte("ack"), nil)
+			timeoutErr := cbs.OnTimeoutPacket(suite.chainA.GetContext(), packet, nil)
+
+			if tc.expPass {
+				suite.Require().NoError(ackErr)
+				suite.Require().NoError(timeoutErr)
+			} else {
+				suite.Require().Error(ackErr)
+				suite.Require().Error(timeoutErr)
+			}
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestSingleHostMultipleControllers() {
 	var (
 		pathAToB *ibctesting.Path
@@ -707,7 +835,7 @@ func (suite *InterchainAccountsTestSuite) TestGetAppVersion() {
 	cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
 	suite.Require().True(ok)
 
-	controllerStack := cbs.(fee.IBCMiddleware)
+	controllerStack := cbs.(porttypes.Middleware)
 	appVersion, found := controllerStack.GetAppVersion(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(path.EndpointA.ChannelConfig.Version, appVersion)
@@ -15,27 +15,165 @@ import (
 // - Callers are expected to provide the appropriate application version string.
 // - For example, this could be an ICS27 encoded metadata type or an ICS29 encoded metadata type with a nested application version.
 // - A new MsgChannelOpenInit is routed through the MsgServiceRouter, executing the OnOpenChanInit callback stack as configured.
-// - An error is returned if the port identifier is already in use. Gaining access to interchain accounts whose channels
-// have closed cannot be done with this function. A regular MsgChannelOpenInit must be used.
+// - An error is returned if the port identifier is already in use by another module or an OPEN active channel exists.
+// - Interchain accounts whose channels have closed may be reopened by calling this function with the same owner,
+// the new channel is bound to the existing interchain account on the host chain.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error {
-	portID, err := icatypes.NewControllerPortID(owner)
+	return k.RegisterInterchainAccountWithAlias(ctx, connectionID, owner, "", version)
+}
+
+// RegisterInterchainAccountWithAlias registers an interchain account in the same manner as RegisterInterchainAccount,
+// using the controller port identifier derived from the provided owner and alias. Registering accounts under distinct
+// aliases allows a single owner to control multiple interchain accounts on the same connection, as each port identifier
+// results in a separate interchain account on the host chain. If the alias is empty, the port identifier derived from
+// the owner alone is used.
+func (k Keeper) RegisterInterchainAccountWithAlias(ctx sdk.Context, connectionID, owner, alias, version string) error {
+	portID, err := icatypes.NewControllerPortID(owner, alias)
 	if err != nil {
 		return err
 	}
 
+	k.SetMiddlewareEnabled(ctx, portID, connectionID)
+
+	_, err = k.registerInterchainAccount(ctx, connectionID, portID, version)
+	return err
+}
+
+// ReopenChannel initiates a new channel handshake for an interchain account whose previous channel on the provided
+// connection has been closed, for example due to a packet timeout on the ORDERED channel. The version of the most recently
+// closed channel is reused, such that the new channel is bound to the existing interchain account on the host chain.
+// An error is returned if a channel for the port identifier on the connection is OPEN or in the process of being opened.
+func (k Keeper) ReopenChannel(ctx sdk.Context, connectionID, portID string) (string, error) {
+	address, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", connectionID, portID)
+	}
+
+	var (
+		previousChannel channeltypes.IdentifiedChannel
+		previousSeq     uint64
+		closedFound     bool
+		err             error
+	)
+
+	k.iterateInterchainAccountChannels(ctx, connectionID, portID, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.State != channeltypes.CLOSED {
+			err = sdkerrors.Wrapf(icatypes.ErrInvalidAccountReopening, "channel %s for port %s is in state %s", channel.ChannelId, portID, channel.State)
+			return true
+		}
+
+		// channel identifiers are not iterated in order of their sequence
+		sequence, parseErr := channeltypes.ParseChannelSequence(channel.ChannelId)
+		if parseErr != nil {
+			err = parseErr
+			return true
+		}
+
+		if !closedFound || sequence > previousSeq {
+			previousChannel, previousSeq, closedFound = channel, sequence, true
+		}
+
+		return false
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if !closedFound {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve closed channel on connection %s for port %s", connectionID, portID)
+	}
+
+	appVersion, found := k.GetAppVersion(ctx, portID, previousChannel.ChannelId)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "failed to retrieve version of channel %s for port %s", previousChannel.ChannelId, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &metadata); err != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if metadata.Address != address {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidAccountReopening, "previous channel metadata address %s does not match interchain account %s", metadata.Address, address)
+	}
+
+	return k.registerInterchainAccount(ctx, connectionID, portID, previousChannel.Version)
+}
+
+// CloseChannel initiates the closing of the OPEN active channel of the interchain account registered on the provided
+// connection and port identifier by routing a MsgChannelCloseInit through the MsgServiceRouter, executing the
+// OnChanCloseInit callback stack as configured. The active channel is removed once the channel is closed, while the
+// interchain account address is preserved such that the interchain account may be controlled again after reopening
+// the channel using ReopenChannel. The identifier of the closed channel is returned.
+func (k Keeper) CloseChannel(ctx sdk.Context, connectionID, portID string) (string, error) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve open active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	// the close is executed in a cached context such that no state changes are persisted if any callback fails
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	// mark the close as initiated by the controller submodule, the mark is removed by the OnChanCloseInit callback
+	cacheCtx.KVStore(k.storeKey).Set(icatypes.KeyCloseChannel(portID, activeChannelID), []byte{0x01})
+
+	msg := channeltypes.NewMsgChannelCloseInit(portID, activeChannelID, authtypes.NewModuleAddress(icatypes.ModuleName).String())
+	handler := k.msgRouter.Handler(msg)
+
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	writeFn()
+
+	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return activeChannelID, nil
+}
+
+// isRegistrationInProgress returns true if a channel handshake for the provided port identifier and connection
+// has been initiated but not yet completed on the controller chain
+func (k Keeper) isRegistrationInProgress(ctx sdk.Context, connectionID, portID string) bool {
+	var inProgress bool
+	k.iterateInterchainAccountChannels(ctx, connectionID, portID, func(channel channeltypes.IdentifiedChannel) bool {
+		inProgress = channel.State == channeltypes.INIT || channel.State == channeltypes.TRYOPEN
+		return inProgress
+	})
+
+	return inProgress
+}
+
+// iterateInterchainAccountChannels iterates over all channels opened on the provided port identifier and connection,
+// calling the provided callback until it returns true
+func (k Keeper) iterateInterchainAccountChannels(ctx sdk.Context, connectionID, portID string, cb func(channeltypes.IdentifiedChannel) bool) {
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.PortId != portID || len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionID {
+			return false
+		}
+
+		return cb(channel)
+	})
+}
+
+// registerInterchainAccount binds the provided port identifier if required and routes a new MsgChannelOpenInit
+// through the MsgServiceRouter, returning the channel identifier of the newly initialised channel
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string) (string, error) {
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s", activeChannelID, portID, connectionID)
 	}
 
 	switch {
 	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
-		return sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
 	case !k.portKeeper.IsBound(ctx, portID):
 		cap := k.BindPort(ctx, portID)
 		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
-			return sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
 		}
 	}
 
@@ -44,11 +182,16 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner,
 
 	res, err := handler(ctx, msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	return nil
+	var response channeltypes.MsgChannelOpenInitResponse
+	if err := k.cdc.Unmarshal(res.Data, &response); err != nil {
+		return "", err
+	}
+
+	return response.ChannelId, nil
 }
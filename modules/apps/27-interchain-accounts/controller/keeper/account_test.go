@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
 )
 
 func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
@@ -118,3 +122,256 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), pathAToC.EndpointA.ConnectionID, owner, string(icatypes.ModuleCdc.MustMarshalJSON(metadata)))
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestReopenChannel() {
+	var (
+		path   *ibctesting.Path
+		portID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {
+				suite.timeoutICAChannel(path)
+			}, nil,
+		},
+		{
+			"channel is still OPEN", func() {}, icatypes.ErrInvalidAccountReopening,
+		},
+		{
+			"channel handshake is in progress", func() {
+				suite.timeoutICAChannel(path)
+
+				_, err := suite.chainA.GetSimApp().ICAControllerKeeper.ReopenChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().NoError(err)
+			}, icatypes.ErrInvalidAccountReopening,
+		},
+		{
+			"interchain account not found", func() {
+				suite.timeoutICAChannel(path)
+
+				portID = "invalid-port-id"
+			}, icatypes.ErrInterchainAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			previousChannelID := path.EndpointA.ChannelID
+			previousVersion := path.EndpointA.GetChannel().Version
+
+			tc.malleate() // malleate mutates test data
+
+			channelID, err := suite.chainA.GetSimApp().ICAControllerKeeper.ReopenChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotEqual(previousChannelID, channelID)
+
+				suite.chainA.NextBlock()
+
+				// relay the closure of the previous channel to the host chain
+				err = path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.ChanCloseConfirm()
+				suite.Require().NoError(err)
+
+				// the new channel reuses the version of the previous channel
+				path.EndpointA.ChannelID = channelID
+				suite.Require().Equal(previousVersion, path.EndpointA.GetChannel().Version)
+				path.EndpointA.ChannelConfig.Version = previousVersion
+
+				path.EndpointB.ChannelID = ""
+				path.EndpointB.ChannelConfig.Version = TestVersion
+
+				err = path.EndpointB.ChanOpenTry()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.ChanOpenAck()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.ChanOpenConfirm()
+				suite.Require().NoError(err)
+
+				// the new channel replaces the active channel bound to the existing interchain account
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().True(found)
+				suite.Require().Equal(channelID, activeChannelID)
+
+				controllerAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().True(found)
+
+				hostAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+				suite.Require().True(found)
+				suite.Require().Equal(hostAccountAddr, controllerAccountAddr)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(channelID)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCloseChannel() {
+	var (
+		path   *ibctesting.Path
+		portID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"channel is already closed", func() {
+				suite.timeoutICAChannel(path)
+			}, icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"active channel not found", func() {
+				portID = "invalid-port-id"
+			}, icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"underlying application rejects the close", func() {
+				suite.chainA.GetSimApp().ICAAuthMiddleware.FailOnChanCloseInit = true
+			}, ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			previousVersion := path.EndpointA.GetChannel().Version
+
+			tc.malleate() // malleate mutates test data
+
+			channelID, err := suite.chainA.GetSimApp().ICAControllerKeeper.CloseChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.ChannelID, channelID)
+				suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+				_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().False(found)
+
+				suite.chainA.NextBlock()
+
+				// relay the closure of the channel to the host chain, removing the active channel on the host
+				err = path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.ChanCloseConfirm()
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(channeltypes.CLOSED, path.EndpointB.GetChannel().State)
+
+				_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+				suite.Require().False(found)
+
+				// reopen the channel on the same port
+				reopenedChannelID, err := suite.chainA.GetSimApp().ICAControllerKeeper.ReopenChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().NoError(err)
+				suite.Require().NotEqual(channelID, reopenedChannelID)
+
+				suite.chainA.NextBlock()
+
+				path.EndpointA.ChannelID = reopenedChannelID
+				path.EndpointA.ChannelConfig.Version = previousVersion
+
+				path.EndpointB.ChannelID = ""
+				path.EndpointB.ChannelConfig.Version = TestVersion
+
+				err = path.EndpointB.ChanOpenTry()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.ChanOpenAck()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.ChanOpenConfirm()
+				suite.Require().NoError(err)
+
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().True(found)
+				suite.Require().Equal(reopenedChannelID, activeChannelID)
+
+				// the reopened channel is bound to the existing interchain account
+				controllerAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().True(found)
+
+				hostAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+				suite.Require().True(found)
+				suite.Require().Equal(hostAccountAddr, controllerAccountAddr)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(channelID)
+
+				// no state changes are persisted if the close fails
+				if tc.expErr != icatypes.ErrActiveChannelNotFound {
+					suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+
+					activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+					suite.Require().True(found)
+					suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+				}
+			}
+		})
+	}
+}
+
+// timeoutICAChannel sends a packet on the interchain accounts channel of the provided path and times it out,
+// closing the ORDERED channel on the controller chain
+func (suite *KeeperTestSuite) timeoutICAChannel(path *ibctesting.Path) {
+	chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(found)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Second).UnixNano())
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+}
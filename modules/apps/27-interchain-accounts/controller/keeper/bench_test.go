@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// BenchmarkSendTxRoundTrips benchmarks the execution of 10 independent bank MsgSend operations, including relaying each
+// packet and its acknowledgement, when sending a packet per operation compared to sending a single batch packet
+func BenchmarkSendTxRoundTrips(b *testing.B) {
+	const opCount = 10
+
+	b.Run("packet per operation", func(b *testing.B) {
+		benchmarkSendTxRoundTrips(b, opCount, false)
+	})
+
+	b.Run("batch packet", func(b *testing.B) {
+		benchmarkSendTxRoundTrips(b, opCount, true)
+	})
+}
+
+func benchmarkSendTxRoundTrips(b *testing.B, opCount int, batch bool) {
+	// the coordinator requires a *testing.T, any failure during setup panics
+	coordinator := ibctesting.NewCoordinator(&testing.T{}, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := NewICAPath(chainA, chainB)
+	coordinator.SetupConnections(path)
+
+	if err := SetupICAPathWithVersion(path, TestOwnerAddress, TestBatchVersion); err != nil {
+		b.Fatal(err)
+	}
+
+	interchainAccountAddr, found := chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	if !found {
+		b.Fatal("interchain account not found")
+	}
+
+	if _, err := chainB.SendMsgs(&banktypes.MsgSend{FromAddress: chainB.SenderAccount.GetAddress().String(), ToAddress: interchainAccountAddr, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))}); err != nil {
+		b.Fatal(err)
+	}
+
+	chainB.GetSimApp().ICAHostKeeper.SetParams(chainB.GetContext(), hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+
+	chanCap, found := chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	if !found {
+		b.Fatal("channel capability not found")
+	}
+
+	msgGroups := make([][]sdk.Msg, opCount)
+	for i := range msgGroups {
+		msgGroups[i] = []sdk.Msg{&banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))}}
+	}
+
+	// relay sends the packet data as a packet from chainA and relays it and its acknowledgement
+	relay := func(send func() (uint64, error)) {
+		sequence, err := send()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		packetData, found := chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+		if !found {
+			b.Fatal("pending packet data not found")
+		}
+
+		chainA.NextBlock()
+
+		packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+		if err := path.RelayPacket(packet); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	var packetCount int
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if batch {
+			relay(func() (uint64, error) {
+				return chainA.GetSimApp().ICAControllerKeeper.SendTxBatch(chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, msgGroups, "", ^uint64(0))
+			})
+			packetCount++
+
+			continue
+		}
+
+		for _, msgs := range msgGroups {
+			data, err := icatypes.SerializeCosmosTx(chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+			relay(func() (uint64, error) {
+				return chainA.GetSimApp().ICAControllerKeeper.SendTx(chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, ^uint64(0))
+			})
+			packetCount++
+		}
+	}
+
+	b.ReportMetric(float64(packetCount)/float64(b.N), "round-trips/op")
+}
@@ -29,3 +29,32 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitRegisterInterchainAccountEvent emits an event signalling the registration of an interchain account
+// using MsgRegisterInterchainAccount and including the generated controller port identifier.
+func EmitRegisterInterchainAccountEvent(ctx sdk.Context, owner, connectionID, portID, channelID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeRegisterInterchainAccount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+		),
+	)
+}
+
+// EmitUpdateChannelVersionEvent emits an event signalling the update of the version of a controller channel once the
+// channel version update has been successfully acknowledged by the host chain.
+func EmitUpdateChannelVersionEvent(ctx sdk.Context, portID, channelID, version string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeUpdateChannelVersion,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyVersion, version),
+		),
+	)
+}
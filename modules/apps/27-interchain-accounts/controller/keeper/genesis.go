@@ -5,13 +5,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 // InitGenesis initializes the interchain accounts controller application state from a provided genesis state
-func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.ControllerGenesisState) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGenesisState) {
 	for _, portID := range state.Ports {
+		keeper.SetPort(ctx, portID)
+
 		if !keeper.IsBound(ctx, portID) {
 			cap := keeper.BindPort(ctx, portID)
 			if err := keeper.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
@@ -22,6 +24,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.ControllerGenesi
 
 	for _, ch := range state.ActiveChannels {
 		keeper.SetActiveChannelID(ctx, ch.ConnectionId, ch.PortId, ch.ChannelId)
+
+		if ch.IsMiddlewareEnabled {
+			keeper.SetMiddlewareEnabled(ctx, ch.PortId, ch.ConnectionId)
+		} else {
+			keeper.SetMiddlewareDisabled(ctx, ch.PortId, ch.ConnectionId)
+		}
 	}
 
 	for _, acc := range state.InterchainAccounts {
@@ -32,8 +40,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.ControllerGenesi
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
-func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.ControllerGenesisState {
-	return icatypes.NewControllerGenesisState(
+func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesisState {
+	return genesistypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
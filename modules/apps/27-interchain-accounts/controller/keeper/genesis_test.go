@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
@@ -11,15 +12,22 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest()
 
 	interchainAccAddr := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
-	genesisState := icatypes.ControllerGenesisState{
-		ActiveChannels: []icatypes.ActiveChannel{
+	genesisState := genesistypes.ControllerGenesisState{
+		ActiveChannels: []genesistypes.ActiveChannel{
 			{
-				ConnectionId: ibctesting.FirstConnectionID,
-				PortId:       TestPortID,
-				ChannelId:    ibctesting.FirstChannelID,
+				ConnectionId:        ibctesting.FirstConnectionID,
+				PortId:              TestPortID,
+				ChannelId:           ibctesting.FirstChannelID,
+				IsMiddlewareEnabled: true,
+			},
+			{
+				ConnectionId:        "connection-1",
+				PortId:              TestPortID,
+				ChannelId:           "channel-1",
+				IsMiddlewareEnabled: false,
 			},
 		},
-		InterchainAccounts: []icatypes.RegisteredInterchainAccount{
+		InterchainAccounts: []genesistypes.RegisteredInterchainAccount{
 			{
 				ConnectionId:   ibctesting.FirstConnectionID,
 				PortId:         TestPortID,
@@ -35,11 +43,17 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(ibctesting.FirstChannelID, channelID)
 
+	isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+	suite.Require().True(isMiddlewareEnabled)
+
+	isMiddlewareEnabled = suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, "connection-1")
+	suite.Require().False(isMiddlewareEnabled)
+
 	accountAdrr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
 	suite.Require().True(found)
 	suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
-	expParams := types.NewParams(false)
+	expParams := types.NewParams(false, 0, false, 0, nil)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
@@ -60,6 +74,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
 	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.ActiveChannels[0].PortId)
+	suite.Require().True(genesisState.ActiveChannels[0].IsMiddlewareEnabled)
 
 	suite.Require().Equal(interchainAccAddr, genesisState.InterchainAccounts[0].AccountAddress)
 	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.InterchainAccounts[0].PortId)
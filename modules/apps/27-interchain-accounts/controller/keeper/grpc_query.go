@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -27,6 +28,10 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	addr, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
 	if !found {
+		if k.isRegistrationInProgress(ctx, req.ConnectionId, portID) {
+			return nil, status.Errorf(codes.FailedPrecondition, "interchain account registration for %s on connection %s is in progress", portID, req.ConnectionId)
+		}
+
 		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", portID, req.ConnectionId)
 	}
 
@@ -35,6 +40,90 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	}, nil
 }
 
+// MsgsExecuted implements the Query/MsgsExecuted gRPC method
+func (k Keeper) MsgsExecuted(goCtx context.Context, req *types.QueryMsgsExecutedRequest) (*types.QueryMsgsExecutedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryMsgsExecutedResponse{
+		MsgCounts: k.GetAllMsgCounts(ctx, req.ConnectionId, portID),
+	}, nil
+}
+
+// PendingPackets implements the Query/PendingPackets gRPC method
+func (k Keeper) PendingPackets(goCtx context.Context, req *types.QueryPendingPacketsRequest) (*types.QueryPendingPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channelID, found := k.GetActiveChannelID(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve active channel for %s on connection %s", portID, req.ConnectionId)
+	}
+
+	nextSequenceSend, _ := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	nextSequenceAck, _ := k.channelKeeper.GetNextSequenceAck(ctx, portID, channelID)
+
+	return &types.QueryPendingPacketsResponse{
+		ChannelId:        channelID,
+		NextSequenceSend: nextSequenceSend,
+		NextSequenceAck:  nextSequenceAck,
+		Packets:          k.GetPendingPackets(ctx, portID, channelID),
+	}, nil
+}
+
+// ChannelMetadata implements the Query/ChannelMetadata gRPC method. The version of the active channel is returned without
+// metadata if it cannot be parsed as ICS27 metadata.
+func (k Keeper) ChannelMetadata(goCtx context.Context, req *types.QueryChannelMetadataRequest) (*types.QueryChannelMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channelID, found := k.GetActiveChannelID(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve active channel for %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, req.PortId, channelID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve channel %s on port %s", channelID, req.PortId)
+	}
+
+	res := &types.QueryChannelMetadataResponse{
+		ChannelId: channelID,
+		Version:   channel.Version,
+	}
+
+	if metadata, err := icatypes.MetadataFromVersion(channel.Version); err == nil {
+		res.Metadata = &metadata
+	}
+
+	return res, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -2,8 +2,16 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -13,33 +21,47 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 	testCases := []struct {
 		name     string
 		malleate func()
-		expPass  bool
+		expCode  codes.Code
 	}{
 		{
 			"success",
 			func() {},
-			true,
+			codes.OK,
 		},
 		{
 			"empty request",
 			func() {
 				req = nil
 			},
-			false,
+			codes.InvalidArgument,
 		},
 		{
 			"empty owner address",
 			func() {
 				req.Owner = ""
 			},
-			false,
+			codes.InvalidArgument,
 		},
 		{
 			"invalid connection, account address not found",
 			func() {
 				req.ConnectionId = "invalid-connection-id"
 			},
-			false,
+			codes.NotFound,
+		},
+		{
+			"registration in progress, channel handshake initiated but account address not yet set",
+			func() {
+				path := NewICAPath(suite.chainA, suite.chainB)
+				path.EndpointA.ConnectionID = ibctesting.FirstConnectionID
+
+				owner := suite.chainA.SenderAccount.GetAddress().String()
+				err := RegisterInterchainAccount(path.EndpointA, owner)
+				suite.Require().NoError(err)
+
+				req.Owner = owner
+			},
+			codes.FailedPrecondition,
 		},
 	}
 
@@ -62,7 +84,7 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 
 			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
 
-			if tc.expPass {
+			if tc.expCode == codes.OK {
 				expAddress, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 				suite.Require().True(exists)
 
@@ -70,14 +92,238 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 				suite.Require().Equal(expAddress, res.Address)
 			} else {
 				suite.Require().Error(err)
+				suite.Require().Equal(tc.expCode, status.Code(err))
 			}
 		})
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryMsgsExecuted() {
+	suite.SetupTest()
+
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetMsgCount(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, msgSendTypeURL, 2)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.MsgsExecuted(ctx, &types.QueryMsgsExecutedRequest{Owner: TestOwnerAddress, ConnectionId: ibctesting.FirstConnectionID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MsgTypeCount{{TypeUrl: msgSendTypeURL, Count: 2}}, res.MsgCounts)
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.MsgsExecuted(ctx, &types.QueryMsgsExecutedRequest{Owner: TestOwnerAddress, ConnectionId: "connection-1"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.MsgCounts)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.MsgsExecuted(ctx, nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.MsgsExecuted(ctx, &types.QueryMsgsExecutedRequest{Owner: "", ConnectionId: ibctesting.FirstConnectionID})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingPackets() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(found)
+
+	// send four packets, the first of which is relayed and acknowledged
+	var packets []icatypes.InterchainAccountPacketData
+	for i := 1; i <= 4; i++ {
+		msg := &banktypes.MsgSend{
+			FromAddress: interchainAccountAddr,
+			ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(i)))),
+		}
+
+		data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+		suite.Require().NoError(err)
+
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+
+		sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, ^uint64(0))
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(i), sequence)
+
+		packets = append(packets, packetData)
+	}
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(packets[0].GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	suite.Require().False(found)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PendingPackets(ctx, &types.QueryPendingPacketsRequest{Owner: TestOwnerAddress, ConnectionId: ibctesting.FirstConnectionID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+	suite.Require().Equal(uint64(5), res.NextSequenceSend)
+	suite.Require().Equal(uint64(2), res.NextSequenceAck)
+	suite.Require().Len(res.Packets, 3)
+
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	for i, pendingPacket := range res.Packets {
+		suite.Require().Equal(uint64(i+2), pendingPacket.Sequence)
+		suite.Require().Equal(&packets[i+1], pendingPacket.PacketData)
+		suite.Require().Equal([]string{msgSendTypeURL}, pendingPacket.MsgTypeUrls)
+	}
+
+	// packets without stored packet data are still reported by sequence
+	suite.chainA.GetSimApp().ICAControllerKeeper.DeletePendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.PendingPackets(ctx, &types.QueryPendingPacketsRequest{Owner: TestOwnerAddress, ConnectionId: ibctesting.FirstConnectionID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Packets, 3)
+	suite.Require().Equal(uint64(2), res.Packets[0].Sequence)
+	suite.Require().Nil(res.Packets[0].PacketData)
+	suite.Require().Empty(res.Packets[0].MsgTypeUrls)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.PendingPackets(ctx, &types.QueryPendingPacketsRequest{Owner: TestOwnerAddress, ConnectionId: "connection-1"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.PendingPackets(ctx, nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.PendingPackets(ctx, &types.QueryPendingPacketsRequest{Owner: "", ConnectionId: ibctesting.FirstConnectionID})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
-	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expParams, res.Params)
+
+	expParams = types.NewParams(false, 1000000000, true, 2048, nil)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), expParams)
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelMetadata() {
+	var (
+		req         *types.QueryChannelMetadataRequest
+		version     string
+		expMetadata *icatypes.Metadata
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: fee enabled channel version",
+			func() {
+				version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: version}))
+			},
+			true,
+		},
+		{
+			"success: legacy version is returned without metadata",
+			func() {
+				version = icatypes.Version
+				expMetadata = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				req.PortId = "icacontroller-unknown"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryChannelMetadataRequest{
+				ConnectionId: path.EndpointA.ConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+
+			channel := path.EndpointA.GetChannel()
+			version = channel.Version
+
+			metadata, err := icatypes.MetadataFromVersion(version)
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(metadata.Address)
+			suite.Require().Equal(path.EndpointA.ConnectionID, metadata.ControllerConnectionId)
+			suite.Require().Equal(path.EndpointB.ConnectionID, metadata.HostConnectionId)
+			expMetadata = &metadata
+
+			tc.malleate()
+
+			channel.Version = version
+			suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ChannelMetadata(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+				suite.Require().Equal(version, res.Version)
+				suite.Require().Equal(expMetadata, res.Metadata)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
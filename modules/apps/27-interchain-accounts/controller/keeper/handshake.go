@@ -10,6 +10,7 @@ import (
 
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
@@ -75,11 +76,21 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
+	// the channel capability is claimed by the controller submodule if the underlying application is not called,
+	// allowing packets to be sent using MsgSendTx
+	if !k.IsMiddlewareEnabled(ctx, portID, connectionHops[0]) {
+		if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
+		}
+	}
+
 	return string(icatypes.ModuleCdc.MustMarshalJSON(&metadata)), nil
 }
 
 // OnChanOpenAck sets the active channel for the interchain account/owner pair
-// and stores the associated interchain account address in state keyed by it's corresponding port identifier
+// and stores the associated interchain account address in state keyed by it's corresponding port identifier.
+// The address returned by the host chain must be a valid bech32 address using the prefix configured for the
+// connection in the ConnectionAddressPrefixes param, if any.
 func (k Keeper) OnChanOpenAck(
 	ctx sdk.Context,
 	portID,
@@ -112,8 +123,22 @@ func (k Keeper) OnChanOpenAck(
 		return err
 	}
 
-	if strings.TrimSpace(metadata.Address) == "" {
-		return sdkerrors.Wrap(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be empty")
+	// the proposed channel version cannot be decoded as ICS27 metadata if it has been wrapped by middleware,
+	// in which case the host is relied upon to return the proposed encoding and transaction type
+	var proposedMetadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &proposedMetadata); err == nil {
+		if metadata.Encoding != proposedMetadata.Encoding {
+			return sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "expected encoding %s, got %s", proposedMetadata.Encoding, metadata.Encoding)
+		}
+
+		if metadata.TxType != proposedMetadata.TxType {
+			return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "expected transaction type %s, got %s", proposedMetadata.TxType, metadata.TxType)
+		}
+	}
+
+	expectedPrefix, _ := k.GetExpectedAddressPrefix(ctx, metadata.ControllerConnectionId)
+	if err := icatypes.ValidateBech32AccountAddress(metadata.Address, expectedPrefix); err != nil {
+		return sdkerrors.Wrapf(err, "invalid interchain account address returned by host chain over connection %s", metadata.ControllerConnectionId)
 	}
 
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
@@ -122,11 +147,53 @@ func (k Keeper) OnChanOpenAck(
 	return nil
 }
 
-// OnChanCloseConfirm removes the active channel stored in state
+// OnChanCloseInit allows the closing of channels initiated by the controller submodule using CloseChannel and
+// removes the active channel stored in state. User-initiated closes of interchain account channels are rejected.
+// The interchain account address is preserved, allowing a new channel to be opened on the same port
+func (k Keeper) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	store := ctx.KVStore(k.storeKey)
+	key := icatypes.KeyCloseChannel(portID, channelID)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+	}
+
+	store.Delete(key)
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	k.deleteActiveChannel(ctx, channel.ConnectionHops[0], portID, channelID)
+
+	return nil
+}
+
+// OnChanCloseConfirm removes the active channel stored in state.
+// The interchain account address is preserved, allowing a new channel to be opened on the same port
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	k.deleteActiveChannel(ctx, channel.ConnectionHops[0], portID, channelID)
+
 	return nil
 }
+
+// deleteActiveChannel removes the active channel for the provided connectionID and portID if it is set to the provided channelID.
+// Closing a previous channel must not remove the active channel of a channel which has since been reopened
+func (k Keeper) deleteActiveChannel(ctx sdk.Context, connectionID, portID, channelID string) {
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID); found && activeChannelID == channelID {
+		k.DeleteActiveChannelID(ctx, connectionID, portID)
+	}
+}
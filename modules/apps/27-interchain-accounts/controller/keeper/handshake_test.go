@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
@@ -320,6 +322,30 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			},
 			false,
 		},
+		{
+			"counterparty encoding does not match proposed encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
+		{
+			"counterparty transaction type does not match proposed transaction type",
+			func() {
+				metadata.TxType = icatypes.TxTypeSDKMultiMsgBatch
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"unsupported transaction type",
 			func() {
@@ -356,6 +382,45 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			},
 			false,
 		},
+		{
+			"success: account address uses the prefix expected for the connection",
+			func() {
+				params := types.DefaultParams()
+				params.ConnectionAddressPrefixes = []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix(ibctesting.FirstConnectionID, sdk.GetConfig().GetBech32AccountAddrPrefix())}
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"success: prefix is only enforced for the configured connection",
+			func() {
+				params := types.DefaultParams()
+				params.ConnectionAddressPrefixes = []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix("connection-1", "osmo")}
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"account address does not use the prefix expected for the connection",
+			func() {
+				params := types.DefaultParams()
+				params.ConnectionAddressPrefixes = []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix(ibctesting.FirstConnectionID, "osmo")}
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"account address is not bech32",
+			func() {
+				metadata.Address = "invalidaccountaddress"
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"invalid counterparty version",
 			func() {
@@ -1,18 +1,22 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
@@ -26,17 +30,22 @@ type Keeper struct {
 
 	ics4Wrapper   icatypes.ICS4Wrapper
 	channelKeeper icatypes.ChannelKeeper
+	clientKeeper  icatypes.ClientKeeper
 	portKeeper    icatypes.PortKeeper
+	feeKeeper     icatypes.FeeKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
 	msgRouter *baseapp.MsgServiceRouter
+
+	callbacks   map[string]types.ICAControllerCallbacks
+	msgLimiters map[string]types.ICAControllerMsgLimiter
 }
 
 // NewKeeper creates a new interchain accounts controller Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	ics4Wrapper icatypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
+	ics4Wrapper icatypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, clientKeeper icatypes.ClientKeeper, portKeeper icatypes.PortKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -50,12 +59,48 @@ func NewKeeper(
 		paramSpace:    paramSpace,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		portKeeper:    portKeeper,
 		scopedKeeper:  scopedKeeper,
 		msgRouter:     msgRouter,
+		callbacks:     make(map[string]types.ICAControllerCallbacks),
+		msgLimiters:   make(map[string]types.ICAControllerMsgLimiter),
 	}
 }
 
+// RegisterCallbacks registers the callbacks invoked on the acknowledgement or timeout of packets sent on the provided
+// controller port identifier. Callbacks are not persisted and must therefore be registered during app initialisation.
+func (k Keeper) RegisterCallbacks(portID string, callbacks types.ICAControllerCallbacks) {
+	if _, found := k.callbacks[portID]; found {
+		panic(fmt.Sprintf("interchain accounts controller callbacks already registered for port %s", portID))
+	}
+
+	k.callbacks[portID] = callbacks
+}
+
+// RegisterMsgLimiter registers the hook consulted before sending packets on the provided controller port identifier when
+// message counters are enabled. The hook is not persisted and must therefore be registered during app initialisation.
+func (k Keeper) RegisterMsgLimiter(portID string, limiter types.ICAControllerMsgLimiter) {
+	if _, found := k.msgLimiters[portID]; found {
+		panic(fmt.Sprintf("interchain accounts controller msg limiter already registered for port %s", portID))
+	}
+
+	k.msgLimiters[portID] = limiter
+}
+
+// SetFeeKeeper sets the FeeKeeper used to enable fees on channels whose version is updated to include the ICS29 fee
+// version. Channel version updates are rejected if no FeeKeeper is set. The FeeKeeper must be set prior to the keeper
+// being passed to the controller IBCMiddleware.
+func (k *Keeper) SetFeeKeeper(feeKeeper icatypes.FeeKeeper) *Keeper {
+	if k.feeKeeper != nil {
+		panic("cannot set interchain accounts controller fee keeper twice")
+	}
+
+	k.feeKeeper = feeKeeper
+
+	return k
+}
+
 // Logger returns the application logger, scoped to the associated module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
@@ -77,10 +122,15 @@ func (k Keeper) GetAllPorts(ctx sdk.Context) []string {
 	return ports
 }
 
-// BindPort stores the provided portID and binds to it, returning the associated capability
-func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
+// SetPort stores the provided portID. Used in InitGenesis, as the port capability may already be restored from genesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyPort(portID), []byte{0x01})
+}
+
+// BindPort stores the provided portID and binds to it, returning the associated capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
+	k.SetPort(ctx, portID)
 
 	return k.portKeeper.BindPort(ctx, portID)
 }
@@ -106,6 +156,16 @@ func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string
 	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
 }
 
+// GetConnectionID returns the connection identifier of the channel for the provided port and channel identifiers
+func (k Keeper) GetConnectionID(ctx sdk.Context, portID, channelID string) (string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	return channel.ConnectionHops[0], nil
+}
+
 // GetActiveChannelID retrieves the active channelID from the store, keyed by the provided connectionID and portID
 func (k Keeper) GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -135,19 +195,22 @@ func (k Keeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID strin
 }
 
 // GetAllActiveChannels returns a list of all active interchain accounts controller channels and their associated connection and port identifiers
-func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []genesistypes.ActiveChannel {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.ActiveChannelKeyPrefix))
 	defer iterator.Close()
 
-	var activeChannels []icatypes.ActiveChannel
+	var activeChannels []genesistypes.ActiveChannel
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
-
-		ch := icatypes.ActiveChannel{
-			ConnectionId: keySplit[2],
-			PortId:       keySplit[1],
-			ChannelId:    string(iterator.Value()),
+		portID := keySplit[1]
+		connectionID := keySplit[2]
+
+		ch := genesistypes.ActiveChannel{
+			ConnectionId:        connectionID,
+			PortId:              portID,
+			ChannelId:           string(iterator.Value()),
+			IsMiddlewareEnabled: k.IsMiddlewareEnabled(ctx, portID, connectionID),
 		}
 
 		activeChannels = append(activeChannels, ch)
@@ -162,6 +225,12 @@ func (k Keeper) SetActiveChannelID(ctx sdk.Context, connectionID, portID, channe
 	store.Set(icatypes.KeyActiveChannel(portID, connectionID), []byte(channelID))
 }
 
+// DeleteActiveChannelID removes the active channel keyed by the provided connectionID and portID
+func (k Keeper) DeleteActiveChannelID(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyActiveChannel(portID, connectionID))
+}
+
 // IsActiveChannel returns true if there exists an active channel for the provided connectionID and portID, otherwise false
 func (k Keeper) IsActiveChannel(ctx sdk.Context, connectionID, portID string) bool {
 	_, ok := k.GetActiveChannelID(ctx, connectionID, portID)
@@ -181,15 +250,15 @@ func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 }
 
 // GetAllInterchainAccounts returns a list of all registered interchain account addresses and their associated connection and controller port identifiers
-func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []icatypes.RegisteredInterchainAccount {
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.RegisteredInterchainAccount {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.OwnerKeyPrefix))
 
-	var interchainAccounts []icatypes.RegisteredInterchainAccount
+	var interchainAccounts []genesistypes.RegisteredInterchainAccount
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
 
-		acc := icatypes.RegisteredInterchainAccount{
+		acc := genesistypes.RegisteredInterchainAccount{
 			ConnectionId:   keySplit[2],
 			PortId:         keySplit[1],
 			AccountAddress: string(iterator.Value()),
@@ -206,3 +275,168 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// IsMiddlewareEnabled returns true if the underlying application callbacks are enabled for the given port and connection identifier pair, otherwise false.
+// Ports registered before the flag was introduced have no value stored and the underlying application callbacks remain enabled
+func (k Keeper) IsMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyIsMiddlewareEnabled(portID, connectionID))
+	return bz == nil || bytes.Equal(icatypes.MiddlewareEnabled, bz)
+}
+
+// SetMiddlewareEnabled stores a flag to indicate that the underlying application callbacks should be enabled for the given port and connection identifier pair
+func (k Keeper) SetMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyIsMiddlewareEnabled(portID, connectionID), icatypes.MiddlewareEnabled)
+}
+
+// SetMiddlewareDisabled stores a flag to indicate that the underlying application callbacks should be disabled for the given port and connection identifier pair.
+// The controller submodule claims the channel capability of channels opened on the port, allowing packets to be sent using MsgSendTx
+func (k Keeper) SetMiddlewareDisabled(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyIsMiddlewareEnabled(portID, connectionID), icatypes.MiddlewareDisabled)
+}
+
+// GetMsgCount returns the number of messages of the provided type URL sent on the provided connection and port identifiers
+func (k Keeper) GetMsgCount(ctx sdk.Context, connectionID, portID, typeURL string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyMsgCount(portID, connectionID, typeURL))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllMsgCounts returns the number of messages sent by type URL on the provided connection and port identifiers
+func (k Keeper) GetAllMsgCounts(ctx sdk.Context, connectionID, portID string) []types.MsgTypeCount {
+	store := ctx.KVStore(k.storeKey)
+	prefix := icatypes.KeyMsgCountPrefix(portID, connectionID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var msgCounts []types.MsgTypeCount
+	for ; iterator.Valid(); iterator.Next() {
+		msgCount := types.MsgTypeCount{
+			TypeUrl: string(iterator.Key()[len(prefix):]),
+			Count:   sdk.BigEndianToUint64(iterator.Value()),
+		}
+
+		msgCounts = append(msgCounts, msgCount)
+	}
+
+	return msgCounts
+}
+
+// SetMsgCount stores the number of messages of the provided type URL sent on the provided connection and port identifiers
+func (k Keeper) SetMsgCount(ctx sdk.Context, connectionID, portID, typeURL string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyMsgCount(portID, connectionID, typeURL), sdk.Uint64ToBigEndian(count))
+}
+
+// PruneMsgCounts removes all message counts stored for the provided connection and port identifiers, allowing
+// authentication modules to reset the counters at the start of each rate limiting period
+func (k Keeper) PruneMsgCounts(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, icatypes.KeyMsgCountPrefix(portID, connectionID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetPendingPacketData returns the packet data stored for the packet sent with the provided sequence on the provided port and channel identifiers
+func (k Keeper) GetPendingPacketData(ctx sdk.Context, portID, channelID string, sequence uint64) (icatypes.InterchainAccountPacketData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyPendingPacket(portID, channelID, sequence))
+	if bz == nil {
+		return icatypes.InterchainAccountPacketData{}, false
+	}
+
+	var packetData icatypes.InterchainAccountPacketData
+	k.cdc.MustUnmarshal(bz, &packetData)
+
+	return packetData, true
+}
+
+// SetPendingPacketData stores the packet data of the packet sent with the provided sequence on the provided port and channel identifiers
+func (k Keeper) SetPendingPacketData(ctx sdk.Context, portID, channelID string, sequence uint64, packetData icatypes.InterchainAccountPacketData) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyPendingPacket(portID, channelID, sequence), k.cdc.MustMarshal(&packetData))
+}
+
+// DeletePendingPacketData removes the packet data stored for the packet sent with the provided sequence on the provided port and channel identifiers
+func (k Keeper) DeletePendingPacketData(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyPendingPacket(portID, channelID, sequence))
+}
+
+// GetChannelVersionUpdateSequence returns the sequence of the packet updating the version of the provided channel which
+// has not yet been acknowledged or timed out, if any
+func (k Keeper) GetChannelVersionUpdateSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyChannelVersionUpdate(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setChannelVersionUpdateSequence stores the sequence of the packet updating the version of the provided channel
+func (k Keeper) setChannelVersionUpdateSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyChannelVersionUpdate(portID, channelID), sdk.Uint64ToBigEndian(sequence))
+}
+
+// deleteChannelVersionUpdateSequence removes the sequence of the packet updating the version of the provided channel
+func (k Keeper) deleteChannelVersionUpdateSequence(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyChannelVersionUpdate(portID, channelID))
+}
+
+// GetPendingPackets walks the packet commitments of the provided port and channel identifiers, returning the packets which
+// have not yet been acknowledged or timed out in order of sequence. The packet data and the type URLs of the messages it
+// contains are included if the packet data is stored and can be decoded
+func (k Keeper) GetPendingPackets(ctx sdk.Context, portID, channelID string) []types.PendingPacket {
+	commitments := k.channelKeeper.GetAllPacketCommitmentsAtChannel(ctx, portID, channelID)
+	sort.Slice(commitments, func(i, j int) bool {
+		return commitments[i].Sequence < commitments[j].Sequence
+	})
+
+	var encoding string
+	if appVersion, found := k.GetAppVersion(ctx, portID, channelID); found {
+		var metadata icatypes.Metadata
+		if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &metadata); err == nil {
+			encoding = metadata.Encoding
+		}
+	}
+
+	pendingPackets := make([]types.PendingPacket, 0, len(commitments))
+	for _, commitment := range commitments {
+		pendingPacket := types.PendingPacket{Sequence: commitment.Sequence}
+
+		if packetData, found := k.GetPendingPacketData(ctx, portID, channelID, commitment.Sequence); found {
+			pendingPacket.PacketData = &packetData
+
+			if packetData.Type == icatypes.EXECUTE_TX {
+				if msgs, err := icatypes.DeserializeCosmosTx(k.cdc, packetData.Data, encoding); err == nil {
+					for _, msg := range msgs {
+						pendingPacket.MsgTypeUrls = append(pendingPacket.MsgTypeUrls, sdk.MsgTypeURL(msg))
+					}
+				}
+			}
+		}
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
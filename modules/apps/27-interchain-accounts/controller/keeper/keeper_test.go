@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
@@ -25,6 +30,15 @@ var (
 		Encoding:               icatypes.EncodingProtobuf,
 		TxType:                 icatypes.TxTypeSDKMultiMsg,
 	}))
+
+	// TestBatchVersion defines a resuable interchainaccounts version string supporting batch packets for testing purposes
+	TestBatchVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsgBatch,
+	}))
 )
 
 type KeeperTestSuite struct {
@@ -59,7 +73,16 @@ func NewICAPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 
 // SetupICAPath invokes the InterchainAccounts entrypoint and subsequent channel handshake handlers
 func SetupICAPath(path *ibctesting.Path, owner string) error {
-	if err := RegisterInterchainAccount(path.EndpointA, owner); err != nil {
+	return SetupICAPathWithVersion(path, owner, TestVersion)
+}
+
+// SetupICAPathWithVersion invokes the InterchainAccounts entrypoint using the provided version and subsequent channel
+// handshake handlers
+func SetupICAPathWithVersion(path *ibctesting.Path, owner, version string) error {
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	if err := registerInterchainAccountWithVersion(path.EndpointA, owner, version); err != nil {
 		return err
 	}
 
@@ -80,6 +103,10 @@ func SetupICAPath(path *ibctesting.Path, owner string) error {
 
 // RegisterInterchainAccount is a helper function for starting the channel handshake
 func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) error {
+	return registerInterchainAccountWithVersion(endpoint, owner, TestVersion)
+}
+
+func registerInterchainAccountWithVersion(endpoint *ibctesting.Endpoint, owner, version string) error {
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
 		return err
@@ -87,7 +114,7 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 
 	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
 
-	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, TestVersion); err != nil {
+	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, version); err != nil {
 		return err
 	}
 
@@ -169,17 +196,20 @@ func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
 	suite.Require().NoError(err)
 
 	suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedChannelID)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), expectedPortID, ibctesting.FirstConnectionID)
 
-	expectedChannels := []icatypes.ActiveChannel{
+	expectedChannels := []genesistypes.ActiveChannel{
 		{
-			ConnectionId: ibctesting.FirstConnectionID,
-			PortId:       TestPortID,
-			ChannelId:    path.EndpointA.ChannelID,
+			ConnectionId:        ibctesting.FirstConnectionID,
+			PortId:              TestPortID,
+			ChannelId:           path.EndpointA.ChannelID,
+			IsMiddlewareEnabled: true,
 		},
 		{
-			ConnectionId: ibctesting.FirstConnectionID,
-			PortId:       expectedPortID,
-			ChannelId:    expectedChannelID,
+			ConnectionId:        ibctesting.FirstConnectionID,
+			PortId:              expectedPortID,
+			ChannelId:           expectedChannelID,
+			IsMiddlewareEnabled: false,
 		},
 	}
 
@@ -207,7 +237,7 @@ func (suite *KeeperTestSuite) TestGetAllInterchainAccounts() {
 
 	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedAccAddr)
 
-	expectedAccounts := []icatypes.RegisteredInterchainAccount{
+	expectedAccounts := []genesistypes.RegisteredInterchainAccount{
 		{
 			ConnectionId:   ibctesting.FirstConnectionID,
 			PortId:         TestPortID,
@@ -252,3 +282,72 @@ func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	suite.Require().True(found)
 	suite.Require().Equal(expectedAccAddr, retrievedAddr)
 }
+
+func (suite *KeeperTestSuite) TestSetMiddlewareEnabled() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+
+	// ports without a stored flag are enabled
+	suite.Require().True(controllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, "connection-100"))
+
+	controllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	suite.Require().False(controllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID))
+
+	controllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	suite.Require().True(controllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID))
+}
+
+func (suite *KeeperTestSuite) TestRegisterCallbacks() {
+	suite.SetupTest()
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.RegisterCallbacks(TestPortID, &mockCallbacks{})
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().ICAControllerKeeper.RegisterCallbacks(TestPortID, &mockCallbacks{})
+	})
+}
+
+func (suite *KeeperTestSuite) TestRegisterMsgLimiter() {
+	suite.SetupTest()
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.RegisterMsgLimiter(TestPortID, &mockMsgLimiter{})
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().ICAControllerKeeper.RegisterMsgLimiter(TestPortID, &mockMsgLimiter{})
+	})
+}
+
+func (suite *KeeperTestSuite) TestPruneMsgCounts() {
+	suite.SetupTest()
+
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegateTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+
+	controllerKeeper.SetMsgCount(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, msgSendTypeURL, 1)
+	controllerKeeper.SetMsgCount(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, msgDelegateTypeURL, 2)
+	controllerKeeper.SetMsgCount(suite.chainA.GetContext(), "connection-1", TestPortID, msgSendTypeURL, 3)
+
+	expMsgCounts := []types.MsgTypeCount{
+		{TypeUrl: msgSendTypeURL, Count: 1},
+		{TypeUrl: msgDelegateTypeURL, Count: 2},
+	}
+
+	suite.Require().ElementsMatch(expMsgCounts, controllerKeeper.GetAllMsgCounts(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID))
+
+	controllerKeeper.PruneMsgCounts(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+
+	suite.Require().Empty(controllerKeeper.GetAllMsgCounts(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID))
+	suite.Require().Zero(controllerKeeper.GetMsgCount(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, msgSendTypeURL))
+
+	// counts of other connections are preserved
+	suite.Require().Equal(uint64(3), controllerKeeper.GetMsgCount(suite.chainA.GetContext(), "connection-1", TestPortID, msgSendTypeURL))
+}
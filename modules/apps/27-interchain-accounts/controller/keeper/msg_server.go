@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ICS27 controller MsgServer interface for the provided Keeper
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
+// The controller port identifier is derived from the optional alias and the owner, which must be the signer of the message.
+// The channel capability is claimed by the controller submodule, the underlying application is not
+// called for channels opened using this message
+func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner, msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
+
+	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version)
+	if err != nil {
+		s.Logger(ctx).Error("error registering interchain account", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully registered interchain account", "channel-id", channelID)
+
+	EmitRegisterInterchainAccountEvent(ctx, msg.Owner, msg.ConnectionId, portID, channelID)
+
+	return &types.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+		PortId:    portID,
+	}, nil
+}
+
+// ReopenChannel defines a rpc handler for MsgReopenChannel.
+// The controller port identifier is derived from the optional alias and the owner, which must be the signer of the message.
+// A new channel handshake is initiated using the version of the most recently closed channel
+func (s msgServer) ReopenChannel(goCtx context.Context, msg *types.MsgReopenChannel) (*types.MsgReopenChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner, msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := s.Keeper.ReopenChannel(ctx, msg.ConnectionId, portID)
+	if err != nil {
+		s.Logger(ctx).Error("error reopening interchain account channel", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully reopened interchain account channel", "channel-id", channelID)
+
+	return &types.MsgReopenChannelResponse{ChannelId: channelID}, nil
+}
+
+// CloseChannel defines a rpc handler for MsgCloseChannel.
+// The controller port identifier is derived from the optional alias and the owner, which must be the signer of the message.
+// The closing of the open active channel of the owner's interchain account is initiated
+func (s msgServer) CloseChannel(goCtx context.Context, msg *types.MsgCloseChannel) (*types.MsgCloseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner, msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.IsBound(ctx, portID) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "port %s for owner %s is not bound by the interchain accounts controller", portID, msg.Owner)
+	}
+
+	channelID, err := s.Keeper.CloseChannel(ctx, msg.ConnectionId, portID)
+	if err != nil {
+		s.Logger(ctx).Error("error closing interchain account channel", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully closed interchain account channel", "channel-id", channelID)
+
+	return &types.MsgCloseChannelResponse{ChannelId: channelID}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx.
+// The controller port identifier is derived from the optional alias and the owner, which must be the signer of the message.
+// The packet is sent on the active channel of the owner's interchain account using the channel
+// capability claimed by the controller submodule during the channel handshake
+func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner, msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.IsBound(ctx, portID) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "port %s for owner %s is not bound by the interchain accounts controller", portID, msg.Owner)
+	}
+
+	activeChannelID, found := s.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	chanCap, found := s.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return nil, sdkerrors.Wrapf(capabilitytypes.ErrCapabilityNotFound, "failed to retrieve channel capability for channel %s on port %s", activeChannelID, portID)
+	}
+
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+	sequence, err := s.Keeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{Sequence: sequence}, nil
+}
+
+// UpdateChannelVersion defines a rpc handler for MsgUpdateChannelVersion.
+// The controller port identifier is derived from the optional alias and the owner, which must be the signer of the message.
+// The version update is sent on the active channel of the owner's interchain account using the channel
+// capability claimed by the controller submodule during the channel handshake
+func (s msgServer) UpdateChannelVersion(goCtx context.Context, msg *types.MsgUpdateChannelVersion) (*types.MsgUpdateChannelVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner, msg.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.IsBound(ctx, portID) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "port %s for owner %s is not bound by the interchain accounts controller", portID, msg.Owner)
+	}
+
+	activeChannelID, found := s.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	chanCap, found := s.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return nil, sdkerrors.Wrapf(capabilitytypes.ErrCapabilityNotFound, "failed to retrieve channel capability for channel %s on port %s", activeChannelID, portID)
+	}
+
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+	sequence, err := s.Keeper.UpdateChannelVersion(ctx, chanCap, msg.ConnectionId, portID, msg.Version, absoluteTimeout)
+	if err != nil {
+		s.Logger(ctx).Error("error updating interchain account channel version", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully sent interchain account channel version update", "channel-id", activeChannelID, "sequence", sequence)
+
+	return &types.MsgUpdateChannelVersionResponse{Sequence: sequence}, nil
+}
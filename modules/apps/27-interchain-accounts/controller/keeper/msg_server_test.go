@@ -0,0 +1,472 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgRegisterInterchainAccount() {
+	var (
+		path       *ibctesting.Path
+		msg        *types.MsgRegisterInterchainAccount
+		expVersion string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: empty version",
+			func() {
+				msg.Version = ""
+
+				// the fee middleware wraps the default interchain accounts metadata when no version is provided
+				defaultMetadata := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+				expVersion = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: defaultMetadata}))
+			},
+			nil,
+		},
+		{
+			"success: ics27 version",
+			func() {},
+			nil,
+		},
+		{
+			"success: fee enabled version",
+			func() {
+				msg.Version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: TestVersion}))
+				expVersion = msg.Version
+			},
+			nil,
+		},
+		{
+			"invalid version",
+			func() {
+				msg.Version = "invalid-version"
+			},
+			icatypes.ErrUnknownDataType,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"port is already bound by another module",
+			func() {
+				portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCKeeper.PortKeeper.BindPort(suite.chainA.GetContext(), portID)
+			},
+			icatypes.ErrPortAlreadyBound,
+		},
+		{
+			"interchain account is already registered with an active channel",
+			func() {
+				err := SetupICAPathWithoutMiddleware(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			},
+			icatypes.ErrActiveChannelAlreadySet,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion)
+			expVersion = TestVersion
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expPortID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPortID, res.PortId)
+				suite.Require().Equal(ibctesting.FirstChannelID, res.ChannelId)
+
+				channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, res.PortId, res.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(expVersion, channel.Version)
+
+				// the channel capability is claimed by the controller submodule
+				suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(ctx, res.PortId, msg.ConnectionId))
+				_, found = suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(ctx, host.ChannelCapabilityPath(res.PortId, res.ChannelId))
+				suite.Require().True(found)
+
+				expEvent := sdk.NewEvent(
+					icatypes.EventTypeRegisterInterchainAccount,
+					sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+					sdk.NewAttribute(icatypes.AttributeKeyOwner, TestOwnerAddress),
+					sdk.NewAttribute(icatypes.AttributeKeyConnectionID, msg.ConnectionId),
+					sdk.NewAttribute(icatypes.AttributeKeyPortID, res.PortId),
+					sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, res.ChannelId),
+				)
+				suite.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), sdk.Events{expEvent}.ToABCIEvents()[0])
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgReopenChannel() {
+	testCases := []struct {
+		name     string
+		malleate func(path *ibctesting.Path)
+		expErr   error
+	}{
+		{
+			"success",
+			func(path *ibctesting.Path) {
+				suite.timeoutICAChannel(path)
+			},
+			nil,
+		},
+		{
+			"channel is still OPEN",
+			func(path *ibctesting.Path) {},
+			icatypes.ErrInvalidAccountReopening,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate(path)
+
+			msg := types.NewMsgReopenChannel(path.EndpointA.ConnectionID, TestOwnerAddress)
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ReopenChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().NotEqual(path.EndpointA.ChannelID, res.ChannelId)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCloseChannel() {
+	testCases := []struct {
+		name     string
+		malleate func(path *ibctesting.Path)
+		owner    string
+		expErr   error
+	}{
+		{
+			"success",
+			func(path *ibctesting.Path) {},
+			TestOwnerAddress,
+			nil,
+		},
+		{
+			"channel is already closed",
+			func(path *ibctesting.Path) {
+				suite.timeoutICAChannel(path)
+			},
+			TestOwnerAddress,
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"port is not bound by the controller for the signer",
+			func(path *ibctesting.Path) {},
+			suite.chainA.SenderAccount.GetAddress().String(),
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate(path)
+
+			msg := types.NewMsgCloseChannel(path.EndpointA.ConnectionID, tc.owner)
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.CloseChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+				suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSendTx() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgSendTx
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"unauthorized owner",
+			func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"interchain account not registered on connection",
+			func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"channel capability owned by the authentication module",
+			func() {
+				authPath := NewICAPath(suite.chainA, suite.chainB)
+				authPath.EndpointA.ClientID = path.EndpointA.ClientID
+				authPath.EndpointB.ClientID = path.EndpointB.ClientID
+				authPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				authPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+				path = authPath
+
+				// register an interchain account for a second owner using the authentication module
+				owner := suite.chainA.SenderAccount.GetAddress().String()
+				err := SetupICAPath(path, owner)
+				suite.Require().NoError(err)
+
+				msg.Owner = owner
+			},
+			capabilitytypes.ErrCapabilityNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithoutMiddleware(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaMsg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{icaMsg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msg = types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, 100000, packetData)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateChannelVersion() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgUpdateChannelVersion
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, 0, false, 0, nil))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"unauthorized owner",
+			func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"interchain account not registered on connection",
+			func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"version unchanged",
+			func() {
+				msg.Version = path.EndpointA.GetChannel().Version
+			},
+			icatypes.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithoutMiddleware(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			channel := path.EndpointA.GetChannel()
+			version := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: channel.Version}))
+
+			msg = types.NewMsgUpdateChannelVersion(TestOwnerAddress, path.EndpointA.ConnectionID, version, 100000)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.UpdateChannelVersion(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// SetupICAPathWithoutMiddleware opens an interchain accounts channel using MsgRegisterInterchainAccount, such that
+// the underlying application is disabled and the channel capability is claimed by the controller submodule
+func SetupICAPathWithoutMiddleware(path *ibctesting.Path, owner string) error {
+	msgServer := keeper.NewMsgServerImpl(path.EndpointA.Chain.GetSimApp().ICAControllerKeeper)
+	msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, TestVersion)
+
+	res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(path.EndpointA.Chain.GetContext()), msg)
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = res.ChannelId
+	path.EndpointA.ChannelConfig.PortID = res.PortId
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
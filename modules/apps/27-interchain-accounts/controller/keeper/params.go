@@ -14,9 +14,53 @@ func (k Keeper) IsControllerEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetDefaultRelativeTimeout retrieves the timeout in nanoseconds applied to packets sent without a timeout timestamp from the
+// paramstore. Zero is returned if no default timeout is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetDefaultRelativeTimeout(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyDefaultRelativeTimeout, &res)
+	return res
+}
+
+// IsMsgCountersEnabled retrieves the msg counters enabled boolean from the paramstore.
+// True is returned if messages sent using SendTx are counted by type URL, false is returned if the param has not been initialized by a chain upgrade.
+func (k Keeper) IsMsgCountersEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyMsgCountersEnabled, &res)
+	return res
+}
+
+// GetMaxPacketDataSize retrieves the maximum size in bytes of the data of a packet sent using SendTx from the paramstore.
+// Zero is returned if no limit is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxPacketDataSize(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxPacketDataSize, &res)
+	return res
+}
+
+// GetConnectionAddressPrefixes retrieves the bech32 prefixes expected of the interchain account addresses returned by the
+// host chain of each connection from the paramstore.
+func (k Keeper) GetConnectionAddressPrefixes(ctx sdk.Context) []types.ConnectionAddressPrefix {
+	var res []types.ConnectionAddressPrefix
+	k.paramSpace.GetIfExists(ctx, types.KeyConnectionAddressPrefixes, &res)
+	return res
+}
+
+// GetExpectedAddressPrefix returns the bech32 prefix expected of the interchain account addresses returned by the host
+// chain of the provided connection. False is returned if no prefix is configured for the connection.
+func (k Keeper) GetExpectedAddressPrefix(ctx sdk.Context, connectionID string) (string, bool) {
+	for _, prefix := range k.GetConnectionAddressPrefixes(ctx) {
+		if prefix.ConnectionId == connectionID {
+			return prefix.AddressPrefix, true
+		}
+	}
+
+	return "", false
+}
+
 // GetParams returns the total set of the controller submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsControllerEnabled(ctx))
+	return types.NewParams(k.IsControllerEnabled(ctx), k.GetDefaultRelativeTimeout(ctx), k.IsMsgCountersEnabled(ctx), k.GetMaxPacketDataSize(ctx), k.GetConnectionAddressPrefixes(ctx))
 }
 
 // SetParams sets the total set of the controller submodule parameters.
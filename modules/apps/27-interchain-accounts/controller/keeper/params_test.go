@@ -9,6 +9,8 @@ func (suite *KeeperTestSuite) TestParams() {
 	suite.Require().Equal(expParams, params)
 
 	expParams.ControllerEnabled = false
+	expParams.DefaultRelativeTimeout = 600000000000
+	expParams.MsgCountersEnabled = true
 	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
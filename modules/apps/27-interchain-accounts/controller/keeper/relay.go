@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -12,10 +13,22 @@ import (
 
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
 // The packet sequence for the outgoing packet is returned as a result.
-// If the base application has the capability to send on the provided portID. An appropriate
-// absolute timeoutTimestamp must be provided. If the packet is timed out, the channel will be closed.
+// If the base application has the capability to send on the provided portID. Batch packets may only be sent on
+// channels which negotiated the sdk_multi_msg_batch transaction type in their version metadata. An appropriate
+// absolute timeoutTimestamp must be provided, or zero to apply the DefaultRelativeTimeout param relative to the
+// timestamp of the latest consensus state of the host chain. If the packet is timed out, the channel will be closed.
 // In the case of channel closure, a new channel may be reopened to reconnect to the host chain.
+// Packets updating the channel version must be sent using UpdateChannelVersion.
 func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
+	if icaPacketData.Type == icatypes.UPDATE_CHANNEL_VERSION {
+		return 0, sdkerrors.Wrap(icatypes.ErrInvalidOutgoingData, "channel version updates must be sent using UpdateChannelVersion")
+	}
+
+	return k.sendTx(ctx, chanCap, connectionID, portID, icaPacketData, timeoutTimestamp)
+}
+
+// sendTx sends a packet containing the provided packet data on the active channel as described in SendTx
+func (k Keeper) sendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if !found {
 		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
@@ -29,11 +42,266 @@ func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, con
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
+	if timeoutTimestamp == 0 {
+		var err error
+		if timeoutTimestamp, err = k.getDefaultTimeoutTimestamp(ctx, connectionID); err != nil {
+			return 0, err
+		}
+	}
+
 	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
 		return 0, icatypes.ErrInvalidTimeoutTimestamp
 	}
 
-	return k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData, timeoutTimestamp)
+	if icaPacketData.Type == icatypes.EXECUTE_TX_BATCH {
+		metadata, err := k.getChannelMetadata(ctx, portID, activeChannelID)
+		if err != nil {
+			return 0, err
+		}
+
+		if metadata.TxType != icatypes.TxTypeSDKMultiMsgBatch {
+			return 0, sdkerrors.Wrapf(icatypes.ErrUnsupported, "transaction type %s was not negotiated for channel %s on port %s", icatypes.TxTypeSDKMultiMsgBatch, activeChannelID, portID)
+		}
+	}
+
+	var msgCounts []types.MsgTypeCount
+	if k.IsMsgCountersEnabled(ctx) {
+		var err error
+		if msgCounts, err = k.countMsgs(ctx, connectionID, portID, activeChannelID, icaPacketData); err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err := k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, msgCount := range msgCounts {
+		k.SetMsgCount(ctx, connectionID, portID, msgCount.TypeUrl, msgCount.Count)
+	}
+
+	return sequence, nil
+}
+
+// countMsgs returns the updated number of messages sent by type URL on the provided connection and port identifiers
+// after sending the messages contained in the provided packet data. An error is returned if an updated count exceeds
+// the limit returned by the ICAControllerMsgLimiter registered for the port, if any. Query packets
+// and channel version update packets contain no messages and are not counted, the messages of each transaction of a
+// batch packet are counted
+func (k Keeper) countMsgs(ctx sdk.Context, connectionID, portID, channelID string, icaPacketData icatypes.InterchainAccountPacketData) ([]types.MsgTypeCount, error) {
+	if icaPacketData.Type == icatypes.EXECUTE_QUERY || icaPacketData.Type == icatypes.UPDATE_CHANNEL_VERSION {
+		return nil, nil
+	}
+
+	metadata, err := k.getChannelMetadata(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []sdk.Msg
+	if icaPacketData.Type == icatypes.EXECUTE_TX_BATCH {
+		msgGroups, err := icatypes.DeserializeCosmosTxBatch(k.cdc, icaPacketData.Data, metadata.Encoding)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "failed to deserialize interchain account transaction batch: %s", err)
+		}
+
+		for _, txMsgs := range msgGroups {
+			msgs = append(msgs, txMsgs...)
+		}
+	} else {
+		if msgs, err = icatypes.DeserializeCosmosTx(k.cdc, icaPacketData.Data, metadata.Encoding); err != nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "failed to deserialize interchain account transaction: %s", err)
+		}
+	}
+
+	var msgCounts []types.MsgTypeCount
+	indexes := make(map[string]int)
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+
+		i, found := indexes[typeURL]
+		if !found {
+			i = len(msgCounts)
+			indexes[typeURL] = i
+			msgCounts = append(msgCounts, types.MsgTypeCount{TypeUrl: typeURL, Count: k.GetMsgCount(ctx, connectionID, portID, typeURL)})
+		}
+
+		msgCounts[i].Count++
+	}
+
+	if limiter, found := k.msgLimiters[portID]; found {
+		for _, msgCount := range msgCounts {
+			if limit, found := limiter.MsgLimit(ctx, connectionID, portID, msgCount.TypeUrl); found && msgCount.Count > limit {
+				return nil, sdkerrors.Wrapf(types.ErrMsgLimitExceeded, "sending messages of type %s would exceed the limit of %d on connection %s for port %s", msgCount.TypeUrl, limit, connectionID, portID)
+			}
+		}
+	}
+
+	return msgCounts, nil
+}
+
+// SendTxBatch serializes each of the provided groups of messages into its own CosmosTx and sends them in a single batch
+// packet containing a CosmosTxBatch, such that multiple independent transactions are executed by the host chain without waiting for the
+// acknowledgement of each one in turn. The host executes each transaction atomically using its own cached context and
+// reports the result of each transaction in the acknowledgement, a failed transaction does not revert the state changes
+// of the other transactions of the batch. The active channel must have negotiated the sdk_multi_msg_batch transaction
+// type in its version metadata. The packet is sent as described in SendTx and its sequence is returned.
+func (k Keeper) SendTxBatch(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, msgGroups [][]sdk.Msg, memo string, timeoutTimestamp uint64) (uint64, error) {
+	if len(msgGroups) == 0 {
+		return 0, sdkerrors.Wrap(icatypes.ErrInvalidOutgoingData, "batch must contain at least one transaction")
+	}
+
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	metadata, err := k.getChannelMetadata(ctx, portID, activeChannelID)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, msgs := range msgGroups {
+		if len(msgs) == 0 {
+			return 0, sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "transaction at index %d contains no messages", i)
+		}
+	}
+
+	data, err := icatypes.SerializeCosmosTxBatch(k.cdc, msgGroups, metadata.Encoding)
+	if err != nil {
+		return 0, err
+	}
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX_BATCH,
+		Data: data,
+		Memo: memo,
+	}
+
+	return k.SendTx(ctx, chanCap, connectionID, portID, icaPacketData, timeoutTimestamp)
+}
+
+// UpdateChannelVersion proposes the provided version as the new version of the open active channel of the interchain
+// account registered on the provided connection and port identifiers, allowing fees to be enabled on an existing channel
+// without closing and reopening it. Only additive changes to the current channel version are permitted, as validated
+// by ValidateVersionUpdate, and a FeeKeeper must be set. The proposed version is sent to the host chain in a packet of
+// type UPDATE_CHANNEL_VERSION, which updates the version of the host channel end upon receipt. The version of the
+// controller channel end is updated and fees are enabled once the packet is successfully acknowledged. A single
+// version update may be in flight per channel, other packets may be sent on the channel in the meantime. The packet
+// is sent as described in SendTx and its sequence is returned.
+func (k Keeper) UpdateChannelVersion(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID, version string, timeoutTimestamp uint64) (uint64, error) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	if sequence, found := k.GetChannelVersionUpdateSequence(ctx, portID, activeChannelID); found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "version update of channel %s on port %s is already in flight with sequence %d", activeChannelID, portID, sequence)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, activeChannelID)
+	}
+
+	if err := icatypes.ValidateVersionUpdate(channel.Version, version); err != nil {
+		return 0, err
+	}
+
+	if k.feeKeeper == nil {
+		return 0, sdkerrors.Wrap(icatypes.ErrUnsupported, "fees cannot be enabled on channels of a controller without a fee keeper")
+	}
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.UPDATE_CHANNEL_VERSION,
+		Data: []byte(version),
+	}
+
+	sequence, err := k.sendTx(ctx, chanCap, connectionID, portID, icaPacketData, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	k.setChannelVersionUpdateSequence(ctx, portID, activeChannelID, sequence)
+
+	return sequence, nil
+}
+
+// onChannelVersionUpdateAcknowledged removes the in flight version update of the source channel of the provided packet.
+// If the acknowledgement is successful, the version of the channel is updated to the version contained in the packet
+// data and fees are enabled on the channel
+func (k Keeper) onChannelVersionUpdateAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	k.deleteChannelVersionUpdateSequence(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+
+	ack, err := icatypes.UnmarshalAcknowledgement(acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	if !ack.Success() {
+		k.Logger(ctx).Info("channel version update rejected by host", "port-id", packet.GetSourcePort(), "channel-id", packet.GetSourceChannel(), "error", ack.GetError())
+		return nil
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", packet.GetSourceChannel(), packet.GetSourcePort())
+	}
+
+	channel.Version = string(data.Data)
+	k.channelKeeper.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	k.feeKeeper.SetFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+
+	EmitUpdateChannelVersionEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel.Version)
+
+	return nil
+}
+
+// getChannelMetadata returns the ICS27 metadata of the application version of the provided channel
+func (k Keeper) getChannelMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
+	appVersion, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return icatypes.Metadata{}, sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "failed to retrieve version of channel %s for port %s", channelID, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &metadata); err != nil {
+		return icatypes.Metadata{}, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return metadata, nil
+}
+
+// getDefaultTimeoutTimestamp returns the absolute timeout timestamp obtained by applying the DefaultRelativeTimeout param to the
+// timestamp of the latest consensus state of the client associated with the provided connection
+func (k Keeper) getDefaultTimeoutTimestamp(ctx sdk.Context, connectionID string) (uint64, error) {
+	relativeTimeout := k.GetDefaultRelativeTimeout(ctx)
+	if relativeTimeout == 0 {
+		return 0, sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "timeout timestamp must be provided if no default relative timeout is set")
+	}
+
+	connection, err := k.channelKeeper.GetConnection(ctx, connectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID())
+	if !found {
+		return 0, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "failed to retrieve client %s", connection.GetClientID())
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, connection.GetClientID(), clientState.GetLatestHeight())
+	if !found {
+		return 0, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "failed to retrieve consensus state of client %s at height %s", connection.GetClientID(), clientState.GetLatestHeight())
+	}
+
+	return consensusState.GetTimestamp() + relativeTimeout, nil
 }
 
 func (k Keeper) createOutgoingPacket(
@@ -50,6 +318,11 @@ func (k Keeper) createOutgoingPacket(
 		return 0, sdkerrors.Wrap(err, "invalid interchain account packet data")
 	}
 
+	data := icaPacketData.GetBytes()
+	if err := icatypes.ValidatePacketDataSize(data, k.GetMaxPacketDataSize(ctx)); err != nil {
+		return 0, err
+	}
+
 	// get the next sequence
 	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -57,7 +330,7 @@ func (k Keeper) createOutgoingPacket(
 	}
 
 	packet := channeltypes.NewPacket(
-		icaPacketData.GetBytes(),
+		data,
 		sequence,
 		sourcePort,
 		sourceChannel,
@@ -71,11 +344,55 @@ func (k Keeper) createOutgoingPacket(
 		return 0, err
 	}
 
+	k.SetPendingPacketData(ctx, sourcePort, sourceChannel, packet.Sequence, icaPacketData)
+
 	return packet.Sequence, nil
 }
 
+// OnAcknowledgementPacket removes the pending packet data of the provided packet and invokes the ICAControllerCallbacks
+// registered for the source port of the packet, if any, with the decoded acknowledgement. ICS-29 incentivized
+// acknowledgements are unwrapped before being decoded. The version of the channel is updated if the packet is a
+// successfully acknowledged channel version update
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	k.DeletePendingPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if sequence, found := k.GetChannelVersionUpdateSequence(ctx, packet.GetSourcePort(), packet.GetSourceChannel()); found && sequence == packet.GetSequence() {
+		if err := k.onChannelVersionUpdateAcknowledged(ctx, packet, acknowledgement); err != nil {
+			return err
+		}
+	}
+
+	callbacks, found := k.callbacks[packet.GetSourcePort()]
+	if !found {
+		return nil
+	}
+
+	ack, err := icatypes.UnmarshalAcknowledgement(acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	return callbacks.OnAcknowledgementPacket(ctx, packet, ack)
+}
+
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// due to the semantics of ORDERED channels. The interchain account address is preserved, allowing a new channel to be
+// opened on the same port. The pending packet data of the packet is removed and the ICAControllerCallbacks registered
+// for the source port of the packet, if any, are invoked. Any in flight version update of the channel is removed
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.DeletePendingPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deleteChannelVersionUpdateSequence(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", packet.SourceChannel, packet.SourcePort)
+	}
+
+	k.deleteActiveChannel(ctx, channel.ConnectionHops[0], packet.SourcePort, packet.SourceChannel)
+
+	if callbacks, found := k.callbacks[packet.GetSourcePort()]; found {
+		return callbacks.OnTimeoutPacket(ctx, packet)
+	}
+
 	return nil
 }
@@ -1,14 +1,22 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -37,12 +45,35 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+			},
+			true,
+		},
+		{
+			"success with memo",
+			func() {
+				interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
 					Type: icatypes.EXECUTE_TX,
 					Data: data,
+					Memo: `{"callback": "memo"}`,
 				}
 			},
 			true,
@@ -66,7 +97,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					},
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgsBankSend)
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgsBankSend, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -76,6 +107,26 @@ func (suite *KeeperTestSuite) TestSendTx() {
 			},
 			true,
 		},
+		{
+			"unknown packet data type",
+			func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.Type(100),
+					Data: []byte("data"),
+				}
+			},
+			false,
+		},
+		{
+			"channel version update packet data",
+			func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.UPDATE_CHANNEL_VERSION,
+					Data: []byte(TestVersion),
+				}
+			},
+			false,
+		},
 		{
 			"data is nil",
 			func() {
@@ -138,7 +189,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				packetData = icatypes.InterchainAccountPacketData{
@@ -171,10 +222,15 @@ func (suite *KeeperTestSuite) TestSendTx() {
 
 			tc.malleate() // malleate mutates test data
 
-			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// the packet data, including the memo, is sent unmodified
+				pendingPacketData, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(packetData, pendingPacketData)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -182,22 +238,233 @@ func (suite *KeeperTestSuite) TestSendTx() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
-	var path *ibctesting.Path
+func (suite *KeeperTestSuite) TestSendTxBatch() {
+	var (
+		path      *ibctesting.Path
+		msgGroups [][]sdk.Msg
+	)
 
 	testCases := []struct {
 		msg      string
 		malleate func()
-		expPass  bool
+		expErr   error
 	}{
 		{
 			"success",
 			func() {},
-			true,
+			nil,
+		},
+		{
+			"batch transaction type not negotiated",
+			func() {
+				channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				channel.Version = TestVersion
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+			},
+			icatypes.ErrUnsupported,
+		},
+		{
+			"empty batch",
+			func() {
+				msgGroups = nil
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"empty transaction within batch",
+			func() {
+				msgGroups = append(msgGroups, []sdk.Msg{})
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"active channel not found",
+			func() {
+				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithVersion(path, TestOwnerAddress, TestBatchVersion)
+			suite.Require().NoError(err)
+
+			msgGroups = [][]sdk.Msg{
+				{&banktypes.MsgSend{ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))}},
+				{&stakingtypes.MsgDelegate{ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}},
+			}
+
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(found)
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTxBatch(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, msgGroups, "memo", ^uint64(0))
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				packetData, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(icatypes.EXECUTE_TX_BATCH, packetData.Type)
+				suite.Require().Equal("memo", packetData.Memo)
+
+				txMsgGroups, err := icatypes.DeserializeCosmosTxBatch(suite.chainA.GetSimApp().AppCodec(), packetData.Data, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+				suite.Require().Equal(msgGroups, txMsgGroups)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTxBatchRelay() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPathWithVersion(path, TestOwnerAddress, TestBatchVersion)
+	suite.Require().NoError(err)
+
+	hostParams := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), hostParams)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))))
+	suite.Require().NoError(err)
+
+	chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(found)
+
+	recipient := suite.chainB.SenderAccount.GetAddress().String()
+	msgGroups := [][]sdk.Msg{
+		{&banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))}},
+		// the second transaction exceeds the balance of the interchain account and fails without reverting the others
+		{&banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))}},
+		{&banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))}},
+	}
+
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTxBatch(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, msgGroups, "", ^uint64(0))
+	suite.Require().NoError(err)
+
+	packetData, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+	suite.Require().True(found)
+
+	suite.chainA.NextBlock()
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	ack, err := icatypes.UnmarshalAcknowledgement(ackBz)
+	suite.Require().NoError(err)
+	suite.Require().True(ack.Success())
+
+	batchTxResult, err := icatypes.UnmarshalBatchTxResult(ack.GetResult())
+	suite.Require().NoError(err)
+	suite.Require().Len(batchTxResult.Results, len(msgGroups))
+
+	for i, txResult := range batchTxResult.Results {
+		suite.Require().Equal(uint64(i), txResult.Index)
+		suite.Require().Equal(i != 1, txResult.Success)
+
+		if txResult.Success {
+			suite.Require().Len(txResult.Result.Data, 1)
+		} else {
+			suite.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), txResult.Code)
+			suite.Require().Nil(txResult.Result)
+		}
+	}
+
+	// only the successful transactions are committed
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(700), balance.Amount)
+
+	err = path.EndpointA.AcknowledgePacket(packet, ackBz)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSendTxDefaultRelativeTimeout() {
+	var (
+		path             *ibctesting.Path
+		timeoutTimestamp uint64
+		expTimeout       uint64
+	)
+
+	// defaultRelativeTimeout is the default relative timeout param used in the test cases (10 minutes)
+	defaultRelativeTimeout := uint64(time.Minute.Nanoseconds() * 10)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: explicit timeout timestamp is used",
+			func() {
+				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+				expTimeout = timeoutTimestamp
+			},
+			nil,
+		},
+		{
+			"success: default relative timeout is applied to the latest consensus state timestamp",
+			func() {},
+			nil,
+		},
+		{
+			"default relative timeout is not set",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 0, false, 0, nil))
+			},
+			icatypes.ErrInvalidTimeoutTimestamp,
+		},
+		{
+			"default timeout timestamp is not in the future",
+			func() {
+				// the latest consensus state of the host chain is older than the current block time of the controller chain
+				suite.coordinator.CommitNBlocks(suite.chainA, 2)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 1, false, 0, nil))
+			},
+			icatypes.ErrInvalidTimeoutTimestamp,
+		},
+		{
+			"client has no consensus state at the latest height",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.LatestHeight = clientState.LatestHeight.Increment().(clienttypes.Height)
+				path.EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrConsensusStateNotFound,
 		},
 	}
 
 	for _, tc := range testCases {
+		tc := tc
+
 		suite.Run(tc.msg, func() {
 			suite.SetupTest() // reset
 
@@ -207,26 +474,733 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, defaultRelativeTimeout, false, 0, nil))
+
+			consensusState := path.EndpointA.GetConsensusState(path.EndpointA.GetClientState().GetLatestHeight())
+			timeoutTimestamp = 0
+			expTimeout = consensusState.GetTimestamp() + defaultRelativeTimeout
+
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(found)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
 			tc.malleate() // malleate mutates test data
 
-			packet := channeltypes.NewPacket(
-				[]byte{},
-				1,
-				path.EndpointA.ChannelConfig.PortID,
-				path.EndpointA.ChannelID,
-				path.EndpointB.ChannelConfig.PortID,
-				path.EndpointB.ChannelID,
-				clienttypes.NewHeight(0, 100),
-				0,
-			)
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
 
-			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), expTimeout)
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), sequence)
+				suite.Require().Equal(channeltypes.CommitPacket(suite.chainA.App.AppCodec(), packet), commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+var _ types.ICAControllerMsgLimiter = &mockMsgLimiter{}
+
+// mockMsgLimiter limits the number of messages of each type URL to the configured limits
+type mockMsgLimiter struct {
+	limits map[string]uint64
+}
+
+func (m *mockMsgLimiter) MsgLimit(ctx sdk.Context, connectionID, portID, typeURL string) (uint64, bool) {
+	limit, found := m.limits[typeURL]
+	return limit, found
+}
+
+func (suite *KeeperTestSuite) TestSendTxMaxPacketDataSize() {
+	testCases := []struct {
+		name string
+		// sizeDelta is added to the size of the packet data to obtain the max packet data size param
+		sizeDelta int
+		noLimit   bool
+		expPass   bool
+	}{
+		{"success: no limit", 0, true, true},
+		{"success: packet data size equal to limit", 0, false, true},
+		{"failure: packet data size exceeds limit by one byte", -1, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: "memo",
+			}
+
+			var maxPacketDataSize uint64
+			if !tc.noLimit {
+				maxPacketDataSize = uint64(len(packetData.GetBytes()) + tc.sizeDelta)
+			}
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 0, false, maxPacketDataSize, nil))
+
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(found)
+
+			timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
 			} else {
-				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, icatypes.ErrMaxPacketDataSize)
+				suite.Require().Zero(sequence)
 			}
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSendTxMsgCounters() {
+	var (
+		path         *ibctesting.Path
+		packetData   icatypes.InterchainAccountPacketData
+		limiter      *mockMsgLimiter
+		expMsgCounts []types.MsgTypeCount
+	)
+
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegateTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: messages are counted by type URL",
+			func() {},
+			nil,
+		},
+		{
+			"success: counts are added to previously stored counts",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMsgCount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, msgSendTypeURL, 3)
+				expMsgCounts[0].Count = 5
+			},
+			nil,
+		},
+		{
+			"success: limit is not exceeded",
+			func() {
+				limiter.limits[msgSendTypeURL] = 2
+			},
+			nil,
+		},
+		{
+			"success: msg counters are disabled",
+			func() {
+				limiter.limits[msgSendTypeURL] = 0
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 0, false, 0, nil))
+				expMsgCounts = nil
+			},
+			nil,
+		},
+		{
+			"success: query packets are not counted",
+			func() {
+				limiter.limits[msgSendTypeURL] = 0
+
+				data, err := icatypes.SerializeCosmosQuery([]icatypes.QueryRequest{{Path: "/cosmos.bank.v1beta1.Query/Balance"}}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				packetData.Type = icatypes.EXECUTE_QUERY
+				packetData.Data = data
+				expMsgCounts = nil
+			},
+			nil,
+		},
+		{
+			"limit is exceeded",
+			func() {
+				limiter.limits[msgDelegateTypeURL] = 10
+				limiter.limits[msgSendTypeURL] = 1
+			},
+			types.ErrMsgLimitExceeded,
+		},
+		{
+			"limit is exceeded by previously stored counts",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMsgCount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, msgDelegateTypeURL, 10)
+				limiter.limits[msgDelegateTypeURL] = 10
+			},
+			types.ErrMsgLimitExceeded,
+		},
+		{
+			"packet data cannot be deserialized",
+			func() {
+				packetData.Data = []byte("invalid packet data")
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, 0, true, 0, nil))
+
+			limiter = &mockMsgLimiter{limits: make(map[string]uint64)}
+			suite.chainA.GetSimApp().ICAControllerKeeper.RegisterMsgLimiter(path.EndpointA.ChannelConfig.PortID, limiter)
+
+			expMsgCounts = []types.MsgTypeCount{
+				{TypeUrl: msgSendTypeURL, Count: 2},
+				{TypeUrl: msgDelegateTypeURL, Count: 1},
+			}
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+				&stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: suite.chainB.Vals.Validators[0].Address.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				},
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			prevMsgCounts := suite.chainA.GetSimApp().ICAControllerKeeper.GetAllMsgCounts(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(found)
+
+			timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+
+			msgCounts := suite.chainA.GetSimApp().ICAControllerKeeper.GetAllMsgCounts(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expMsgCounts, msgCounts)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(prevMsgCounts, msgCounts)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	var (
+		path               *ibctesting.Path
+		expActiveChannelID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: active channel set to a different channel is preserved",
+			func() {
+				expActiveChannelID = "channel-100"
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, expActiveChannelID)
+			},
+			true,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expActiveChannelID = ""
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			packet := channeltypes.NewPacket(
+				[]byte{},
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().Equal(expActiveChannelID != "", found)
+				suite.Require().Equal(expActiveChannelID, activeChannelID)
+
+				// the interchain account address is preserved
+				_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+var _ types.ICAControllerCallbacks = &mockCallbacks{}
+
+// mockCallbacks records the invocations of the ICAControllerCallbacks
+type mockCallbacks struct {
+	acks     []channeltypes.Acknowledgement
+	timeouts []channeltypes.Packet
+	err      error
+}
+
+func (m *mockCallbacks) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	m.acks = append(m.acks, ack)
+	return m.err
+}
+
+func (m *mockCallbacks) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	m.timeouts = append(m.timeouts, packet)
+	return m.err
+}
+
+func (suite *KeeperTestSuite) TestControllerCallbacks() {
+	var (
+		callbacks  *mockCallbacks
+		ack        channeltypes.Acknowledgement
+		ackBz      []byte
+		expInvoked bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: result acknowledgement",
+			func() {},
+			nil,
+		},
+		{
+			"success: error acknowledgement",
+			func() {
+				ack = icatypes.NewErrorAcknowledgement(icatypes.ErrUnknownDataType)
+				ackBz = ack.Acknowledgement()
+			},
+			nil,
+		},
+		{
+			"success: incentivized acknowledgement of a fee enabled channel",
+			func() {
+				ackBz = feetypes.NewIncentivizedAcknowledgement(suite.chainB.SenderAccount.GetAddress().String(), ack.Acknowledgement(), true).Acknowledgement()
+			},
+			nil,
+		},
+		{
+			"success: callbacks registered for a different port are not invoked",
+			func() {
+				callbacks = &mockCallbacks{}
+				suite.chainA.GetSimApp().ICAControllerKeeper.RegisterCallbacks("icacontroller-other", callbacks)
+
+				expInvoked = false
+			},
+			nil,
+		},
+		{
+			"callback returns an error",
+			func() {
+				callbacks.err = icatypes.ErrUnsupported
+			},
+			icatypes.ErrUnsupported,
+		},
+		{
+			"invalid acknowledgement",
+			func() {
+				ackBz = []byte("invalid acknowledgement")
+			},
+			icatypes.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			callbacks = &mockCallbacks{}
+			suite.chainA.GetSimApp().ICAControllerKeeper.RegisterCallbacks(path.EndpointA.ChannelConfig.PortID, callbacks)
+
+			ack = channeltypes.NewResultAcknowledgement([]byte("result"))
+			ackBz = ack.Acknowledgement()
+			expInvoked = true
+
+			tc.malleate() // malleate mutates test data
+
+			packet := channeltypes.NewPacket(
+				[]byte{},
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ackErr := suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ackBz)
+			timeoutErr := suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(ackErr)
+				suite.Require().NoError(timeoutErr)
+
+				if expInvoked {
+					suite.Require().Equal([]channeltypes.Acknowledgement{ack}, callbacks.acks)
+					suite.Require().Equal([]channeltypes.Packet{packet}, callbacks.timeouts)
+				} else {
+					suite.Require().Empty(callbacks.acks)
+					suite.Require().Empty(callbacks.timeouts)
+				}
+			} else {
+				suite.Require().ErrorIs(ackErr, tc.expErr)
+			}
+		})
+	}
+}
+
+// newFeeVersion returns the version of the provided channel wrapped in the ICS29 fee metadata
+func newFeeVersion(endpoint *ibctesting.Endpoint) string {
+	channel := endpoint.GetChannel()
+	return string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: channel.Version}))
+}
+
+func (suite *KeeperTestSuite) TestUpdateChannelVersion() {
+	var (
+		path    *ibctesting.Path
+		version string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"version unchanged",
+			func() {
+				version = path.EndpointA.GetChannel().Version
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"encoding changed",
+			func() {
+				metadata, err := icatypes.MetadataFromVersion(path.EndpointA.GetChannel().Version)
+				suite.Require().NoError(err)
+
+				metadata.Encoding = icatypes.EncodingProto3JSON
+				appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: appVersion}))
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"version update already in flight",
+			func() {
+				chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().True(found)
+
+				_, err := suite.chainA.GetSimApp().ICAControllerKeeper.UpdateChannelVersion(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, version, ^uint64(0))
+				suite.Require().NoError(err)
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"active channel not found",
+			func() {
+				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(found)
+
+			version = newFeeVersion(path.EndpointA)
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.UpdateChannelVersion(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, version, ^uint64(0))
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				inFlightSequence, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelVersionUpdateSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(sequence, inFlightSequence)
+
+				// the channel version is not updated until the packet is acknowledged
+				suite.Require().NotEqual(version, path.EndpointA.GetChannel().Version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateChannelVersionRelay() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expUpdate bool
+	}{
+		{
+			"success: version update accepted by the host",
+			func() {},
+			true,
+		},
+		{
+			"version update rejected by the host: host channel metadata differs",
+			func() {
+				channel := path.EndpointB.GetChannel()
+
+				metadata, err := icatypes.MetadataFromVersion(channel.Version)
+				suite.Require().NoError(err)
+
+				metadata.TxType = icatypes.TxTypeSDKMultiMsgBatch
+				channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				path.EndpointB.SetChannel(channel)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			controllerVersion := path.EndpointA.GetChannel().Version
+			hostVersion := path.EndpointB.GetChannel().Version
+			version := newFeeVersion(path.EndpointA)
+
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(found)
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.UpdateChannelVersion(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, version, ^uint64(0))
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.UPDATE_CHANNEL_VERSION, Data: []byte(version)}
+			packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+
+			suite.chainA.NextBlock()
+
+			_, ackBz, err := path.RelayPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ack, err := icatypes.UnmarshalAcknowledgement(ackBz)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expUpdate, ack.Success())
+
+			_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelVersionUpdateSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().False(found)
+
+			controllerFeeEnabled := suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			hostFeeEnabled := suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expUpdate, controllerFeeEnabled)
+			suite.Require().Equal(tc.expUpdate, hostFeeEnabled)
+
+			if tc.expUpdate {
+				suite.Require().Equal(version, path.EndpointA.GetChannel().Version)
+				suite.Require().Equal(version, path.EndpointB.GetChannel().Version)
+
+				// the application version of the channel is unchanged
+				appVersion, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAppVersion(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(controllerVersion, appVersion)
+			} else {
+				suite.Require().Equal(controllerVersion, path.EndpointA.GetChannel().Version)
+				suite.Require().Equal(hostVersion, path.EndpointB.GetChannel().Version)
+			}
+		})
+	}
+}
+
+// TestUpdateChannelVersionPacketInFlight tests that a packet sent after a channel version update, but before the update is
+// acknowledged, is executed by the host and acknowledged on the controller once fees are enabled on both channel ends
+func (suite *KeeperTestSuite) TestUpdateChannelVersionPacketInFlight() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	hostParams := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), hostParams)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))))
+	suite.Require().NoError(err)
+
+	chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(found)
+
+	version := newFeeVersion(path.EndpointA)
+	updateSequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.UpdateChannelVersion(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, version, ^uint64(0))
+	suite.Require().NoError(err)
+
+	// a second version update cannot be sent while the first is in flight
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.UpdateChannelVersion(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, version, ^uint64(0))
+	suite.Require().ErrorIs(err, icatypes.ErrInvalidVersion)
+
+	msg := &banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))}
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	txPacketData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	txSequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, txPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+
+	updatePacketData := icatypes.InterchainAccountPacketData{Type: icatypes.UPDATE_CHANNEL_VERSION, Data: []byte(version)}
+	updatePacket := channeltypes.NewPacket(updatePacketData.GetBytes(), updateSequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	txPacket := channeltypes.NewPacket(txPacketData.GetBytes(), txSequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+
+	// the version update is received by the host prior to the packet in flight, which is therefore acknowledged using
+	// an incentivized acknowledgement
+	suite.chainA.NextBlock()
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := path.EndpointB.RecvPacketWithResult(updatePacket)
+	suite.Require().NoError(err)
+
+	updateAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = path.EndpointB.RecvPacketWithResult(txPacket)
+	suite.Require().NoError(err)
+
+	txAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var incentivizedAck feetypes.IncentivizedAcknowledgement
+	suite.Require().NoError(feetypes.ModuleCdc.UnmarshalJSON(txAck, &incentivizedAck))
+	suite.Require().True(incentivizedAck.Success())
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(900), balance.Amount)
+
+	// the acknowledgements are processed in order on the controller
+	err = path.EndpointA.AcknowledgePacket(updatePacket, updateAck)
+	suite.Require().NoError(err)
+	suite.Require().Equal(version, path.EndpointA.GetChannel().Version)
+
+	err = path.EndpointA.AcknowledgePacket(txPacket, txAck)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, txSequence)
+	suite.Require().False(found)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// ICAControllerCallbacks defines the callbacks which may be registered with the controller keeper for a controller
+// port identifier, allowing modules to learn the outcome of interchain accounts transactions without implementing the
+// full IBCModule interface. The callbacks are invoked after the packet has been handled by the controller submodule.
+// An error returned by a callback results in the failure of the acknowledgement or timeout.
+type ICAControllerCallbacks interface {
+	// OnAcknowledgementPacket is called with the decoded acknowledgement of a packet sent on the registered port
+	OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error
+	// OnTimeoutPacket is called when a packet sent on the registered port has timed out
+	OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error
+}
+
+// ICAControllerMsgLimiter defines the hook which may be registered with the controller keeper for a controller port
+// identifier, allowing modules to veto sending packets which would exceed a limit on the number of messages of a given
+// type URL sent on a connection. The hook is only consulted if the MsgCountersEnabled param is set.
+type ICAControllerMsgLimiter interface {
+	// MsgLimit returns the maximum number of messages of the provided type URL which may be counted for the provided
+	// connection and port identifiers, or false if messages of the type URL are not limited
+	MsgLimit(ctx sdk.Context, connectionID, portID, typeURL string) (uint64, bool)
+}
@@ -28,6 +28,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty" yaml:"controller_enabled"`
+	// default_relative_timeout is the timeout in nanoseconds, relative to the timestamp of the latest consensus state
+	// of the host chain, applied to packets sent without a timeout timestamp. Zero disables the default timeout.
+	DefaultRelativeTimeout uint64 `protobuf:"varint,2,opt,name=default_relative_timeout,json=defaultRelativeTimeout,proto3" json:"default_relative_timeout,omitempty" yaml:"default_relative_timeout"`
+	// msg_counters_enabled enables or disables counting the messages sent by type URL for each controller port and
+	// connection.
+	MsgCountersEnabled bool `protobuf:"varint,3,opt,name=msg_counters_enabled,json=msgCountersEnabled,proto3" json:"msg_counters_enabled,omitempty" yaml:"msg_counters_enabled"`
+	// max_packet_data_size defines the maximum size in bytes of the data of a packet sent using SendTx. A value of 0
+	// indicates no limit.
+	MaxPacketDataSize uint64 `protobuf:"varint,4,opt,name=max_packet_data_size,json=maxPacketDataSize,proto3" json:"max_packet_data_size,omitempty" yaml:"max_packet_data_size"`
+	// connection_address_prefixes defines the bech32 prefix expected of the interchain account addresses returned by
+	// the host chain of a connection. Addresses returned over connections without an entry may use any bech32 prefix.
+	ConnectionAddressPrefixes []ConnectionAddressPrefix `protobuf:"bytes,5,rep,name=connection_address_prefixes,json=connectionAddressPrefixes,proto3" json:"connection_address_prefixes" yaml:"connection_address_prefixes"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,8 +82,148 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDefaultRelativeTimeout() uint64 {
+	if m != nil {
+		return m.DefaultRelativeTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMsgCountersEnabled() bool {
+	if m != nil {
+		return m.MsgCountersEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxPacketDataSize() uint64 {
+	if m != nil {
+		return m.MaxPacketDataSize
+	}
+	return 0
+}
+
+func (m *Params) GetConnectionAddressPrefixes() []ConnectionAddressPrefix {
+	if m != nil {
+		return m.ConnectionAddressPrefixes
+	}
+	return nil
+}
+
+// ConnectionAddressPrefix defines the bech32 prefix expected of the interchain account addresses returned by the host
+// chain of a specific connection during the channel handshake.
+type ConnectionAddressPrefix struct {
+	// connection_id is the controller connection identifier the prefix applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// address_prefix is the expected bech32 human readable part of the interchain account address
+	AddressPrefix string `protobuf:"bytes,2,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty" yaml:"address_prefix"`
+}
+
+func (m *ConnectionAddressPrefix) Reset()         { *m = ConnectionAddressPrefix{} }
+func (m *ConnectionAddressPrefix) String() string { return proto.CompactTextString(m) }
+func (*ConnectionAddressPrefix) ProtoMessage()    {}
+func (*ConnectionAddressPrefix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *ConnectionAddressPrefix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionAddressPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionAddressPrefix.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionAddressPrefix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionAddressPrefix.Merge(m, src)
+}
+func (m *ConnectionAddressPrefix) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionAddressPrefix) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionAddressPrefix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionAddressPrefix proto.InternalMessageInfo
+
+func (m *ConnectionAddressPrefix) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionAddressPrefix) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+// MsgTypeCount defines the number of messages of a given type URL sent by an interchain account controller port on a
+// connection.
+type MsgTypeCount struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	Count   uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MsgTypeCount) Reset()         { *m = MsgTypeCount{} }
+func (m *MsgTypeCount) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeCount) ProtoMessage()    {}
+func (*MsgTypeCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{2}
+}
+func (m *MsgTypeCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeCount.Merge(m, src)
+}
+func (m *MsgTypeCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeCount proto.InternalMessageInfo
+
+func (m *MsgTypeCount) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*ConnectionAddressPrefix)(nil), "ibc.applications.interchain_accounts.controller.v1.ConnectionAddressPrefix")
+	proto.RegisterType((*MsgTypeCount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTypeCount")
 }
 
 func init() {
@@ -79,23 +231,42 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0xce, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe2,
-	0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x19, 0x65, 0x26, 0x25, 0xeb, 0x21, 0x1b, 0xa2, 0x87,
-	0xc5, 0x10, 0x3d, 0x24, 0x6d, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xed, 0xfa,
-	0x20, 0x16, 0xc4, 0x24, 0xa5, 0x30, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x1f,
-	0x2e, 0x21, 0x84, 0x86, 0xf8, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x0e, 0x27, 0xd9, 0x4f, 0xf7, 0xe4, 0x25, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0xd5,
-	0x28, 0x05, 0x09, 0x22, 0x04, 0x5d, 0x21, 0x62, 0x4e, 0x59, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x15, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66,
-	0xa2, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x0a, 0xa0, 0x62, 0x7d, 0x23, 0x73, 0x5d,
-	0x84, 0xb7, 0x74, 0xb1, 0x85, 0x4d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x2b, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0xd4, 0x5d, 0xad, 0x5b, 0x01, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0xaf, 0x97, 0xaf, 0x1d, 0x5a, 0x50, 0x4d, 0x00, 0x97, 0x0a, 0x3b, 0x1a, 0x36,
+	0x11, 0x52, 0x6c, 0x35, 0x20, 0x21, 0x21, 0x21, 0x81, 0x03, 0x0b, 0x04, 0x48, 0xc1, 0x84, 0x0d,
+	0x12, 0xb2, 0xc6, 0xe3, 0xa9, 0x3b, 0x60, 0x7b, 0xac, 0x99, 0x71, 0x94, 0xf4, 0x29, 0x58, 0xb0,
+	0xe2, 0x45, 0x78, 0x85, 0x2e, 0xbb, 0x64, 0x65, 0xa1, 0xe4, 0x0d, 0xfc, 0x04, 0x28, 0x9e, 0x34,
+	0x71, 0xd5, 0x64, 0xc1, 0x6e, 0xce, 0xed, 0x77, 0xfe, 0xf6, 0x39, 0x07, 0xf4, 0x68, 0x80, 0x1d,
+	0x94, 0x65, 0x31, 0xc5, 0x48, 0x52, 0x96, 0x0a, 0x87, 0xa6, 0x92, 0x70, 0x7c, 0x8a, 0x68, 0xea,
+	0x23, 0x8c, 0x59, 0x9e, 0x4a, 0xe1, 0x60, 0x96, 0x4a, 0xce, 0xe2, 0x98, 0x70, 0x67, 0x78, 0x5c,
+	0xb3, 0xec, 0x8c, 0x33, 0xc9, 0xf4, 0x2e, 0x0d, 0xb0, 0x5d, 0x87, 0xd8, 0x2b, 0x20, 0x76, 0xad,
+	0x6c, 0x78, 0x7c, 0xbf, 0x19, 0xb1, 0x88, 0x55, 0xe5, 0xce, 0xec, 0xa5, 0x48, 0xf0, 0xc7, 0x26,
+	0xd8, 0xee, 0x23, 0x8e, 0x12, 0xa1, 0xbf, 0x03, 0xfa, 0xb2, 0xc2, 0x27, 0x29, 0x0a, 0x62, 0x12,
+	0x1a, 0x5a, 0x4b, 0x6b, 0xef, 0xb8, 0x0f, 0xca, 0xc2, 0x3a, 0x1c, 0xa3, 0x24, 0x7e, 0x06, 0xaf,
+	0xe7, 0x40, 0xef, 0x60, 0xe9, 0x7c, 0xad, 0x7c, 0xfa, 0x17, 0x60, 0x84, 0xe4, 0x04, 0xe5, 0xb1,
+	0xf4, 0x39, 0x89, 0x91, 0xa4, 0x43, 0xe2, 0x4b, 0x9a, 0x10, 0x96, 0x4b, 0xe3, 0xbf, 0x96, 0xd6,
+	0xde, 0x74, 0x1f, 0x96, 0x85, 0x65, 0x29, 0xe6, 0xba, 0x4c, 0xe8, 0xdd, 0x9d, 0x87, 0xbc, 0x79,
+	0x64, 0xa0, 0x02, 0xfa, 0x07, 0xd0, 0x4c, 0x44, 0xe4, 0x57, 0x5f, 0x4a, 0xb8, 0x58, 0xc8, 0xdd,
+	0xa8, 0xe4, 0x5a, 0x65, 0x61, 0x1d, 0x29, 0xf4, 0xaa, 0x2c, 0xe8, 0xe9, 0x89, 0x88, 0x7a, 0x73,
+	0xef, 0xa5, 0xe2, 0x3e, 0x68, 0x26, 0x68, 0xe4, 0x67, 0x08, 0x7f, 0x23, 0xd2, 0x0f, 0x91, 0x44,
+	0xbe, 0xa0, 0x67, 0xc4, 0xd8, 0xac, 0xd4, 0xd6, 0x91, 0x2b, 0xb2, 0xa0, 0x77, 0x90, 0xa0, 0x51,
+	0xbf, 0xf2, 0xbe, 0x42, 0x12, 0x7d, 0xa4, 0x67, 0x44, 0xff, 0xa5, 0x81, 0x23, 0xcc, 0xd2, 0x94,
+	0xe0, 0xd9, 0x90, 0x7c, 0x14, 0x86, 0x9c, 0x08, 0xe1, 0x67, 0x9c, 0x9c, 0xd0, 0x11, 0x11, 0xc6,
+	0x56, 0x6b, 0xa3, 0x7d, 0xa3, 0xfb, 0xd6, 0xfe, 0xf7, 0x69, 0xda, 0xbd, 0x05, 0xf6, 0xa5, 0xa2,
+	0xf6, 0x2b, 0xa8, 0xfb, 0xe8, 0xbc, 0xb0, 0x1a, 0x65, 0x61, 0xc1, 0xc5, 0xb0, 0xd6, 0x75, 0x87,
+	0xde, 0x21, 0x5e, 0x0d, 0x21, 0x02, 0xfe, 0xd4, 0xc0, 0xbd, 0x35, 0x2d, 0xf4, 0xe7, 0x60, 0xbf,
+	0x86, 0xa5, 0x6a, 0x45, 0x76, 0x5d, 0xa3, 0x2c, 0xac, 0xe6, 0xb5, 0xae, 0x34, 0x84, 0xde, 0xde,
+	0xd2, 0x7e, 0x13, 0xea, 0x2f, 0xc0, 0xcd, 0xab, 0x52, 0xaa, 0x75, 0xd8, 0x75, 0x0f, 0xcb, 0xc2,
+	0xba, 0xa3, 0xea, 0xaf, 0xc6, 0xa1, 0xb7, 0x8f, 0xea, 0x02, 0xe0, 0x00, 0xec, 0xbd, 0x17, 0xd1,
+	0x60, 0x9c, 0x91, 0x6a, 0x84, 0xba, 0x0d, 0x76, 0xe4, 0x38, 0x23, 0x7e, 0xce, 0xe3, 0xb9, 0x96,
+	0xdb, 0x65, 0x61, 0xdd, 0x52, 0xac, 0xcb, 0x08, 0xf4, 0xfe, 0x9f, 0x3d, 0x3f, 0xf1, 0x58, 0x6f,
+	0x82, 0xad, 0xea, 0x9f, 0xaa, 0x3d, 0xf4, 0x94, 0xe1, 0x7e, 0x3d, 0x9f, 0x98, 0xda, 0xc5, 0xc4,
+	0xd4, 0xfe, 0x4c, 0x4c, 0xed, 0xfb, 0xd4, 0x6c, 0x5c, 0x4c, 0xcd, 0xc6, 0xef, 0xa9, 0xd9, 0xf8,
+	0xdc, 0x8f, 0xa8, 0x3c, 0xcd, 0x03, 0x1b, 0xb3, 0xc4, 0xc1, 0x4c, 0x24, 0x4c, 0x38, 0x34, 0xc0,
+	0x9d, 0x88, 0x39, 0xc3, 0x27, 0x4e, 0xc2, 0xc2, 0x3c, 0x26, 0x62, 0x76, 0xd2, 0xc2, 0xe9, 0x3e,
+	0xed, 0x2c, 0x47, 0xd7, 0x59, 0x75, 0xcd, 0x33, 0x0d, 0x22, 0xd8, 0xae, 0x8e, 0xef, 0xf1, 0xdf,
+	0x01, 0x00, 0xdb, 0x66, 0x3b, 0xde, 0x0d, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -118,6 +289,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConnectionAddressPrefixes) > 0 {
+		for iNdEx := len(m.ConnectionAddressPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionAddressPrefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxPacketDataSize != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxPacketDataSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MsgCountersEnabled {
+		i--
+		if m.MsgCountersEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DefaultRelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.DefaultRelativeTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -131,6 +336,78 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionAddressPrefix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionAddressPrefix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionAddressPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintController(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintController(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -151,6 +428,54 @@ func (m *Params) Size() (n int) {
 	if m.ControllerEnabled {
 		n += 2
 	}
+	if m.DefaultRelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.DefaultRelativeTimeout))
+	}
+	if m.MsgCountersEnabled {
+		n += 2
+	}
+	if m.MaxPacketDataSize != 0 {
+		n += 1 + sovController(uint64(m.MaxPacketDataSize))
+	}
+	if len(m.ConnectionAddressPrefixes) > 0 {
+		for _, e := range m.ConnectionAddressPrefixes {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionAddressPrefix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTypeCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovController(uint64(m.Count))
+	}
 	return n
 }
 
@@ -209,6 +534,313 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRelativeTimeout", wireType)
+			}
+			m.DefaultRelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultRelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCountersEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MsgCountersEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataSize", wireType)
+			}
+			m.MaxPacketDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionAddressPrefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionAddressPrefixes = append(m.ConnectionAddressPrefixes, ConnectionAddressPrefix{})
+			if err := m.ConnectionAddressPrefixes[len(m.ConnectionAddressPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionAddressPrefix) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionAddressPrefix: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionAddressPrefix: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgReopenChannel{}
+	_ sdk.Msg = &MsgCloseChannel{}
+	_ sdk.Msg = &MsgSendTx{}
+	_ sdk.Msg = &MsgUpdateChannelVersion{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
+func NewMsgRegisterInterchainAccount(connectionID, owner, version string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		ConnectionId: connectionID,
+		Owner:        owner,
+		Version:      version,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from owner address")
+	}
+
+	if msg.Alias != "" {
+		if err := icatypes.ValidateAlias(msg.Alias); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgReopenChannel creates a new instance of MsgReopenChannel
+func NewMsgReopenChannel(connectionID, owner string) *MsgReopenChannel {
+	return &MsgReopenChannel{
+		ConnectionId: connectionID,
+		Owner:        owner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgReopenChannel) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from owner address")
+	}
+
+	if msg.Alias != "" {
+		if err := icatypes.ValidateAlias(msg.Alias); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReopenChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgCloseChannel creates a new instance of MsgCloseChannel
+func NewMsgCloseChannel(connectionID, owner string) *MsgCloseChannel {
+	return &MsgCloseChannel{
+		ConnectionId: connectionID,
+		Owner:        owner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgCloseChannel) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from owner address")
+	}
+
+	if msg.Alias != "" {
+		if err := icatypes.ValidateAlias(msg.Alias); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCloseChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgSendTx creates a new instance of MsgSendTx
+func NewMsgSendTx(owner, connectionID string, relativeTimeout uint64, packetData icatypes.InterchainAccountPacketData) *MsgSendTx {
+	return &MsgSendTx{
+		Owner:           owner,
+		ConnectionId:    connectionID,
+		PacketData:      packetData,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgSendTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from owner address")
+	}
+
+	if msg.Alias != "" {
+		if err := icatypes.ValidateAlias(msg.Alias); err != nil {
+			return err
+		}
+	}
+
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgUpdateChannelVersion creates a new instance of MsgUpdateChannelVersion
+func NewMsgUpdateChannelVersion(owner, connectionID, version string, relativeTimeout uint64) *MsgUpdateChannelVersion {
+	return &MsgUpdateChannelVersion{
+		Owner:           owner,
+		ConnectionId:    connectionID,
+		Version:         version,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgUpdateChannelVersion) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from owner address")
+	}
+
+	if msg.Alias != "" {
+		if err := icatypes.ValidateAlias(msg.Alias); err != nil {
+			return err
+		}
+	}
+
+	if strings.TrimSpace(msg.Version) == "" {
+		return sdkerrors.Wrap(icatypes.ErrInvalidVersion, "version cannot be empty")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateChannelVersion) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,268 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterInterchainAccount
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty version",
+			func() {
+				msg.Version = ""
+			},
+			true,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				msg.ConnectionId = "invalid|connection"
+			},
+			false,
+		},
+		{
+			"success: alias",
+			func() {
+				msg.Alias = "savings"
+			},
+			true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-owner"
+			},
+			false,
+		},
+		{
+			"invalid alias",
+			func() {
+				msg.Alias = "invalid-alias"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, ibctesting.TestAccAddress, icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
+	msg := types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, ibctesting.TestAccAddress, "")
+	require.Equal(t, ibctesting.TestAccAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgReopenChannelValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReopenChannel
+		expPass bool
+	}{
+		{"success", types.NewMsgReopenChannel(ibctesting.FirstConnectionID, ibctesting.TestAccAddress), true},
+		{"invalid connection ID", types.NewMsgReopenChannel("invalid|connection", ibctesting.TestAccAddress), false},
+		{"invalid owner address", types.NewMsgReopenChannel(ibctesting.FirstConnectionID, "invalid-owner"), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgReopenChannelGetSigners(t *testing.T) {
+	msg := types.NewMsgReopenChannel(ibctesting.FirstConnectionID, ibctesting.TestAccAddress)
+	require.Equal(t, ibctesting.TestAccAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgCloseChannelValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCloseChannel
+		expPass bool
+	}{
+		{"success", types.NewMsgCloseChannel(ibctesting.FirstConnectionID, ibctesting.TestAccAddress), true},
+		{"invalid connection ID", types.NewMsgCloseChannel("invalid|connection", ibctesting.TestAccAddress), false},
+		{"invalid owner address", types.NewMsgCloseChannel(ibctesting.FirstConnectionID, "invalid-owner"), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCloseChannelGetSigners(t *testing.T) {
+	msg := types.NewMsgCloseChannel(ibctesting.FirstConnectionID, ibctesting.TestAccAddress)
+	require.Equal(t, ibctesting.TestAccAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	var msg *types.MsgSendTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				msg.ConnectionId = "invalid|connection"
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-owner"
+			},
+			false,
+		},
+		{
+			"invalid alias",
+			func() {
+				msg.Alias = "invalid-alias"
+			},
+			false,
+		},
+		{
+			"invalid packet data",
+			func() {
+				msg.PacketData = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("data"),
+		}
+
+		msg = types.NewMsgSendTx(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, 100000, packetData)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxGetSigners(t *testing.T) {
+	msg := types.NewMsgSendTx(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, 100000, icatypes.InterchainAccountPacketData{})
+	require.Equal(t, ibctesting.TestAccAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgUpdateChannelVersionValidateBasic(t *testing.T) {
+	var msg *types.MsgUpdateChannelVersion
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				msg.ConnectionId = "invalid|connection"
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-owner"
+			},
+			false,
+		},
+		{
+			"empty version",
+			func() {
+				msg.Version = " "
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgUpdateChannelVersion(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "version", 100000)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateChannelVersionGetSigners(t *testing.T) {
+	msg := types.NewMsgUpdateChannelVersion(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "version", 100000)
+	require.Equal(t, ibctesting.TestAccAddress, msg.GetSigners()[0].String())
+}
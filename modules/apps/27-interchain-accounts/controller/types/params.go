@@ -2,17 +2,36 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultRelativeTimeoutUnset is the default value for the default relative timeout param (set to 0, disabled)
+	DefaultRelativeTimeoutUnset = 0
+	// DefaultMsgCountersEnabled is the default value for the msg counters param (set to false)
+	DefaultMsgCountersEnabled = false
+	// DefaultMaxPacketDataSize is the default value for the max packet data size param (set to 256 KiB)
+	DefaultMaxPacketDataSize = 256 * 1024
 )
 
-// KeyControllerEnabled is the store key for ControllerEnabled Params
-var KeyControllerEnabled = []byte("ControllerEnabled")
+var (
+	// KeyControllerEnabled is the store key for ControllerEnabled Params
+	KeyControllerEnabled = []byte("ControllerEnabled")
+	// KeyDefaultRelativeTimeout is the store key for the DefaultRelativeTimeout Params
+	KeyDefaultRelativeTimeout = []byte("DefaultRelativeTimeout")
+	// KeyMsgCountersEnabled is the store key for the MsgCountersEnabled Params
+	KeyMsgCountersEnabled = []byte("MsgCountersEnabled")
+	// KeyMaxPacketDataSize is the store key for the MaxPacketDataSize Params
+	KeyMaxPacketDataSize = []byte("MaxPacketDataSize")
+	// KeyConnectionAddressPrefixes is the store key for the ConnectionAddressPrefixes Params
+	KeyConnectionAddressPrefixes = []byte("ConnectionAddressPrefixes")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
@@ -20,15 +39,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the controller submodule
-func NewParams(enableController bool) Params {
+func NewParams(enableController bool, defaultRelativeTimeout uint64, enableMsgCounters bool, maxPacketDataSize uint64, connectionAddressPrefixes []ConnectionAddressPrefix) Params {
 	return Params{
-		ControllerEnabled: enableController,
+		ControllerEnabled:         enableController,
+		DefaultRelativeTimeout:    defaultRelativeTimeout,
+		MsgCountersEnabled:        enableMsgCounters,
+		MaxPacketDataSize:         maxPacketDataSize,
+		ConnectionAddressPrefixes: connectionAddressPrefixes,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the controller submodule
 func DefaultParams() Params {
-	return NewParams(DefaultControllerEnabled)
+	return NewParams(DefaultControllerEnabled, DefaultRelativeTimeoutUnset, DefaultMsgCountersEnabled, DefaultMaxPacketDataSize, nil)
 }
 
 // Validate validates all controller submodule parameters
@@ -37,6 +60,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateRelativeTimeout(p.DefaultRelativeTimeout); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.MsgCountersEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMaxPacketDataSize(p.MaxPacketDataSize); err != nil {
+		return err
+	}
+
+	if err := validateConnectionAddressPrefixes(p.ConnectionAddressPrefixes); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -44,6 +83,10 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyControllerEnabled, p.ControllerEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyDefaultRelativeTimeout, p.DefaultRelativeTimeout, validateRelativeTimeout),
+		paramtypes.NewParamSetPair(KeyMsgCountersEnabled, p.MsgCountersEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMaxPacketDataSize, p.MaxPacketDataSize, validateMaxPacketDataSize),
+		paramtypes.NewParamSetPair(KeyConnectionAddressPrefixes, p.ConnectionAddressPrefixes, validateConnectionAddressPrefixes),
 	}
 }
 
@@ -55,3 +98,56 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateRelativeTimeout(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPacketDataSize(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateConnectionAddressPrefixes ensures each entry contains a valid connection identifier and a non-empty prefix, and
+// that no connection identifier is present more than once
+func validateConnectionAddressPrefixes(i interface{}) error {
+	prefixes, ok := i.([]ConnectionAddressPrefix)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(prefixes))
+	for _, prefix := range prefixes {
+		if err := host.ConnectionIdentifierValidator(prefix.ConnectionId); err != nil {
+			return err
+		}
+
+		if seen[prefix.ConnectionId] {
+			return fmt.Errorf("duplicate address prefix for connection %s", prefix.ConnectionId)
+		}
+		seen[prefix.ConnectionId] = true
+
+		if strings.TrimSpace(prefix.AddressPrefix) == "" {
+			return fmt.Errorf("address prefix for connection %s cannot be empty", prefix.ConnectionId)
+		}
+	}
+
+	return nil
+}
+
+// NewConnectionAddressPrefix creates a new ConnectionAddressPrefix instance
+func NewConnectionAddressPrefix(connectionID, addressPrefix string) ConnectionAddressPrefix {
+	return ConnectionAddressPrefix{
+		ConnectionId:  connectionID,
+		AddressPrefix: addressPrefix,
+	}
+}
@@ -10,5 +10,12 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false).Validate())
+	require.NoError(t, types.NewParams(false, 0, false, 0, nil).Validate())
+	require.NoError(t, types.NewParams(true, 0, false, types.DefaultMaxPacketDataSize, nil).Validate())
+	require.Equal(t, uint64(256*1024), types.DefaultParams().MaxPacketDataSize)
+
+	require.NoError(t, types.NewParams(true, 0, false, 0, []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix("connection-0", "cosmos"), types.NewConnectionAddressPrefix("connection-1", "osmo")}).Validate())
+	require.Error(t, types.NewParams(true, 0, false, 0, []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix("connection-0", "cosmos"), types.NewConnectionAddressPrefix("connection-0", "osmo")}).Validate())
+	require.Error(t, types.NewParams(true, 0, false, 0, []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix("invalid connection", "cosmos")}).Validate())
+	require.Error(t, types.NewParams(true, 0, false, 0, []types.ConnectionAddressPrefix{types.NewConnectionAddressPrefix("connection-0", " ")}).Validate())
 }
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -127,6 +128,414 @@ func (m *QueryInterchainAccountResponse) GetAddress() string {
 	return ""
 }
 
+// QueryMsgsExecutedRequest is the request type for the Query/MsgsExecuted RPC method.
+type QueryMsgsExecutedRequest struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryMsgsExecutedRequest) Reset()         { *m = QueryMsgsExecutedRequest{} }
+func (m *QueryMsgsExecutedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgsExecutedRequest) ProtoMessage()    {}
+func (*QueryMsgsExecutedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{2}
+}
+func (m *QueryMsgsExecutedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgsExecutedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgsExecutedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgsExecutedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgsExecutedRequest.Merge(m, src)
+}
+func (m *QueryMsgsExecutedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgsExecutedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgsExecutedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgsExecutedRequest proto.InternalMessageInfo
+
+func (m *QueryMsgsExecutedRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryMsgsExecutedRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryMsgsExecutedResponse the response type for the Query/MsgsExecuted RPC method.
+type QueryMsgsExecutedResponse struct {
+	MsgCounts []MsgTypeCount `protobuf:"bytes,1,rep,name=msg_counts,json=msgCounts,proto3" json:"msg_counts" yaml:"msg_counts"`
+}
+
+func (m *QueryMsgsExecutedResponse) Reset()         { *m = QueryMsgsExecutedResponse{} }
+func (m *QueryMsgsExecutedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgsExecutedResponse) ProtoMessage()    {}
+func (*QueryMsgsExecutedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{3}
+}
+func (m *QueryMsgsExecutedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgsExecutedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgsExecutedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgsExecutedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgsExecutedResponse.Merge(m, src)
+}
+func (m *QueryMsgsExecutedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgsExecutedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgsExecutedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgsExecutedResponse proto.InternalMessageInfo
+
+func (m *QueryMsgsExecutedResponse) GetMsgCounts() []MsgTypeCount {
+	if m != nil {
+		return m.MsgCounts
+	}
+	return nil
+}
+
+// QueryPendingPacketsRequest is the request type for the Query/PendingPackets RPC method.
+type QueryPendingPacketsRequest struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryPendingPacketsRequest) Reset()         { *m = QueryPendingPacketsRequest{} }
+func (m *QueryPendingPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryPendingPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryPendingPacketsRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryPendingPacketsResponse the response type for the Query/PendingPackets RPC method.
+type QueryPendingPacketsResponse struct {
+	// channel_id is the identifier of the active channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// next_sequence_send is the sequence of the next packet to be sent on the active channel
+	NextSequenceSend uint64 `protobuf:"varint,2,opt,name=next_sequence_send,json=nextSequenceSend,proto3" json:"next_sequence_send,omitempty" yaml:"next_sequence_send"`
+	// next_sequence_ack is the sequence of the next packet to be acknowledged on the active channel
+	NextSequenceAck uint64 `protobuf:"varint,3,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty" yaml:"next_sequence_ack"`
+	// packets are the packets which have not yet been acknowledged or timed out
+	Packets []PendingPacket `protobuf:"bytes,4,rep,name=packets,proto3" json:"packets"`
+}
+
+func (m *QueryPendingPacketsResponse) Reset()         { *m = QueryPendingPacketsResponse{} }
+func (m *QueryPendingPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{5}
+}
+func (m *QueryPendingPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPendingPacketsResponse) GetNextSequenceSend() uint64 {
+	if m != nil {
+		return m.NextSequenceSend
+	}
+	return 0
+}
+
+func (m *QueryPendingPacketsResponse) GetNextSequenceAck() uint64 {
+	if m != nil {
+		return m.NextSequenceAck
+	}
+	return 0
+}
+
+func (m *QueryPendingPacketsResponse) GetPackets() []PendingPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+// PendingPacket defines a packet sent by the controller submodule which has not yet been acknowledged or timed out
+type PendingPacket struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// packet_data is the data of the packet, which is only available for packets sent by a controller submodule which
+	// stores the data of pending packets
+	PacketData *types.InterchainAccountPacketData `protobuf:"bytes,2,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty" yaml:"packet_data"`
+	// msg_type_urls are the type URLs of the messages contained in the packet data, if the packet data could be decoded
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+}
+
+func (m *PendingPacket) Reset()         { *m = PendingPacket{} }
+func (m *PendingPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingPacket) ProtoMessage()    {}
+func (*PendingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{6}
+}
+func (m *PendingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacket.Merge(m, src)
+}
+func (m *PendingPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPacket proto.InternalMessageInfo
+
+func (m *PendingPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingPacket) GetPacketData() *types.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *PendingPacket) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryChannelMetadataRequest is the request type for the Query/ChannelMetadata RPC method.
+type QueryChannelMetadataRequest struct {
+	// connection_id is the controller connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// port_id is the controller port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QueryChannelMetadataRequest) Reset()         { *m = QueryChannelMetadataRequest{} }
+func (m *QueryChannelMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMetadataRequest) ProtoMessage()    {}
+func (*QueryChannelMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{7}
+}
+func (m *QueryChannelMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMetadataRequest.Merge(m, src)
+}
+func (m *QueryChannelMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryChannelMetadataRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryChannelMetadataRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryChannelMetadataResponse is the response type for the Query/ChannelMetadata RPC method.
+type QueryChannelMetadataResponse struct {
+	// channel_id is the identifier of the active channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// version is the version of the active channel, as stored by the channel
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// metadata is the ICS27 metadata parsed from the channel version. It is unset if the version cannot be parsed,
+	// for example for channels opened using a legacy version
+	Metadata *types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *QueryChannelMetadataResponse) Reset()         { *m = QueryChannelMetadataResponse{} }
+func (m *QueryChannelMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMetadataResponse) ProtoMessage()    {}
+func (*QueryChannelMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{8}
+}
+func (m *QueryChannelMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMetadataResponse.Merge(m, src)
+}
+func (m *QueryChannelMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryChannelMetadataResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryChannelMetadataResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *QueryChannelMetadataResponse) GetMetadata() *types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -135,7 +544,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df0d8b259d72854e, []int{2}
+	return fileDescriptor_df0d8b259d72854e, []int{9}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +583,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df0d8b259d72854e, []int{3}
+	return fileDescriptor_df0d8b259d72854e, []int{10}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +622,13 @@ func (m *QueryParamsResponse) GetParams() *Params {
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*QueryMsgsExecutedRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryMsgsExecutedRequest")
+	proto.RegisterType((*QueryMsgsExecutedResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryMsgsExecutedResponse")
+	proto.RegisterType((*QueryPendingPacketsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPendingPacketsRequest")
+	proto.RegisterType((*QueryPendingPacketsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryPendingPacketsResponse")
+	proto.RegisterType((*PendingPacket)(nil), "ibc.applications.interchain_accounts.controller.v1.PendingPacket")
+	proto.RegisterType((*QueryChannelMetadataRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryChannelMetadataRequest")
+	proto.RegisterType((*QueryChannelMetadataResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryChannelMetadataResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
 }
@@ -222,36 +638,69 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x77, 0x56, 0xba, 0x62, 0xd4, 0x83, 0x71, 0x0f, 0xcb, 0xa2, 0xa3, 0xcc, 0xc9, 0xcb,
-	0x26, 0x74, 0x2c, 0x08, 0x0b, 0x0a, 0x56, 0x50, 0x7a, 0x6b, 0xe7, 0x20, 0xe2, 0xc1, 0x92, 0xcd,
-	0x84, 0x69, 0x64, 0x26, 0x6f, 0x9a, 0x64, 0x56, 0x96, 0xd2, 0x8b, 0x9f, 0x40, 0xf0, 0xe6, 0x27,
-	0xf2, 0x58, 0x10, 0xc1, 0x93, 0xc8, 0xae, 0x9f, 0xc0, 0xb3, 0x07, 0x99, 0x4c, 0x74, 0x3b, 0x58,
-	0xc5, 0x5d, 0x7b, 0x9a, 0xbc, 0xf7, 0x78, 0xff, 0xdf, 0x7b, 0xf9, 0x67, 0xd0, 0x03, 0x39, 0xe1,
-	0x94, 0x95, 0x65, 0x2e, 0x39, 0xb3, 0x12, 0x94, 0xa1, 0x52, 0x59, 0xa1, 0xf9, 0x01, 0x93, 0x6a,
-	0x9f, 0x71, 0x0e, 0x95, 0xb2, 0x86, 0x72, 0x50, 0x56, 0x43, 0x9e, 0x0b, 0x4d, 0xa7, 0x9b, 0xf4,
-	0xb0, 0x12, 0x7a, 0x46, 0x4a, 0x0d, 0x16, 0x70, 0x2c, 0x27, 0x9c, 0x9c, 0xee, 0x27, 0x67, 0xf4,
-	0x93, 0x65, 0x3f, 0x99, 0x6e, 0x0e, 0x1f, 0xad, 0xc1, 0x3c, 0xa5, 0xe0, 0xc0, 0xc3, 0x7e, 0x06,
-	0x19, 0xb8, 0x23, 0xad, 0x4f, 0x3e, 0x7b, 0x23, 0x03, 0xc8, 0x72, 0x41, 0x59, 0x29, 0x29, 0x53,
-	0x0a, 0xac, 0x1f, 0xca, 0x55, 0x23, 0x8b, 0x6e, 0xee, 0xd5, 0xb3, 0xef, 0xfc, 0xc2, 0x3d, 0x6c,
-	0x68, 0x89, 0x38, 0xac, 0x84, 0xb1, 0xb8, 0x8f, 0x36, 0xe0, 0x95, 0x12, 0x7a, 0x10, 0xdc, 0x0e,
-	0xee, 0x5c, 0x4a, 0x9a, 0x00, 0xdf, 0x47, 0x57, 0x39, 0x28, 0x25, 0x78, 0xad, 0xb5, 0x2f, 0xd3,
-	0x41, 0xb7, 0xae, 0x6e, 0x0f, 0xbe, 0x7d, 0xbe, 0xd5, 0x9f, 0xb1, 0x22, 0x1f, 0x47, 0xad, 0x72,
-	0x94, 0x5c, 0x59, 0xc6, 0x3b, 0x69, 0x34, 0x46, 0xe1, 0x9f, 0xa8, 0xa6, 0x04, 0x65, 0x04, 0x1e,
-	0xa0, 0x8b, 0x2c, 0x4d, 0xb5, 0x30, 0xc6, 0x83, 0x7f, 0x86, 0x51, 0x1f, 0x61, 0xd7, 0xbb, 0xcb,
-	0x34, 0x2b, 0x8c, 0x1f, 0x33, 0x92, 0xe8, 0x7a, 0x2b, 0xeb, 0x65, 0x12, 0xd4, 0x2b, 0x5d, 0xc6,
-	0xa9, 0x5c, 0x8e, 0xc7, 0x64, 0x75, 0x73, 0x88, 0xd7, 0xf4, 0x4a, 0xf1, 0xf7, 0x0b, 0x68, 0xc3,
-	0xb1, 0xf0, 0xbb, 0x2e, 0xba, 0xf6, 0xdb, 0x0a, 0x78, 0x6f, 0x1d, 0xc6, 0x5f, 0x4d, 0x18, 0x26,
-	0xe7, 0x29, 0xd9, 0x5c, 0x4d, 0xf4, 0xe2, 0xf5, 0x87, 0xaf, 0x6f, 0xbb, 0xcf, 0xf0, 0x53, 0xea,
-	0xdf, 0xde, 0xbf, 0xbc, 0x39, 0xe7, 0xbe, 0xa1, 0x47, 0xee, 0x7b, 0x4c, 0x97, 0xa6, 0x1a, 0x7a,
-	0xd4, 0x72, 0xfc, 0x18, 0x7f, 0x0c, 0x50, 0xaf, 0xb9, 0x39, 0xfc, 0x78, 0xed, 0xf1, 0x5b, 0x26,
-	0x0f, 0x9f, 0xfc, 0xb7, 0x8e, 0xdf, 0x7d, 0xec, 0x76, 0xdf, 0xc2, 0xf1, 0x2a, 0xbb, 0x37, 0xf6,
-	0x6f, 0xbf, 0x7c, 0x3f, 0x0f, 0x83, 0x93, 0x79, 0x18, 0x7c, 0x99, 0x87, 0xc1, 0x9b, 0x45, 0xd8,
-	0x39, 0x59, 0x84, 0x9d, 0x4f, 0x8b, 0xb0, 0xf3, 0x7c, 0x37, 0x93, 0xf6, 0xa0, 0x9a, 0x10, 0x0e,
-	0x05, 0xe5, 0x60, 0x0a, 0x30, 0xb5, 0xfc, 0x28, 0x03, 0x3a, 0xdd, 0xa2, 0x05, 0xa4, 0x55, 0x2e,
-	0x4c, 0x03, 0x8b, 0xef, 0x8d, 0x96, 0xbc, 0xd1, 0x59, 0x3c, 0x3b, 0x2b, 0x85, 0x99, 0xf4, 0xdc,
-	0x4f, 0x7a, 0xf7, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x05, 0x64, 0x1e, 0x93, 0x04, 0x00,
+	// 993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x69, 0xd2, 0x3c, 0x37, 0x2d, 0x19, 0x02, 0xda, 0x98, 0xd4, 0x8e, 0xf6, 0x54,
+	0x09, 0xc5, 0x23, 0x9b, 0x08, 0xa4, 0x08, 0x10, 0x71, 0xca, 0x8f, 0x08, 0x0c, 0xe9, 0x16, 0x10,
+	0xe2, 0x80, 0x35, 0xd9, 0x9d, 0xae, 0xb7, 0xf1, 0xce, 0x6c, 0x76, 0xc6, 0xa6, 0x21, 0xca, 0x85,
+	0x1b, 0x37, 0x24, 0x0e, 0x08, 0x6e, 0x5c, 0xf8, 0x2f, 0xb8, 0xf7, 0x82, 0x54, 0x09, 0x21, 0x71,
+	0xb2, 0x50, 0x02, 0x12, 0x67, 0xff, 0x05, 0x68, 0x67, 0x66, 0xfd, 0x83, 0x98, 0xca, 0x71, 0x93,
+	0x93, 0x77, 0x7e, 0xbc, 0xef, 0x7b, 0xf3, 0xcd, 0x37, 0xef, 0xc9, 0xf0, 0x66, 0xb8, 0xef, 0x61,
+	0x12, 0xc7, 0xad, 0xd0, 0x23, 0x32, 0xe4, 0x4c, 0xe0, 0x90, 0x49, 0x9a, 0x78, 0x4d, 0x12, 0xb2,
+	0x06, 0xf1, 0x3c, 0xde, 0x66, 0x52, 0x60, 0x8f, 0x33, 0x99, 0xf0, 0x56, 0x8b, 0x26, 0xb8, 0x53,
+	0xc1, 0x87, 0x6d, 0x9a, 0x1c, 0x95, 0xe3, 0x84, 0x4b, 0x8e, 0xaa, 0xe1, 0xbe, 0x57, 0x1e, 0x8e,
+	0x2f, 0x8f, 0x89, 0x2f, 0x0f, 0xe2, 0xcb, 0x9d, 0x4a, 0x61, 0x67, 0x0a, 0xce, 0x21, 0x04, 0x45,
+	0x5c, 0xd8, 0x9c, 0x08, 0xa4, 0x53, 0xc1, 0x31, 0xf1, 0x0e, 0xa8, 0x34, 0x51, 0xaf, 0x4e, 0x1a,
+	0x15, 0x51, 0x49, 0x7c, 0x22, 0x89, 0x89, 0x5b, 0x09, 0x78, 0xc0, 0xd5, 0x27, 0x4e, 0xbf, 0xcc,
+	0xec, 0x5a, 0xc0, 0x79, 0xd0, 0xa2, 0x98, 0xc4, 0x21, 0x26, 0x8c, 0x71, 0x69, 0x24, 0x50, 0xab,
+	0x8e, 0x84, 0xdb, 0xf7, 0x52, 0xa5, 0x76, 0xfb, 0x0c, 0xdb, 0x9a, 0xc0, 0xa5, 0x87, 0x6d, 0x2a,
+	0x24, 0x5a, 0x81, 0x6b, 0xfc, 0x4b, 0x46, 0x13, 0xdb, 0x5a, 0xb7, 0xee, 0x2c, 0xba, 0x7a, 0x80,
+	0xde, 0x80, 0x25, 0x8f, 0x33, 0x46, 0xbd, 0x14, 0xab, 0x11, 0xfa, 0x76, 0x2e, 0x5d, 0xad, 0xd9,
+	0xbd, 0x6e, 0x69, 0xe5, 0x88, 0x44, 0xad, 0x2d, 0x67, 0x64, 0xd9, 0x71, 0x6f, 0x0c, 0xc6, 0xbb,
+	0xbe, 0xb3, 0x05, 0xc5, 0xff, 0x63, 0x15, 0x31, 0x67, 0x82, 0x22, 0x1b, 0x16, 0x88, 0xef, 0x27,
+	0x54, 0x08, 0x43, 0x9c, 0x0d, 0x1d, 0x0e, 0xb6, 0x8a, 0xad, 0x8b, 0x40, 0xbc, 0xfd, 0x88, 0x7a,
+	0x6d, 0x49, 0xfd, 0x2b, 0x4d, 0xf6, 0x7b, 0x0b, 0x56, 0xc7, 0x30, 0x9a, 0x44, 0xbf, 0x02, 0x88,
+	0x44, 0xd0, 0xd0, 0x97, 0x62, 0x5b, 0xeb, 0xb3, 0x77, 0xf2, 0xd5, 0xb7, 0xca, 0x17, 0x37, 0x5c,
+	0xb9, 0x2e, 0x82, 0x8f, 0x8f, 0x62, 0xba, 0x93, 0xae, 0xd5, 0x56, 0x1f, 0x77, 0x4b, 0x33, 0xbd,
+	0x6e, 0x69, 0x59, 0xe7, 0x37, 0x60, 0x70, 0xdc, 0xc5, 0x48, 0x04, 0x3b, 0xfa, 0xfb, 0x10, 0x0a,
+	0x2a, 0xb1, 0x3d, 0xca, 0xfc, 0x90, 0x05, 0x7b, 0xca, 0x44, 0xe2, 0x4a, 0xc5, 0xf8, 0x35, 0x07,
+	0x2f, 0x8d, 0xe5, 0x34, 0x72, 0x6c, 0x02, 0x78, 0x4d, 0xc2, 0x18, 0x6d, 0xa5, 0xd8, 0x8a, 0xb9,
+	0xf6, 0xc2, 0xe0, 0x20, 0x83, 0x35, 0xc7, 0x5d, 0x34, 0x83, 0x5d, 0x1f, 0xbd, 0x0f, 0x88, 0xd1,
+	0x47, 0xb2, 0x21, 0xd2, 0xd4, 0x99, 0x47, 0x1b, 0x82, 0x32, 0x9d, 0xd9, 0x5c, 0xed, 0x76, 0xaf,
+	0x5b, 0x5a, 0xd5, 0xd1, 0xe7, 0xf7, 0x38, 0xee, 0x73, 0xe9, 0xe4, 0x7d, 0x33, 0x77, 0x9f, 0x32,
+	0x1f, 0xbd, 0x07, 0xcb, 0xa3, 0x1b, 0x89, 0x77, 0x60, 0xcf, 0x2a, 0xac, 0xb5, 0x5e, 0xb7, 0x64,
+	0x8f, 0xc3, 0x22, 0xde, 0x81, 0xe3, 0xde, 0x1a, 0x86, 0xda, 0xf6, 0x0e, 0x10, 0x81, 0x05, 0xfd,
+	0x30, 0x85, 0x3d, 0xa7, 0x2e, 0x76, 0x7b, 0x9a, 0x8b, 0x1d, 0x51, 0xaa, 0x36, 0x97, 0xde, 0xac,
+	0x9b, 0xe1, 0x3a, 0xff, 0x58, 0xb0, 0x34, 0xb2, 0x01, 0x15, 0xe0, 0x7a, 0x96, 0x96, 0xd2, 0x6f,
+	0xce, 0xed, 0x8f, 0xd1, 0x09, 0xe4, 0x75, 0x60, 0x23, 0x7d, 0xf6, 0x4a, 0xa0, 0x7c, 0xf5, 0xee,
+	0x64, 0x49, 0x75, 0x2a, 0xe5, 0x73, 0xcf, 0x4d, 0x53, 0xde, 0x25, 0x92, 0xd4, 0x5e, 0xec, 0x75,
+	0x4b, 0x48, 0x4b, 0x33, 0x44, 0xe1, 0xb8, 0x10, 0xf7, 0xf7, 0xa0, 0xd7, 0x61, 0x29, 0x75, 0xa2,
+	0x3c, 0x8a, 0x69, 0xa3, 0x9d, 0xb4, 0x84, 0x3d, 0xbb, 0x3e, 0x3b, 0xea, 0x9d, 0x91, 0x65, 0xc7,
+	0xcd, 0x47, 0xda, 0xd4, 0x9f, 0xa4, 0xa3, 0x6f, 0x2c, 0x63, 0x9d, 0x1d, 0x7d, 0xef, 0x75, 0x53,
+	0xbd, 0x32, 0xbf, 0x9e, 0x73, 0xa6, 0x75, 0x11, 0x67, 0xa2, 0x97, 0x61, 0x21, 0xe6, 0x89, 0x1c,
+	0x58, 0x1a, 0xf5, 0xba, 0xa5, 0x9b, 0xe6, 0x44, 0x7a, 0xc1, 0x71, 0xe7, 0xd3, 0xaf, 0x5d, 0xdf,
+	0xf9, 0xc5, 0x82, 0xb5, 0xf1, 0xb9, 0x3c, 0x93, 0x8f, 0x6d, 0x58, 0xe8, 0xd0, 0x44, 0x84, 0x9c,
+	0xe9, 0x1c, 0xdc, 0x6c, 0x88, 0xea, 0x70, 0x3d, 0xab, 0xd6, 0xca, 0x8b, 0xf9, 0x6a, 0x65, 0xe2,
+	0x6b, 0xeb, 0x27, 0xd7, 0x87, 0x70, 0x56, 0x00, 0xe9, 0x57, 0x48, 0x12, 0x12, 0x65, 0x2f, 0xde,
+	0x09, 0xe1, 0xf9, 0x91, 0x59, 0x73, 0x16, 0x17, 0xe6, 0x63, 0x35, 0xa3, 0xce, 0x91, 0xaf, 0x6e,
+	0x4d, 0xe5, 0x62, 0x8d, 0x69, 0x90, 0xaa, 0x7f, 0x03, 0x5c, 0x53, 0x5c, 0xe8, 0xc7, 0x1c, 0x2c,
+	0x9f, 0x33, 0x16, 0xba, 0x37, 0x0d, 0xc7, 0x53, 0x3b, 0x51, 0xc1, 0xbd, 0x4c, 0x48, 0x2d, 0x8d,
+	0xf3, 0xc5, 0xd7, 0xbf, 0xfd, 0xf5, 0x5d, 0xee, 0x33, 0xf4, 0x29, 0x36, 0x3d, 0x77, 0x92, 0x36,
+	0xaf, 0x0a, 0xa9, 0xc0, 0xc7, 0xea, 0xf7, 0x04, 0x0f, 0x5c, 0x28, 0xf0, 0xf1, 0x88, 0x45, 0x4f,
+	0xd0, 0x0f, 0x39, 0xb8, 0x31, 0xdc, 0x36, 0xd0, 0x07, 0x53, 0x1f, 0x62, 0x4c, 0xbf, 0x2b, 0xd4,
+	0x2f, 0x09, 0xcd, 0xa8, 0xd1, 0x52, 0x6a, 0x3c, 0x40, 0xfe, 0xd5, 0xa8, 0x81, 0x23, 0x11, 0x88,
+	0x06, 0xcd, 0xa4, 0xf8, 0x29, 0x07, 0x37, 0x47, 0xbb, 0x08, 0xfa, 0x70, 0xea, 0xf3, 0x8c, 0x6d,
+	0x81, 0x85, 0x8f, 0x2e, 0x0d, 0xcf, 0x28, 0xc4, 0x94, 0x42, 0x4d, 0xf4, 0xe0, 0x8a, 0x14, 0x8a,
+	0x35, 0x6d, 0xc3, 0xb4, 0x07, 0xf4, 0x73, 0x0e, 0x6e, 0xfd, 0xa7, 0x44, 0xa1, 0xe9, 0x0f, 0x35,
+	0xbe, 0xf0, 0x16, 0xf6, 0x2e, 0x0f, 0xd0, 0xc8, 0x14, 0x2b, 0x99, 0x1e, 0xa2, 0xe6, 0x45, 0x64,
+	0x7a, 0xaa, 0x2e, 0x3c, 0x91, 0x02, 0x1f, 0x9b, 0x3a, 0x7e, 0x82, 0xb3, 0xfa, 0x9b, 0x15, 0x44,
+	0xf4, 0xbb, 0x05, 0xf3, 0xba, 0x44, 0xa1, 0x77, 0xa6, 0xbf, 0xf4, 0xe1, 0x6a, 0x5a, 0x78, 0xf7,
+	0x99, 0x71, 0x8c, 0x1a, 0x5b, 0x4a, 0x8d, 0x4d, 0x54, 0xbd, 0x88, 0x1a, 0xba, 0xce, 0xd6, 0x1e,
+	0x3e, 0x3e, 0x2d, 0x5a, 0x4f, 0x4e, 0x8b, 0xd6, 0x9f, 0xa7, 0x45, 0xeb, 0xdb, 0xb3, 0xe2, 0xcc,
+	0x93, 0xb3, 0xe2, 0xcc, 0x1f, 0x67, 0xc5, 0x99, 0xcf, 0xf7, 0x82, 0x50, 0x36, 0xdb, 0xfb, 0x65,
+	0x8f, 0x47, 0xd8, 0xe3, 0x22, 0xe2, 0x22, 0x85, 0xdf, 0x08, 0x38, 0xee, 0x6c, 0xe2, 0x88, 0xfb,
+	0xed, 0x16, 0x15, 0x9a, 0xac, 0xfa, 0xda, 0xc6, 0x80, 0x6f, 0x63, 0x1c, 0x5f, 0xda, 0xb2, 0xc5,
+	0xfe, 0xbc, 0xfa, 0x4b, 0xf0, 0xca, 0xbf, 0x03, 0x00, 0x79, 0x5a, 0x16, 0x0b, 0x6f, 0x0d, 0x00,
 	0x00,
 }
 
@@ -267,8 +716,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// InterchainAccount returns the interchain account address for a given owner address on a given connection
+	// InterchainAccount returns the interchain account address for a given owner address on a given connection.
+	// A FailedPrecondition error is returned if the channel handshake has been initiated but the address is not yet set
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// MsgsExecuted returns the number of messages sent by type URL for a given owner address on a given connection.
+	MsgsExecuted(ctx context.Context, in *QueryMsgsExecutedRequest, opts ...grpc.CallOption) (*QueryMsgsExecutedResponse, error)
+	// PendingPackets returns the packets sent on the active channel of a given owner address on a given connection which
+	// have not yet been acknowledged or timed out, in order of sequence.
+	PendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error)
+	// ChannelMetadata returns the ICS27 metadata of the active channel of a given controller port on a given connection, as
+	// agreed during the channel handshake.
+	ChannelMetadata(ctx context.Context, in *QueryChannelMetadataRequest, opts ...grpc.CallOption) (*QueryChannelMetadataResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -290,30 +748,75 @@ func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchain
 	return out, nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Params", in, out, opts...)
+func (c *queryClient) MsgsExecuted(ctx context.Context, in *QueryMsgsExecutedRequest, opts ...grpc.CallOption) (*QueryMsgsExecutedResponse, error) {
+	out := new(QueryMsgsExecutedResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/MsgsExecuted", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// InterchainAccount returns the interchain account address for a given owner address on a given connection
-	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
-	// Params queries all parameters of the ICA controller submodule.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (c *queryClient) PendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error) {
+	out := new(QueryPendingPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/PendingPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) ChannelMetadata(ctx context.Context, in *QueryChannelMetadataRequest, opts ...grpc.CallOption) (*QueryChannelMetadataResponse, error) {
+	out := new(QueryChannelMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/ChannelMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// InterchainAccount returns the interchain account address for a given owner address on a given connection.
+	// A FailedPrecondition error is returned if the channel handshake has been initiated but the address is not yet set
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// MsgsExecuted returns the number of messages sent by type URL for a given owner address on a given connection.
+	MsgsExecuted(context.Context, *QueryMsgsExecutedRequest) (*QueryMsgsExecutedResponse, error)
+	// PendingPackets returns the packets sent on the active channel of a given owner address on a given connection which
+	// have not yet been acknowledged or timed out, in order of sequence.
+	PendingPackets(context.Context, *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error)
+	// ChannelMetadata returns the ICS27 metadata of the active channel of a given controller port on a given connection, as
+	// agreed during the channel handshake.
+	ChannelMetadata(context.Context, *QueryChannelMetadataRequest) (*QueryChannelMetadataResponse, error)
+	// Params queries all parameters of the ICA controller submodule.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
 func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
 }
+func (*UnimplementedQueryServer) MsgsExecuted(ctx context.Context, req *QueryMsgsExecutedRequest) (*QueryMsgsExecutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgsExecuted not implemented")
+}
+func (*UnimplementedQueryServer) PendingPackets(ctx context.Context, req *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPackets not implemented")
+}
+func (*UnimplementedQueryServer) ChannelMetadata(ctx context.Context, req *QueryChannelMetadataRequest) (*QueryChannelMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelMetadata not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -340,6 +843,60 @@ func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgsExecuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgsExecutedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgsExecuted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/MsgsExecuted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgsExecuted(ctx, req.(*QueryMsgsExecutedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/PendingPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPackets(ctx, req.(*QueryPendingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/ChannelMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelMetadata(ctx, req.(*QueryChannelMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +923,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterchainAccount",
 			Handler:    _Query_InterchainAccount_Handler,
 		},
+		{
+			MethodName: "MsgsExecuted",
+			Handler:    _Query_MsgsExecuted_Handler,
+		},
+		{
+			MethodName: "PendingPackets",
+			Handler:    _Query_PendingPackets_Handler,
+		},
+		{
+			MethodName: "ChannelMetadata",
+			Handler:    _Query_ChannelMetadata_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -442,7 +1011,7 @@ func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMsgsExecutedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -452,20 +1021,34 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMsgsExecutedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMsgsExecutedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMsgsExecutedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -475,19 +1058,156 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMsgsExecutedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMsgsExecutedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if len(m.MsgCounts) > 0 {
+		for iNdEx := len(m.MsgCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextSequenceSend != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceSend))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PacketData != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -495,81 +1215,1163 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryChannelMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInterchainAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryChannelMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgsExecutedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgsExecutedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgCounts) > 0 {
+		for _, e := range m.MsgCounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceSend))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceAck))
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.PacketData != nil {
+		l = m.PacketData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChannelMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgsExecutedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgsExecutedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgsExecutedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgsExecutedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgsExecutedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgsExecutedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgCounts = append(m.MsgCounts, MsgTypeCount{})
+			if err := m.MsgCounts[len(m.MsgCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
+			}
+			m.NextSequenceAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PendingPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PacketData == nil {
+				m.PacketData = &types.InterchainAccountPacketData{}
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,15 +2394,15 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -628,11 +2430,11 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -660,7 +2462,7 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -683,7 +2485,7 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -706,15 +2508,15 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -742,7 +2544,75 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package types
 import (
 	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
@@ -69,7 +70,7 @@ func (gs ControllerGenesisState) Validate() error {
 			return err
 		}
 
-		if err := ValidateAccountAddress(acc.AccountAddress); err != nil {
+		if err := icatypes.ValidateAccountAddress(acc.AccountAddress); err != nil {
 			return err
 		}
 	}
@@ -90,7 +91,7 @@ func (gs ControllerGenesisState) Validate() error {
 // DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
 func DefaultHostGenesis() HostGenesisState {
 	return HostGenesisState{
-		Port:   PortID,
+		Port:   icatypes.PortID,
 		Params: hosttypes.DefaultParams(),
 	}
 }
@@ -122,11 +123,25 @@ func (gs HostGenesisState) Validate() error {
 			return err
 		}
 
-		if err := ValidateAccountAddress(acc.AccountAddress); err != nil {
+		if err := icatypes.ValidateAccountAddress(acc.AccountAddress); err != nil {
 			return err
 		}
 	}
 
+	for _, connAllowMsgs := range gs.ConnectionAllowMessages {
+		if err := connAllowMsgs.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := hosttypes.ValidateAddressBlocklist(gs.AddressBlocklist); err != nil {
+		return err
+	}
+
+	if err := hosttypes.ValidatePausedMessageTypes(gs.PausedMessageTypes); err != nil {
+		return err
+	}
+
 	if err := host.PortIdentifierValidator(gs.Port); err != nil {
 		return err
 	}
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ibc/applications/interchain_accounts/v1/genesis.proto
+// source: ibc/applications/interchain_accounts/genesis/v1/genesis.proto
 
 package types
 
@@ -35,7 +35,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{0}
+	return fileDescriptor_d4aa48c8e29a1947, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{}
 func (m *ControllerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ControllerGenesisState) ProtoMessage()    {}
 func (*ControllerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{1}
+	return fileDescriptor_d4aa48c8e29a1947, []int{1}
 }
 func (m *ControllerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,17 +149,22 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
-	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
-	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels          []ActiveChannel                  `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
+	InterchainAccounts      []RegisteredInterchainAccount    `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	Port                    string                           `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Params                  types1.Params                    `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ConnectionAllowMessages []types1.ConnectionAllowMessages `protobuf:"bytes,5,rep,name=connection_allow_messages,json=connectionAllowMessages,proto3" json:"connection_allow_messages" yaml:"connection_allow_messages"`
+	// address_blocklist defines the bech32 addresses interchain accounts are not allowed to send funds to
+	AddressBlocklist []string `protobuf:"bytes,6,rep,name=address_blocklist,json=addressBlocklist,proto3" json:"address_blocklist,omitempty" yaml:"address_blocklist"`
+	// paused_message_types defines the message types interchain accounts are not allowed to execute
+	PausedMessageTypes []types1.PausedMessageType `protobuf:"bytes,7,rep,name=paused_message_types,json=pausedMessageTypes,proto3" json:"paused_message_types" yaml:"paused_message_types"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
 func (m *HostGenesisState) String() string { return proto.CompactTextString(m) }
 func (*HostGenesisState) ProtoMessage()    {}
 func (*HostGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{2}
+	return fileDescriptor_d4aa48c8e29a1947, []int{2}
 }
 func (m *HostGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,18 +221,41 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
-// ActiveChannel contains a connection ID, port ID and associated active channel ID
+func (m *HostGenesisState) GetConnectionAllowMessages() []types1.ConnectionAllowMessages {
+	if m != nil {
+		return m.ConnectionAllowMessages
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetAddressBlocklist() []string {
+	if m != nil {
+		return m.AddressBlocklist
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetPausedMessageTypes() []types1.PausedMessageType {
+	if m != nil {
+		return m.PausedMessageTypes
+	}
+	return nil
+}
+
+// ActiveChannel contains a connection ID, port ID, associated active channel ID, as well as a boolean flag to
+// indicate if the channel is middleware enabled
 type ActiveChannel struct {
-	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	ConnectionId        string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId              string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId           string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	IsMiddlewareEnabled bool   `protobuf:"varint,4,opt,name=is_middleware_enabled,json=isMiddlewareEnabled,proto3" json:"is_middleware_enabled,omitempty" yaml:"is_middleware_enabled"`
 }
 
 func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
 func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
 func (*ActiveChannel) ProtoMessage()    {}
 func (*ActiveChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{3}
+	return fileDescriptor_d4aa48c8e29a1947, []int{3}
 }
 func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +305,13 @@ func (m *ActiveChannel) GetChannelId() string {
 	return ""
 }
 
+func (m *ActiveChannel) GetIsMiddlewareEnabled() bool {
+	if m != nil {
+		return m.IsMiddlewareEnabled
+	}
+	return false
+}
+
 // RegisteredInterchainAccount contains a connection ID, port ID and associated interchain account address
 type RegisteredInterchainAccount struct {
 	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
@@ -288,7 +323,7 @@ func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchai
 func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
 func (*RegisteredInterchainAccount) ProtoMessage()    {}
 func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{4}
+	return fileDescriptor_d4aa48c8e29a1947, []int{4}
 }
 func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,60 +374,71 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 }
 
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
-	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
-	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
-	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
-	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
+	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
+	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
+	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
+	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
 }
 
 func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/v1/genesis.proto", fileDescriptor_629b3ced0911516b)
-}
-
-var fileDescriptor_629b3ced0911516b = []byte{
-	// 643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0xa4, 0x14, 0xc5, 0xfd, 0x43, 0x31, 0xa5, 0x3a, 0x82, 0x74, 0x09, 0x5e, 0x1a,
-	0x09, 0xf5, 0x4e, 0x2d, 0x85, 0x8a, 0x4a, 0x08, 0xf5, 0x02, 0x82, 0x6c, 0xc8, 0x2c, 0x88, 0xe5,
-	0xe4, 0xf8, 0xac, 0xc4, 0x52, 0x72, 0x8e, 0xce, 0x6e, 0xa4, 0x4e, 0xec, 0x4c, 0x6c, 0x88, 0x15,
-	0x89, 0x0f, 0xc0, 0x37, 0x60, 0xec, 0x84, 0x3a, 0x32, 0x45, 0xa8, 0xfd, 0x06, 0xf9, 0x04, 0xc8,
-	0xbe, 0x53, 0x92, 0x1e, 0x69, 0x75, 0x13, 0x13, 0x53, 0xec, 0x7b, 0xfd, 0x3c, 0xef, 0xef, 0xf5,
-	0xeb, 0xd8, 0xf0, 0xb1, 0xe8, 0x30, 0x9f, 0x0e, 0x87, 0x7d, 0xc1, 0xa8, 0x16, 0x32, 0x56, 0xbe,
-	0x88, 0x35, 0x4f, 0x58, 0x8f, 0x8a, 0x38, 0xa4, 0x8c, 0xc9, 0xe3, 0x58, 0x2b, 0x7f, 0xb4, 0xeb,
-	0x77, 0x79, 0xcc, 0x95, 0x50, 0xde, 0x30, 0x91, 0x5a, 0xa2, 0x6d, 0xd1, 0x61, 0xde, 0xbc, 0xcc,
-	0x5b, 0x20, 0xf3, 0x46, 0xbb, 0xb5, 0xcd, 0xae, 0xec, 0x4a, 0xab, 0xf1, 0xcd, 0x28, 0x95, 0xd7,
-	0x5a, 0x85, 0xb2, 0x32, 0x19, 0xeb, 0x44, 0xf6, 0xfb, 0x3c, 0x31, 0x00, 0xb3, 0x59, 0x66, 0x72,
-	0x50, 0xc8, 0xa4, 0x27, 0x95, 0x36, 0x72, 0xf3, 0x9b, 0x0a, 0xf1, 0x8f, 0x32, 0x5c, 0x7d, 0x95,
-	0x96, 0xf3, 0x56, 0x53, 0xcd, 0xd1, 0x57, 0x00, 0x9d, 0x99, 0x7d, 0x98, 0x95, 0x1a, 0x2a, 0x13,
-	0x74, 0x40, 0x03, 0x34, 0x57, 0xf6, 0x9e, 0x7b, 0x05, 0x2b, 0xf6, 0x5a, 0x53, 0xa3, 0xf9, 0x1c,
-	0xc1, 0xf6, 0xe9, 0xb8, 0x5e, 0x9a, 0x8c, 0xeb, 0xf5, 0x13, 0x3a, 0xe8, 0x1f, 0xe2, 0xab, 0xd2,
-	0x61, 0xb2, 0xc5, 0x16, 0x1a, 0xa0, 0x8f, 0x00, 0x22, 0x53, 0x44, 0x0e, 0xaf, 0x6c, 0xf1, 0x9e,
-	0x16, 0xc6, 0x7b, 0x2d, 0x95, 0xbe, 0x04, 0xf6, 0x20, 0x03, 0xbb, 0x97, 0x82, 0xfd, 0x9d, 0x02,
-	0x93, 0x8d, 0x5e, 0x4e, 0x84, 0xbf, 0x55, 0xe0, 0xd6, 0xe2, 0x42, 0xd1, 0x07, 0x78, 0x8b, 0x32,
-	0x2d, 0x46, 0x3c, 0x64, 0x3d, 0x1a, 0xc7, 0xbc, 0xaf, 0x1c, 0xd0, 0xa8, 0x34, 0x57, 0xf6, 0x9e,
-	0x14, 0x66, 0x3c, 0xb2, 0xfa, 0x56, 0x2a, 0x0f, 0xdc, 0x0c, 0x70, 0x2b, 0x05, 0xcc, 0x99, 0x63,
-	0xb2, 0x4e, 0xe7, 0x97, 0x2b, 0xf4, 0x05, 0xc0, 0x3b, 0x0b, 0x8c, 0x9d, 0xb2, 0xa5, 0x78, 0x51,
-	0x98, 0x82, 0xf0, 0xae, 0x50, 0x9a, 0x27, 0x3c, 0x6a, 0x4f, 0x17, 0x1c, 0xa5, 0xf1, 0x00, 0x67,
-	0x4c, 0xb5, 0x94, 0x69, 0x81, 0x03, 0x26, 0x48, 0xe4, 0x65, 0x0a, 0x6d, 0xc2, 0x1b, 0x43, 0x99,
-	0x68, 0xe5, 0x54, 0x1a, 0x95, 0x66, 0x95, 0xa4, 0x13, 0xf4, 0x0e, 0x2e, 0x0f, 0x69, 0x42, 0x07,
-	0xca, 0x59, 0xb2, 0xdd, 0x3c, 0x2c, 0xc6, 0x38, 0xf7, 0x8f, 0x18, 0xed, 0x7a, 0x6f, 0xac, 0x43,
-	0xb0, 0x64, 0xc8, 0x48, 0xe6, 0x87, 0x3f, 0x57, 0xe0, 0x46, 0xbe, 0xe3, 0xff, 0x3b, 0x74, 0x5d,
-	0x87, 0x10, 0x5c, 0x32, 0x4d, 0x71, 0x2a, 0x0d, 0xd0, 0xac, 0x12, 0x3b, 0x46, 0x24, 0xd7, 0x9f,
-	0xfd, 0x62, 0x84, 0xf6, 0xca, 0xb9, 0xaa, 0x33, 0xdf, 0x01, 0x5c, 0xbb, 0xb4, 0x8b, 0xe8, 0x19,
-	0x5c, 0x63, 0x32, 0x8e, 0x39, 0x33, 0x8e, 0xa1, 0x88, 0xec, 0xcd, 0x53, 0x0d, 0x9c, 0xc9, 0xb8,
-	0xbe, 0x39, 0xbd, 0x34, 0x66, 0x61, 0x4c, 0x56, 0x67, 0xf3, 0x76, 0x84, 0x1e, 0xc2, 0x9b, 0x06,
-	0xd6, 0x08, 0xcb, 0x56, 0x88, 0x26, 0xe3, 0xfa, 0x7a, 0x2a, 0xcc, 0x02, 0x98, 0x2c, 0x9b, 0x51,
-	0x3b, 0x42, 0xfb, 0x10, 0x66, 0xed, 0x31, 0xeb, 0x6d, 0xad, 0xc1, 0xdd, 0xc9, 0xb8, 0x7e, 0x3b,
-	0x4b, 0x34, 0x8d, 0x61, 0x52, 0xcd, 0x26, 0xed, 0x08, 0xff, 0x04, 0xf0, 0xfe, 0x35, 0x7b, 0xfe,
-	0x4f, 0x2b, 0x68, 0x99, 0x43, 0x6c, 0xd3, 0x86, 0x34, 0x8a, 0x12, 0xae, 0x54, 0x56, 0x46, 0x6d,
-	0xfe, 0x20, 0x5e, 0x5a, 0x60, 0x0f, 0xa2, 0xfd, 0x72, 0x94, 0x7e, 0x08, 0xc2, 0xd3, 0x73, 0x17,
-	0x9c, 0x9d, 0xbb, 0xe0, 0xf7, 0xb9, 0x0b, 0x3e, 0x5d, 0xb8, 0xa5, 0xb3, 0x0b, 0xb7, 0xf4, 0xeb,
-	0xc2, 0x2d, 0xbd, 0x7f, 0xd9, 0x15, 0xba, 0x77, 0xdc, 0xf1, 0x98, 0x1c, 0xf8, 0x4c, 0xaa, 0x81,
-	0x54, 0xbe, 0xe8, 0xb0, 0x9d, 0xae, 0xf4, 0x47, 0xfb, 0xfe, 0x40, 0x46, 0xc7, 0x7d, 0xae, 0xcc,
-	0xe3, 0xa3, 0xfc, 0xbd, 0x83, 0x9d, 0x59, 0xf3, 0x77, 0xa6, 0xef, 0x8e, 0x3e, 0x19, 0x72, 0xd5,
-	0x59, 0xb6, 0x2f, 0xce, 0xa3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xd8, 0xa4, 0x04, 0x67,
-	0x07, 0x00, 0x00,
+	proto.RegisterFile("ibc/applications/interchain_accounts/genesis/v1/genesis.proto", fileDescriptor_d4aa48c8e29a1947)
+}
+
+var fileDescriptor_d4aa48c8e29a1947 = []byte{
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcd, 0x8e, 0xdb, 0x36,
+	0x10, 0xc7, 0x57, 0xf6, 0xc6, 0xa9, 0x99, 0x8f, 0x6e, 0x18, 0x67, 0xab, 0x38, 0x81, 0xe5, 0xb2,
+	0x87, 0x1a, 0x28, 0x56, 0xc2, 0x6e, 0x17, 0x08, 0x10, 0x20, 0x2d, 0x2c, 0x23, 0x48, 0x0d, 0x74,
+	0x81, 0x82, 0xcd, 0xa1, 0xe8, 0x45, 0xa0, 0x29, 0x42, 0x26, 0x2a, 0x8b, 0x82, 0x28, 0x3b, 0xd8,
+	0x27, 0xc8, 0xb5, 0xe8, 0xa1, 0xf7, 0x5e, 0xdb, 0x7b, 0x0f, 0x7d, 0x82, 0x9c, 0x8a, 0x3d, 0xf6,
+	0x64, 0x14, 0xbb, 0x6f, 0xe0, 0x27, 0x28, 0x48, 0xd1, 0x1f, 0xab, 0x55, 0x0a, 0xfb, 0xd2, 0x53,
+	0x4f, 0x26, 0xa9, 0x99, 0xff, 0xfc, 0x38, 0x33, 0xa4, 0x09, 0x5e, 0xf0, 0x11, 0xf5, 0x48, 0x9a,
+	0xc6, 0x9c, 0x92, 0x9c, 0x8b, 0x44, 0x7a, 0x3c, 0xc9, 0x59, 0x46, 0xc7, 0x84, 0x27, 0x01, 0xa1,
+	0x54, 0x4c, 0x93, 0x5c, 0x7a, 0x11, 0x4b, 0x98, 0xe4, 0xd2, 0x9b, 0x1d, 0x2f, 0x87, 0x6e, 0x9a,
+	0x89, 0x5c, 0x40, 0x8f, 0x8f, 0xa8, 0xbb, 0xe9, 0xee, 0x56, 0xb8, 0xbb, 0x4b, 0x9f, 0xd9, 0x71,
+	0xbb, 0x15, 0x89, 0x48, 0x68, 0x5f, 0x4f, 0x8d, 0x0a, 0x99, 0xf6, 0x60, 0x2b, 0x0a, 0x2a, 0x92,
+	0x3c, 0x13, 0x71, 0xcc, 0x32, 0x05, 0xb2, 0x9e, 0x19, 0x91, 0x67, 0x5b, 0x89, 0x8c, 0x85, 0xcc,
+	0x95, 0xbb, 0xfa, 0x2d, 0x1c, 0xd1, 0x45, 0x0d, 0xdc, 0x7d, 0x55, 0x20, 0x7e, 0x9b, 0x93, 0x9c,
+	0xc1, 0x5f, 0x2d, 0x60, 0xaf, 0xe5, 0x03, 0x83, 0x1f, 0x48, 0xf5, 0xd1, 0xb6, 0xba, 0x56, 0xef,
+	0xce, 0xc9, 0x2b, 0x77, 0xc7, 0x9d, 0xbb, 0x83, 0x95, 0xe0, 0x66, 0x2c, 0xff, 0xd3, 0x77, 0x73,
+	0x67, 0x6f, 0x31, 0x77, 0x9c, 0x73, 0x32, 0x89, 0x9f, 0xa3, 0xf7, 0x85, 0x45, 0xf8, 0x90, 0x56,
+	0x0a, 0xc0, 0x9f, 0x2c, 0x00, 0xd5, 0x66, 0x4a, 0x98, 0x35, 0x8d, 0xd9, 0xdf, 0x19, 0xf3, 0x2b,
+	0x21, 0xf3, 0x6b, 0x80, 0x1f, 0x1b, 0xc0, 0xc7, 0x05, 0xe0, 0xcd, 0x50, 0x08, 0x1f, 0x8c, 0x4b,
+	0x4e, 0xe8, 0xf7, 0x3a, 0x38, 0xac, 0xde, 0x30, 0x7c, 0x6b, 0x81, 0x0f, 0x09, 0xcd, 0xf9, 0x8c,
+	0x05, 0x74, 0x4c, 0x92, 0x84, 0xc5, 0xd2, 0xb6, 0xba, 0xf5, 0xde, 0x9d, 0x93, 0x2f, 0x76, 0x86,
+	0xed, 0x6b, 0x9d, 0x41, 0x21, 0xe3, 0x77, 0x0c, 0xe9, 0x61, 0x41, 0x5a, 0x0a, 0x82, 0xf0, 0x7d,
+	0xb2, 0x69, 0x2e, 0xe1, 0x2f, 0x16, 0x78, 0x58, 0x11, 0xc0, 0xae, 0x69, 0x9a, 0xaf, 0x77, 0xa6,
+	0xc1, 0x2c, 0xe2, 0x32, 0x67, 0x19, 0x0b, 0x87, 0x2b, 0xc3, 0x7e, 0x61, 0xe7, 0x23, 0xc3, 0xd6,
+	0x2e, 0xd8, 0x2a, 0x94, 0x10, 0x86, 0xbc, 0xec, 0x26, 0x61, 0x0b, 0xdc, 0x4a, 0x45, 0x96, 0x4b,
+	0xbb, 0xde, 0xad, 0xf7, 0x9a, 0xb8, 0x98, 0xc0, 0xef, 0x40, 0x23, 0x25, 0x19, 0x99, 0x48, 0x7b,
+	0x5f, 0x97, 0xf9, 0xf9, 0x76, 0xac, 0x1b, 0x47, 0x66, 0x76, 0xec, 0x7e, 0xa3, 0x15, 0xfc, 0x7d,
+	0x45, 0x86, 0x8d, 0x1e, 0xfa, 0xa3, 0x01, 0x0e, 0xca, 0x2d, 0xf0, 0x7f, 0xc9, 0x76, 0x2a, 0x19,
+	0x04, 0xfb, 0xaa, 0x4a, 0x76, 0xbd, 0x6b, 0xf5, 0x9a, 0x58, 0x8f, 0x21, 0x2e, 0x15, 0xec, 0x74,
+	0x3b, 0x52, 0x7d, 0x49, 0xbd, 0xa7, 0x54, 0xf0, 0x37, 0x0b, 0x3c, 0xa6, 0x22, 0x49, 0x18, 0x55,
+	0x02, 0x01, 0x89, 0x63, 0xf1, 0x26, 0x98, 0x30, 0x29, 0x49, 0xc4, 0xa4, 0x7d, 0x4b, 0x67, 0xe4,
+	0xe5, 0x6e, 0x71, 0x06, 0x2b, 0xb9, 0xbe, 0x52, 0x3b, 0x33, 0x62, 0x7e, 0xcf, 0xa4, 0xa2, 0xbb,
+	0xba, 0xa4, 0xaa, 0xa3, 0x22, 0xfc, 0x11, 0xad, 0x96, 0x80, 0x43, 0xf0, 0x80, 0x84, 0x61, 0xc6,
+	0xa4, 0x0c, 0x46, 0xb1, 0xa0, 0x3f, 0xc4, 0x5c, 0xe6, 0x76, 0x43, 0x35, 0xb5, 0xff, 0x74, 0x31,
+	0x77, 0x6c, 0xd3, 0x00, 0x65, 0x13, 0x84, 0x0f, 0xcc, 0x9a, 0xbf, 0x5c, 0x82, 0x3f, 0x5b, 0xa0,
+	0x95, 0x92, 0xa9, 0x64, 0xe1, 0x32, 0x70, 0x90, 0x9f, 0xa7, 0x4c, 0xda, 0xb7, 0xf5, 0x9e, 0xbf,
+	0xdc, 0x35, 0xb7, 0x4a, 0xc9, 0x70, 0xbe, 0x3e, 0x4f, 0x99, 0xff, 0x89, 0xd9, 0xed, 0x93, 0x82,
+	0xa9, 0x2a, 0x14, 0xc2, 0x30, 0x2d, 0xfb, 0x49, 0xf4, 0xb6, 0x06, 0xee, 0x5d, 0xeb, 0x6f, 0xf8,
+	0x02, 0xdc, 0xdb, 0x48, 0x16, 0x0f, 0xf5, 0xbf, 0x47, 0xd3, 0xb7, 0x17, 0x73, 0xa7, 0x75, 0x23,
+	0x97, 0x3c, 0x44, 0xf8, 0xee, 0x7a, 0x3e, 0x0c, 0xe1, 0x67, 0xe0, 0xb6, 0x6a, 0x1f, 0xe5, 0x58,
+	0xd3, 0x8e, 0x70, 0x31, 0x77, 0xee, 0x1b, 0xac, 0xe2, 0x03, 0xc2, 0x0d, 0x35, 0x1a, 0x86, 0xf0,
+	0x14, 0x00, 0x73, 0x70, 0x94, 0xbd, 0xee, 0x3e, 0xff, 0xd1, 0x62, 0xee, 0x3c, 0x30, 0x81, 0x56,
+	0xdf, 0x10, 0x6e, 0x9a, 0xc9, 0x30, 0x84, 0xaf, 0xc1, 0x23, 0x2e, 0x83, 0x09, 0x0f, 0xc3, 0x98,
+	0xbd, 0x21, 0x19, 0x0b, 0x58, 0x42, 0x46, 0x31, 0x0b, 0x75, 0xa3, 0x7e, 0xe0, 0x77, 0x17, 0x73,
+	0xe7, 0xa9, 0x39, 0x00, 0x55, 0x66, 0x08, 0x3f, 0xe4, 0xf2, 0x6c, 0xb5, 0xfc, 0xd2, 0xac, 0xfe,
+	0x69, 0x81, 0x27, 0xff, 0x72, 0xb6, 0xfe, 0xd3, 0xbc, 0x0c, 0xd4, 0xe5, 0xa5, 0xc3, 0x06, 0xa6,
+	0x95, 0x4c, 0x72, 0xda, 0x9b, 0x17, 0xcf, 0x35, 0x03, 0x7d, 0xf1, 0xe8, 0x95, 0xbe, 0x69, 0xbe,
+	0xe8, 0xdd, 0x65, 0xc7, 0xba, 0xb8, 0xec, 0x58, 0x7f, 0x5f, 0x76, 0xac, 0x1f, 0xaf, 0x3a, 0x7b,
+	0x17, 0x57, 0x9d, 0xbd, 0xbf, 0xae, 0x3a, 0x7b, 0xdf, 0x9f, 0x45, 0x3c, 0x1f, 0x4f, 0x47, 0x2e,
+	0x15, 0x13, 0x8f, 0x0a, 0x39, 0x11, 0x52, 0x3d, 0x8a, 0x8e, 0x22, 0xe1, 0xcd, 0x4e, 0xbd, 0x89,
+	0x08, 0xa7, 0x31, 0x93, 0xea, 0x59, 0x22, 0xbd, 0x93, 0x67, 0x47, 0xeb, 0x46, 0x3c, 0xba, 0xf1,
+	0xb8, 0xd2, 0x8d, 0x35, 0x6a, 0xe8, 0x37, 0xc9, 0xe7, 0xff, 0x0c, 0x00, 0x0a, 0xc9, 0xea, 0x81,
+	0x99, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -528,6 +574,43 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedMessageTypes) > 0 {
+		for iNdEx := len(m.PausedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedMessageTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddressBlocklist) > 0 {
+		for iNdEx := len(m.AddressBlocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressBlocklist[iNdEx])
+			copy(dAtA[i:], m.AddressBlocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AddressBlocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConnectionAllowMessages) > 0 {
+		for iNdEx := len(m.ConnectionAllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionAllowMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -596,6 +679,16 @@ func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsMiddlewareEnabled {
+		i--
+		if m.IsMiddlewareEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -741,6 +834,24 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConnectionAllowMessages) > 0 {
+		for _, e := range m.ConnectionAllowMessages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressBlocklist) > 0 {
+		for _, s := range m.AddressBlocklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedMessageTypes) > 0 {
+		for _, e := range m.PausedMessageTypes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -762,6 +873,9 @@ func (m *ActiveChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.IsMiddlewareEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1253,6 +1367,106 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionAllowMessages = append(m.ConnectionAllowMessages, types1.ConnectionAllowMessages{})
+			if err := m.ConnectionAllowMessages[len(m.ConnectionAllowMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBlocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBlocklist = append(m.AddressBlocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedMessageTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedMessageTypes = append(m.PausedMessageTypes, types1.PausedMessageType{})
+			if err := m.PausedMessageTypes[len(m.PausedMessageTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1399,6 +1613,26 @@ func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMiddlewareEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMiddlewareEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
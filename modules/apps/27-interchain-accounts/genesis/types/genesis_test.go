@@ -1,13 +1,34 @@
 package types_test
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
 	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
-	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
-func (suite *TypesTestSuite) TestValidateGenesisState() {
+var (
+	// TestOwnerAddress defines a reusable bech32 address for testing purposes
+	TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+	// TestPortID defines a reusable port identifier for testing purposes
+	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
+)
+
+type GenesisTypesTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTypesTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTypesTestSuite))
+}
+
+func (suite *GenesisTypesTestSuite) TestValidateGenesisState() {
 	var genesisState types.GenesisState
 
 	testCases := []struct {
@@ -60,7 +81,7 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 	var genesisState types.ControllerGenesisState
 
 	testCases := []struct {
@@ -183,7 +204,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateHostGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 	var genesisState types.HostGenesisState
 
 	testCases := []struct {
@@ -206,7 +227,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -220,7 +241,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -241,7 +262,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -262,7 +283,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -287,6 +308,55 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 			},
 			false,
 		},
+		{
+			"failed to validate connection allow messages - invalid connection identifier",
+			func() {
+				genesisState.ConnectionAllowMessages = []hosttypes.ConnectionAllowMessages{
+					hosttypes.NewConnectionAllowMessages("invalid|connection", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate connection allow messages - wildcard alongside other entries",
+			func() {
+				genesisState.ConnectionAllowMessages = []hosttypes.ConnectionAllowMessages{
+					hosttypes.NewConnectionAllowMessages(ibctesting.FirstConnectionID, []string{hosttypes.AllowAllHostMsgs, "/cosmos.bank.v1beta1.MsgSend"}),
+				}
+			},
+			false,
+		},
+		{
+			"failed to validate address blocklist - invalid address",
+			func() {
+				genesisState.AddressBlocklist = []string{"invalid"}
+			},
+			false,
+		},
+		{
+			"failed to validate address blocklist - duplicate address",
+			func() {
+				genesisState.AddressBlocklist = []string{TestOwnerAddress, TestOwnerAddress}
+			},
+			false,
+		},
+		{
+			"failed to validate paused message types - invalid type URL",
+			func() {
+				genesisState.PausedMessageTypes = []hosttypes.PausedMessageType{hosttypes.NewPausedMessageType("cosmos.gov.v1beta1.MsgVote", 0)}
+			},
+			false,
+		},
+		{
+			"failed to validate paused message types - duplicate type URL",
+			func() {
+				genesisState.PausedMessageTypes = []hosttypes.PausedMessageType{
+					hosttypes.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 0),
+					hosttypes.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 100),
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
@@ -16,7 +17,35 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdAllowMessagesForConnection(),
+		GetCmdInterchainAccounts(),
+		GetCmdInterchainAccount(),
+		GetCmdChannelMetadata(),
+		GetCmdExecutionResults(),
+		GetCmdAddressBlocklist(),
+		GetCmdPausedMessageTypes(),
+		GetCmdCollectedExecutionFees(),
+		GetCmdGasByConnection(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd creates and returns the tx command
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "host",
+		Short:                      "ica host transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewPauseMessageTypeCmd(),
+		NewUnpauseMessageTypeCmd(),
+		NewResetGasByConnectionCmd(),
+	)
+
+	return cmd
+}
@@ -47,6 +47,325 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAllowMessagesForConnection returns the command handler for querying the allow messages which apply to a connection.
+func GetCmdAllowMessagesForConnection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allow-messages [connection-id]",
+		Short:   "Query the interchain-accounts host allow messages for a connection",
+		Long:    "Query the interchain-accounts host allow messages which apply to interchain accounts registered over a particular connection",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host allow-messages connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllowMessagesForConnectionRequest{
+				ConnectionId: args[0],
+			}
+
+			res, err := queryClient.AllowMessagesForConnection(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for querying all interchain accounts registered on the host chain.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accounts",
+		Short:   "Query all interchain accounts registered on the host chain",
+		Long:    "Query all interchain accounts registered on the host chain, along with their associated connection and controller port identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdInterchainAccount returns the command handler for querying the interchain account registered for a controller port on a connection.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account [connection-id] [port-id]",
+		Short:   "Query the interchain account registered for a controller port on a connection",
+		Long:    "Query the interchain account address registered on the host chain for a controller port identifier on a particular host connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host account connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChannelMetadata returns the command handler for querying the ICS27 metadata of the active channel of a controller port on a connection.
+func GetCmdChannelMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-metadata [connection-id] [port-id]",
+		Short:   "Query the ICS27 metadata of the active channel of a controller port on a connection",
+		Long:    "Query the host submodule for the ICS27 metadata agreed during the handshake of the active channel of a controller port identifier on a particular host connection. The channel version is returned without metadata if it cannot be parsed",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host channel-metadata connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelMetadataRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			}
+
+			res, err := queryClient.ChannelMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExecutionResults returns the command handler for querying the recent execution results stored for a host channel.
+func GetCmdExecutionResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execution-results [channel-id]",
+		Short:   "Query the recent execution results stored for a host channel",
+		Long:    "Query the recent execution results stored for a host channel, ordered by packet sequence. Execution results are only stored if enabled by the host submodule params",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host execution-results channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryExecutionResultsRequest{
+				ChannelId:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ExecutionResults(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "execution results")
+
+	return cmd
+}
+
+// GetCmdAddressBlocklist returns the command handler for the host address blocklist querying.
+func GetCmdAddressBlocklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address-blocklist",
+		Short:   "Query the addresses interchain accounts are not allowed to send funds to",
+		Long:    "Query the addresses interchain accounts are not allowed to send funds to. Module accounts are always blocked and are not listed",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host address-blocklist", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AddressBlocklist(cmd.Context(), &types.QueryAddressBlocklistRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked addresses")
+
+	return cmd
+}
+
+// GetCmdPausedMessageTypes returns the command handler for the host paused message types querying.
+func GetCmdPausedMessageTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-message-types",
+		Short:   "Query the message types interchain accounts are currently not allowed to execute",
+		Long:    "Query the message types paused by the circuit breaker authority. Paused message types which have expired are not listed",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host paused-message-types", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PausedMessageTypes(cmd.Context(), &types.QueryPausedMessageTypesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paused message types")
+
+	return cmd
+}
+
+// GetCmdCollectedExecutionFees returns the command handler for the host collected execution fees querying.
+func GetCmdCollectedExecutionFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "collected-execution-fees [connection-id]",
+		Short:   "Query the execution fees collected from interchain accounts",
+		Long:    "Query the execution fees collected from interchain accounts over each connection, or over the provided connection",
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host collected-execution-fees connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCollectedExecutionFeesRequest{}
+			if len(args) > 0 {
+				req.ConnectionId = args[0]
+			}
+
+			res, err := queryClient.CollectedExecutionFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdGasByConnection returns the command handler for the host gas by connection querying.
+func GetCmdGasByConnection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gas-by-connection",
+		Short:   "Query the gas consumed by the execution of interchain accounts transactions",
+		Long:    "Query the total gas consumed by the execution of interchain accounts transactions received over each connection, since the last reset by the circuit breaker authority",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host gas-by-connection", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasByConnection(cmd.Context(), &types.QueryGasByConnectionRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gas by connection")
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,250 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+const (
+	flagAddAddresses    = "add"
+	flagRemoveAddresses = "remove"
+	flagExpiryHeight    = "expiry-height"
+)
+
+// NewCmdSubmitUpdateAllowMessagesProposal implements a command handler for submitting a proposal to update the host allow messages.
+func NewCmdSubmitUpdateAllowMessagesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-ica-host-allow-messages [comma-separated-msg-type-urls]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the interchain accounts host allow messages",
+		Long: "Submit a proposal to replace the list of sdk message type URLs interchain accounts are allowed to execute on the host chain, along with an initial deposit.\n" +
+			"Use \"*\" as the only entry to allow all message types.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal update-ica-host-allow-messages /cosmos.bank.v1beta1.MsgSend,/cosmos.staking.v1beta1.MsgDelegate --title=\"allow bank and staking\" --description=\"...\" --deposit=10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			allowMsgs := strings.Split(args[0], ",")
+
+			content := types.NewUpdateAllowMessagesProposal(title, description, allowMsgs)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitUpdateAddressBlocklistProposal implements a command handler for submitting a proposal to update the host address blocklist.
+func NewCmdSubmitUpdateAddressBlocklistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-ica-host-address-blocklist",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to update the interchain accounts host address blocklist",
+		Long: "Submit a proposal to add and remove addresses from the list of addresses interchain accounts are not allowed to send funds to, along with an initial deposit.\n" +
+			"At least one address must be added or removed.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal update-ica-host-address-blocklist --add=cosmos1... --remove=cosmos1... --title=\"block address\" --description=\"...\" --deposit=10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			addAddresses, err := cmd.Flags().GetStringSlice(flagAddAddresses)
+			if err != nil {
+				return err
+			}
+
+			removeAddresses, err := cmd.Flags().GetStringSlice(flagRemoveAddresses)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateAddressBlocklistProposal(title, description, addAddresses, removeAddresses)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(flagAddAddresses, nil, "comma separated list of bech32 addresses to add to the blocklist")
+	cmd.Flags().StringSlice(flagRemoveAddresses, nil, "comma separated list of bech32 addresses to remove from the blocklist")
+
+	return cmd
+}
+
+// NewPauseMessageTypeCmd returns the command handler for pausing the execution of a message type using MsgPauseMessageType.
+func NewPauseMessageTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-message-type [msg-type-url]",
+		Short: "Pause the execution of a message type by interchain accounts",
+		Long: "Pause the execution of a message type by interchain accounts on the host chain. The transaction must be signed by the circuit breaker authority.\n" +
+			"The message type remains paused until it is unpaused, or until the provided expiry height is exceeded.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts host pause-message-type /cosmos.gov.v1beta1.MsgVote --expiry-height=1000 --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetUint64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseMessageType(clientCtx.GetFromAddress().String(), args[0], expiryHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "block height after which the message type is no longer paused, 0 for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnpauseMessageTypeCmd returns the command handler for unpausing the execution of a message type using MsgUnpauseMessageType.
+func NewUnpauseMessageTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause-message-type [msg-type-url]",
+		Short:   "Unpause the execution of a message type by interchain accounts",
+		Long:    "Unpause the execution of a paused message type by interchain accounts on the host chain. The transaction must be signed by the circuit breaker authority.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts host unpause-message-type /cosmos.gov.v1beta1.MsgVote --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpauseMessageType(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResetGasByConnectionCmd returns the command handler for resetting the gas used over connections using MsgResetGasByConnection.
+func NewResetGasByConnectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-gas-by-connection [connection-id]",
+		Short: "Reset the gas consumed by the execution of interchain accounts transactions",
+		Long: "Reset the gas consumed by the execution of interchain accounts transactions received over the provided connection. The transaction must be signed by the gas reset authority.\n" +
+			"The gas consumed over all connections is reset if no connection is provided.",
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts host reset-gas-by-connection connection-0 --from cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var connectionID string
+			if len(args) > 0 {
+				connectionID = args[0]
+			}
+
+			msg := types.NewMsgResetGasByConnection(clientCtx.GetFromAddress().String(), connectionID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
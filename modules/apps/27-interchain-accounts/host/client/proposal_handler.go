@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/client/cli"
+)
+
+// UpdateAllowMessagesProposalHandler is the gov client handler for the interchain accounts host allow messages proposal
+var UpdateAllowMessagesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateAllowMessagesProposal, emptyRestHandler)
+
+// UpdateAddressBlocklistProposalHandler is the gov client handler for the interchain accounts host address blocklist proposal
+var UpdateAddressBlocklistProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateAddressBlocklistProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ica-host",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for interchain accounts host proposals")
+		},
+	}
+}
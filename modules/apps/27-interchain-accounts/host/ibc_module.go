@@ -1,6 +1,8 @@
 package host
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -50,7 +52,7 @@ func (im IBCModule) OnChanOpenTry(
 	counterpartyVersion string,
 ) (string, error) {
 	if !im.keeper.IsHostEnabled(ctx) {
-		return "", types.ErrHostSubModuleDisabled
+		return "", icatypes.WrapRejectionReason(types.ErrHostSubModuleDisabled, icatypes.RejectionReasonHostDisabled)
 	}
 
 	return im.keeper.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
@@ -105,14 +107,19 @@ func (im IBCModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	if !im.keeper.IsHostEnabled(ctx) {
-		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	if errors.Is(err, types.ErrHostSubModuleDisabled) {
+		// the deterministic error acknowledgement allows the packet to be sent again once the host submodule is
+		// enabled, the paused event emitted by the keeper, which core IBC emits as an error event, distinguishes the
+		// rejection from a failed execution
+		return channeltypes.NewErrorAcknowledgementWithCodespace(types.ErrHostSubModuleDisabled)
 	}
 
-	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
+		// the error string is redacted from the acknowledgement and is instead logged and included in events
+		ack = icatypes.NewErrorAcknowledgement(err)
+		im.keeper.Logger(ctx).Error("failed to execute interchain accounts packet", "port-id", packet.SourcePort, "channel-id", packet.DestinationChannel, "sequence", packet.Sequence, "error", err.Error())
 	}
 
 	// Emit an event indicating a successful or failed acknowledgement.
@@ -3,20 +3,29 @@ package host_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
+	controllerkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	coretypes "github.com/cosmos/ibc-go/v4/modules/core/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/cosmos/ibc-go/v4/testing/simapp"
 )
 
 var (
@@ -141,8 +150,8 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 			"success", func() {}, true,
 		},
 		{
-			"account address generation is block dependent", func() {
-				icaHostAccount := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			"funds sent to the interchain account address prior to registration", func() {
+				icaHostAccount := icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), icaHostAccount, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(1))})
 				suite.Require().NoError(err)
 				suite.Require().True(suite.chainB.GetSimApp().AccountKeeper.HasAccount(suite.chainB.GetContext(), icaHostAccount))
@@ -156,17 +165,26 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
 			}, false,
 		},
+		{
+			"untrusted controller connection", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.Params{
+					HostEnabled:                  true,
+					AllowMessages:                []string{},
+					TrustedControllerConnections: []string{"connection-100"},
+				})
+			}, false,
+		},
 		{
 			"success: ICA auth module callback returns error", func() {
 				// mock module callback should not be called on host side
-				suite.chainB.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenTry = func(ctx sdk.Context, order channeltypes.Order, connectionHops []string,
-					portID, channelID string, chanCap *capabilitytypes.Capability,
-					counterparty channeltypes.Counterparty, counterpartyVersion string,
-				) (string, error) {
-					return "", fmt.Errorf("mock ica auth fails")
-				}
+				suite.chainB.GetSimApp().ICAAuthMiddleware.FailOnChanOpenTry = true
 			}, true,
 		},
+		{
+			"ICA host stack callback fails", func() {
+				suite.chainB.GetSimApp().ICAHostMiddleware.FailOnChanOpenTry = true
+			}, false,
+		},
 		{
 			"ICA callback fails - invalid channel order", func() {
 				channel.Ordering = channeltypes.UNORDERED
@@ -211,10 +229,15 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
 			suite.Require().True(ok)
 
+			suite.chainB.GetSimApp().ICACallbackRecorder.Reset()
+
 			version, err := cbs.OnChanOpenTry(suite.chainB.GetContext(), channel.Ordering, channel.GetConnectionHops(),
 				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, chanCap, channel.Counterparty, path.EndpointA.ChannelConfig.Version,
 			)
 
+			// the host stack has no underlying application, the ICA auth module is never called on the host chain
+			suite.Require().Equal([]string{"icahost/OnChanOpenTry"}, suite.chainB.GetSimApp().ICACallbackRecorder.Callbacks())
+
 			if tc.expPass {
 				suite.Require().NoError(err)
 
@@ -229,6 +252,37 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnChanOpenTryHostDisabledRejectionReason() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+	path.EndpointB.ChannelID = ibctesting.FirstChannelID
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	chanCap, err := suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	ctx := suite.chainB.GetContext()
+	counterparty := channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	version, err := cbs.OnChanOpenTry(ctx, channeltypes.ORDERED, []string{path.EndpointB.ConnectionID},
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, chanCap, counterparty, path.EndpointA.ChannelConfig.Version,
+	)
+	suite.Require().ErrorIs(err, types.ErrHostSubModuleDisabled)
+	suite.Require().Contains(err.Error(), fmt.Sprintf("%s: %s", icatypes.AttributeKeyRejectionReason, icatypes.RejectionReasonHostDisabled))
+	suite.Require().Empty(version)
+}
+
 // Test initiating a ChanOpenAck using the host chain instead of the controller chain
 // ChainA is the controller chain. ChainB is the host chain
 func (suite *InterchainAccountsTestSuite) TestChanOpenAck() {
@@ -280,13 +334,14 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		{
 			"success: ICA auth module callback returns error", func() {
 				// mock module callback should not be called on host side
-				suite.chainB.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenConfirm = func(
-					ctx sdk.Context, portID, channelID string,
-				) error {
-					return fmt.Errorf("mock ica auth fails")
-				}
+				suite.chainB.GetSimApp().ICAAuthMiddleware.FailOnChanOpenConfirm = true
 			}, true,
 		},
+		{
+			"ICA host stack callback fails", func() {
+				suite.chainB.GetSimApp().ICAHostMiddleware.FailOnChanOpenConfirm = true
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -314,8 +369,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
 			suite.Require().True(ok)
 
+			suite.chainB.GetSimApp().ICACallbackRecorder.Reset()
+
 			err = cbs.OnChanOpenConfirm(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 
+			// the host stack has no underlying application, the ICA auth module is never called on the host chain
+			suite.Require().Equal([]string{"icahost/OnChanOpenConfirm"}, suite.chainB.GetSimApp().ICACallbackRecorder.Callbacks())
+
 			if tc.expPass {
 				suite.Require().NoError(err)
 			} else {
@@ -403,6 +463,20 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
 			}, false,
 		},
+		{
+			"no message types allowed", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{}))
+			}, false,
+		},
+		{
+			"success: no message types allowed with allow all when empty", func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.Params{
+					HostEnabled:       true,
+					AllowMessages:     []string{},
+					AllowAllWhenEmpty: true,
+				})
+			}, true,
+		},
 		{
 			"success with ICA auth module callback failure", func() {
 				suite.chainB.GetSimApp().ICAAuthModule.IBCApp.OnRecvPacket = func(
@@ -444,7 +518,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
 				Amount:      amount,
 			}
-			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg})
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 			suite.Require().NoError(err)
 
 			icaPacketData := icatypes.InterchainAccountPacketData{
@@ -467,8 +541,6 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			})
 			suite.Require().NoError(err)
 
-			expectedAck := channeltypes.NewResultAcknowledgement(expectedTxResponse)
-
 			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
@@ -489,7 +561,26 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
 			if tc.expAckSuccess {
 				suite.Require().True(ack.Success())
-				suite.Require().Equal(expectedAck, ack)
+
+				channelAck, ok := ack.(channeltypes.Acknowledgement)
+				suite.Require().True(ok)
+				result := channelAck.GetResult()
+
+				// the acknowledgement result remains decodable as sdk.TxMsgData
+				var txMsgData sdk.TxMsgData
+				err = proto.Unmarshal(result, &txMsgData)
+				suite.Require().NoError(err)
+
+				txResponse, err := proto.Marshal(&txMsgData)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedTxResponse, txResponse)
+
+				txMsgResult, err := icatypes.UnmarshalTxMsgResult(result)
+				suite.Require().NoError(err)
+				suite.Require().Len(txMsgResult.Results, 1)
+				suite.Require().Equal(uint64(0), txMsgResult.Results[0].Index)
+				suite.Require().Equal(sdk.MsgTypeURL(msg), txMsgResult.Results[0].MsgTypeUrl)
+				suite.Require().NotZero(txMsgResult.Results[0].GasUsed)
 			} else {
 				suite.Require().False(ack.Success())
 			}
@@ -497,6 +588,130 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketErrorAcknowledgementDeterminism() {
+	var (
+		acks      [][]byte
+		ackErrors []string
+	)
+
+	// each packet fails with an insufficient funds error whose error string includes the amount sent
+	for _, amount := range []int64{1000, 2000} {
+		suite.SetupTest() // reset
+
+		path := NewICAPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+		err := SetupICAPath(path, TestOwnerAddress)
+		suite.Require().NoError(err)
+
+		interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+		suite.Require().True(found)
+
+		msg := &banktypes.MsgSend{
+			FromAddress: interchainAccountAddr,
+			ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))),
+		}
+
+		data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+		suite.Require().NoError(err)
+
+		icaPacketData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+
+		params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+		suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+		packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+		module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+		suite.Require().NoError(err)
+
+		cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+		suite.Require().True(ok)
+
+		ctx := suite.chainB.GetContext()
+		ack := cbs.OnRecvPacket(ctx, packet, nil)
+		suite.Require().False(ack.Success())
+
+		acks = append(acks, ack.Acknowledgement())
+
+		// the full error string is included in the acknowledgement event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != icatypes.EventTypePacket {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == icatypes.AttributeKeyAckError {
+					ackErrors = append(ackErrors, string(attr.Value))
+				}
+			}
+		}
+	}
+
+	suite.Require().Len(ackErrors, 2)
+	suite.Require().NotEqual(ackErrors[0], ackErrors[1])
+	suite.Require().Equal(acks[0], acks[1])
+
+	expAck := icatypes.NewErrorAcknowledgement(icatypes.NewMsgExecutionError(0, sdkerrors.ErrInsufficientFunds))
+	suite.Require().Equal(expAck.Acknowledgement(), acks[0])
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketHostDisabledToggle() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	icaAddr := sdk.MustAccAddressFromBech32(suite.chainA.GetICAAddress(path, TestOwnerAddress))
+	err = suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), icaAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))}
+
+	params := types.NewParams(false, []string{sdk.MsgTypeURL(msgs[0])})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// the packet is rejected with a deterministic error acknowledgement while the host submodule is disabled
+	res, ack, err := suite.chainA.SendICATxWithResult(path, TestOwnerAddress, msgs, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().False(ack.Success())
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgementWithCodespace(types.ErrHostSubModuleDisabled), ack)
+
+	// the paused event is included in the events of the transaction receiving the packet as an error event
+	expEvent := sdk.NewEvent(
+		coretypes.ErrorAttributeKeyPrefix+types.EventTypeHostPaused,
+		sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+sdk.AttributeKeyModule, types.SubModuleName),
+		sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+types.AttributeKeyControllerPortID, path.EndpointA.ChannelConfig.PortID),
+		sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+icatypes.AttributeKeyHostChannelID, path.EndpointB.ChannelID),
+		sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+types.AttributeKeyPacketSequence, "1"),
+	)
+	suite.Require().Contains(res.GetEvents(), expEvent)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(int64(1000), balance.Amount.Int64())
+
+	// the channel remains open and the same messages are executed once the host submodule is enabled again
+	channel, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.OPEN, channel.State)
+
+	params.HostEnabled = true
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	ack, err = suite.chainA.SendICATx(path, TestOwnerAddress, msgs, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().True(ack.Success())
+
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(int64(900), balance.Amount.Int64())
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	testCases := []struct {
 		name     string
@@ -646,7 +861,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Amount:      tokenAmt,
 	}
 
-	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 	suite.Require().NoError(err)
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
@@ -681,6 +896,10 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	err = path.EndpointB.SetChannelClosed()
 	suite.Require().NoError(err)
 
+	// run the host callback which is executed on ChanCloseConfirm, removing the active channel
+	err = suite.chainB.GetSimApp().ICAHostKeeper.OnChanCloseConfirm(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().NoError(err)
+
 	// open a new channel on the same port
 	path.EndpointA.ChannelID = ""
 	path.EndpointB.ChannelID = ""
@@ -702,9 +921,421 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.assertBalance(icaAddr, expBalAfterSecondSend)
 }
 
+// TestControlAccountAfterChannelTimeout tests that a controller chain can control a registered interchain account after the active channel
+// for that interchain account has been closed by a packet timeout. The closure is relayed to the host chain, after which a new channel is
+// opened for the controller portID. The active channel is cleared on both chains while the interchain account address remains unchanged.
+func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelTimeout() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	var (
+		startingBal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+		tokenAmt    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	// send a packet which will time out before it is relayed
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Second).UnixNano())
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, timeoutTimestamp)
+	suite.Require().NoError(err)
+
+	// time out the packet, closing the ordered channel on the controller chain
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	err = path.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().False(found)
+
+	controllerAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(interchainAccountAddr, controllerAccountAddr)
+
+	// relay the channel closure to the host chain
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanCloseConfirm()
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointB.GetChannel().State)
+
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().False(found)
+
+	hostAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(interchainAccountAddr, hostAccountAddr)
+
+	// reopen a channel on the same port, binding it to the existing interchain account
+	path.EndpointA.ChannelID = ""
+	path.EndpointB.ChannelID = ""
+	path.EndpointA.ChannelConfig.Version = TestVersion
+	path.EndpointB.ChannelConfig.Version = TestVersion
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+
+	controllerAccountAddr, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(interchainAccountAddr, controllerAccountAddr)
+
+	// execute a bank send through the new channel
+	chanCap, ok = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	sequence, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	packetRelay := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	_, ack, err := path.RelayPacketWithResult(packetRelay)
+	suite.Require().NoError(err) // relay committed
+
+	var acknowledgement channeltypes.Acknowledgement
+	err = channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement)
+	suite.Require().NoError(err)
+	suite.Require().True(acknowledgement.Success())
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt))
+}
+
 // assertBalance asserts that the provided address has exactly the expected balance.
 // CONTRACT: the expected balance must only contain one coin denom.
 func (suite *InterchainAccountsTestSuite) assertBalance(addr sdk.AccAddress, expBalance sdk.Coins) {
 	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), addr, sdk.DefaultBondDenom)
 	suite.Require().Equal(expBalance[0], balance)
 }
+
+func (suite *InterchainAccountsTestSuite) TestMaxMsgsPerPacketErrorAcknowledgement() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	startingBal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg, msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.Params{
+		HostEnabled:      true,
+		AllowMessages:    []string{sdk.MsgTypeURL(msg)},
+		MaxMsgsPerPacket: 1,
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	seq, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	// relay the packet and the resulting error acknowledgement back to the controller
+	packetRelay := channeltypes.NewPacket(icaPacketData.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	_, ack, err := path.RelayPacketWithResult(packetRelay)
+	suite.Require().NoError(err)
+
+	expAck := icatypes.NewErrorAcknowledgement(types.ErrMaxMsgsPerPacket)
+	suite.Require().Equal(expAck.Acknowledgement(), ack)
+
+	// the packet commitment is deleted once the acknowledgement is processed by the controller
+	packetCommitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+	suite.Require().Nil(packetCommitment)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	suite.assertBalance(icaAddr, startingBal)
+}
+
+func (suite *InterchainAccountsTestSuite) TestMaxPacketDataSizeErrorAcknowledgement() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	startingBal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	// the host accepts packet data one byte smaller than the packet sent by the controller
+	params := types.Params{
+		HostEnabled:       true,
+		AllowMessages:     []string{sdk.MsgTypeURL(msg)},
+		MaxPacketDataSize: uint64(len(icaPacketData.GetBytes()) - 1),
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	seq, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+	path.EndpointB.UpdateClient()
+
+	// relay the packet and the resulting error acknowledgement back to the controller
+	packetRelay := channeltypes.NewPacket(icaPacketData.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	err = path.RelayPacket(packetRelay)
+	suite.Require().NoError(err)
+
+	expAck := icatypes.NewErrorAcknowledgement(icatypes.ErrMaxPacketDataSize)
+	suite.Require().Equal("ABCI code: 21: codespace: interchainaccounts: error handling packet: see events for details", expAck.GetError())
+
+	ackCommitment, found := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, seq)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackCommitment)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	suite.assertBalance(icaAddr, startingBal)
+}
+
+func (suite *InterchainAccountsTestSuite) TestMaxMemoLengthErrorAcknowledgement() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	startingBal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: "memo accepted by the controller",
+	}
+
+	// the host accepts memos one byte shorter than the memo sent by the controller
+	params := types.Params{
+		HostEnabled:   true,
+		AllowMessages: []string{sdk.MsgTypeURL(msg)},
+		MaxMemoLength: uint64(len(icaPacketData.Memo) - 1),
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	seq, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+	path.EndpointB.UpdateClient()
+
+	// relay the packet and the resulting error acknowledgement back to the controller
+	packetRelay := channeltypes.NewPacket(icaPacketData.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	err = path.RelayPacket(packetRelay)
+	suite.Require().NoError(err)
+
+	expAck := icatypes.NewErrorAcknowledgement(types.ErrMaxMemoLength)
+	suite.Require().Equal("ABCI code: 7: codespace: icahost: error handling packet: see events for details", expAck.GetError())
+
+	ackCommitment, found := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, seq)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackCommitment)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	suite.assertBalance(icaAddr, startingBal)
+}
+
+// TestControlAccountAfterGenesisExportImport tests that a controller chain can control a registered interchain account after both chains
+// are restarted from their exported genesis. The interchain account is registered using MsgRegisterInterchainAccount, the disabled
+// middleware flag of the controller port as well as the port and channel capabilities must be restored when importing the genesis.
+func (suite *InterchainAccountsTestSuite) TestControlAccountAfterGenesisExportImport() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	msgRegister := controllertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, TestVersion)
+	_, err = suite.chainA.SendMsgs(msgRegister)
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	var (
+		startingBal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+		tokenAmt    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), portID, startingBal)
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	sendTx := func() {
+		msgSendTx := controllertypes.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), icaPacketData)
+		res, err := suite.chainA.SendMsgs(msgSendTx)
+		suite.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+
+		err = path.RelayPacket(packet)
+		suite.Require().NoError(err)
+	}
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	sendTx()
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt))
+
+	controllerGenesis := controllerkeeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
+	hostGenesis := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
+
+	suite.restartChainFromExportedGenesis(path.EndpointA)
+	suite.restartChainFromExportedGenesis(path.EndpointB)
+
+	suite.Require().Equal(controllerGenesis, controllerkeeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper))
+	suite.Require().Equal(hostGenesis, keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper))
+
+	suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), portID, path.EndpointA.ConnectionID))
+	suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.IsBound(suite.chainA.GetContext(), portID))
+	suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsBound(suite.chainB.GetContext(), icatypes.PortID))
+
+	_, found = suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, path.EndpointA.ChannelID))
+	suite.Require().True(found)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	sendTx()
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt).Sub(tokenAmt))
+}
+
+// restartChainFromExportedGenesis replaces the application of the chain of the provided endpoint with a fresh application
+// initialized from the genesis exported at the latest committed height. The counterparty client is updated to the latest
+// header beforehand, and the block in progress is replayed on the fresh application, so that the validator set at the
+// trusted height of the counterparty client remains available from the historical info.
+func (suite *InterchainAccountsTestSuite) restartChainFromExportedGenesis(endpoint *ibctesting.Endpoint) {
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+
+	chain := endpoint.Chain
+	exported, err := chain.GetSimApp().ExportAppStateAndValidators(false, nil)
+	suite.Require().NoError(err)
+
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 5, simapp.MakeTestEncodingConfig(), simapp.EmptyAppOptions{})
+	app.InitChain(abci.RequestInitChain{
+		Time:            chain.CurrentHeader.Time,
+		ChainId:         chain.ChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+		InitialHeight:   exported.Height,
+	})
+	app.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
+
+	chain.App = app
+	chain.QueryServer = app.GetIBCKeeper()
+
+	suite.coordinator.CommitBlock(chain)
+}
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 )
 
@@ -28,25 +29,75 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, control
 	k.SetInterchainAccountAddress(ctx, connectionID, controllerPortID, interchainAccount.Address)
 }
 
-// createInterchainAccount creates a new interchain account. An address is generated using the host connectionID, the controller portID,
-// and block dependent information. An error is returned if an account already exists for the generated account.
-// An interchain account type is set in the account keeper and the interchain account address mapping is updated.
+// createInterchainAccount creates a new interchain account. The address is derived from the host connectionID and the controller
+// portID using BuildInterchainAccountAddress. As the address is known in advance, an account may already exist for it, for example
+// if funds were sent to the address prior to registration. An existing base account which has never been used to sign a transaction
+// is converted to an interchain account, retaining its account number and balances. An existing interchain account owned by the same
+// controller port is reused. If any other account exists for the derived address, the address generated by GenerateUniqueAddress is
+// used instead, such that registration cannot be blocked by creating an account for the derived address. An interchain account type
+// is set in the account keeper and the interchain account address mapping is updated.
 func (k Keeper) createInterchainAccount(ctx sdk.Context, connectionID, controllerPortID string) (sdk.AccAddress, error) {
-	accAddress := icatypes.GenerateUniqueAddress(ctx, connectionID, controllerPortID)
+	accAddress := icatypes.BuildInterchainAccountAddress(connectionID, controllerPortID)
 
-	if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
-		return nil, sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for newly generated interchain account address %s", accAddress)
-	}
+	interchainAccount, ok := k.newInterchainAccount(ctx, accAddress, controllerPortID)
+	if !ok {
+		accAddress = icatypes.GenerateUniqueAddress(ctx, connectionID, controllerPortID)
 
-	interchainAccount := icatypes.NewInterchainAccount(
-		authtypes.NewBaseAccountWithAddress(accAddress),
-		controllerPortID,
-	)
+		k.Logger(ctx).Info("interchain account address is already in use, falling back to unique address", "connection-id", connectionID, "port-id", controllerPortID, "address", accAddress)
+
+		if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for newly generated interchain account address %s", accAddress)
+		}
+
+		interchainAccount, _ = k.newInterchainAccount(ctx, accAddress, controllerPortID)
+	}
 
-	k.accountKeeper.NewAccount(ctx, interchainAccount)
 	k.accountKeeper.SetAccount(ctx, interchainAccount)
 
 	k.SetInterchainAccountAddress(ctx, connectionID, controllerPortID, interchainAccount.Address)
 
 	return accAddress, nil
 }
+
+// newInterchainAccount returns the interchain account owned by the controller port for the provided address. A new account is
+// created if no account exists for the address. False is returned if the existing account cannot be used as interchain account.
+func (k Keeper) newInterchainAccount(ctx sdk.Context, accAddress sdk.AccAddress, controllerPortID string) (*icatypes.InterchainAccount, bool) {
+	switch acc := k.accountKeeper.GetAccount(ctx, accAddress).(type) {
+	case nil:
+		interchainAccount := icatypes.NewInterchainAccount(
+			authtypes.NewBaseAccountWithAddress(accAddress),
+			controllerPortID,
+		)
+
+		k.accountKeeper.NewAccount(ctx, interchainAccount)
+
+		return interchainAccount, true
+	case *icatypes.InterchainAccount:
+		return acc, acc.AccountOwner == controllerPortID
+	case *authtypes.BaseAccount:
+		if acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return nil, false
+		}
+
+		return icatypes.NewInterchainAccount(acc, controllerPortID), true
+	default:
+		return nil, false
+	}
+}
+
+// GetInterchainAccountAddressScheme returns the scheme used to derive the address of the interchain account registered for the
+// provided connection and controller port identifiers. Accounts registered prior to the introduction of BuildInterchainAccountAddress
+// retain their stored address and are reported using the legacy scheme, as are accounts registered using GenerateUniqueAddress because
+// the derived address was already in use. False is returned if no account is registered.
+func (k Keeper) GetInterchainAccountAddressScheme(ctx sdk.Context, connectionID, controllerPortID string) (types.AddressScheme, bool) {
+	addr, found := k.GetInterchainAccountAddress(ctx, connectionID, controllerPortID)
+	if !found {
+		return types.ADDRESS_SCHEME_UNSPECIFIED, false
+	}
+
+	if addr == icatypes.BuildInterchainAccountAddress(connectionID, controllerPortID).String() {
+		return types.ADDRESS_SCHEME_CONNECTION_PORT, true
+	}
+
+	return types.ADDRESS_SCHEME_LEGACY, true
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// BenchmarkOnRecvPacket benchmarks the execution of a packet containing 50 bank MsgSend messages
+func BenchmarkOnRecvPacket(b *testing.B) {
+	const msgCount = 50
+
+	// the coordinator requires a *testing.T, any failure during setup panics
+	coordinator := ibctesting.NewCoordinator(&testing.T{}, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := NewICAPath(chainA, chainB)
+	coordinator.SetupConnections(path)
+
+	if err := SetupICAPath(path, TestOwnerAddress); err != nil {
+		b.Fatal(err)
+	}
+
+	interchainAccountAddr, found := chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	if !found {
+		b.Fatal("interchain account not found")
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+	if _, err := chainB.SendMsgs(&banktypes.MsgSend{FromAddress: chainB.SenderAccount.GetAddress().String(), ToAddress: interchainAccountAddr, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(msgCount)))}); err != nil {
+		b.Fatal(err)
+	}
+
+	msgs := make([]sdk.Msg, msgCount)
+	for i := range msgs {
+		msgs[i] = &banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: chainB.SenderAccount.GetAddress().String(), Amount: amount}
+	}
+
+	data, err := icatypes.SerializeCosmosTx(chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	chainB.GetSimApp().ICAHostKeeper.SetParams(chainB.GetContext(), types.NewParams(true, []string{types.AllowAllHostMsgs}))
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		// each packet is executed against the same state
+		ctx, _ := chainB.GetContext().CacheContext()
+		if _, err := chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
@@ -29,3 +32,170 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitHostPausedEvent emits an event signalling that the provided packet was rejected as the host submodule is disabled.
+// The event allows relayers to distinguish packets rejected while the host is paused from failed executions. As the
+// packet is acknowledged with an error, the event is emitted by core IBC with the ErrorAttributeKeyPrefix.
+func EmitHostPausedEvent(ctx sdk.Context, packet exported.PacketI) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostPaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyControllerPortID, packet.GetSourcePort()),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		),
+	)
+}
+
+// EmitUpdateAllowMessagesEvent emits an event listing the message type URLs added to and removed from the host allow messages.
+func EmitUpdateAllowMessagesEvent(ctx sdk.Context, prevAllowMsgs, allowMsgs []string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateAllowMessages,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddedMessages, strings.Join(difference(allowMsgs, prevAllowMsgs), ",")),
+			sdk.NewAttribute(types.AttributeKeyRemovedMessages, strings.Join(difference(prevAllowMsgs, allowMsgs), ",")),
+		),
+	)
+}
+
+// EmitUpdateAddressBlocklistEvent emits an event listing the addresses added to and removed from the host address blocklist.
+func EmitUpdateAddressBlocklistEvent(ctx sdk.Context, added, removed []string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateBlocklist,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddedAddresses, strings.Join(added, ",")),
+			sdk.NewAttribute(types.AttributeKeyRemovedAddresses, strings.Join(removed, ",")),
+		),
+	)
+}
+
+// EmitPauseMessageTypeEvent emits an event signalling that the provided message type has been paused by the circuit breaker authority.
+func EmitPauseMessageTypeEvent(ctx sdk.Context, authority string, pausedMsgType types.PausedMessageType) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseMessageType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, pausedMsgType.TypeUrl),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatUint(pausedMsgType.ExpiryHeight, 10)),
+		),
+	)
+}
+
+// EmitUnpauseMessageTypeEvent emits an event signalling that the provided message type has been unpaused by the circuit breaker authority.
+func EmitUnpauseMessageTypeEvent(ctx sdk.Context, authority, typeURL string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpauseMessageType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+		),
+	)
+}
+
+// EmitNormalizeAllowMessagesEvent emits an event listing the allow messages entries which were provided as Msg service method
+// names and stored in type URL form. The connection ID is empty if the entries were provided in the host submodule params.
+func EmitNormalizeAllowMessagesEvent(ctx sdk.Context, connectionID string, normalized []string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNormalizeAllowMsgs,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyNormalizedMsgs, strings.Join(normalized, ",")),
+		),
+	)
+}
+
+// EmitExecuteMsgEvent emits an event signalling the successful or failed execution of a message by the host.
+func EmitExecuteMsgEvent(ctx sdk.Context, sourcePort, destChannel string, msg sdk.Msg, success bool) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteMsg,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyControllerPortID, sourcePort),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, destChannel),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+			sdk.NewAttribute(types.AttributeKeyMsgSuccess, strconv.FormatBool(success)),
+		),
+	)
+}
+
+// EmitExecuteTxEvent emits a summary event including the packet sequence, the number of messages executed by the host,
+// the packet memo and the gas consumed by the execution.
+func EmitExecuteTxEvent(ctx sdk.Context, sourcePort, destChannel string, sequence uint64, msgCount int, memo string, gasUsed uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyControllerPortID, sourcePort),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, destChannel),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgCount, strconv.Itoa(msgCount)),
+			sdk.NewAttribute(icatypes.AttributeKeyMemo, memo),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+}
+
+// EmitResetGasByConnectionEvent emits an event signalling that the gas used over the provided connection, or over all
+// connections if empty, has been reset by the gas reset authority.
+func EmitResetGasByConnectionEvent(ctx sdk.Context, authority, connectionID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetGasByConnection,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+		),
+	)
+}
+
+// EmitExecutionFeeEvent emits an event signalling the deduction of the execution fee from the interchain account
+// executing a transaction.
+func EmitExecutionFeeEvent(ctx sdk.Context, connectionID, sourcePort, address string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecutionFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyControllerPortID, sourcePort),
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, address),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
+
+// difference returns the elements of a which are not present in b, preserving the order of a
+func difference(a, b []string) []string {
+	set := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		set[s] = struct{}{}
+	}
+
+	var diff []string
+	for _, s := range a {
+		if _, ok := set[s]; !ok {
+			diff = append(diff, s)
+		}
+	}
+
+	return diff
+}
+
+// EmitUpdateChannelVersionEvent emits an event signalling the update of the version of a host channel upon receiving a
+// channel version update from the controller chain.
+func EmitUpdateChannelVersionEvent(ctx sdk.Context, portID, channelID, version string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeUpdateChannelVersion,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyVersion, version),
+		),
+	)
+}
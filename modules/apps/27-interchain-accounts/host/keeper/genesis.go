@@ -5,12 +5,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 // InitGenesis initializes the interchain accounts host application state from a provided genesis state
-func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.HostGenesisState) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisState) {
+	keeper.SetPort(ctx, state.Port)
+
 	if !keeper.IsBound(ctx, state.Port) {
 		cap := keeper.BindPort(ctx, state.Port)
 		if err := keeper.ClaimCapability(ctx, cap, host.PortPath(state.Port)); err != nil {
@@ -26,15 +29,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.HostGenesisState
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, connAllowMsgs := range state.ConnectionAllowMessages {
+		keeper.SetConnectionAllowMessages(ctx, connAllowMsgs.ConnectionId, connAllowMsgs.AllowMessages)
+	}
+
+	for _, address := range state.AddressBlocklist {
+		keeper.SetBlockedAddress(ctx, address)
+	}
+
+	for _, pausedMsgType := range state.PausedMessageTypes {
+		keeper.SetPausedMessageType(ctx, pausedMsgType)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the interchain accounts host exported genesis
-func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.HostGenesisState {
-	return icatypes.NewHostGenesisState(
+func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState {
+	genesisState := genesistypes.NewHostGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.PortID,
 		keeper.GetParams(ctx),
 	)
+	genesisState.ConnectionAllowMessages = keeper.GetAllConnectionAllowMessages(ctx)
+	genesisState.AddressBlocklist = keeper.GetAddressBlocklist(ctx)
+	genesisState.PausedMessageTypes = keeper.GetAllPausedMessageTypes(ctx)
+
+	return genesisState
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
@@ -11,15 +12,15 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest()
 
 	interchainAccAddr := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
-	genesisState := icatypes.HostGenesisState{
-		ActiveChannels: []icatypes.ActiveChannel{
+	genesisState := genesistypes.HostGenesisState{
+		ActiveChannels: []genesistypes.ActiveChannel{
 			{
 				ConnectionId: ibctesting.FirstConnectionID,
 				PortId:       TestPortID,
 				ChannelId:    ibctesting.FirstChannelID,
 			},
 		},
-		InterchainAccounts: []icatypes.RegisteredInterchainAccount{
+		InterchainAccounts: []genesistypes.RegisteredInterchainAccount{
 			{
 				ConnectionId:   ibctesting.FirstConnectionID,
 				PortId:         TestPortID,
@@ -27,10 +28,23 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.PortID,
+		ConnectionAllowMessages: []types.ConnectionAllowMessages{
+			types.NewConnectionAllowMessages(ibctesting.FirstConnectionID, []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+		},
+		AddressBlocklist: []string{suite.chainB.SenderAccount.GetAddress().String()},
+		PausedMessageTypes: []types.PausedMessageType{
+			types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 0),
+		},
 	}
 
+	// the port capability is already bound, as is the case when the capability genesis is imported first
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Delete(icatypes.KeyPort(icatypes.PortID))
+
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
 
+	suite.Require().True(store.Has(icatypes.KeyPort(icatypes.PortID)))
+
 	channelID, found := suite.chainA.GetSimApp().ICAHostKeeper.GetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
 	suite.Require().True(found)
 	suite.Require().Equal(ibctesting.FirstChannelID, channelID)
@@ -39,6 +53,14 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+	allowMsgs, found := suite.chainA.GetSimApp().ICAHostKeeper.GetConnectionAllowMessages(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, allowMsgs)
+
+	suite.Require().True(suite.chainA.GetSimApp().ICAHostKeeper.IsAddressBlocked(suite.chainA.GetContext(), suite.chainB.SenderAccount.GetAddress().String()))
+
+	suite.Require().True(suite.chainA.GetSimApp().ICAHostKeeper.IsMessageTypePaused(suite.chainA.GetContext(), "/cosmos.gov.v1beta1.MsgVote"))
+
 	expParams := types.NewParams(false, nil)
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
@@ -56,6 +78,10 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
+	suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainB.GetContext(), path.EndpointB.ConnectionID, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetBlockedAddress(suite.chainB.GetContext(), suite.chainA.SenderAccount.GetAddress().String())
+	suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 100))
+
 	genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
 
 	suite.Require().Equal(path.EndpointB.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -66,6 +92,26 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal(icatypes.PortID, genesisState.GetPort())
 
+	suite.Require().Equal(path.EndpointB.ConnectionID, genesisState.ConnectionAllowMessages[0].ConnectionId)
+	suite.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, genesisState.ConnectionAllowMessages[0].AllowMessages)
+
+	suite.Require().Equal([]string{suite.chainA.SenderAccount.GetAddress().String()}, genesisState.AddressBlocklist)
+
+	suite.Require().Equal([]types.PausedMessageType{types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 100)}, genesisState.PausedMessageTypes)
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
+
+func (suite *KeeperTestSuite) TestGenesisAllowAllHostMsgs() {
+	suite.SetupTest()
+
+	genesisState := genesistypes.DefaultHostGenesis()
+	genesisState.Params = types.NewParams(true, []string{types.AllowAllHostMsgs})
+
+	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
+
+	exported := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper)
+	suite.Require().Equal(genesisState.Params, exported.GetParams())
+	suite.Require().NoError(exported.Validate())
+}
@@ -2,10 +2,18 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +27,287 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// AllowMessagesForConnection implements the Query/AllowMessagesForConnection gRPC method
+func (q Keeper) AllowMessagesForConnection(c context.Context, req *types.QueryAllowMessagesForConnectionRequest) (*types.QueryAllowMessagesForConnectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowMsgs, found := q.GetConnectionAllowMessages(ctx, req.ConnectionId)
+	if !found {
+		allowMsgs = q.GetAllowMessages(ctx)
+	}
+
+	return &types.QueryAllowMessagesForConnectionResponse{
+		AllowMessages:      allowMsgs,
+		ConnectionOverride: found,
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var interchainAccounts []types.RegisteredInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(fmt.Sprintf("%s/", icatypes.OwnerKeyPrefix)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return fmt.Errorf("invalid interchain account key: %s", key)
+		}
+
+		interchainAccounts = append(interchainAccounts, types.RegisteredInterchainAccount{
+			ConnectionId:   keySplit[1],
+			PortId:         keySplit[0],
+			AccountAddress: string(value),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve interchain account for port %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	scheme, _ := q.GetInterchainAccountAddressScheme(ctx, req.ConnectionId, req.PortId)
+
+	return &types.QueryInterchainAccountResponse{
+		Address:       addr,
+		AddressScheme: scheme,
+	}, nil
+}
+
+// ExecutionResults implements the Query/ExecutionResults gRPC method
+func (q Keeper) ExecutionResults(c context.Context, req *types.QueryExecutionResultsRequest) (*types.QueryExecutionResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var results []types.ExecutionResult
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyExecutionResultPrefix(icatypes.PortID, req.ChannelId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.ExecutionResult
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutionResultsResponse{
+		ExecutionResults: results,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// AddressBlocklist implements the Query/AddressBlocklist gRPC method
+func (q Keeper) AddressBlocklist(c context.Context, req *types.QueryAddressBlocklistRequest) (*types.QueryAddressBlocklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var addresses []string
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyAddressBlocklistPrefix())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressBlocklistResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PausedMessageTypes implements the Query/PausedMessageTypes gRPC method. Paused message types which have expired are omitted.
+func (q Keeper) PausedMessageTypes(c context.Context, req *types.QueryPausedMessageTypesRequest) (*types.QueryPausedMessageTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pausedMsgTypes []types.PausedMessageType
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPausedMessageTypePrefix())
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var pausedMsgType types.PausedMessageType
+		if err := q.cdc.Unmarshal(value, &pausedMsgType); err != nil {
+			return false, err
+		}
+
+		if !pausedMsgType.IsActive(uint64(ctx.BlockHeight())) {
+			return false, nil
+		}
+
+		if accumulate {
+			pausedMsgTypes = append(pausedMsgTypes, pausedMsgType)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausedMessageTypesResponse{
+		PausedMessageTypes: pausedMsgTypes,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// ChannelMetadata implements the Query/ChannelMetadata gRPC method. The version of the active channel is returned without
+// metadata if it cannot be parsed as ICS27 metadata.
+func (q Keeper) ChannelMetadata(c context.Context, req *types.QueryChannelMetadataRequest) (*types.QueryChannelMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channelID, found := q.GetActiveChannelID(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve active channel for %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	channel, found := q.channelKeeper.GetChannel(ctx, icatypes.PortID, channelID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve channel %s on port %s", channelID, icatypes.PortID)
+	}
+
+	res := &types.QueryChannelMetadataResponse{
+		ChannelId: channelID,
+		Version:   channel.Version,
+	}
+
+	if metadata, err := icatypes.MetadataFromVersion(channel.Version); err == nil {
+		res.Metadata = &metadata
+	}
+
+	return res, nil
+}
+
+// CollectedExecutionFees implements the Query/CollectedExecutionFees gRPC method. The execution fees collected over all
+// connections are returned if no connection identifier is provided.
+func (q Keeper) CollectedExecutionFees(c context.Context, req *types.QueryCollectedExecutionFeesRequest) (*types.QueryCollectedExecutionFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var collectedFees []types.CollectedExecutionFees
+	if req.ConnectionId == "" {
+		collectedFees = q.GetAllCollectedExecutionFees(ctx)
+	} else {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if fees := q.GetCollectedExecutionFees(ctx, req.ConnectionId); !fees.IsZero() {
+			collectedFees = []types.CollectedExecutionFees{{ConnectionId: req.ConnectionId, Fees: fees}}
+		}
+	}
+
+	total := sdk.NewCoins()
+	for _, fees := range collectedFees {
+		total = total.Add(fees.Fees...)
+	}
+
+	return &types.QueryCollectedExecutionFeesResponse{
+		CollectedFees: collectedFees,
+		Total:         total,
+	}, nil
+}
+
+// GasByConnection implements the Query/GasByConnection gRPC method
+func (q Keeper) GasByConnection(c context.Context, req *types.QueryGasByConnectionRequest) (*types.QueryGasByConnectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var gasByConnection []types.ConnectionGasUsed
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyConnectionGasUsedPrefix())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var gasUsed types.ConnectionGasUsed
+		if err := q.cdc.Unmarshal(value, &gasUsed); err != nil {
+			return err
+		}
+
+		gasByConnection = append(gasByConnection, gasUsed)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasByConnectionResponse{
+		GasByConnection: gasByConnection,
+		Pagination:      pageRes,
+	}, nil
+}
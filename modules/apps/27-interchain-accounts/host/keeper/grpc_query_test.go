@@ -2,13 +2,771 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
-	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
+	res, err := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
 	suite.Require().Equal(&expParams, res.Params)
+
+	expParams = types.Params{
+		AllowMessages:        []string{"/cosmos.bank.v1beta1.MsgSend"},
+		MaxTxGas:             100000,
+		MaxMsgsPerPacket:     5,
+		MaxExecutionResults:  10,
+		AllowMultiIcaSigners: true,
+		AllowQueries:         []string{"/cosmos.bank.v1beta1.Query/Balance"},
+		MaxQueryResponseSize: 1024,
+		MaxPacketDataSize:    2048,
+	}
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
+
+	res, err = suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryAllowMessagesForConnection() {
+	var (
+		req         *types.QueryAllowMessagesForConnectionRequest
+		expMsgs     []string
+		expOverride bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: host params apply",
+			func() {
+				expMsgs = suite.chainA.GetSimApp().ICAHostKeeper.GetAllowMessages(suite.chainA.GetContext())
+				expOverride = false
+			},
+			true,
+		},
+		{
+			"success: connection allow messages apply",
+			func() {
+				expMsgs = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+				expOverride = true
+
+				suite.chainA.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainA.GetContext(), ibctesting.FirstConnectionID, expMsgs)
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryAllowMessagesForConnectionRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.AllowMessagesForConnection(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expMsgs, res.AllowMessages)
+				suite.Require().Equal(expOverride, res.ConnectionOverride)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts []types.RegisteredInterchainAccount
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			addr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			expAccounts = []types.RegisteredInterchainAccount{
+				{
+					ConnectionId:   path.EndpointB.ConnectionID,
+					PortId:         path.EndpointA.ChannelConfig.PortID,
+					AccountAddress: addr,
+				},
+			}
+
+			req = &types.QueryInterchainAccountsRequest{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAccounts, res.InterchainAccounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		req       *types.QueryInterchainAccountRequest
+		expScheme types.AddressScheme
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: legacy address scheme",
+			func() {
+				legacyAddr := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), req.ConnectionId, req.PortId)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), req.ConnectionId, req.PortId, legacyAddr.String())
+
+				expScheme = types.ADDRESS_SCHEME_LEGACY
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.PortId = "icacontroller-unknown"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				ConnectionId: path.EndpointB.ConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+			expScheme = types.ADDRESS_SCHEME_CONNECTION_PORT
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddr, res.Address)
+				suite.Require().Equal(expScheme, res.AddressScheme)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExecutionResults() {
+	var (
+		req        *types.QueryExecutionResultsRequest
+		expResults []types.ExecutionResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+
+				expResults = expResults[:1]
+			},
+			true,
+		},
+		{
+			"success: no execution results stored",
+			func() {
+				req.ChannelId = "channel-100"
+
+				expResults = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expResults = []types.ExecutionResult{
+				{Sequence: 1, MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}, Success: true},
+				{Sequence: 2, MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}, Success: false, Code: 5},
+			}
+
+			for _, result := range expResults {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetExecutionResult(suite.chainB.GetContext(), icatypes.PortID, ibctesting.FirstChannelID, result)
+			}
+
+			req = &types.QueryExecutionResultsRequest{
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ExecutionResults(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.ExecutionResults)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAddressBlocklist() {
+	var (
+		req          *types.QueryAddressBlocklistRequest
+		expAddresses []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+				expAddresses = expAddresses[:1]
+			},
+			true,
+		},
+		{
+			"success: empty blocklist",
+			func() {
+				for _, addr := range expAddresses {
+					suite.chainA.GetSimApp().ICAHostKeeper.DeleteBlockedAddress(suite.chainA.GetContext(), addr)
+				}
+				expAddresses = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			for _, addr := range []string{suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()} {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetBlockedAddress(suite.chainA.GetContext(), addr)
+			}
+			expAddresses = suite.chainA.GetSimApp().ICAHostKeeper.GetAddressBlocklist(suite.chainA.GetContext())
+			suite.Require().Len(expAddresses, 2)
+
+			req = &types.QueryAddressBlocklistRequest{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.AddressBlocklist(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddresses, res.Addresses)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPausedMessageTypes() {
+	var (
+		req               *types.QueryPausedMessageTypesRequest
+		expPausedMsgTypes []types.PausedMessageType
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+				expPausedMsgTypes = expPausedMsgTypes[:1]
+			},
+			true,
+		},
+		{
+			"success: expired paused message types are omitted",
+			func() {
+				expiryHeight := uint64(suite.chainA.GetContext().BlockHeight()) - 1
+				suite.chainA.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainA.GetContext(), types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", expiryHeight))
+			},
+			true,
+		},
+		{
+			"success: no paused message types",
+			func() {
+				for _, pausedMsgType := range expPausedMsgTypes {
+					suite.chainA.GetSimApp().ICAHostKeeper.DeletePausedMessageType(suite.chainA.GetContext(), pausedMsgType.TypeUrl)
+				}
+				expPausedMsgTypes = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expPausedMsgTypes = []types.PausedMessageType{
+				types.NewPausedMessageType("/cosmos.bank.v1beta1.MsgSend", 0),
+				types.NewPausedMessageType("/cosmos.staking.v1beta1.MsgDelegate", uint64(suite.chainA.GetContext().BlockHeight())),
+			}
+			for _, pausedMsgType := range expPausedMsgTypes {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainA.GetContext(), pausedMsgType)
+			}
+
+			req = &types.QueryPausedMessageTypesRequest{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.PausedMessageTypes(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPausedMsgTypes, res.PausedMessageTypes)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelMetadata() {
+	var (
+		req         *types.QueryChannelMetadataRequest
+		version     string
+		expMetadata *icatypes.Metadata
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: fee enabled channel version",
+			func() {
+				version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: version}))
+			},
+			true,
+		},
+		{
+			"success: legacy version is returned without metadata",
+			func() {
+				version = icatypes.Version
+				expMetadata = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				req.PortId = "icacontroller-unknown"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryChannelMetadataRequest{
+				ConnectionId: path.EndpointB.ConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+			}
+
+			channel := path.EndpointB.GetChannel()
+			version = channel.Version
+
+			metadata, err := icatypes.MetadataFromVersion(version)
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(metadata.Address)
+			suite.Require().Equal(path.EndpointA.ConnectionID, metadata.ControllerConnectionId)
+			suite.Require().Equal(path.EndpointB.ConnectionID, metadata.HostConnectionId)
+			expMetadata = &metadata
+
+			tc.malleate()
+
+			channel.Version = version
+			suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.SetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channel)
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ChannelMetadata(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointB.ChannelID, res.ChannelId)
+				suite.Require().Equal(version, res.Version)
+				suite.Require().Equal(expMetadata, res.Metadata)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryCollectedExecutionFees() {
+	var (
+		req              *types.QueryCollectedExecutionFeesRequest
+		expCollectedFees []types.CollectedExecutionFees
+		expTotal         sdk.Coins
+	)
+
+	collectedFees := []types.CollectedExecutionFees{
+		{ConnectionId: ibctesting.FirstConnectionID, Fees: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+		{ConnectionId: "connection-1", Fees: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)), sdk.NewCoin("atom", sdk.NewInt(10)))},
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: all connections",
+			func() {},
+			true,
+		},
+		{
+			"success: single connection",
+			func() {
+				req.ConnectionId = ibctesting.FirstConnectionID
+				expCollectedFees = collectedFees[:1]
+				expTotal = collectedFees[0].Fees
+			},
+			true,
+		},
+		{
+			"success: no fees collected over connection",
+			func() {
+				req.ConnectionId = "connection-2"
+				expCollectedFees = nil
+				expTotal = sdk.NewCoins()
+			},
+			true,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = "connection/0"
+			},
+			false,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			for _, fees := range collectedFees {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetCollectedExecutionFees(suite.chainA.GetContext(), fees)
+			}
+
+			req = &types.QueryCollectedExecutionFeesRequest{}
+			expCollectedFees = collectedFees
+			expTotal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)), sdk.NewCoin("atom", sdk.NewInt(10)))
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.CollectedExecutionFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expCollectedFees, res.CollectedFees)
+				suite.Require().Equal(expTotal, res.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryGasByConnection() {
+	var (
+		req                *types.QueryGasByConnectionRequest
+		expGasByConnection []types.ConnectionGasUsed
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+				expGasByConnection = expGasByConnection[:1]
+			},
+			true,
+		},
+		{
+			"success: no gas used",
+			func() {
+				for _, gasUsed := range expGasByConnection {
+					suite.chainA.GetSimApp().ICAHostKeeper.DeleteConnectionGasUsed(suite.chainA.GetContext(), gasUsed.ConnectionId)
+				}
+				expGasByConnection = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			expGasByConnection = []types.ConnectionGasUsed{
+				{ConnectionId: ibctesting.FirstConnectionID, GasUsed: 100000},
+				{ConnectionId: "connection-1", GasUsed: 50000},
+			}
+			for _, gasUsed := range expGasByConnection {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetConnectionGasUsed(suite.chainA.GetContext(), gasUsed)
+			}
+
+			req = &types.QueryGasByConnectionRequest{}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.GasByConnection(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expGasByConnection, res.GasByConnection)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
 }
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -16,7 +17,8 @@ import (
 // OnChanOpenTry performs basic validation of the ICA channel
 // and registers a new interchain account (if it doesn't exist).
 // The version returned will include the registered interchain
-// account address.
+// account address. If the channel handshake is rejected, the reason
+// for the rejection is included in the returned error.
 func (k Keeper) OnChanOpenTry(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -27,21 +29,52 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
+	version, reason, err := k.onChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+	if err != nil {
+		return "", icatypes.WrapRejectionReason(err, reason)
+	}
+
+	return version, nil
+}
+
+// onChanOpenTry performs the validation of the ICA channel and the registration of the interchain account for
+// OnChanOpenTry, returning the reason for the rejection of the channel handshake along with any error.
+func (k Keeper) onChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, string, error) {
 	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+		return "", icatypes.RejectionReasonInvalidOrdering, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
 	}
 
 	if portID != icatypes.PortID {
-		return "", sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.PortID, portID)
+		return "", icatypes.RejectionReasonInvalidPort, sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.PortID, portID)
+	}
+
+	// the host port is bound during genesis, however a handshake may be retried against a host which has not bound the port
+	switch {
+	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
+		return "", icatypes.RejectionReasonPortBound, sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+	case !k.portKeeper.IsBound(ctx, portID):
+		cap := k.BindPort(ctx, portID)
+		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
+			return "", icatypes.RejectionReasonCapability, sdkerrors.Wrapf(err, "unable to bind to portID: %s", portID)
+		}
 	}
 
 	var metadata icatypes.Metadata
 	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &metadata); err != nil {
-		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+		return "", icatypes.RejectionReasonInvalidMetadata, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
 	}
 
 	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
-		return "", err
+		return "", icatypes.RejectionReasonInvalidMetadata, err
 	}
 
 	activeChannelID, found := k.GetActiveChannelID(ctx, connectionHops[0], counterparty.PortId)
@@ -52,18 +85,27 @@ func (k Keeper) OnChanOpenTry(
 		}
 
 		if channel.State == channeltypes.OPEN {
-			return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
+			return "", icatypes.RejectionReasonActiveChannelOpen, sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
 		if !icatypes.IsPreviousMetadataEqual(channel.Version, metadata) {
-			return "", sdkerrors.Wrap(icatypes.ErrInvalidVersion, "previous active channel metadata does not match provided version")
+			return "", icatypes.RejectionReasonMetadataMismatch, sdkerrors.Wrap(icatypes.ErrInvalidVersion, "previous active channel metadata does not match provided version")
 		}
 	}
 
+	// new interchain accounts may only be registered over trusted controller connections,
+	// an existing interchain account may be reopened over any connection
+	if _, found := k.GetInterchainAccountAddress(ctx, metadata.HostConnectionId, counterparty.PortId); !found && !k.IsTrustedControllerConnection(ctx, metadata.HostConnectionId) {
+		return "", icatypes.RejectionReasonUntrustedConnection, sdkerrors.Wrapf(types.ErrUntrustedControllerConnection, "cannot register interchain account for portID %s over connection %s", counterparty.PortId, metadata.HostConnectionId)
+	}
+
 	// On the host chain the capability may only be claimed during the OnChanOpenTry
 	// The capability being claimed in OpenInit is for a controller chain (the port is different)
-	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
-		return "", sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
+	// The capability is reused if it has already been claimed by the host submodule in a previous attempt
+	if !k.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", icatypes.RejectionReasonCapability, sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
+		}
 	}
 
 	var (
@@ -76,27 +118,27 @@ func (k Keeper) OnChanOpenTry(
 		// reopening an interchain account
 		accAddress = sdk.MustAccAddressFromBech32(interchainAccAddr)
 		if _, ok := k.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount); !ok {
-			return "", sdkerrors.Wrapf(icatypes.ErrInvalidAccountReopening, "existing account address %s, does not have interchain account type", accAddress)
+			return "", icatypes.RejectionReasonInvalidAccount, sdkerrors.Wrapf(icatypes.ErrInvalidAccountReopening, "existing account address %s, does not have interchain account type", accAddress)
 		}
 
 	} else {
 		accAddress, err = k.createInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId)
 		if err != nil {
-			return "", err
+			return "", icatypes.RejectionReasonInvalidAccount, err
 		}
 	}
 
-	logger.InitLogger()
-	logger.LogInfo("The ICA address is:", interchainAccAddr)
-	fmt.Printf("The ICA address is %s:", interchainAccAddr)
+	logger.LogDebugKV(ctx, "interchain account address", "address", interchainAccAddr)
 
 	metadata.Address = accAddress.String()
 	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
 	if err != nil {
-		return "", err
+		return "", icatypes.RejectionReasonInvalidMetadata, err
 	}
 
-	return string(versionBytes), nil
+	k.SetChannelEncoding(ctx, portID, channelID, metadata.Encoding)
+
+	return string(versionBytes), "", nil
 }
 
 // OnChanOpenConfirm completes the handshake process by setting the active channel in state on the host chain
@@ -119,11 +161,23 @@ func (k Keeper) OnChanOpenConfirm(
 	return nil
 }
 
-// OnChanCloseConfirm removes the active channel stored in state
+// OnChanCloseConfirm removes the active channel stored in state.
+// The interchain account address is preserved, allowing the controller chain to open a new channel for the same account
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	// the active channel is keyed by the controller port identifier
+	activeChannelID, found := k.GetActiveChannelID(ctx, channel.ConnectionHops[0], channel.Counterparty.PortId)
+	if found && activeChannelID == channelID {
+		k.DeleteActiveChannelID(ctx, channel.ConnectionHops[0], channel.Counterparty.PortId)
+	}
+
 	return nil
 }
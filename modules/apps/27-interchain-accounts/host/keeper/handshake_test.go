@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
@@ -40,10 +43,11 @@ func (suite *KeeperTestSuite) openAndCloseChannel(path *ibctesting.Path) {
 
 func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 	var (
-		channel  *channeltypes.Channel
-		path     *ibctesting.Path
-		chanCap  *capabilitytypes.Capability
-		metadata icatypes.Metadata
+		channel    *channeltypes.Channel
+		path       *ibctesting.Path
+		chanCap    *capabilitytypes.Capability
+		metadata   icatypes.Metadata
+		expAddress sdk.AccAddress
 	)
 
 	testCases := []struct {
@@ -69,7 +73,7 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			}, true,
 		},
 		{
-			"success - reopening account with new address",
+			"success - reopening account with deleted address mapping",
 			func() {
 				// create interchain account
 				// undo setup
@@ -127,13 +131,50 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			}, false,
 		},
 		{
-			"account already exists",
+			"success: unused base account is converted to interchain account",
 			func() {
-				interchainAccAddr := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				interchainAccAddr := icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), interchainAccAddr, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(1))})
 				suite.Require().NoError(err)
 				suite.Require().True(suite.chainB.GetSimApp().AccountKeeper.HasAccount(suite.chainB.GetContext(), interchainAccAddr))
 			},
+			true,
+		},
+		{
+			"success: used base account at derived address falls back to unique address",
+			func() {
+				interchainAccAddr := icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				baseAcc := authtypes.NewBaseAccountWithAddress(interchainAccAddr)
+				err := baseAcc.SetSequence(1)
+				suite.Require().NoError(err)
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), baseAcc)
+				expAddress = icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			},
+			true,
+		},
+		{
+			"success: vesting account at derived address falls back to unique address",
+			func() {
+				interchainAccAddr := icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				vestingAcc := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(interchainAccAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))), suite.chainB.GetContext().BlockTime().Unix()+1000)
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), vestingAcc)
+				expAddress = icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			},
+			true,
+		},
+		{
+			"account already exists at derived and unique address",
+			func() {
+				for _, addr := range []sdk.AccAddress{
+					icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID),
+					icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID),
+				} {
+					baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+					err := baseAcc.SetSequence(1)
+					suite.Require().NoError(err)
+					suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), baseAcc)
+				}
+			},
 			false,
 		},
 		{
@@ -247,12 +288,51 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			false,
 		},
 		{
-			"capability already claimed",
+			"success - capability already claimed by host submodule",
 			func() {
 				path.EndpointB.SetChannel(*channel)
 				err := suite.chainB.GetSimApp().ScopedICAHostKeeper.ClaimCapability(suite.chainB.GetContext(), chanCap, host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
 				suite.Require().NoError(err)
 			},
+			true,
+		},
+		{
+			"success - OnChanOpenTry is retried",
+			func() {
+				path.EndpointB.SetChannel(*channel)
+				_, err := suite.chainB.GetSimApp().ICAHostKeeper.OnChanOpenTry(suite.chainB.GetContext(), channel.Ordering, channel.GetConnectionHops(),
+					path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, chanCap, channel.Counterparty, path.EndpointA.ChannelConfig.Version,
+				)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"success - host port is not bound",
+			func() {
+				portCap, found := suite.chainB.GetSimApp().ScopedICAHostKeeper.GetCapability(suite.chainB.GetContext(), host.PortPath(icatypes.PortID))
+				suite.Require().True(found)
+
+				err := suite.chainB.GetSimApp().ScopedICAHostKeeper.ReleaseCapability(suite.chainB.GetContext(), portCap)
+				suite.Require().NoError(err)
+				err = suite.chainB.GetSimApp().GetScopedIBCKeeper().ReleaseCapability(suite.chainB.GetContext(), portCap)
+				suite.Require().NoError(err)
+
+				suite.Require().False(suite.chainB.GetSimApp().GetIBCKeeper().PortKeeper.IsBound(suite.chainB.GetContext(), icatypes.PortID))
+			},
+			true,
+		},
+		{
+			"host port is bound by another module",
+			func() {
+				portCap, found := suite.chainB.GetSimApp().ScopedICAHostKeeper.GetCapability(suite.chainB.GetContext(), host.PortPath(icatypes.PortID))
+				suite.Require().True(found)
+
+				err := suite.chainB.GetSimApp().ScopedICAHostKeeper.ReleaseCapability(suite.chainB.GetContext(), portCap)
+				suite.Require().NoError(err)
+				err = suite.chainB.GetSimApp().ScopedTransferKeeper.ClaimCapability(suite.chainB.GetContext(), portCap, host.PortPath(icatypes.PortID))
+				suite.Require().NoError(err)
+			},
 			false,
 		},
 		{
@@ -301,6 +381,8 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			chanCap, err = suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
 			suite.Require().NoError(err)
 
+			expAddress = icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
 			tc.malleate() // malleate mutates test data
 
 			version, err := suite.chainB.GetSimApp().ICAHostKeeper.OnChanOpenTry(suite.chainB.GetContext(), channel.Ordering, channel.GetConnectionHops(),
@@ -312,6 +394,7 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 
 				storedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 				suite.Require().True(found)
+				suite.Require().Equal(expAddress.String(), storedAddr)
 
 				interchainAccAddr, err := sdk.AccAddressFromBech32(storedAddr)
 				suite.Require().NoError(err)
@@ -319,6 +402,9 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 				// Check if account is created
 				interchainAccount := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), interchainAccAddr)
 				suite.Require().Equal(interchainAccount.GetAddress().String(), storedAddr)
+
+				_, ok := interchainAccount.(*icatypes.InterchainAccount)
+				suite.Require().True(ok)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -327,6 +413,259 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnChanOpenTryRejectionReason() {
+	var (
+		channel  *channeltypes.Channel
+		path     *ibctesting.Path
+		chanCap  *capabilitytypes.Capability
+		metadata icatypes.Metadata
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expReason string
+	}{
+		{
+			"invalid order - UNORDERED",
+			func() {
+				channel.Ordering = channeltypes.UNORDERED
+			},
+			icatypes.RejectionReasonInvalidOrdering,
+		},
+		{
+			"invalid port ID",
+			func() {
+				path.EndpointB.ChannelConfig.PortID = "invalid-port-id"
+			},
+			icatypes.RejectionReasonInvalidPort,
+		},
+		{
+			"host port is bound by another module",
+			func() {
+				portCap, found := suite.chainB.GetSimApp().ScopedICAHostKeeper.GetCapability(suite.chainB.GetContext(), host.PortPath(icatypes.PortID))
+				suite.Require().True(found)
+
+				err := suite.chainB.GetSimApp().ScopedICAHostKeeper.ReleaseCapability(suite.chainB.GetContext(), portCap)
+				suite.Require().NoError(err)
+				err = suite.chainB.GetSimApp().ScopedTransferKeeper.ClaimCapability(suite.chainB.GetContext(), portCap, host.PortPath(icatypes.PortID))
+				suite.Require().NoError(err)
+			},
+			icatypes.RejectionReasonPortBound,
+		},
+		{
+			"invalid metadata bytestring",
+			func() {
+				path.EndpointA.ChannelConfig.Version = "invalid-metadata-bytestring"
+			},
+			icatypes.RejectionReasonInvalidMetadata,
+		},
+		{
+			"unsupported encoding format",
+			func() {
+				metadata.Encoding = "invalid-encoding-format"
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+			},
+			icatypes.RejectionReasonInvalidMetadata,
+		},
+		{
+			"active channel already set",
+			func() {
+				ch := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), []string{path.EndpointA.ConnectionID}, ibctesting.DefaultChannelVersion)
+				suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, ch)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			icatypes.RejectionReasonActiveChannelOpen,
+		},
+		{
+			"previous metadata is different",
+			func() {
+				ch := channeltypes.NewChannel(channeltypes.CLOSED, channeltypes.ORDERED, channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), []string{path.EndpointA.ConnectionID}, TestVersion)
+				suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, ch)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+				metadata.TxType = icatypes.TxTypeSDKMultiMsgBatch
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+			},
+			icatypes.RejectionReasonMetadataMismatch,
+		},
+		{
+			"untrusted controller connection",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.TrustedControllerConnections = []string{"connection-100"}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			icatypes.RejectionReasonUntrustedConnection,
+		},
+		{
+			"reopened account is not interchain account type",
+			func() {
+				accAddress := icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), authtypes.NewBaseAccountWithAddress(accAddress))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, accAddress.String())
+			},
+			icatypes.RejectionReasonInvalidAccount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// set the channel id on host
+			channelSequence := path.EndpointB.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(path.EndpointB.Chain.GetContext())
+			path.EndpointB.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+
+			// default values
+			metadata = icatypes.NewMetadata(icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, "", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+			suite.Require().NoError(err)
+
+			counterparty := channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			channel = &channeltypes.Channel{
+				State:          channeltypes.TRYOPEN,
+				Ordering:       channeltypes.ORDERED,
+				Counterparty:   counterparty,
+				ConnectionHops: []string{path.EndpointB.ConnectionID},
+				Version:        string(versionBytes),
+			}
+
+			chanCap, err = suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainB.GetContext()
+			version, err := suite.chainB.GetSimApp().ICAHostKeeper.OnChanOpenTry(ctx, channel.Ordering, channel.GetConnectionHops(),
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, chanCap, channel.Counterparty, path.EndpointA.ChannelConfig.Version,
+			)
+
+			suite.Require().Error(err)
+			suite.Require().Empty(version)
+			suite.Require().Contains(err.Error(), fmt.Sprintf("%s: %s", icatypes.AttributeKeyRejectionReason, tc.expReason))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnChanOpenTryTrustedControllerConnections() {
+	var (
+		path          *ibctesting.Path
+		chanCap       *capabilitytypes.Capability
+		trustedConnID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success - no trusted controller connections set",
+			func() {
+				trustedConnID = ""
+			},
+			nil,
+		},
+		{
+			"success - connection is trusted",
+			func() {},
+			nil,
+		},
+		{
+			"success - reopening existing interchain account over untrusted connection",
+			func() {
+				// undo setup
+				path.EndpointB.ChannelID = ""
+				err := suite.chainB.App.GetScopedIBCKeeper().ReleaseCapability(suite.chainB.GetContext(), chanCap)
+				suite.Require().NoError(err)
+
+				suite.openAndCloseChannel(path)
+
+				chanCap, err = suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				suite.Require().NoError(err)
+
+				trustedConnID = "connection-100"
+			},
+			nil,
+		},
+		{
+			"untrusted connection cannot register a new interchain account",
+			func() {
+				trustedConnID = "connection-100"
+			},
+			hosttypes.ErrUntrustedControllerConnection,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// set the channel id on host
+			channelSequence := path.EndpointB.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(path.EndpointB.Chain.GetContext())
+			path.EndpointB.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+
+			chanCap, err = suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			suite.Require().NoError(err)
+
+			trustedConnID = path.EndpointB.ConnectionID
+
+			tc.malleate() // malleate mutates test data
+
+			params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+			params.TrustedControllerConnections = nil
+			if trustedConnID != "" {
+				params.TrustedControllerConnections = []string{trustedConnID}
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			counterparty := channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			version, err := suite.chainB.GetSimApp().ICAHostKeeper.OnChanOpenTry(suite.chainB.GetContext(), channeltypes.ORDERED, []string{path.EndpointB.ConnectionID},
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, chanCap, counterparty, path.EndpointA.ChannelConfig.Version,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(version)
+
+				_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal("", version)
+
+				_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnChanOpenConfirm() {
 	var path *ibctesting.Path
 
@@ -381,7 +720,10 @@ func (suite *KeeperTestSuite) TestOnChanOpenConfirm() {
 }
 
 func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
-	var path *ibctesting.Path
+	var (
+		path               *ibctesting.Path
+		expActiveChannelID string
+	)
 
 	testCases := []struct {
 		name     string
@@ -391,11 +733,23 @@ func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: active channel set to a different channel is preserved", func() {
+				expActiveChannelID = "channel-100"
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, expActiveChannelID)
+			}, true,
+		},
+		{
+			"channel not found", func() {
+				path.EndpointB.ChannelID = "channel-100"
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.SetupTest() // reset
+			expActiveChannelID = ""
 
 			path = NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
@@ -410,6 +764,14 @@ func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				activeChannelID, found := suite.chainB.GetSimApp().ICAHostKeeper.GetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().Equal(expActiveChannelID != "", found)
+				suite.Require().Equal(expActiveChannelID, activeChannelID)
+
+				// the interchain account address is preserved
+				_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+var _ types.ICAHostHooks = Keeper{}
+
+// BeforeExecuteTx implements ICAHostHooks, calling the registered hooks if any
+func (k Keeper) BeforeExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	if err := k.hooks.BeforeExecuteTx(ctx, connectionID, portID, msgs, memo); err != nil {
+		return sdkerrors.Wrap(err, "BeforeExecuteTx hook failed")
+	}
+
+	return nil
+}
+
+// AfterExecuteTx implements ICAHostHooks, calling the registered hooks if any
+func (k Keeper) AfterExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string, txResponse []byte, err error) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	if hookErr := k.hooks.AfterExecuteTx(ctx, connectionID, portID, msgs, memo, txResponse, err); hookErr != nil {
+		return sdkerrors.Wrap(hookErr, "AfterExecuteTx hook failed")
+	}
+
+	return nil
+}
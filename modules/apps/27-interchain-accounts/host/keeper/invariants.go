@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// RegisterInvariants registers all interchain accounts host invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(icatypes.ModuleName, "host-active-channels",
+		ActiveChannelsInvariant(k))
+	ir.RegisterRoute(icatypes.ModuleName, "host-interchain-accounts",
+		InterchainAccountsInvariant(k))
+}
+
+// ActiveChannelsInvariant checks that the channel of every active channel mapping exists
+// on the host port, is OPEN and that its capability has been claimed by the host submodule.
+func ActiveChannelsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+			channel, found := k.channelKeeper.GetChannel(ctx, icatypes.PortID, activeChannel.ChannelId)
+			switch {
+			case !found:
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, channel not found\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId)
+				continue
+			case channel.State != channeltypes.OPEN:
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, expected channel state %s, got %s\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId, channeltypes.OPEN, channel.State)
+			}
+
+			if _, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(icatypes.PortID, activeChannel.ChannelId)); !found {
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, channel capability not claimed\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			icatypes.ModuleName,
+			"host active channels invariance",
+			fmt.Sprintf("found active channels which are not open channels owned by the host submodule\n%s", msg),
+		), broken
+	}
+}
+
+// InterchainAccountsInvariant checks that an interchain account address is stored for the connection and
+// controller port of every active channel, and that the address belongs to an interchain account in x/auth.
+func InterchainAccountsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+			address, found := k.GetInterchainAccountAddress(ctx, activeChannel.ConnectionId, activeChannel.PortId)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, interchain account address not found\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId)
+				continue
+			}
+
+			accAddress, err := sdk.AccAddressFromBech32(address)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, invalid interchain account address %s: %s\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId, address, err)
+				continue
+			}
+
+			acc := k.accountKeeper.GetAccount(ctx, accAddress)
+			if acc == nil {
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, account %s not found\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId, address)
+				continue
+			}
+
+			if _, ok := acc.(*icatypes.InterchainAccount); !ok {
+				broken = true
+				msg += fmt.Sprintf("\tconnection: %s, port: %s, channel: %s, account %s is not an interchain account\n",
+					activeChannel.ConnectionId, activeChannel.PortId, activeChannel.ChannelId, address)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			icatypes.ModuleName,
+			"host interchain accounts invariance",
+			fmt.Sprintf("found active channels without a stored interchain account\n%s", msg),
+		), broken
+	}
+}
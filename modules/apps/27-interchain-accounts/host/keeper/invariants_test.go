@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		invariant func(k *keeper.Keeper) sdk.Invariant
+		expMsg    string // empty if the invariant is expected to hold
+	}{
+		{
+			"success: active channel is open",
+			func() {},
+			keeper.ActiveChannelsInvariant,
+			"",
+		},
+		{
+			"success: interchain account is stored",
+			func() {},
+			keeper.InterchainAccountsInvariant,
+			"",
+		},
+		{
+			"success: no active channels",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.DeleteActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			},
+			keeper.ActiveChannelsInvariant,
+			"",
+		},
+		{
+			"failure: active channel does not exist",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, "channel-100")
+			},
+			keeper.ActiveChannelsInvariant,
+			"channel: channel-100, channel not found",
+		},
+		{
+			"failure: active channel is closed",
+			func() {
+				err := path.EndpointB.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			keeper.ActiveChannelsInvariant,
+			"expected channel state STATE_OPEN, got STATE_CLOSED",
+		},
+		{
+			"failure: active channel capability is not claimed",
+			func() {
+				chanCap, found := suite.chainB.GetSimApp().ScopedICAHostKeeper.GetCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				suite.Require().True(found)
+
+				err := suite.chainB.GetSimApp().ScopedICAHostKeeper.ReleaseCapability(suite.chainB.GetContext(), chanCap)
+				suite.Require().NoError(err)
+			},
+			keeper.ActiveChannelsInvariant,
+			"channel capability not claimed",
+		},
+		{
+			"failure: interchain account address is not stored",
+			func() {
+				store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(hosttypes.SubModuleName))
+				store.Delete(icatypes.KeyOwnerAccount(path.EndpointA.ChannelConfig.PortID, path.EndpointB.ConnectionID))
+			},
+			keeper.InterchainAccountsInvariant,
+			"interchain account address not found",
+		},
+		{
+			"failure: interchain account does not exist in x/auth",
+			func() {
+				acc := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), suite.interchainAccountAddress(path))
+				suite.chainB.GetSimApp().AccountKeeper.RemoveAccount(suite.chainB.GetContext(), acc)
+			},
+			keeper.InterchainAccountsInvariant,
+			"not found",
+		},
+		{
+			"failure: account is not an interchain account",
+			func() {
+				acc := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), suite.interchainAccountAddress(path))
+				suite.chainB.GetSimApp().AccountKeeper.RemoveAccount(suite.chainB.GetContext(), acc)
+
+				baseAcc := authtypes.NewBaseAccountWithAddress(acc.GetAddress())
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), suite.chainB.GetSimApp().AccountKeeper.NewAccount(suite.chainB.GetContext(), baseAcc))
+			},
+			keeper.InterchainAccountsInvariant,
+			"is not an interchain account",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			msg, broken := tc.invariant(&hostKeeper)(suite.chainB.GetContext())
+
+			if tc.expMsg == "" {
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().Contains(msg, tc.expMsg)
+				suite.Require().Contains(msg, path.EndpointB.ConnectionID)
+				suite.Require().Contains(msg, path.EndpointA.ChannelConfig.PortID)
+			}
+		})
+	}
+}
+
+// interchainAccountAddress returns the address of the interchain account registered on the provided path
+func (suite *KeeperTestSuite) interchainAccountAddress(path *ibctesting.Path) sdk.AccAddress {
+	address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	return sdk.MustAccAddressFromBech32(address)
+}
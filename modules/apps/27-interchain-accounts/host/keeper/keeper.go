@@ -12,6 +12,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -27,10 +28,17 @@ type Keeper struct {
 	channelKeeper icatypes.ChannelKeeper
 	portKeeper    icatypes.PortKeeper
 	accountKeeper icatypes.AccountKeeper
+	feeKeeper     icatypes.FeeKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
-	msgRouter *baseapp.MsgServiceRouter
+	msgRouter   *baseapp.MsgServiceRouter
+	queryRouter *baseapp.GRPCQueryRouter
+
+	hooks          types.ICAHostHooks
+	signerResolver types.SignerResolver
+	bankKeeper     types.BankKeeper
+	msgValidator   types.MsgValidator
 }
 
 // NewKeeper creates a new interchain accounts host Keeper instance
@@ -38,6 +46,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
 	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	queryRouter *baseapp.GRPCQueryRouter,
 ) Keeper {
 	// ensure ibc interchain accounts module account is set
 	if addr := accountKeeper.GetModuleAddress(icatypes.ModuleName); addr == nil {
@@ -58,7 +67,71 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		scopedKeeper:  scopedKeeper,
 		msgRouter:     msgRouter,
+		queryRouter:   queryRouter,
+	}
+}
+
+// SetHooks sets the hooks called before and after the execution of interchain accounts transactions.
+// The hooks must be set prior to the keeper being passed to the host IBCModule.
+func (k *Keeper) SetHooks(hooks types.ICAHostHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set interchain accounts host hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// SetSignerResolver sets the SignerResolver used to accept msg signers other than the executing interchain account.
+// The SignerResolver must be set prior to the keeper being passed to the host IBCModule.
+func (k *Keeper) SetSignerResolver(resolver types.SignerResolver) *Keeper {
+	if k.signerResolver != nil {
+		panic("cannot set interchain accounts host signer resolver twice")
 	}
+
+	k.signerResolver = resolver
+
+	return k
+}
+
+// SetFeeKeeper sets the FeeKeeper used to enable fees on channels whose version is updated to include the ICS29 fee
+// version. Channel version updates are rejected if no FeeKeeper is set. The FeeKeeper must be set prior to the keeper
+// being passed to the host IBCModule.
+func (k *Keeper) SetFeeKeeper(feeKeeper icatypes.FeeKeeper) *Keeper {
+	if k.feeKeeper != nil {
+		panic("cannot set interchain accounts host fee keeper twice")
+	}
+
+	k.feeKeeper = feeKeeper
+
+	return k
+}
+
+// SetBankKeeper sets the BankKeeper used to deduct the execution fee from interchain accounts. Packets are rejected with
+// an error acknowledgement if an execution fee is set and no BankKeeper is set. The BankKeeper must be set prior to the
+// keeper being passed to the host IBCModule.
+func (k *Keeper) SetBankKeeper(bankKeeper types.BankKeeper) *Keeper {
+	if k.bankKeeper != nil {
+		panic("cannot set interchain accounts host bank keeper twice")
+	}
+
+	k.bankKeeper = bankKeeper
+
+	return k
+}
+
+// SetMsgValidator sets the MsgValidator invoked for each msg executed by an interchain account, including msgs nested
+// within an authz MsgExec, prior to the msg being executed. The MsgValidator must be set prior to the keeper being passed
+// to the host IBCModule.
+func (k *Keeper) SetMsgValidator(validator types.MsgValidator) *Keeper {
+	if k.msgValidator != nil {
+		panic("cannot set interchain accounts host msg validator twice")
+	}
+
+	k.msgValidator = validator
+
+	return k
 }
 
 // Logger returns the application logger, scoped to the associated module
@@ -66,10 +139,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
 }
 
-// BindPort stores the provided portID and binds to it, returning the associated capability
-func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
+// SetPort stores the provided portID. Used in InitGenesis, as the port capability may already be restored from genesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyPort(portID), []byte{0x01})
+}
+
+// BindPort stores the provided portID and binds to it, returning the associated capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
+	k.SetPort(ctx, portID)
 
 	return k.portKeeper.BindPort(ctx, portID)
 }
@@ -119,16 +197,16 @@ func (k Keeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID strin
 }
 
 // GetAllActiveChannels returns a list of all active interchain accounts host channels and their associated connection and port identifiers
-func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []genesistypes.ActiveChannel {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.ActiveChannelKeyPrefix))
 	defer iterator.Close()
 
-	var activeChannels []icatypes.ActiveChannel
+	var activeChannels []genesistypes.ActiveChannel
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
 
-		ch := icatypes.ActiveChannel{
+		ch := genesistypes.ActiveChannel{
 			ConnectionId: keySplit[2],
 			PortId:       keySplit[1],
 			ChannelId:    string(iterator.Value()),
@@ -146,6 +224,12 @@ func (k Keeper) SetActiveChannelID(ctx sdk.Context, connectionID, portID, channe
 	store.Set(icatypes.KeyActiveChannel(portID, connectionID), []byte(channelID))
 }
 
+// DeleteActiveChannelID removes the active channel keyed by the provided connectionID and portID
+func (k Keeper) DeleteActiveChannelID(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyActiveChannel(portID, connectionID))
+}
+
 // IsActiveChannel returns true if there exists an active channel for the provided connectionID and portID, otherwise false
 func (k Keeper) IsActiveChannel(ctx sdk.Context, connectionID, portID string) bool {
 	_, ok := k.GetActiveChannelID(ctx, connectionID, portID)
@@ -165,15 +249,15 @@ func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 }
 
 // GetAllInterchainAccounts returns a list of all registered interchain account addresses and their associated connection and controller port identifiers
-func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []icatypes.RegisteredInterchainAccount {
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.RegisteredInterchainAccount {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.OwnerKeyPrefix))
 
-	var interchainAccounts []icatypes.RegisteredInterchainAccount
+	var interchainAccounts []genesistypes.RegisteredInterchainAccount
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
 
-		acc := icatypes.RegisteredInterchainAccount{
+		acc := genesistypes.RegisteredInterchainAccount{
 			ConnectionId:   keySplit[2],
 			PortId:         keySplit[1],
 			AccountAddress: string(iterator.Value()),
@@ -190,3 +274,311 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// GetChannelEncoding retrieves the encoding negotiated in the channel version metadata for the provided portID and channelID.
+// Channels opened prior to the encoding being stored fall back to the encoding of the channel version, defaulting to protobuf.
+func (k Keeper) GetChannelEncoding(ctx sdk.Context, portID, channelID string) string {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.KeyChannelEncoding(portID, channelID)); bz != nil {
+		return string(bz)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return icatypes.EncodingProtobuf
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &metadata); err != nil || metadata.Encoding == "" {
+		return icatypes.EncodingProtobuf
+	}
+
+	return metadata.Encoding
+}
+
+// SetChannelEncoding stores the encoding negotiated in the channel version metadata, keyed by the provided portID and channelID
+func (k Keeper) SetChannelEncoding(ctx sdk.Context, portID, channelID, encoding string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyChannelEncoding(portID, channelID), []byte(encoding))
+}
+
+// GetConnectionAllowMessages retrieves the allow messages specific to the provided connectionID
+func (k Keeper) GetConnectionAllowMessages(ctx sdk.Context, connectionID string) ([]string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyConnectionAllowMessages(connectionID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var connAllowMsgs types.ConnectionAllowMessages
+	k.cdc.MustUnmarshal(bz, &connAllowMsgs)
+
+	return connAllowMsgs.AllowMessages, true
+}
+
+// GetAllConnectionAllowMessages returns all allow messages stored for specific connections
+func (k Keeper) GetAllConnectionAllowMessages(ctx sdk.Context) []types.ConnectionAllowMessages {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ConnectionAllowMessagesKeyPrefix))
+	defer iterator.Close()
+
+	var connAllowMsgs []types.ConnectionAllowMessages
+	for ; iterator.Valid(); iterator.Next() {
+		var allowMsgs types.ConnectionAllowMessages
+		k.cdc.MustUnmarshal(iterator.Value(), &allowMsgs)
+
+		connAllowMsgs = append(connAllowMsgs, allowMsgs)
+	}
+
+	return connAllowMsgs
+}
+
+// SetConnectionAllowMessages stores the allow messages specific to the provided connectionID. The stored list
+// overrides the host submodule params when authenticating messages sent over the connection. Allow messages provided
+// as Msg service method names are stored in type URL form
+func (k Keeper) SetConnectionAllowMessages(ctx sdk.Context, connectionID string, allowMsgs []string) {
+	allowMsgs = k.canonicalizeAllowMessages(ctx, connectionID, allowMsgs)
+
+	store := ctx.KVStore(k.storeKey)
+	connAllowMsgs := types.NewConnectionAllowMessages(connectionID, allowMsgs)
+	store.Set(types.KeyConnectionAllowMessages(connectionID), k.cdc.MustMarshal(&connAllowMsgs))
+}
+
+// DeleteConnectionAllowMessages removes the allow messages specific to the provided connectionID, reverting
+// the connection to the host submodule params
+func (k Keeper) DeleteConnectionAllowMessages(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyConnectionAllowMessages(connectionID))
+}
+
+// GetAllowMessagesForConnection returns the allow messages which apply to the provided connectionID. The allow messages
+// specific to the connection are returned if present, otherwise the host submodule params are returned
+func (k Keeper) GetAllowMessagesForConnection(ctx sdk.Context, connectionID string) []string {
+	if allowMsgs, found := k.GetConnectionAllowMessages(ctx, connectionID); found {
+		return allowMsgs
+	}
+
+	return k.GetAllowMessages(ctx)
+}
+
+// IsAddressBlocked returns true if the provided bech32 address is present in the address blocklist
+func (k Keeper) IsAddressBlocked(ctx sdk.Context, address string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyBlockedAddress(address))
+}
+
+// GetAddressBlocklist returns all addresses present in the address blocklist
+func (k Keeper) GetAddressBlocklist(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAddressBlocklistPrefix())
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()[len(types.KeyAddressBlocklistPrefix()):]))
+	}
+
+	return addresses
+}
+
+// SetBlockedAddress adds the provided bech32 address to the address blocklist, preventing interchain accounts from
+// executing messages which send funds to it
+func (k Keeper) SetBlockedAddress(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyBlockedAddress(address), []byte{0x01})
+}
+
+// DeleteBlockedAddress removes the provided bech32 address from the address blocklist
+func (k Keeper) DeleteBlockedAddress(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyBlockedAddress(address))
+}
+
+// GetPausedMessageType retrieves the paused message type stored for the provided type URL, regardless of its expiry
+func (k Keeper) GetPausedMessageType(ctx sdk.Context, typeURL string) (types.PausedMessageType, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPausedMessageType(typeURL))
+	if bz == nil {
+		return types.PausedMessageType{}, false
+	}
+
+	var pausedMsgType types.PausedMessageType
+	k.cdc.MustUnmarshal(bz, &pausedMsgType)
+
+	return pausedMsgType, true
+}
+
+// GetAllPausedMessageTypes returns all stored paused message types, including those which have expired
+func (k Keeper) GetAllPausedMessageTypes(ctx sdk.Context) []types.PausedMessageType {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPausedMessageTypePrefix())
+	defer iterator.Close()
+
+	var pausedMsgTypes []types.PausedMessageType
+	for ; iterator.Valid(); iterator.Next() {
+		var pausedMsgType types.PausedMessageType
+		k.cdc.MustUnmarshal(iterator.Value(), &pausedMsgType)
+
+		pausedMsgTypes = append(pausedMsgTypes, pausedMsgType)
+	}
+
+	return pausedMsgTypes
+}
+
+// SetPausedMessageType stores the provided paused message type, preventing interchain accounts from executing messages
+// of its type URL until its expiry height
+func (k Keeper) SetPausedMessageType(ctx sdk.Context, pausedMsgType types.PausedMessageType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPausedMessageType(pausedMsgType.TypeUrl), k.cdc.MustMarshal(&pausedMsgType))
+}
+
+// DeletePausedMessageType removes the paused message type stored for the provided type URL
+func (k Keeper) DeletePausedMessageType(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPausedMessageType(typeURL))
+}
+
+// IsMessageTypePaused returns true if the provided type URL is paused and its expiry height has not been exceeded
+func (k Keeper) IsMessageTypePaused(ctx sdk.Context, typeURL string) bool {
+	pausedMsgType, found := k.GetPausedMessageType(ctx, typeURL)
+	return found && pausedMsgType.IsActive(uint64(ctx.BlockHeight()))
+}
+
+// GetCollectedExecutionFees returns the total execution fees collected from the interchain accounts registered over the
+// provided connection
+func (k Keeper) GetCollectedExecutionFees(ctx sdk.Context, connectionID string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCollectedExecutionFees(connectionID))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var collectedFees types.CollectedExecutionFees
+	k.cdc.MustUnmarshal(bz, &collectedFees)
+
+	return collectedFees.Fees
+}
+
+// GetAllCollectedExecutionFees returns the total execution fees collected over each connection
+func (k Keeper) GetAllCollectedExecutionFees(ctx sdk.Context) []types.CollectedExecutionFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyCollectedExecutionFeesPrefix())
+	defer iterator.Close()
+
+	var collectedFees []types.CollectedExecutionFees
+	for ; iterator.Valid(); iterator.Next() {
+		var fees types.CollectedExecutionFees
+		k.cdc.MustUnmarshal(iterator.Value(), &fees)
+
+		collectedFees = append(collectedFees, fees)
+	}
+
+	return collectedFees
+}
+
+// SetCollectedExecutionFees stores the total execution fees collected over the connection of the provided collected fees
+func (k Keeper) SetCollectedExecutionFees(ctx sdk.Context, collectedFees types.CollectedExecutionFees) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyCollectedExecutionFees(collectedFees.ConnectionId), k.cdc.MustMarshal(&collectedFees))
+}
+
+// addCollectedExecutionFees adds the provided fees to the total execution fees collected over the provided connection
+func (k Keeper) addCollectedExecutionFees(ctx sdk.Context, connectionID string, fees sdk.Coins) {
+	k.SetCollectedExecutionFees(ctx, types.CollectedExecutionFees{
+		ConnectionId: connectionID,
+		Fees:         k.GetCollectedExecutionFees(ctx, connectionID).Add(fees...),
+	})
+}
+
+// GetConnectionGasUsed returns the total gas consumed by the execution of the transactions received over the provided
+// connection
+func (k Keeper) GetConnectionGasUsed(ctx sdk.Context, connectionID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyConnectionGasUsed(connectionID))
+	if bz == nil {
+		return 0
+	}
+
+	var gasUsed types.ConnectionGasUsed
+	k.cdc.MustUnmarshal(bz, &gasUsed)
+
+	return gasUsed.GasUsed
+}
+
+// GetAllConnectionGasUsed returns the total gas consumed over each connection
+func (k Keeper) GetAllConnectionGasUsed(ctx sdk.Context) []types.ConnectionGasUsed {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyConnectionGasUsedPrefix())
+	defer iterator.Close()
+
+	var gasByConnection []types.ConnectionGasUsed
+	for ; iterator.Valid(); iterator.Next() {
+		var gasUsed types.ConnectionGasUsed
+		k.cdc.MustUnmarshal(iterator.Value(), &gasUsed)
+
+		gasByConnection = append(gasByConnection, gasUsed)
+	}
+
+	return gasByConnection
+}
+
+// SetConnectionGasUsed stores the total gas consumed over the connection of the provided connection gas used
+func (k Keeper) SetConnectionGasUsed(ctx sdk.Context, gasUsed types.ConnectionGasUsed) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyConnectionGasUsed(gasUsed.ConnectionId), k.cdc.MustMarshal(&gasUsed))
+}
+
+// DeleteConnectionGasUsed removes the total gas consumed stored for the provided connection
+func (k Keeper) DeleteConnectionGasUsed(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyConnectionGasUsed(connectionID))
+}
+
+// addConnectionGasUsed adds the provided gas to the total gas consumed over the provided connection
+func (k Keeper) addConnectionGasUsed(ctx sdk.Context, connectionID string, gasUsed uint64) {
+	k.SetConnectionGasUsed(ctx, types.ConnectionGasUsed{
+		ConnectionId: connectionID,
+		GasUsed:      k.GetConnectionGasUsed(ctx, connectionID) + gasUsed,
+	})
+}
+
+// GetExecutionResult retrieves the execution result stored for the packet with the provided sequence on the provided portID and channelID
+func (k Keeper) GetExecutionResult(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ExecutionResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyExecutionResult(portID, channelID, sequence))
+	if bz == nil {
+		return types.ExecutionResult{}, false
+	}
+
+	var result types.ExecutionResult
+	k.cdc.MustUnmarshal(bz, &result)
+
+	return result, true
+}
+
+// SetExecutionResult stores the execution result of a packet received on the provided portID and channelID
+func (k Keeper) SetExecutionResult(ctx sdk.Context, portID, channelID string, result types.ExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyExecutionResult(portID, channelID, result.Sequence), k.cdc.MustMarshal(&result))
+}
+
+// PruneExecutionResults removes the execution results stored for the provided portID and channelID which are older than
+// the most recent maxResults results, with respect to the provided sequence of the latest packet received on the channel
+func (k Keeper) PruneExecutionResults(ctx sdk.Context, portID, channelID string, sequence, maxResults uint64) {
+	if sequence < maxResults {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyExecutionResultPrefix(portID, channelID), types.KeyExecutionResult(portID, channelID, sequence-maxResults+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
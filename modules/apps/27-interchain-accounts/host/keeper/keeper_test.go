@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
 
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
@@ -36,6 +41,9 @@ type KeeperTestSuite struct {
 	chainA *ibctesting.TestChain
 	chainB *ibctesting.TestChain
 	chainC *ibctesting.TestChain
+
+	// logs captures the log lines written at debug level by the host submodule using a context returned by logContext
+	logs *captureLogger
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -43,6 +51,99 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
 	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
 	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	logger.SetLevel(logger.LevelDebug)
+
+	suite.logs = newCaptureLogger()
+}
+
+func (suite *KeeperTestSuite) TearDownTest() {
+	logger.SetLevel(logger.LevelInfo)
+}
+
+// logContext returns a context of chainB whose log lines are captured by suite.logs
+func (suite *KeeperTestSuite) logContext() sdk.Context {
+	return suite.chainB.GetContext().WithLogger(suite.logs)
+}
+
+// requirePacketLogs asserts that the provided number of log lines with the provided message were captured for the provided packet
+func (suite *KeeperTestSuite) requirePacketLogs(msg string, packet channeltypes.Packet, count int) []logEntry {
+	var entries []logEntry
+	for _, entry := range suite.logs.entriesWithMsg(msg) {
+		if sequence, ok := entry.value("sequence"); ok && sequence == packet.GetSequence() {
+			entries = append(entries, entry)
+		}
+	}
+
+	suite.Require().Len(entries, count)
+
+	return entries
+}
+
+// logEntry is a log line captured by a captureLogger
+type logEntry struct {
+	level   string
+	msg     string
+	keyvals []interface{}
+}
+
+// value returns the value of the provided key of the entry, and false if the entry does not contain the key
+func (e logEntry) value(key string) (interface{}, bool) {
+	for i := 0; i+1 < len(e.keyvals); i += 2 {
+		if e.keyvals[i] == key {
+			return e.keyvals[i+1], true
+		}
+	}
+
+	return nil, false
+}
+
+// captureLogger is a logger capturing log lines in memory, the loggers returned by With share the captured log lines
+type captureLogger struct {
+	entries *[]logEntry
+	keyvals []interface{}
+}
+
+var _ log.Logger = captureLogger{}
+
+func newCaptureLogger() *captureLogger {
+	return &captureLogger{entries: &[]logEntry{}}
+}
+
+func (l captureLogger) Debug(msg string, keyvals ...interface{}) { l.capture("debug", msg, keyvals) }
+func (l captureLogger) Info(msg string, keyvals ...interface{})  { l.capture("info", msg, keyvals) }
+func (l captureLogger) Error(msg string, keyvals ...interface{}) { l.capture("error", msg, keyvals) }
+
+func (l captureLogger) With(keyvals ...interface{}) log.Logger {
+	return captureLogger{
+		entries: l.entries,
+		keyvals: append(append([]interface{}(nil), l.keyvals...), keyvals...),
+	}
+}
+
+func (l captureLogger) capture(level, msg string, keyvals []interface{}) {
+	*l.entries = append(*l.entries, logEntry{
+		level:   level,
+		msg:     msg,
+		keyvals: append(append([]interface{}(nil), l.keyvals...), keyvals...),
+	})
+}
+
+// entriesWithMsg returns the captured log lines with the provided message
+func (l captureLogger) entriesWithMsg(msg string) []logEntry {
+	var entries []logEntry
+	for _, entry := range *l.entries {
+		if entry.msg == msg {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// reset discards the captured log lines
+func (l captureLogger) reset() {
+	*l.entries = nil
 }
 
 func NewICAPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
@@ -154,7 +255,7 @@ func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
 
 	suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedChannelID)
 
-	expectedChannels := []icatypes.ActiveChannel{
+	expectedChannels := []genesistypes.ActiveChannel{
 		{
 			ConnectionId: ibctesting.FirstConnectionID,
 			PortId:       path.EndpointA.ChannelConfig.PortID,
@@ -191,7 +292,7 @@ func (suite *KeeperTestSuite) TestGetAllInterchainAccounts() {
 
 	suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedAccAddr)
 
-	expectedAccounts := []icatypes.RegisteredInterchainAccount{
+	expectedAccounts := []genesistypes.RegisteredInterchainAccount{
 		{
 			ConnectionId:   ibctesting.FirstConnectionID,
 			PortId:         TestPortID,
@@ -234,3 +335,82 @@ func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	suite.Require().True(found)
 	suite.Require().Equal(expectedAccAddr, retrievedAddr)
 }
+
+func (suite *KeeperTestSuite) TestConnectionAllowMessages() {
+	suite.SetupTest()
+
+	var (
+		connectionID = ibctesting.FirstConnectionID
+		allowMsgs    = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+		params       = types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	)
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionAllowMessages(suite.chainB.GetContext(), connectionID)
+	suite.Require().False(found)
+	suite.Require().Equal(params.AllowMessages, suite.chainB.GetSimApp().ICAHostKeeper.GetAllowMessagesForConnection(suite.chainB.GetContext(), connectionID))
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainB.GetContext(), connectionID, allowMsgs)
+
+	retrievedMsgs, found := suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionAllowMessages(suite.chainB.GetContext(), connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(allowMsgs, retrievedMsgs)
+	suite.Require().Equal(allowMsgs, suite.chainB.GetSimApp().ICAHostKeeper.GetAllowMessagesForConnection(suite.chainB.GetContext(), connectionID))
+
+	expConnAllowMsgs := []types.ConnectionAllowMessages{types.NewConnectionAllowMessages(connectionID, allowMsgs)}
+	suite.Require().Equal(expConnAllowMsgs, suite.chainB.GetSimApp().ICAHostKeeper.GetAllConnectionAllowMessages(suite.chainB.GetContext()))
+
+	suite.chainB.GetSimApp().ICAHostKeeper.DeleteConnectionAllowMessages(suite.chainB.GetContext(), connectionID)
+
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionAllowMessages(suite.chainB.GetContext(), connectionID)
+	suite.Require().False(found)
+	suite.Require().Equal(params.AllowMessages, suite.chainB.GetSimApp().ICAHostKeeper.GetAllowMessagesForConnection(suite.chainB.GetContext(), connectionID))
+}
+
+func (suite *KeeperTestSuite) TestAddressBlocklist() {
+	suite.SetupTest()
+
+	blockedAddr := suite.chainA.SenderAccount.GetAddress().String()
+
+	suite.Require().False(suite.chainB.GetSimApp().ICAHostKeeper.IsAddressBlocked(suite.chainB.GetContext(), blockedAddr))
+	suite.Require().Empty(suite.chainB.GetSimApp().ICAHostKeeper.GetAddressBlocklist(suite.chainB.GetContext()))
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetBlockedAddress(suite.chainB.GetContext(), blockedAddr)
+
+	suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsAddressBlocked(suite.chainB.GetContext(), blockedAddr))
+	suite.Require().Equal([]string{blockedAddr}, suite.chainB.GetSimApp().ICAHostKeeper.GetAddressBlocklist(suite.chainB.GetContext()))
+
+	suite.chainB.GetSimApp().ICAHostKeeper.DeleteBlockedAddress(suite.chainB.GetContext(), blockedAddr)
+
+	suite.Require().False(suite.chainB.GetSimApp().ICAHostKeeper.IsAddressBlocked(suite.chainB.GetContext(), blockedAddr))
+	suite.Require().Empty(suite.chainB.GetSimApp().ICAHostKeeper.GetAddressBlocklist(suite.chainB.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestExecutionResults() {
+	suite.SetupTest()
+
+	channelID := ibctesting.FirstChannelID
+
+	for sequence := uint64(1); sequence <= 5; sequence++ {
+		result := types.ExecutionResult{
+			Sequence:    sequence,
+			MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			Success:     true,
+		}
+
+		suite.chainB.GetSimApp().ICAHostKeeper.SetExecutionResult(suite.chainB.GetContext(), icatypes.PortID, channelID, result)
+
+		retrievedResult, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), icatypes.PortID, channelID, sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(result, retrievedResult)
+	}
+
+	// only the two most recent execution results are retained
+	suite.chainB.GetSimApp().ICAHostKeeper.PruneExecutionResults(suite.chainB.GetContext(), icatypes.PortID, channelID, 5, 2)
+
+	for sequence := uint64(1); sequence <= 5; sequence++ {
+		_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), icatypes.PortID, channelID, sequence)
+		suite.Require().Equal(sequence > 3, found)
+	}
+}
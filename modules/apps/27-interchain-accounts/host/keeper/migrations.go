@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateAllowMessages rewrites the allow messages stored as Msg service method names, both in the host submodule
+// params and for specific connections, in their canonical type URL form. Allow messages already stored as type
+// URLs are left untouched, such that the migration may be run more than once.
+func (m Migrator) MigrateAllowMessages(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	allowMsgs := m.keeper.GetAllowMessages(ctx)
+	if _, normalized := types.CanonicalizeAllowMessages(allowMsgs); len(normalized) > 0 {
+		m.keeper.paramSpace.Set(ctx, types.KeyAllowMessages, m.keeper.canonicalizeAllowMessages(ctx, "", allowMsgs))
+	}
+
+	var migrated int
+	for _, connAllowMsgs := range m.keeper.GetAllConnectionAllowMessages(ctx) {
+		if _, normalized := types.CanonicalizeAllowMessages(connAllowMsgs.AllowMessages); len(normalized) > 0 {
+			m.keeper.SetConnectionAllowMessages(ctx, connAllowMsgs.ConnectionId, connAllowMsgs.AllowMessages)
+			migrated++
+		}
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated host allow messages", "number of connections", migrated)
+	return nil
+}
+
+// MigrateNormalizeAllowMessages rewrites the allow messages stored in the host submodule params sorted and with
+// duplicate entries removed, see NormalizeAllowMessages. Allow messages already stored in normalized form are left
+// untouched, such that the migration may be run more than once.
+func (m Migrator) MigrateNormalizeAllowMessages(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	allowMsgs := m.keeper.GetAllowMessages(ctx)
+	normalized := types.NormalizeAllowMessages(m.keeper.canonicalizeAllowMessages(ctx, "", allowMsgs))
+	if !equalAllowMessages(allowMsgs, normalized) {
+		m.keeper.paramSpace.Set(ctx, types.KeyAllowMessages, normalized)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully normalized host allow messages", "number of allow messages", len(normalized))
+	return nil
+}
+
+// equalAllowMessages returns true if the provided allow messages contain the same entries in the same order
+func equalAllowMessages(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigratorMigrateAllowMessages() {
+	var (
+		legacyAllowMsgs    = []string{"cosmos.bank.v1beta1.Msg/Send", "/cosmos.staking.v1beta1.MsgDelegate"}
+		canonicalAllowMsgs = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}
+	)
+
+	testCases := []struct {
+		msg                  string
+		malleate             func()
+		expAllowMsgs         []string
+		expConnAllowMessages []types.ConnectionAllowMessages
+	}{
+		{
+			"success: legacy params and connection allow messages are canonicalized",
+			func() {
+				suite.seedLegacyConnectionAllowMessages(ibctesting.FirstConnectionID, legacyAllowMsgs)
+				suite.seedLegacyConnectionAllowMessages("connection-1", canonicalAllowMsgs)
+			},
+			canonicalAllowMsgs,
+			[]types.ConnectionAllowMessages{
+				types.NewConnectionAllowMessages(ibctesting.FirstConnectionID, canonicalAllowMsgs),
+				types.NewConnectionAllowMessages("connection-1", canonicalAllowMsgs),
+			},
+		},
+		{
+			"success: params without connection allow messages",
+			func() {},
+			canonicalAllowMsgs,
+			nil,
+		},
+		{
+			"success: wildcard allow messages are left untouched",
+			func() {
+				suite.chainB.GetSimApp().GetSubspace(types.SubModuleName).Set(suite.chainB.GetContext(), types.KeyAllowMessages, []string{"*"})
+				suite.seedLegacyConnectionAllowMessages(ibctesting.FirstConnectionID, []string{"*"})
+			},
+			[]string{"*"},
+			[]types.ConnectionAllowMessages{
+				types.NewConnectionAllowMessages(ibctesting.FirstConnectionID, []string{"*"}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			// write the allow messages directly to the param subspace, bypassing the canonicalization of SetParams
+			suite.chainB.GetSimApp().GetSubspace(types.SubModuleName).Set(suite.chainB.GetContext(), types.KeyAllowMessages, legacyAllowMsgs)
+
+			tc.malleate()
+
+			migrator := keeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper)
+
+			// the migration is run twice to assert it is idempotent
+			for i := 0; i < 2; i++ {
+				err := migrator.MigrateAllowMessages(suite.chainB.GetContext())
+				suite.Require().NoError(err)
+
+				allowMsgs := suite.chainB.GetSimApp().ICAHostKeeper.GetAllowMessages(suite.chainB.GetContext())
+				suite.Require().Equal(tc.expAllowMsgs, allowMsgs)
+
+				connAllowMsgs := suite.chainB.GetSimApp().ICAHostKeeper.GetAllConnectionAllowMessages(suite.chainB.GetContext())
+				suite.Require().Equal(tc.expConnAllowMessages, connAllowMsgs)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateNormalizeAllowMessages() {
+	testCases := []struct {
+		msg          string
+		allowMsgs    []string
+		expAllowMsgs []string
+	}{
+		{
+			"success: unsorted allow messages with duplicates are normalized",
+			[]string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.bank.v1beta1.MsgSend", "cosmos.bank.v1beta1.Msg/Send", "/cosmos.staking.v1beta1.MsgDelegate"},
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"},
+		},
+		{
+			"success: normalized allow messages are left untouched",
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"},
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"},
+		},
+		{
+			"success: wildcard allow messages are left untouched",
+			[]string{"*"},
+			[]string{"*"},
+		},
+		{
+			"success: empty allow messages are left untouched",
+			[]string{},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			// write the allow messages directly to the param subspace, bypassing the normalization of SetParams
+			suite.chainB.GetSimApp().GetSubspace(types.SubModuleName).Set(suite.chainB.GetContext(), types.KeyAllowMessages, tc.allowMsgs)
+
+			migrator := keeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper)
+
+			// the migration is run twice to assert it is idempotent
+			for i := 0; i < 2; i++ {
+				err := migrator.MigrateNormalizeAllowMessages(suite.chainB.GetContext())
+				suite.Require().NoError(err)
+
+				allowMsgs := suite.chainB.GetSimApp().ICAHostKeeper.GetAllowMessages(suite.chainB.GetContext())
+				suite.Require().Equal(tc.expAllowMsgs, allowMsgs)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigratorNilKeeper() {
+	migrator := keeper.NewMigrator(nil)
+	suite.Require().NoError(migrator.MigrateAllowMessages(suite.chainB.GetContext()))
+	suite.Require().NoError(migrator.MigrateNormalizeAllowMessages(suite.chainB.GetContext()))
+}
+
+// seedLegacyConnectionAllowMessages writes the provided allow messages for the connection directly to the host
+// submodule store, bypassing the canonicalization of SetConnectionAllowMessages
+func (suite *KeeperTestSuite) seedLegacyConnectionAllowMessages(connectionID string, allowMsgs []string) {
+	store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey))
+	connAllowMsgs := types.NewConnectionAllowMessages(connectionID, allowMsgs)
+	store.Set(types.KeyConnectionAllowMessages(connectionID), suite.chainB.Codec.MustMarshal(&connAllowMsgs))
+}
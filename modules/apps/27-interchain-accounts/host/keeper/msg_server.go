@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ICS27 host MsgServer interface for the provided Keeper
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// PauseMessageType defines a rpc handler for MsgPauseMessageType.
+// The message type is paused until the provided expiry height, or until it is unpaused if no expiry height is provided.
+// Pausing a message type which is already paused replaces its expiry height
+func (s msgServer) PauseMessageType(goCtx context.Context, msg *types.MsgPauseMessageType) (*types.MsgPauseMessageTypeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateCircuitBreakerAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight < uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPausedMessageType, "expiry height %d must not be lower than the current block height %d", msg.ExpiryHeight, ctx.BlockHeight())
+	}
+
+	pausedMsgType := types.NewPausedMessageType(msg.TypeUrl, msg.ExpiryHeight)
+	s.SetPausedMessageType(ctx, pausedMsgType)
+
+	s.Logger(ctx).Info("paused message type", "msg-type-url", msg.TypeUrl, "expiry-height", msg.ExpiryHeight)
+
+	EmitPauseMessageTypeEvent(ctx, msg.Authority, pausedMsgType)
+
+	return &types.MsgPauseMessageTypeResponse{}, nil
+}
+
+// UnpauseMessageType defines a rpc handler for MsgUnpauseMessageType.
+// Paused message types which have expired may be unpaused to remove them from state
+func (s msgServer) UnpauseMessageType(goCtx context.Context, msg *types.MsgUnpauseMessageType) (*types.MsgUnpauseMessageTypeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateCircuitBreakerAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, found := s.GetPausedMessageType(ctx, msg.TypeUrl); !found {
+		return nil, sdkerrors.Wrapf(types.ErrMessageTypeNotPaused, "message type %s", msg.TypeUrl)
+	}
+
+	s.DeletePausedMessageType(ctx, msg.TypeUrl)
+
+	s.Logger(ctx).Info("unpaused message type", "msg-type-url", msg.TypeUrl)
+
+	EmitUnpauseMessageTypeEvent(ctx, msg.Authority, msg.TypeUrl)
+
+	return &types.MsgUnpauseMessageTypeResponse{}, nil
+}
+
+// ResetGasByConnection defines a rpc handler for MsgResetGasByConnection.
+// The gas used over the provided connection is reset, or the gas used over all connections if no connection is provided
+func (s msgServer) ResetGasByConnection(goCtx context.Context, msg *types.MsgResetGasByConnection) (*types.MsgResetGasByConnectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.validateGasResetAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.ConnectionId != "" {
+		s.DeleteConnectionGasUsed(ctx, msg.ConnectionId)
+	} else {
+		for _, gasUsed := range s.GetAllConnectionGasUsed(ctx) {
+			s.DeleteConnectionGasUsed(ctx, gasUsed.ConnectionId)
+		}
+	}
+
+	s.Logger(ctx).Info("reset gas by connection", "connection-id", msg.ConnectionId)
+
+	EmitResetGasByConnectionEvent(ctx, msg.Authority, msg.ConnectionId)
+
+	return &types.MsgResetGasByConnectionResponse{}, nil
+}
+
+// validateCircuitBreakerAuthority returns an error if the provided signer is not the circuit breaker authority
+func (s msgServer) validateCircuitBreakerAuthority(ctx sdk.Context, signer string) error {
+	authority := s.GetCircuitBreakerAuthority(ctx)
+	if authority == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "circuit breaker authority is not set")
+	}
+
+	if signer != authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected circuit breaker authority %s, got %s", authority, signer)
+	}
+
+	return nil
+}
+
+// validateGasResetAuthority returns an error if the provided signer is not the gas reset authority
+func (s msgServer) validateGasResetAuthority(ctx sdk.Context, signer string) error {
+	authority := s.GetGasResetAuthority(ctx)
+	if authority == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "gas reset authority is not set")
+	}
+
+	if signer != authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected gas reset authority %s, got %s", authority, signer)
+	}
+
+	return nil
+}
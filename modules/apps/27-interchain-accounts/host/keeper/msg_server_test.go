@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgPauseMessageType() {
+	var (
+		msg       *types.MsgPauseMessageType
+		authority string
+	)
+
+	voteTypeURL := sdk.MsgTypeURL(&govtypes.MsgVote{})
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no expiry",
+			func() {},
+			nil,
+		},
+		{
+			"success: expiry height",
+			func() {
+				msg.ExpiryHeight = uint64(suite.chainB.GetContext().BlockHeight()) + 10
+			},
+			nil,
+		},
+		{
+			"success: expiry at current block height",
+			func() {
+				msg.ExpiryHeight = uint64(suite.chainB.GetContext().BlockHeight())
+			},
+			nil,
+		},
+		{
+			"success: message type already paused",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(voteTypeURL, 0))
+
+				msg.ExpiryHeight = uint64(suite.chainB.GetContext().BlockHeight()) + 10
+			},
+			nil,
+		},
+		{
+			"failure: expiry below current block height",
+			func() {
+				msg.ExpiryHeight = uint64(suite.chainB.GetContext().BlockHeight()) - 1
+			},
+			types.ErrInvalidPausedMessageType,
+		},
+		{
+			"failure: circuit breaker authority not set",
+			func() {
+				authority = ""
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is not the circuit breaker authority",
+			func() {
+				msg.Authority = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			authority = suite.chainB.SenderAccount.GetAddress().String()
+			msg = types.NewMsgPauseMessageType(authority, voteTypeURL, 0)
+
+			tc.malleate()
+
+			params := types.DefaultParams()
+			params.CircuitBreakerAuthority = authority
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			msgServer := keeper.NewMsgServerImpl(suite.chainB.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.PauseMessageType(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				pausedMsgType, found := suite.chainB.GetSimApp().ICAHostKeeper.GetPausedMessageType(ctx, voteTypeURL)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPausedMessageType(voteTypeURL, msg.ExpiryHeight), pausedMsgType)
+				suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsMessageTypePaused(ctx, voteTypeURL))
+
+				expEvent := sdk.NewEvent(
+					types.EventTypePauseMessageType,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+					sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+					sdk.NewAttribute(types.AttributeKeyMsgTypeURL, voteTypeURL),
+					sdk.NewAttribute(types.AttributeKeyExpiryHeight, sdk.NewIntFromUint64(msg.ExpiryHeight).String()),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUnpauseMessageType() {
+	var msg *types.MsgUnpauseMessageType
+
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: paused message type expired",
+			func() {
+				expiryHeight := uint64(suite.chainB.GetContext().BlockHeight()) - 1
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, expiryHeight))
+			},
+			nil,
+		},
+		{
+			"failure: message type not paused",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.DeletePausedMessageType(suite.chainB.GetContext(), sendTypeURL)
+			},
+			types.ErrMessageTypeNotPaused,
+		},
+		{
+			"failure: signer is not the circuit breaker authority",
+			func() {
+				msg.Authority = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			authority := suite.chainB.SenderAccount.GetAddress().String()
+
+			params := types.DefaultParams()
+			params.CircuitBreakerAuthority = authority
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, 0))
+
+			msg = types.NewMsgUnpauseMessageType(authority, sendTypeURL)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			msgServer := keeper.NewMsgServerImpl(suite.chainB.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.UnpauseMessageType(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetPausedMessageType(ctx, sendTypeURL)
+				suite.Require().False(found)
+				suite.Require().False(suite.chainB.GetSimApp().ICAHostKeeper.IsMessageTypePaused(ctx, sendTypeURL))
+
+				expEvent := sdk.NewEvent(
+					types.EventTypeUnpauseMessageType,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+					sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+					sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sendTypeURL),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgResetGasByConnection() {
+	var (
+		msg                *types.MsgResetGasByConnection
+		authority          string
+		expGasByConnection []types.ConnectionGasUsed
+	)
+
+	gasByConnection := []types.ConnectionGasUsed{
+		{ConnectionId: ibctesting.FirstConnectionID, GasUsed: 100000},
+		{ConnectionId: "connection-1", GasUsed: 50000},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: all connections",
+			func() {
+				msg.ConnectionId = ""
+				expGasByConnection = nil
+			},
+			nil,
+		},
+		{
+			"success: no gas used over connection",
+			func() {
+				msg.ConnectionId = "connection-2"
+				expGasByConnection = gasByConnection
+			},
+			nil,
+		},
+		{
+			"failure: gas reset authority not set",
+			func() {
+				authority = ""
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is not the gas reset authority",
+			func() {
+				msg.Authority = suite.chainB.SenderAccounts[2].SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is the circuit breaker authority",
+			func() {
+				msg.Authority = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			for _, gasUsed := range gasByConnection {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionGasUsed(suite.chainB.GetContext(), gasUsed)
+			}
+
+			authority = suite.chainB.SenderAccount.GetAddress().String()
+			msg = types.NewMsgResetGasByConnection(authority, ibctesting.FirstConnectionID)
+			expGasByConnection = gasByConnection[1:]
+
+			tc.malleate()
+
+			params := types.DefaultParams()
+			params.CircuitBreakerAuthority = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+			params.GasResetAuthority = authority
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			msgServer := keeper.NewMsgServerImpl(suite.chainB.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.ResetGasByConnection(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expGasByConnection, suite.chainB.GetSimApp().ICAHostKeeper.GetAllConnectionGasUsed(ctx))
+
+				expEvent := sdk.NewEvent(
+					types.EventTypeResetGasByConnection,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+					sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+					sdk.NewAttribute(icatypes.AttributeKeyConnectionID, msg.ConnectionId),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().Equal(gasByConnection, suite.chainB.GetSimApp().ICAHostKeeper.GetAllConnectionGasUsed(ctx))
+			}
+		})
+	}
+}
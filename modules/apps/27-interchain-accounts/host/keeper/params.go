@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
@@ -21,12 +23,165 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetMaxTxGas retrieves the maximum gas which may be consumed by a single interchain accounts transaction from the paramstore.
+// Zero is returned if no limit is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxTxGas(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxTxGas, &res)
+	return res
+}
+
+// GetMaxMsgsPerPacket retrieves the maximum number of messages which may be included in a single interchain accounts packet
+// from the paramstore. Zero is returned if no limit is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxMsgsPerPacket(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxMsgsPerPacket, &res)
+	return res
+}
+
+// GetMaxExecutionResults retrieves the number of recent execution results stored for each channel from the paramstore.
+// Zero is returned if the storage of execution results is disabled, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxExecutionResults(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxExecutionResults, &res)
+	return res
+}
+
+// IsMultiICASignersAllowed retrieves the allow multi ICA signers boolean from the paramstore. False is returned
+// if the param has not been initialized by a chain upgrade.
+func (k Keeper) IsMultiICASignersAllowed(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowMultiICASigners, &res)
+	return res
+}
+
+// IsAllowAllWhenEmpty retrieves the allow all when empty boolean from the paramstore. True is returned if all message
+// types are allowed when no message types are allowed on a connection. False is returned if the param has not been
+// initialized by a chain upgrade.
+func (k Keeper) IsAllowAllWhenEmpty(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowAllWhenEmpty, &res)
+	return res
+}
+
+// GetAllowQueries retrieves the gRPC query method paths which may be executed using query packets from the paramstore.
+// An empty list is returned if the param has not been initialized by a chain upgrade.
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetMaxQueryResponseSize retrieves the maximum size in bytes of the query responses of a single query packet from the
+// paramstore. Zero is returned if no limit is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxQueryResponseSize(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxQueryResponseSize, &res)
+	return res
+}
+
+// GetMaxPacketDataSize retrieves the maximum size in bytes of the data of a received interchain accounts packet from the
+// paramstore. Zero is returned if no limit is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxPacketDataSize(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxPacketDataSize, &res)
+	return res
+}
+
+// GetMaxMemoLength retrieves the maximum length in bytes of the memo of a received interchain accounts packet from the
+// paramstore. Zero is returned if no limit is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxMemoLength(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxMemoLength, &res)
+	return res
+}
+
+// GetTrustedControllerConnections retrieves the host connection identifiers over which new interchain accounts may be
+// registered from the paramstore. An empty list is returned if the param has not been initialized by a chain upgrade.
+func (k Keeper) GetTrustedControllerConnections(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyTrustedControllerConnections, &res)
+	return res
+}
+
+// IsTrustedControllerConnection returns true if new interchain accounts may be registered over the provided host connection.
+// New interchain accounts may be registered over any connection if no trusted controller connections are set.
+func (k Keeper) IsTrustedControllerConnection(ctx sdk.Context, connectionID string) bool {
+	trustedConnections := k.GetTrustedControllerConnections(ctx)
+	if len(trustedConnections) == 0 {
+		return true
+	}
+
+	for _, trustedConnectionID := range trustedConnections {
+		if trustedConnectionID == connectionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetCircuitBreakerAuthority retrieves the address allowed to pause and unpause message types from the paramstore.
+// An empty string is returned if the param has not been initialized by a chain upgrade.
+func (k Keeper) GetCircuitBreakerAuthority(ctx sdk.Context) string {
+	var res string
+	k.paramSpace.GetIfExists(ctx, types.KeyCircuitBreakerAuthority, &res)
+	return res
+}
+
+// GetExecutionFee retrieves the fee deducted from the interchain account once for each executed packet from the paramstore.
+// An empty fee is returned if no fee is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetExecutionFee(ctx sdk.Context) sdk.Coins {
+	var res sdk.Coins
+	k.paramSpace.GetIfExists(ctx, types.KeyExecutionFee, &res)
+	return res
+}
+
+// GetGasResetAuthority retrieves the address allowed to reset the gas consumed by a connection from the paramstore.
+// An empty string is returned if the param has not been initialized by a chain upgrade.
+func (k Keeper) GetGasResetAuthority(ctx sdk.Context) string {
+	var res string
+	k.paramSpace.GetIfExists(ctx, types.KeyGasResetAuthority, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.Params{
+		HostEnabled:                  k.IsHostEnabled(ctx),
+		AllowMessages:                k.GetAllowMessages(ctx),
+		MaxTxGas:                     k.GetMaxTxGas(ctx),
+		MaxMsgsPerPacket:             k.GetMaxMsgsPerPacket(ctx),
+		MaxExecutionResults:          k.GetMaxExecutionResults(ctx),
+		AllowMultiIcaSigners:         k.IsMultiICASignersAllowed(ctx),
+		AllowAllWhenEmpty:            k.IsAllowAllWhenEmpty(ctx),
+		AllowQueries:                 k.GetAllowQueries(ctx),
+		MaxQueryResponseSize:         k.GetMaxQueryResponseSize(ctx),
+		MaxPacketDataSize:            k.GetMaxPacketDataSize(ctx),
+		MaxMemoLength:                k.GetMaxMemoLength(ctx),
+		TrustedControllerConnections: k.GetTrustedControllerConnections(ctx),
+		CircuitBreakerAuthority:      k.GetCircuitBreakerAuthority(ctx),
+		ExecutionFee:                 k.GetExecutionFee(ctx),
+		GasResetAuthority:            k.GetGasResetAuthority(ctx),
+	}
 }
 
-// SetParams sets the total set of the host submodule parameters.
+// SetParams sets the total set of the host submodule parameters. Allow messages provided as Msg service method names
+// are stored in type URL form. Allow messages are sorted and deduplicated, such that they are stored and returned by
+// GetAllowMessages in a deterministic order.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	params.AllowMessages = types.NormalizeAllowMessages(k.canonicalizeAllowMessages(ctx, "", params.AllowMessages))
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// canonicalizeAllowMessages returns the provided allow messages in type URL form. Entries provided as Msg service method
+// names are logged and included in an event, such that operators may update the source of the allow messages
+func (k Keeper) canonicalizeAllowMessages(ctx sdk.Context, connectionID string, allowMsgs []string) []string {
+	canonicalMsgs, normalized := types.CanonicalizeAllowMessages(allowMsgs)
+	if len(normalized) > 0 {
+		k.Logger(ctx).Info("normalized allow messages provided as Msg service method names", "connection-id", connectionID, "entries", strings.Join(normalized, ","))
+		EmitNormalizeAllowMessagesEvent(ctx, connectionID, normalized)
+	}
+
+	return canonicalMsgs
+}
@@ -1,6 +1,13 @@
 package keeper_test
 
-import "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
 
 func (suite *KeeperTestSuite) TestParams() {
 	expParams := types.DefaultParams()
@@ -14,3 +21,72 @@ func (suite *KeeperTestSuite) TestParams() {
 	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
+
+func (suite *KeeperTestSuite) TestSetParamsNormalizesAllowMessages() {
+	params := types.DefaultParams()
+	params.AllowMessages = []string{"cosmos.staking.v1beta1.Msg/Delegate", "/cosmos.bank.v1beta1.MsgSend"}
+
+	ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, params)
+
+	expAllowMsgs := []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}
+	suite.Require().Equal(expAllowMsgs, suite.chainA.GetSimApp().ICAHostKeeper.GetAllowMessages(ctx))
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeNormalizeAllowMsgs, events[0].Type)
+	suite.Require().Contains(events[0].Attributes, sdk.NewAttribute(types.AttributeKeyNormalizedMsgs, "cosmos.staking.v1beta1.Msg/Delegate").ToKVPair())
+
+	ctx = suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	suite.chainA.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(ctx, ibctesting.FirstConnectionID, []string{"/cosmos.bank.v1beta1.Msg/Send"})
+
+	allowMsgs, found := suite.chainA.GetSimApp().ICAHostKeeper.GetConnectionAllowMessages(ctx, ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, allowMsgs)
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+
+	// type URLs are stored unchanged without emitting an event
+	ctx = suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, types.DefaultParams())
+	suite.Require().Empty(ctx.EventManager().Events())
+}
+
+// TestSetParamsAllowMessagesDeterministic asserts that random permutations of the same allow messages, including
+// duplicate entries and entries provided as Msg service method names, are always stored as identical bytes
+func (suite *KeeperTestSuite) TestSetParamsAllowMessagesDeterministic() {
+	allowMsgs := []string{
+		"/cosmos.bank.v1beta1.MsgSend",
+		"/cosmos.staking.v1beta1.MsgDelegate",
+		"/cosmos.staking.v1beta1.MsgUndelegate",
+		"/cosmos.gov.v1beta1.MsgVote",
+		"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+		"/ibc.applications.transfer.v1.MsgTransfer",
+	}
+
+	ctx := suite.chainA.GetContext()
+	subspace := suite.chainA.GetSimApp().GetSubspace(types.SubModuleName)
+
+	params := types.DefaultParams()
+	params.AllowMessages = allowMsgs
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, params)
+
+	expBz := subspace.GetRaw(ctx, types.KeyAllowMessages)
+	suite.Require().NotEmpty(expBz)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		permutation := make([]string, 0, len(allowMsgs)+2)
+		for _, idx := range r.Perm(len(allowMsgs)) {
+			permutation = append(permutation, allowMsgs[idx])
+		}
+
+		// duplicate a random entry and provide another in its Msg service method name form
+		permutation = append(permutation, allowMsgs[r.Intn(len(allowMsgs))], "cosmos.bank.v1beta1.Msg/Send")
+		r.Shuffle(len(permutation), func(i, j int) { permutation[i], permutation[j] = permutation[j], permutation[i] })
+
+		params.AllowMessages = permutation
+		suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, params)
+
+		suite.Require().Equal(expBz, subspace.GetRaw(ctx, types.KeyAllowMessages), permutation)
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// HandleUpdateAllowMessagesProposal replaces the host submodule allow messages with those provided by the proposal.
+// Each message type URL must resolve to an sdk.Msg registered with the application interface registry, entries provided as
+// Msg service method names are resolved using their type URL form. The wildcard
+// AllowAllHostMsgs is accepted as the sole entry. An event listing the added and removed type URLs is emitted.
+func (k Keeper) HandleUpdateAllowMessagesProposal(ctx sdk.Context, p *types.UpdateAllowMessagesProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	allowMsgs := types.NormalizeAllowMessages(k.canonicalizeAllowMessages(ctx, "", p.AllowMessages))
+	if err := k.validateAllowMessages(allowMsgs); err != nil {
+		return err
+	}
+
+	prevAllowMsgs := k.GetAllowMessages(ctx)
+
+	params := k.GetParams(ctx)
+	params.AllowMessages = allowMsgs
+	k.SetParams(ctx, params)
+
+	EmitUpdateAllowMessagesEvent(ctx, prevAllowMsgs, allowMsgs)
+
+	return nil
+}
+
+// HandleUpdateAddressBlocklistProposal adds and removes the addresses provided by the proposal from the host address
+// blocklist. Removing an address which is not blocked is a no-op. An event listing the added and removed addresses is emitted.
+func (k Keeper) HandleUpdateAddressBlocklistProposal(ctx sdk.Context, p *types.UpdateAddressBlocklistProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, address := range p.AddAddresses {
+		k.SetBlockedAddress(ctx, address)
+	}
+
+	for _, address := range p.RemoveAddresses {
+		k.DeleteBlockedAddress(ctx, address)
+	}
+
+	EmitUpdateAddressBlocklistEvent(ctx, p.AddAddresses, p.RemoveAddresses)
+
+	return nil
+}
+
+// validateAllowMessages ensures each of the provided type URLs resolves to an sdk.Msg known to the interface registry
+func (k Keeper) validateAllowMessages(allowMsgs []string) error {
+	if len(allowMsgs) == 1 && allowMsgs[0] == types.AllowAllHostMsgs {
+		return nil
+	}
+
+	cdc, ok := k.cdc.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return sdkerrors.Wrap(icatypes.ErrInvalidCodec, "only ProtoCodec is supported for resolving message type URLs")
+	}
+
+	for _, typeURL := range allowMsgs {
+		msg, err := cdc.InterfaceRegistry().Resolve(typeURL)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidAllowMessage, "%s: %s", typeURL, err)
+		}
+
+		if _, ok := msg.(sdk.Msg); !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidAllowMessage, "%s does not implement sdk.Msg", typeURL)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandleUpdateAllowMessagesProposal() {
+	var proposal *types.UpdateAllowMessagesProposal
+
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegateTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: wildcard", func() {
+				proposal.AllowMessages = []string{types.AllowAllHostMsgs}
+			}, true,
+		},
+		{
+			"success: empty allow messages", func() {
+				proposal.AllowMessages = nil
+			}, true,
+		},
+		{
+			"success: Msg service method names", func() {
+				proposal.AllowMessages = []string{"cosmos.bank.v1beta1.Msg/Send", msgDelegateTypeURL}
+			}, true,
+		},
+		{
+			"Msg service method name is not registered", func() {
+				proposal.AllowMessages = []string{"cosmos.bank.v1beta1.Msg/DoesNotExist"}
+			}, false,
+		},
+		{
+			"type URL is not registered", func() {
+				proposal.AllowMessages = []string{msgSendTypeURL, "/cosmos.bank.v1beta1.MsgDoesNotExist"}
+			}, false,
+		},
+		{
+			"type URL does not resolve to an sdk.Msg", func() {
+				proposal.AllowMessages = []string{"/cosmos.bank.v1beta1.Params"}
+			}, false,
+		},
+		{
+			"wildcard alongside other entries", func() {
+				proposal.AllowMessages = []string{types.AllowAllHostMsgs, msgSendTypeURL}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			prevParams := types.NewParams(true, []string{msgSendTypeURL})
+			suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), prevParams)
+
+			proposal = types.NewUpdateAllowMessagesProposal(ibctesting.Title, ibctesting.Description, []string{msgDelegateTypeURL}).(*types.UpdateAllowMessagesProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().ICAHostKeeper.HandleUpdateAllowMessagesProposal(ctx, proposal)
+
+			params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				expAllowMsgs, _ := types.CanonicalizeAllowMessages(proposal.AllowMessages)
+				suite.Require().Equal(expAllowMsgs, params.AllowMessages)
+				suite.Require().True(params.HostEnabled)
+
+				events := ctx.EventManager().Events()
+				suite.Require().NotEmpty(events)
+				suite.Require().Equal(types.EventTypeUpdateAllowMessages, events[len(events)-1].Type)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(prevParams, params)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleUpdateAddressBlocklistProposal() {
+	var proposal *types.UpdateAddressBlocklistProposal
+
+	blockedAddr := suite.chainA.SenderAccount.GetAddress().String()
+	addr := suite.chainB.SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expBlocklist []string
+		expPass      bool
+	}{
+		{
+			"success: add address", func() {}, []string{blockedAddr, addr}, true,
+		},
+		{
+			"success: remove address", func() {
+				proposal.AddAddresses = nil
+				proposal.RemoveAddresses = []string{blockedAddr}
+			}, nil, true,
+		},
+		{
+			"success: remove address which is not blocked", func() {
+				proposal.AddAddresses = nil
+				proposal.RemoveAddresses = []string{addr}
+			}, []string{blockedAddr}, true,
+		},
+		{
+			"invalid bech32 address", func() {
+				proposal.AddAddresses = []string{"invalid"}
+			}, []string{blockedAddr}, false,
+		},
+		{
+			"address both added and removed", func() {
+				proposal.RemoveAddresses = []string{addr}
+			}, []string{blockedAddr}, false,
+		},
+		{
+			"no addresses", func() {
+				proposal.AddAddresses = nil
+			}, []string{blockedAddr}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().ICAHostKeeper.SetBlockedAddress(suite.chainA.GetContext(), blockedAddr)
+
+			proposal = types.NewUpdateAddressBlocklistProposal(ibctesting.Title, ibctesting.Description, []string{addr}, nil).(*types.UpdateAddressBlocklistProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().ICAHostKeeper.HandleUpdateAddressBlocklistProposal(ctx, proposal)
+
+			blocklist := suite.chainA.GetSimApp().ICAHostKeeper.GetAddressBlocklist(ctx)
+			suite.Require().ElementsMatch(tc.expBlocklist, blocklist)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				events := ctx.EventManager().Events()
+				suite.Require().NotEmpty(events)
+				suite.Require().Equal(types.EventTypeUpdateBlocklist, events[len(events)-1].Type)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
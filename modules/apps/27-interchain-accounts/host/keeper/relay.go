@@ -2,10 +2,16 @@ package keeper
 
 import (
 	"fmt"
+	"runtime/debug"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
@@ -14,148 +20,915 @@ import (
 )
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
+// The messages are decoded using the encoding negotiated in the channel version metadata.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
-	var data icatypes.InterchainAccountPacketData
+// Errors returned are not included verbatim in the acknowledgement, only their ABCI code and codespace are written.
+// The execution result is stored if enabled by the host MaxExecutionResults param, only for packets which are
+// acknowledged successfully. Query packets are decoded into
+// query requests rather than messages and executed using executeQuery. Packets whose data exceeds the host
+// MaxPacketDataSize param are rejected before the packet data is decoded, packets whose memo exceeds the host
+// MaxMemoLength param are rejected before the messages or queries are decoded, as is packet data failing the basic
+// validation of InterchainAccountPacketData, such as packet data of an unknown type. The memo is provided to the
+// registered ICAHostHooks and included in the events emitted once the transaction is executed. The transactions of
+// batch packets are executed independently using executeTxBatch, the host MaxMsgsPerPacket param bounds the total
+// number of messages of the batch. Channel version update packets update the version of the channel using
+// updateChannelVersion, the proposed version is returned as the acknowledgement result. The gas consumed by the
+// execution of transactions is included in the stored execution result.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (txResponse []byte, err error) {
+	var (
+		data    icatypes.InterchainAccountPacketData
+		msgs    []sdk.Msg
+		gasUsed uint64
+	)
+
+	// packets on open channels are rejected without being executed while the host submodule is disabled
+	if !k.IsHostEnabled(ctx) {
+		logger.WithPacket(ctx, packet).Info("host submodule is disabled, packet rejected")
+		EmitHostPausedEvent(ctx, packet)
+		return nil, types.ErrHostSubModuleDisabled
+	}
 
-	logger.InitLogger()
+	defer func() {
+		k.setExecutionResult(ctx, packet, data.Type, msgs, txResponse, gasUsed, err)
+	}()
 
-	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	packetLogger := logger.WithPacket(ctx, packet)
+
+	if err := icatypes.ValidatePacketDataSize(packet.GetData(), k.GetMaxPacketDataSize(ctx)); err != nil {
+		packetLogger.Info("packet data size exceeded", "size", len(packet.GetData()), "error", err)
+		return nil, err
+	}
 
-		logger.LogError("Error occurred during UnmarshalJSON: ", err.Error())
-		fmt.Println("Error occurred during UnmarshalJSON at OnRecvPacket")
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		packetLogger.Error("failed to unmarshal packet data", "error", err)
 
 		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
 		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
 	}
 
-	logger.LogInfo("packet data successfully marshalled")
-	fmt.Println("packet data successfully marshalled")
+	packetLogger.Debug("packet data unmarshalled", "type", data.Type, "memo_length", len(data.Memo))
 
-	// For some reason the msg type is not being logged even though the transaction is succeeding
-	// Let's log the msg outside the switch statement
-	logger.LogInfo("un packing the msg type now")
-	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
-	if err != nil {
-		logger.LogInfo("Could not deserialize cosmos tx into msgs, error is:", err)
-		fmt.Println("Could not deserialize cosmos tx into msgs")
+	if err := k.validateMemoLength(ctx, data.Memo); err != nil {
+		packetLogger.Info("memo length exceeded", "error", err)
 		return nil, err
 	}
 
-	logger.LogInfo("How many messages we packed into IBC_packet.data:", len(msgs))
-	msg0 := msgs[0]
-	logger.LogInfo("msg0 as String is:", msg0.String()) // we can probably parse this string to obtain the protobuf.decode() value here
-	logger.LogInfo("msg0 signers are:", msg0.GetSigners())
-	logger.LogInfo("msg0 type URL is:", sdk.MsgTypeURL(msg0))
+	// the memo length is bounded by the host MaxMemoLength param rather than the controller MaxMemoCharLength
+	if err := data.ValidateBasicWithMaxMemoLength(0); err != nil {
+		packetLogger.Info("invalid packet data", "error", err)
+		return nil, err
+	}
 
-	for i, msg := range msgs {
-		logger.LogInfo(fmt.Sprintf("Message %d: %s", i, msg.String()))
+	if data.Type == icatypes.UPDATE_CHANNEL_VERSION {
+		if err := k.updateChannelVersion(ctx, packet.DestinationPort, packet.DestinationChannel, string(data.Data)); err != nil {
+			packetLogger.Info("channel version update rejected", "error", err)
+			return nil, err
+		}
+		packetLogger.Debug("channel version updated", "version", string(data.Data))
+
+		return data.Data, nil
+	}
+
+	encoding := k.GetChannelEncoding(ctx, packet.DestinationPort, packet.DestinationChannel)
+
+	if data.Type == icatypes.EXECUTE_QUERY {
+		requests, err := icatypes.DeserializeCosmosQuery(data.Data, encoding)
+		if err != nil {
+			packetLogger.Error("failed to deserialize cosmos query", "encoding", encoding, "error", err)
+			return nil, err
+		}
+
+		queryResponse, err := k.executeQuery(ctx, requests)
+		if err != nil {
+			packetLogger.Info("query failed", "error", err)
+			return nil, err
+		}
+		packetLogger.Debug("query executed", "query_count", len(requests), "response_size", len(queryResponse))
+
+		return queryResponse, nil
+	}
+
+	if data.Type == icatypes.EXECUTE_TX_BATCH {
+		msgGroups, err := icatypes.DeserializeCosmosTxBatch(k.cdc, data.Data, encoding)
+		if err != nil {
+			packetLogger.Error("failed to deserialize cosmos tx batch", "encoding", encoding, "error", err)
+			return nil, err
+		}
+
+		if len(msgGroups) == 0 {
+			return nil, sdkerrors.Wrap(icatypes.ErrInvalidOutgoingData, "batch must contain at least one transaction")
+		}
+
+		for _, txMsgs := range msgGroups {
+			msgs = append(msgs, txMsgs...)
+		}
+
+		if err := k.validateMsgCount(ctx, msgs); err != nil {
+			return nil, err
+		}
+
+		txResponse, gasUsed, err = k.executeTxBatch(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, packet.Sequence, msgGroups, data.Memo)
+		return txResponse, err
+	}
+
+	msgs, err = icatypes.DeserializeCosmosTx(k.cdc, data.Data, encoding)
+	if err != nil {
+		packetLogger.Error("failed to deserialize cosmos tx", "encoding", encoding, "error", err)
+		return nil, err
+	}
+
+	packetLogger.Debug("cosmos tx deserialized", "msg_count", len(msgs))
+	if logger.Enabled(logger.LevelDebug) {
+		for i, msg := range msgs {
+			packetLogger.Debug("message deserialized", "index", i, "msg", logger.SanitizeMsg(msg))
+		}
 	}
 
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
-		if err != nil {
-			logger.LogInfo("Could not deserialize cosmos tx into msgs, error is:", err)
-			fmt.Println("Could not deserialize cosmos tx into msgs")
+		if err := k.validateMsgCount(ctx, msgs); err != nil {
 			return nil, err
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		txResponse, gasUsed, err = k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, packet.Sequence, msgs, data.Memo, true)
 		if err != nil {
-			logger.LogInfo("Transaction failed. Error:", err)
+			packetLogger.Info("transaction failed", "error", err)
 			return nil, err
 		}
-		logger.LogInfo("Transaction did not error. Tx response:", txResponse)
+		packetLogger.Debug("transaction executed", "tx_response", txResponse)
 
 		return txResponse, nil
+	case icatypes.EXECUTE_TX_NON_ATOMIC:
+		if err := k.validateMsgCount(ctx, msgs); err != nil {
+			return nil, err
+		}
+
+		txResponse, gasUsed, err = k.executeTxNonAtomic(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, packet.Sequence, msgs, data.Memo)
+		return txResponse, err
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
+// setExecutionResult stores the result of the execution of the provided packet and prunes the results stored for the
+// channel which exceed the host MaxExecutionResults param. No result is stored if the param is zero. For non-atomic
+// executions the result is unsuccessful if any message failed, in which case the ABCI code of the first failed message
+// is stored. Batch executions are likewise unsuccessful if any transaction of the batch failed. No result is stored for
+// packets which are acknowledged with an error, as core IBC discards the state changes of such packets.
+func (k Keeper) setExecutionResult(ctx sdk.Context, packet channeltypes.Packet, packetType icatypes.Type, msgs []sdk.Msg, txResponse []byte, gasUsed uint64, err error) {
+	maxResults := k.GetMaxExecutionResults(ctx)
+	if maxResults == 0 || err != nil {
+		return
+	}
+
+	result := types.ExecutionResult{
+		Sequence:    packet.Sequence,
+		MsgTypeUrls: make([]string, len(msgs)),
+		Success:     true,
+		GasUsed:     gasUsed,
+	}
+
+	for i, msg := range msgs {
+		result.MsgTypeUrls[i] = sdk.MsgTypeURL(msg)
+	}
+
+	if packetType == icatypes.EXECUTE_TX_BATCH {
+		if batchTxResult, err := icatypes.UnmarshalBatchTxResult(txResponse); err == nil {
+			for _, txResult := range batchTxResult.Results {
+				if !txResult.Success {
+					result.Success = false
+					result.Code = txResult.Code
+					break
+				}
+			}
+		}
+	} else if txMsgResult, err := icatypes.UnmarshalTxMsgResult(txResponse); err == nil {
+		for _, msgResult := range txMsgResult.Results {
+			if !msgResult.Success {
+				result.Success = false
+				result.Code = msgResult.Code
+				break
+			}
+		}
+	}
+
+	k.SetExecutionResult(ctx, packet.DestinationPort, packet.DestinationChannel, result)
+	k.PruneExecutionResults(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence, maxResults)
+}
+
+// validateMsgCount ensures the number of msgs does not exceed the host MaxMsgsPerPacket param. A limit of zero is unbounded.
+func (k Keeper) validateMsgCount(ctx sdk.Context, msgs []sdk.Msg) error {
+	maxMsgs := k.GetMaxMsgsPerPacket(ctx)
+	if maxMsgs > 0 && uint64(len(msgs)) > maxMsgs {
+		return sdkerrors.Wrapf(types.ErrMaxMsgsPerPacket, "packet contains %d messages, max messages per packet %d", len(msgs), maxMsgs)
+	}
+
+	return nil
+}
+
+// updateChannelVersion updates the version of the provided channel to the provided version and enables fees on the
+// channel. Only additive changes to the current channel version are permitted, as validated by ValidateVersionUpdate,
+// and a FeeKeeper must be set. The fee enabled flag is set after the ICS29 middleware has determined whether the
+// acknowledgement of the packet is incentivized, such that the acknowledgement of the update itself is not.
+func (k Keeper) updateChannelVersion(ctx sdk.Context, portID, channelID, version string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	if err := icatypes.ValidateVersionUpdate(channel.Version, version); err != nil {
+		return err
+	}
+
+	if k.feeKeeper == nil {
+		return sdkerrors.Wrap(icatypes.ErrUnsupported, "fees cannot be enabled on channels of a host without a fee keeper")
+	}
+
+	channel.Version = version
+	k.channelKeeper.SetChannel(ctx, portID, channelID, channel)
+	k.feeKeeper.SetFeeEnabled(ctx, portID, channelID)
+
+	EmitUpdateChannelVersionEvent(ctx, portID, channelID, version)
+
+	return nil
+}
+
+// validateMemoLength ensures the length of the memo does not exceed the host MaxMemoLength param. A limit of zero is unbounded.
+func (k Keeper) validateMemoLength(ctx sdk.Context, memo string) error {
+	maxLength := k.GetMaxMemoLength(ctx)
+	if maxLength > 0 && uint64(len(memo)) > maxLength {
+		return sdkerrors.Wrapf(types.ErrMaxMemoLength, "memo length %d bytes exceeds max memo length %d bytes", len(memo), maxLength)
+	}
+
+	return nil
+}
+
+// executeQuery executes the provided query requests in order using the gRPC query router, returning the marshaled
+// CosmosQueryResponse containing the response of each query. Each query path must be allowed by the host AllowQueries
+// param, all paths are validated before any query is executed. The queries are executed against a cached context which
+// is discarded, such that query handlers cannot modify state. The total size of the query responses must not exceed
+// the host MaxQueryResponseSize param, if set.
+func (k Keeper) executeQuery(ctx sdk.Context, requests []icatypes.QueryRequest) ([]byte, error) {
+	allowQueries := k.GetAllowQueries(ctx)
+	for _, request := range requests {
+		if !types.ContainsQueryPath(allowQueries, request.Path) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", request.Path)
+		}
+	}
+
+	maxResponseSize := k.GetMaxQueryResponseSize(ctx)
+	queryResponse := &icatypes.CosmosQueryResponse{
+		Responses: make([][]byte, len(requests)),
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	var responseSize uint64
+	for i, request := range requests {
+		handler := k.queryRouter.Route(request.Path)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "no route found for query path %s", request.Path)
+		}
+
+		res, err := handler(cacheCtx, abci.RequestQuery{
+			Path:   request.Path,
+			Data:   request.Data,
+			Height: ctx.BlockHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		responseSize += uint64(len(res.Value))
+		if maxResponseSize > 0 && responseSize > maxResponseSize {
+			return nil, sdkerrors.Wrapf(types.ErrMaxQueryResponseSize, "query responses size exceeds max query response size %d", maxResponseSize)
+		}
+
+		queryResponse.Responses[i] = res.Value
+	}
+
+	bz, err := proto.Marshal(queryResponse)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal query response")
+	}
+
+	return bz, nil
+}
+
 // executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// The returned TxMsgResult is wire compatible with sdk.TxMsgData and additionally contains the gas used by
+// each message. Errors returned by a message are wrapped in a MsgExecutionError containing the message index.
+// An event is emitted for each executed message, followed by a summary event, once the state changes are committed.
+// If the host MaxTxGas param is set, the messages are executed using a gas meter bounded by its value and running
+// out of gas results in an error rather than a panic. Telemetry is recorded for the received packet and either each
+// executed message or the failure of the transaction. The registered ICAHostHooks are called using the cached context
+// before and after the messages are executed, an error returned by a hook aborts the transaction. The gas consumed
+// within the cached context is returned, included in the summary event and added to the gas used over the connection.
+// If deductFee is true, the execution fee is deducted within the cached context before the messages are executed.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, sequence uint64, msgs []sdk.Msg, memo string, deductFee bool) (txResponse []byte, gasUsed uint64, err error) {
+	defer func() {
+		incrPacketReceivedTelemetry(len(msgs))
+
+		if err != nil {
+			incrExecutionFailedTelemetry(err)
+			return
+		}
+
+		for _, msg := range msgs {
+			incrMsgExecutedTelemetry(msg)
+		}
+	}()
+
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
-		return nil, channeltypes.ErrChannelNotFound
-	}
-
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
-		return nil, err
+		return nil, 0, channeltypes.ErrChannelNotFound
 	}
 
-	txMsgData := &sdk.TxMsgData{
-		Data: make([]*sdk.MsgData, len(msgs)),
+	connectionID := channel.ConnectionHops[0]
+	if err := k.authenticateTx(ctx, msgs, connectionID, sourcePort); err != nil {
+		return nil, 0, err
 	}
 
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
+	if maxTxGas := k.GetMaxTxGas(ctx); maxTxGas > 0 {
+		// the gas consumed by the bounded gas meter is charged to the parent gas meter once execution completes
+		gasMeter := sdk.NewGasMeter(maxTxGas)
+		cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGas, ok := r.(sdk.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+
+				txResponse, gasUsed, err = nil, 0, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; max tx gas: %d", outOfGas.Descriptor, maxTxGas)
+			}
+
+			ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain accounts host tx execution")
+		}()
+	}
+
+	gasBefore := cacheCtx.GasMeter().GasConsumedToLimit()
+
+	// the execution fee is deducted within the cached context, such that it is only charged if the transaction succeeds
+	if deductFee {
+		if err := k.deductExecutionFee(cacheCtx, connectionID, sourcePort); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if err := k.BeforeExecuteTx(cacheCtx, connectionID, sourcePort, msgs, memo); err != nil {
+		return nil, 0, err
+	}
+
+	txMsgResult, err := k.executeMsgs(cacheCtx, msgs)
+	if err == nil {
+		if txResponse, err = proto.Marshal(txMsgResult); err != nil {
+			err = sdkerrors.Wrap(err, "failed to marshal tx data")
+		}
+	}
+
+	if err := k.AfterExecuteTx(cacheCtx, connectionID, sourcePort, msgs, memo, txResponse, err); err != nil {
+		return nil, 0, err
+	}
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	gasUsed = cacheCtx.GasMeter().GasConsumedToLimit() - gasBefore
+
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+
+	k.addConnectionGasUsed(ctx, connectionID, gasUsed)
+
+	// events are only emitted once the transaction state changes have been committed
+	for _, msg := range msgs {
+		EmitExecuteMsgEvent(ctx, sourcePort, destChannel, msg, true)
+	}
+	EmitExecuteTxEvent(ctx, sourcePort, destChannel, sequence, len(msgs), memo, gasUsed)
+
+	return txResponse, gasUsed, nil
+}
+
+// deductExecutionFee sends the execution fee set in the host submodule params from the interchain account registered
+// for the provided connection and controller port to the fee collector module account. No fee is deducted if the
+// execution fee is empty.
+func (k Keeper) deductExecutionFee(ctx sdk.Context, connectionID, sourcePort string) error {
+	fee := k.GetExecutionFee(ctx)
+	if fee.IsZero() {
+		return nil
+	}
+
+	if k.bankKeeper == nil {
+		return sdkerrors.Wrapf(types.ErrExecutionFeeUnsupported, "cannot deduct execution fee %s", fee)
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, connectionID, sourcePort)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", connectionID, sourcePort)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(address), authtypes.FeeCollectorName, fee); err != nil {
+		return sdkerrors.Wrapf(err, "failed to deduct execution fee %s from interchain account %s", fee, address)
+	}
+
+	k.addCollectedExecutionFees(ctx, connectionID, fee)
+	EmitExecutionFeeEvent(ctx, connectionID, sourcePort, address, fee)
+
+	return nil
+}
+
+// executeTxBatch executes each of the provided transactions in order using executeTx, such that each transaction is
+// authenticated and executed atomically using its own cached context. A failed transaction does not revert the state
+// changes of the other transactions of the batch. The returned BatchTxResult reports the success or failure of each
+// transaction by index, successful transactions include their TxMsgResult and failed transactions the ABCI code of
+// the returned error. The returned gas used is the sum of the gas consumed by the successful transactions. The execution
+// fee is deducted once for the batch before its transactions are executed, a failure to deduct it fails the batch.
+func (k Keeper) executeTxBatch(ctx sdk.Context, sourcePort, destPort, destChannel string, sequence uint64, msgGroups [][]sdk.Msg, memo string) ([]byte, uint64, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, 0, channeltypes.ErrChannelNotFound
+	}
+
+	if err := k.deductExecutionFee(ctx, channel.ConnectionHops[0], sourcePort); err != nil {
+		return nil, 0, err
+	}
+
+	batchTxResult := &icatypes.BatchTxResult{
+		Results: make([]icatypes.TxGroupResult, len(msgGroups)),
+	}
+
+	var gasUsed uint64
+	for i, msgs := range msgGroups {
+		batchTxResult.Results[i].Index = uint64(i)
+
+		txResponse, txGasUsed, err := k.executeTx(ctx, sourcePort, destPort, destChannel, sequence, msgs, memo, false)
+		if err != nil {
+			// the ABCI code is deterministic, the codespace and log values are discarded
+			_, batchTxResult.Results[i].Code, _ = sdkerrors.ABCIInfo(err, false)
+			continue
+		}
+
+		txMsgResult, err := icatypes.UnmarshalTxMsgResult(txResponse)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		batchTxResult.Results[i].Success = true
+		batchTxResult.Results[i].Result = txMsgResult
+		gasUsed += txGasUsed
+	}
+
+	bz, err := proto.Marshal(batchTxResult)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "failed to marshal batch tx result")
+	}
+
+	return bz, gasUsed, nil
+}
+
+// executeMsgs validates and executes each of the provided msgs in order, returning the TxMsgResult containing the
+// response and gas used by each msg. Execution stops at the first msg which fails, the returned error is wrapped
+// in a MsgExecutionError containing the index of the msg.
+func (k Keeper) executeMsgs(ctx sdk.Context, msgs []sdk.Msg) (*icatypes.TxMsgResult, error) {
+	txMsgResult := &icatypes.TxMsgResult{
+		Data:         make([]*icatypes.MsgData, len(msgs)),
+		Results:      make([]icatypes.MsgResult, len(msgs)),
+		MsgResponses: make([]*codectypes.Any, len(msgs)),
+	}
+
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
+			return nil, icatypes.NewMsgExecutionError(uint64(i), err)
 		}
 
-		msgResponse, err := k.executeMsg(cacheCtx, msg)
+		if err := k.validateMsg(ctx, msg); err != nil {
+			return nil, icatypes.NewMsgExecutionError(uint64(i), err)
+		}
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		msgResponse, err := k.executeMsg(ctx, msg)
 		if err != nil {
-			return nil, err
+			return nil, icatypes.NewMsgExecutionError(uint64(i), err)
+		}
+
+		txMsgResult.Data[i] = &icatypes.MsgData{
+			MsgType: sdk.MsgTypeURL(msg),
+			Data:    msgResponse,
+		}
+
+		txMsgResult.MsgResponses[i] = icatypes.NewMsgResponseAny(sdk.MsgTypeURL(msg), msgResponse)
+
+		txMsgResult.Results[i] = icatypes.MsgResult{
+			Index:      uint64(i),
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			GasUsed:    ctx.GasMeter().GasConsumed() - gasBefore,
+			Success:    true,
+		}
+	}
+
+	return txMsgResult, nil
+}
+
+// executeTxNonAtomic attempts to execute the provided transaction on a best-effort basis. Authentication of the transaction
+// signer is performed identically to executeTx and a failure to authenticate results in an error. Each message is then
+// validated and delivered into state using its own cached context, which is only written if the message succeeds.
+// The returned TxMsgResult reports the success or failure of each message by index, failed messages are reported
+// with empty response data and the ABCI code of the returned error. The host MaxTxGas param bounds the gas consumed
+// by the entire transaction. The registered ICAHostHooks are called before and after the messages are executed,
+// an error returned by a hook fails the entire transaction. The gas consumed by all messages, including failed messages,
+// is returned and accounted for identically to executeTx. The execution fee is deducted once before the messages are
+// executed, regardless of the success of the individual messages, a failure to deduct it fails the entire transaction.
+// As in executeTx, the fee, hooks and messages are executed within a cached context which is only written if the
+// transaction does not fail, and running out of gas is returned as an error.
+func (k Keeper) executeTxNonAtomic(ctx sdk.Context, sourcePort, destPort, destChannel string, sequence uint64, msgs []sdk.Msg, memo string) (txResponse []byte, gasUsed uint64, err error) {
+	defer func() {
+		incrPacketReceivedTelemetry(len(msgs))
+
+		if err != nil {
+			incrExecutionFailedTelemetry(err)
 		}
+	}()
+
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, 0, channeltypes.ErrChannelNotFound
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	if err := k.authenticateTx(ctx, msgs, connectionID, sourcePort); err != nil {
+		return nil, 0, err
+	}
+
+	txMsgResult := &icatypes.TxMsgResult{
+		Data:         make([]*icatypes.MsgData, len(msgs)),
+		Results:      make([]icatypes.MsgResult, len(msgs)),
+		MsgResponses: make([]*codectypes.Any, len(msgs)),
+	}
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object
+	// writeCache is called only if the execution fee, the hooks and the marshalling of the results succeed
+	cacheCtx, writeCache := ctx.CacheContext()
+	maxTxGas := k.GetMaxTxGas(ctx)
+	if maxTxGas > 0 {
+		// the gas consumed by the bounded gas meter is charged to the parent gas meter once execution completes
+		gasMeter := sdk.NewGasMeter(maxTxGas)
+		cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGas, ok := r.(sdk.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+
+				txResponse, gasUsed, err = nil, 0, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; max tx gas: %d", outOfGas.Descriptor, maxTxGas)
+			}
+
+			ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain accounts host tx execution")
+		}()
+	}
+
+	gasBefore := cacheCtx.GasMeter().GasConsumedToLimit()
+
+	if err := k.deductExecutionFee(cacheCtx, connectionID, sourcePort); err != nil {
+		return nil, 0, err
+	}
+
+	if err := k.BeforeExecuteTx(cacheCtx, connectionID, sourcePort, msgs, memo); err != nil {
+		return nil, 0, err
+	}
+
+	for i, msg := range msgs {
+		gasBefore := cacheCtx.GasMeter().GasConsumedToLimit()
+
+		msgResponse, err := k.executeMsgNonAtomic(cacheCtx, msg, maxTxGas > 0)
 
-		txMsgData.Data[i] = &sdk.MsgData{
+		txMsgResult.Data[i] = &icatypes.MsgData{
 			MsgType: sdk.MsgTypeURL(msg),
 			Data:    msgResponse,
 		}
 
+		// the response of a failed message is left empty
+		txMsgResult.MsgResponses[i] = &codectypes.Any{}
+		if err == nil {
+			txMsgResult.MsgResponses[i] = icatypes.NewMsgResponseAny(sdk.MsgTypeURL(msg), msgResponse)
+		}
+
+		txMsgResult.Results[i] = icatypes.MsgResult{
+			Index:      uint64(i),
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			GasUsed:    cacheCtx.GasMeter().GasConsumedToLimit() - gasBefore,
+			Success:    err == nil,
+		}
+
+		if err != nil {
+			// the ABCI code is deterministic, the codespace and log values are discarded
+			_, txMsgResult.Results[i].Code, _ = sdkerrors.ABCIInfo(err, false)
+			incrExecutionFailedTelemetry(err)
+		}
+	}
+
+	if txResponse, err = proto.Marshal(txMsgResult); err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "failed to marshal tx data")
+	}
+
+	if err := k.AfterExecuteTx(cacheCtx, connectionID, sourcePort, msgs, memo, txResponse, nil); err != nil {
+		return nil, 0, err
 	}
 
+	gasUsed = cacheCtx.GasMeter().GasConsumedToLimit() - gasBefore
+
 	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
+	k.addConnectionGasUsed(ctx, connectionID, gasUsed)
+
+	// events are only emitted once the transaction state changes have been committed
+	for i, msg := range msgs {
+		if txMsgResult.Results[i].Success {
+			incrMsgExecutedTelemetry(msg)
+		}
+
+		EmitExecuteMsgEvent(ctx, sourcePort, destChannel, msg, txMsgResult.Results[i].Success)
+	}
+	EmitExecuteTxEvent(ctx, sourcePort, destChannel, sequence, len(msgs), memo, gasUsed)
+
+	return txResponse, gasUsed, nil
+}
+
+// executeMsgNonAtomic validates and executes the provided msg using its own cached context. The state changes and events
+// of the msg are only written if it succeeds. If recoverOutOfGas is true, running out of gas is returned as an error
+// rather than a panic.
+func (k Keeper) executeMsgNonAtomic(ctx sdk.Context, msg sdk.Msg, recoverOutOfGas bool) (msgResponse []byte, err error) {
+	if recoverOutOfGas {
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGas, ok := r.(sdk.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+
+				msgResponse, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+			}
+		}()
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.validateMsg(cacheCtx, msg); err != nil {
+		return nil, err
+	}
+
+	msgResponse, err = k.executeMsg(cacheCtx, msg)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+		return nil, err
 	}
 
-	return txResponse, nil
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+
+	return msgResponse, nil
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier. If no message types are allowed over the provided
+// connection, the msgs are rejected unless the host AllowAllWhenEmpty param is enabled. The recipients of the
+// msgs are validated against the address blocklist using validateMsgRecipients
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	logger.InitLogger()
-	logger.LogInfo("interchainAccountAddr is:", interchainAccountAddr)
+	allowMsgs := k.GetAllowMessagesForConnection(ctx, connectionID)
 
-	allowMsgs := k.GetAllowMessages(ctx)
+	if logger.Enabled(logger.LevelDebug) {
+		logger.LogDebugKV(ctx, "authenticating interchain account tx", "connection_id", connectionID, "port_id", portID, "interchain_account", interchainAccountAddr, "allow_messages", allowMsgs)
+	}
+
+	if len(allowMsgs) == 0 {
+		if !k.IsAllowAllWhenEmpty(ctx) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no message types are allowed to be executed over connection %s", connectionID)
+		}
 
-	for i, allowMsg := range allowMsgs {
-		logger.LogInfo(fmt.Sprintf("ICA Host Allowed message %d: %s", i, allowMsg))
+		allowMsgs = []string{types.AllowAllHostMsgs}
 	}
-	logger.LogInfo("length of allowMsgs slice is", len(allowMsgs))
-	logger.LogInfo("first allowed message is:", allowMsgs[0])
 
-	// Based on the below code, how could the wild card of "*" possible work to allow all messages from all modules?
-	// Does this wild card only work for latest ibc-go?
+	if err := k.validateMsgsNotPaused(ctx, msgs); err != nil {
+		return err
+	}
 
+	validateSigners := k.newSignersValidator(ctx, connectionID, interchainAccountAddr)
 	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		if err := authenticateMsg(msg, allowMsgs, validateSigners, 0); err != nil {
+			return err
 		}
+	}
 
+	return k.validateMsgRecipients(ctx, msgs)
+}
+
+// validateMsg invokes the MsgValidator, if set, for the provided msg and each msg nested within an authz MsgExec
+func (k Keeper) validateMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if k.msgValidator == nil {
+		return nil
+	}
+
+	if err := k.msgValidator(ctx, msg); err != nil {
+		return err
+	}
+
+	if execMsg, ok := msg.(*authz.MsgExec); ok {
+		nestedMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for _, nestedMsg := range nestedMsgs {
+			if err := k.validateMsg(ctx, nestedMsg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMsgsNotPaused ensures none of the provided msgs, including those nested within an authz MsgExec, are of a message
+// type paused by the circuit breaker authority
+func (k Keeper) validateMsgsNotPaused(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if typeURL := sdk.MsgTypeURL(msg); k.IsMessageTypePaused(ctx, typeURL) {
+			return sdkerrors.Wrapf(types.ErrMessageTypePaused, "message type %s is paused", typeURL)
+		}
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := k.validateMsgsNotPaused(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMsgRecipients ensures the provided msgs do not send funds to an address present in the address blocklist or to
+// a module account. The recipients of bank MsgSend and MsgMultiSend are inspected, including those of msgs nested within
+// an authz MsgExec. Other msg types are not inspected.
+func (k Keeper) validateMsgRecipients(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var recipients []string
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			recipients = append(recipients, msg.ToAddress)
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				recipients = append(recipients, output.Address)
+			}
+		case *authz.MsgExec:
+			nestedMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := k.validateMsgRecipients(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		}
+
+		for _, recipient := range recipients {
+			if err := k.validateRecipient(ctx, recipient); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRecipient returns an error if the provided recipient address is present in the address blocklist or is the
+// address of a module account
+func (k Keeper) validateRecipient(ctx sdk.Context, recipient string) error {
+	if k.IsAddressBlocked(ctx, recipient) {
+		return sdkerrors.Wrapf(types.ErrBlockedAddress, "recipient %s is present in the address blocklist", recipient)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		// invalid recipient addresses are rejected by the msg ValidateBasic
+		return nil
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+		return sdkerrors.Wrapf(types.ErrBlockedAddress, "recipient %s is a module account", recipient)
+	}
+
+	return nil
+}
+
+// newSignersValidator returns a function validating the signers of a msg executed by the provided interchain account.
+// Each signer must be the interchain account, unless the host AllowMultiICASigners param is enabled, in which case each
+// signer must be an interchain account registered on the provided connection and the executing interchain account must
+// be one of the signers. Signers accepted by the SignerResolver, if set, are considered controlled by the interchain
+// account and are treated as the interchain account itself.
+func (k Keeper) newSignersValidator(ctx sdk.Context, connectionID, interchainAccountAddr string) func(sdk.Msg) error {
+	allowMultiSigners := k.IsMultiICASignersAllowed(ctx)
+
+	return func(msg sdk.Msg) error {
+		var signedByAccount bool
 		for _, signer := range msg.GetSigners() {
-			if interchainAccountAddr != signer.String() {
+			if signer.String() == interchainAccountAddr || k.isSignerControlled(ctx, interchainAccountAddr, signer, msg) {
+				signedByAccount = true
+				continue
+			}
+
+			if !allowMultiSigners || !k.isInterchainAccount(ctx, connectionID, signer) {
 				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
 			}
 		}
+
+		if allowMultiSigners && !signedByAccount {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg is not signed by the executing interchain account %s", interchainAccountAddr)
+		}
+
+		return nil
+	}
+}
+
+// isSignerControlled returns true if the SignerResolver is set and accepts the provided signer of the msg as controlled
+// by the provided interchain account
+func (k Keeper) isSignerControlled(ctx sdk.Context, interchainAccountAddr string, signer sdk.AccAddress, msg sdk.Msg) bool {
+	if k.signerResolver == nil {
+		return false
+	}
+
+	interchainAccount, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	if err != nil {
+		return false
+	}
+
+	return k.signerResolver.IsSignerControlled(ctx, interchainAccount, signer, msg)
+}
+
+// isInterchainAccount returns true if the provided address is an interchain account registered on the provided connection
+func (k Keeper) isInterchainAccount(ctx sdk.Context, connectionID string, addr sdk.AccAddress) bool {
+	interchainAccount, ok := k.accountKeeper.GetAccount(ctx, addr).(*icatypes.InterchainAccount)
+	if !ok {
+		return false
+	}
+
+	registeredAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, interchainAccount.AccountOwner)
+	return found && registeredAddr == addr.String()
+}
+
+// authenticateMsg ensures the provided msg type is allowed and that the signers of the msg are valid for the interchain account.
+// The messages nested within an authz MsgExec are authenticated recursively, preventing disallowed messages from
+// being executed on behalf of the interchain account. An error is returned if the nested depth exceeds MaxNestedMsgDepth.
+func authenticateMsg(msg sdk.Msg, allowMsgs []string, validateSigners func(sdk.Msg) error, depth int) error {
+	if depth > types.MaxNestedMsgDepth {
+		return sdkerrors.Wrapf(types.ErrMaxNestedMsgDepth, "message nested at depth %d, max depth %d", depth, types.MaxNestedMsgDepth)
+	}
+
+	if !types.ContainsMsgType(allowMsgs, msg) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+	}
+
+	if err := validateSigners(msg); err != nil {
+		return err
+	}
+
+	if execMsg, ok := msg.(*authz.MsgExec); ok {
+		nestedMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for _, nestedMsg := range nestedMsgs {
+			if err := authenticateMsg(nestedMsg, allowMsgs, validateSigners, depth+1); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -163,17 +936,32 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
-func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
-
-	logger.InitLogger()
-	logger.LogInfo("the msg before it hits the handler is:", msg)
-	logger.LogInfo("As string the msg before it hits the handler is:", msg.String())
+// A panic raised by the message handler, other than running out of gas, is returned as ErrMsgHandlerPanic.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (msgResponse []byte, err error) {
+	if logger.Enabled(logger.LevelDebug) {
+		logger.LogDebugKV(ctx, "executing message", "msg", logger.SanitizeMsg(msg))
+	}
 
 	handler := k.msgRouter.Handler(msg)
 	if handler == nil {
 		return nil, icatypes.ErrInvalidRoute
 	}
 
+	// a panic raised by the msg handler is returned as an error, such that an error acknowledgement is written rather
+	// than the panic aborting the relayer transaction. Out of gas panics are raised again to preserve gas accounting.
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			logger.LogErrorKV(ctx, "recovered panic in message handler", "msg_type", sdk.MsgTypeURL(msg), "panic", fmt.Sprintf("%v", r), "stack", string(debug.Stack()))
+
+			// the panic value is not included in the error, as the error acknowledgement must be deterministic
+			msgResponse, err = nil, sdkerrors.Wrapf(types.ErrMsgHandlerPanic, "message type %s", sdk.MsgTypeURL(msg))
+		}
+	}()
+
 	res, err := handler(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -1,17 +1,30 @@
 package keeper_test
 
 import (
+	"context"
+	"strconv"
+	"strings"
 	"time"
 
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -53,7 +66,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Option:     govtypes.OptionYes,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -80,7 +93,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -108,7 +121,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -142,7 +155,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -177,7 +190,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Proposer:       interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -216,7 +229,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Option:     govtypes.OptionYes,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -242,7 +255,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Depositor: interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -268,7 +281,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					WithdrawAddress:  suite.chainB.SenderAccount.GetAddress().String(),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -307,7 +320,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					TimeoutTimestamp: uint64(0),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -346,7 +359,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{
 			"invalid packet type - UNSPECIFIED",
 			func() {
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -358,12 +371,38 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			false,
 		},
+		{
+			"invalid packet type - unknown type",
+			func() {
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.Type(100),
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			false,
+		},
+		{
+			"invalid packet data - empty data",
+			func() {
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			false,
+		},
 		{
 			"unauthorised: interchain account not found for controller port ID",
 			func() {
 				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -384,7 +423,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -405,7 +444,62 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"interchain account successfully executes a message allowed by the connection allow messages but not the host params",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainB.GetContext(), path.EndpointB.ConnectionID, []string{sdk.MsgTypeURL(msg)})
+			},
+			true,
+		},
+		{
+			"unauthorised: connection allow messages override the host params",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -417,6 +511,153 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainB.GetContext(), path.EndpointB.ConnectionID, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+			},
+			false,
+		},
+		{
+			"interchain account successfully executes authz.MsgExec containing an allowed message",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msgSend := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				msg := nestMsgExec(interchainAccountAddr, msgSend, 1)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&authz.MsgExec{}), sdk.MsgTypeURL(msgSend)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes messages nested at the max depth",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msgSend := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				msg := nestMsgExec(interchainAccountAddr, msgSend, types.MaxNestedMsgDepth)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"unauthorised: authz.MsgExec contains a message type not allowed",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msgDelegate := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+				}
+
+				msg := nestMsgExec(interchainAccountAddr, msgDelegate, 2)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&authz.MsgExec{}), sdk.MsgTypeURL(&banktypes.MsgSend{})})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"unauthorised: authz.MsgExec contains a message with an unexpected signer",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msgSend := &banktypes.MsgSend{
+					FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+					ToAddress:   interchainAccountAddr,
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				msg := nestMsgExec(interchainAccountAddr, msgSend, 1)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"max nested message depth exceeded",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msgSend := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				msg := nestMsgExec(interchainAccountAddr, msgSend, types.MaxNestedMsgDepth+1)
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
 		},
@@ -431,7 +672,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			path = NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
-			err := SetupICAPath(path, TestOwnerAddress)
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
 			portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
@@ -463,30 +704,3592 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				0,
 			)
 
-			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			ctx := suite.logContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(txResponse)
+
+				var data icatypes.InterchainAccountPacketData
+				err = icatypes.ModuleCdc.UnmarshalJSON(packetData, &data)
+				suite.Require().NoError(err)
+
+				msgs, err := icatypes.DeserializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), data.Data, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				var msgEvents []sdk.Event
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeExecuteMsg {
+						msgEvents = append(msgEvents, event)
+					}
+				}
+
+				suite.Require().Len(msgEvents, len(msgs))
+				for i, event := range msgEvents {
+					suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyControllerPortID), Value: []byte(packet.SourcePort)})
+					suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: []byte(icatypes.AttributeKeyHostChannelID), Value: []byte(packet.DestinationChannel)})
+					suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyMsgTypeURL), Value: []byte(sdk.MsgTypeURL(msgs[i]))})
+					suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyMsgSuccess), Value: []byte("true")})
+				}
+
+				events := ctx.EventManager().Events()
+				txEvent := events[len(events)-1]
+				suite.Require().Equal(types.EventTypeExecuteTx, txEvent.Type)
+				suite.Require().Contains(txEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyPacketSequence), Value: []byte(strconv.FormatUint(packet.Sequence, 10))})
+				suite.Require().Contains(txEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyMsgCount), Value: []byte(strconv.Itoa(len(msgs)))})
+				suite.Require().Contains(txEvent.Attributes, abci.EventAttribute{Key: []byte(icatypes.AttributeKeyMemo), Value: []byte(data.Memo)})
+
+				// every message is logged without its contents
+				entries := suite.requirePacketLogs("message deserialized", packet, len(msgs))
+				for i, entry := range entries {
+					value, ok := entry.value("msg")
+					suite.Require().True(ok)
+					suite.Require().Equal(logger.SanitizeMsg(msgs[i]), value)
+				}
+
+				suite.requirePacketLogs("transaction executed", packet, 1)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(txResponse)
+
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeExecuteTx, event.Type)
+				}
+
+				suite.requirePacketLogs("transaction executed", packet, 0)
 			}
 		})
 	}
 }
 
-func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
-	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
-	suite.Require().True(found)
+func (suite *KeeperTestSuite) TestOnRecvPacketEndToEnd() {
+	var (
+		path     *ibctesting.Path
+		icaAddr  sdk.AccAddress
+		msgs     []sdk.Msg
+		recvAddr = suite.chainB.SenderAccount.GetAddress()
+	)
 
-	msgBankSend := &banktypes.MsgSend{
-		FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
-		ToAddress:   interchainAccountAddr,
-		Amount:      amount,
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: bank send executed by the interchain account",
+			func() {},
+			true,
+		},
+		{
+			"success: multiple messages executed atomically",
+			func() {
+				msgs = append(msgs, banktypes.NewMsgSend(icaAddr, recvAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+			},
+			true,
+		},
+		{
+			"failure: message type is not allowed",
+			func() {
+				msgs = []sdk.Msg{&stakingtypes.MsgDelegate{
+					DelegatorAddress: icaAddr.String(),
+					ValidatorAddress: suite.chainB.Vals.Validators[0].Address.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				}}
+			},
+			false,
+		},
+		{
+			"failure: insufficient funds",
+			func() {
+				msgs = []sdk.Msg{banktypes.NewMsgSend(icaAddr, recvAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))}
+			},
+			false,
+		},
 	}
 
-	res, err := suite.chainB.SendMsgs(msgBankSend)
-	suite.Require().NotEmpty(res)
-	suite.Require().NoError(err)
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			icaAddr = sdk.MustAccAddressFromBech32(suite.chainA.GetICAAddress(path, TestOwnerAddress))
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			params := types.Params{
+				HostEnabled:         true,
+				AllowMessages:       []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				MaxExecutionResults: 5,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			msgs = []sdk.Msg{banktypes.NewMsgSend(icaAddr, recvAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))}
+
+			tc.malleate() // malleate mutates test data
+
+			icaBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+
+			ack, err := suite.chainA.SendICATx(path, TestOwnerAddress, msgs, time.Hour)
+			suite.Require().NoError(err)
+
+			// the acknowledgement is relayed back, clearing the packet commitment on the controller chain
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			suite.Require().Empty(commitment)
+
+			newICABalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().True(ack.Success())
+				suite.Require().NotEmpty(ack.GetResult())
+
+				result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+				suite.Require().True(found)
+				suite.Require().True(result.Success)
+				suite.Require().NotZero(result.GasUsed)
+				suite.Require().Equal(result.GasUsed, suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionGasUsed(suite.chainB.GetContext(), path.EndpointB.ConnectionID))
+				suite.Require().Equal(icaBalance.Amount.SubRaw(int64(100*len(msgs))), newICABalance.Amount)
+			} else {
+				suite.Require().False(ack.Success())
+				suite.Require().NotEmpty(ack.GetError())
+
+				// the state changes of packets acknowledged with an error are discarded by core IBC
+				_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+				suite.Require().False(found)
+				suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionGasUsed(suite.chainB.GetContext(), path.EndpointB.ConnectionID))
+				suite.Require().Equal(icaBalance, newICABalance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketHostDisabled() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	icaAddr := sdk.MustAccAddressFromBech32(suite.chainA.GetICAAddress(path, TestOwnerAddress))
+	msg := banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	params := types.Params{
+		AllowMessages:       []string{sdk.MsgTypeURL(msg)},
+		MaxExecutionResults: 5,
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	ctx := suite.chainB.GetContext()
+	txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+	suite.Require().ErrorIs(err, types.ErrHostSubModuleDisabled)
+	suite.Require().Nil(txResponse)
+
+	// the paused event is emitted instead of the execution events
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeHostPaused, events[0].Type)
+	suite.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyControllerPortID), Value: []byte(packet.SourcePort)})
+	suite.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(icatypes.AttributeKeyHostChannelID), Value: []byte(packet.DestinationChannel)})
+	suite.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyPacketSequence), Value: []byte(strconv.FormatUint(packet.Sequence, 10))})
+
+	// no execution result is recorded for the rejected packet
+	_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(int64(10000), balance.Amount.Int64())
+
+	// the same packet is executed once the host submodule is enabled again
+	params.HostEnabled = true
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	ctx = suite.chainB.GetContext()
+	txResponse, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(txResponse)
+
+	for _, event := range ctx.EventManager().Events() {
+		suite.Require().NotEqual(types.EventTypeHostPaused, event.Type)
+	}
+
+	result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().True(result.Success)
+
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+	suite.Require().Equal(int64(9900), balance.Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketAllowMessages() {
+	var (
+		msg    *banktypes.MsgSend
+		params types.Params
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty allow messages",
+			func() {
+				params = types.NewParams(true, []string{})
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"nil allow messages",
+			func() {
+				params = types.NewParams(true, nil)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"empty connection allow messages overriding non-empty params",
+			func() {
+				params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainB.GetContext(), ibctesting.FirstConnectionID, []string{})
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"single allowed message type",
+			func() {
+				params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			},
+			nil,
+		},
+		{
+			"single message type not matching the msg",
+			func() {
+				params = types.NewParams(true, []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"Msg service method name",
+			func() {
+				params = types.NewParams(true, []string{"cosmos.bank.v1beta1.Msg/Send"})
+			},
+			nil,
+		},
+		{
+			"mixed type URLs and Msg service method names",
+			func() {
+				params = types.NewParams(true, []string{"cosmos.staking.v1beta1.Msg/Delegate", sdk.MsgTypeURL(msg)})
+			},
+			nil,
+		},
+		{
+			"mixed type URLs and Msg service method names not matching the msg",
+			func() {
+				params = types.NewParams(true, []string{"cosmos.staking.v1beta1.Msg/Delegate", sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})})
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"connection allow messages with Msg service method name",
+			func() {
+				params = types.NewParams(true, []string{})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowMessages(suite.chainB.GetContext(), ibctesting.FirstConnectionID, []string{"/cosmos.bank.v1beta1.Msg/Send"})
+			},
+			nil,
+		},
+		{
+			"empty allow messages with allow all when empty",
+			func() {
+				params = types.Params{
+					HostEnabled:       true,
+					AllowMessages:     []string{},
+					AllowAllWhenEmpty: true,
+				}
+			},
+			nil,
+		},
+		{
+			"allow all when empty does not affect non-empty allow messages",
+			func() {
+				params = types.Params{
+					HostEnabled:       true,
+					AllowMessages:     []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})},
+					AllowAllWhenEmpty: true,
+				}
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"allow all when empty still validates signers",
+			func() {
+				params = types.Params{
+					HostEnabled:       true,
+					AllowMessages:     []string{},
+					AllowAllWhenEmpty: true,
+				}
+				msg.FromAddress = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg = &banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))}
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			// packet handling must return an error rather than panic, so that an error acknowledgement is written
+			var txResponse []byte
+			suite.Require().NotPanics(func() {
+				txResponse, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			})
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+// countingInterfaceRegistry counts the number of Any values unpacked, allowing tests to assert the number of times
+// a CosmosTx is decoded by a codec using the registry
+type countingInterfaceRegistry struct {
+	codectypes.InterfaceRegistry
+	unpacked int
+}
+
+func (r *countingInterfaceRegistry) UnpackAny(any *codectypes.Any, iface interface{}) error {
+	r.unpacked++
+	return r.InterfaceRegistry.UnpackAny(any, iface)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketDeserializesOnce() {
+	for _, packetType := range []icatypes.Type{icatypes.EXECUTE_TX, icatypes.EXECUTE_TX_NON_ATOMIC} {
+		packetType := packetType
+
+		suite.Run(packetType.String(), func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+				&banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: packetType,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msgs[0])})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			// the number of Any values unpacked by decoding the CosmosTx a single time
+			registry := &countingInterfaceRegistry{InterfaceRegistry: suite.chainB.GetSimApp().InterfaceRegistry()}
+			cdc := codec.NewProtoCodec(registry)
+
+			_, err = icatypes.DeserializeCosmosTx(cdc, data, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			unpackedOnce := registry.unpacked
+			suite.Require().Positive(unpackedOnce)
+
+			// a host keeper using the counting codec executes the packet
+			app := suite.chainB.GetSimApp()
+			registry.unpacked = 0
+
+			hostKeeper := keeper.NewKeeper(
+				cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.SubModuleName),
+				app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+				app.AccountKeeper, app.ScopedICAHostKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter(),
+			)
+
+			txResponse, err := hostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			suite.Require().NoError(err)
+			suite.Require().NotNil(txResponse)
+
+			suite.Require().Equal(unpackedOnce, registry.unpacked)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMaxTxGas() {
+	testCases := []struct {
+		name     string
+		maxTxGas uint64
+		expPass  bool
+	}{
+		{"success: no limit", 0, true},
+		{"success: limit not exceeded", 1_000_000, true},
+		{"failure: limit exceeded", 1_000, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+			msg := &stakingtypes.MsgDelegate{
+				DelegatorAddress: interchainAccountAddr,
+				ValidatorAddress: validatorAddr.String(),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.Params{
+				HostEnabled:   true,
+				AllowMessages: []string{sdk.MsgTypeURL(msg)},
+				MaxTxGas:      tc.maxTxGas,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			_, found = suite.chainB.GetSimApp().StakingKeeper.GetDelegation(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), validatorAddr)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+				suite.Require().Nil(txResponse)
+				suite.Require().False(found)
+
+				// the gas consumed up to the limit is charged to the parent gas meter
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), tc.maxTxGas)
+
+				ack := icatypes.NewErrorAcknowledgement(err)
+				suite.Require().False(ack.Success())
+
+				codespace, code, _, _, err := icatypes.ParseErrorAcknowledgement(ack.GetError())
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdkerrors.ErrOutOfGas.Codespace(), codespace)
+				suite.Require().Equal(sdkerrors.ErrOutOfGas.ABCICode(), code)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketNonAtomic() {
+	var (
+		path         *ibctesting.Path
+		msgs         []sdk.Msg
+		expSuccesses []bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(icaAddr string, validatorAddr sdk.ValAddress)
+		expPass  bool
+	}{
+		{
+			"all messages succeed",
+			func(icaAddr string, validatorAddr sdk.ValAddress) {
+				msgs = []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+				}
+				expSuccesses = []bool{true, true}
+			},
+			true,
+		},
+		{
+			"failed message does not revert successful messages",
+			func(icaAddr string, validatorAddr sdk.ValAddress) {
+				msgs = []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+				}
+				expSuccesses = []bool{true, false, true}
+			},
+			true,
+		},
+		{
+			"message failing basic validation is reported as failed",
+			func(icaAddr string, validatorAddr sdk.ValAddress) {
+				msgs = []sdk.Msg{
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+				}
+				expSuccesses = []bool{true, false}
+			},
+			true,
+		},
+		{
+			"unauthorized signer fails the entire transaction",
+			func(icaAddr string, validatorAddr sdk.ValAddress) {
+				msgs = []sdk.Msg{
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+					&banktypes.MsgSend{FromAddress: suite.chainB.SenderAccount.GetAddress().String(), ToAddress: icaAddr, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+
+			tc.malleate(interchainAccountAddr, validatorAddr)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX_NON_ATOMIC,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			balanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			balanceAfter := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				txMsgResult, err := icatypes.UnmarshalTxMsgResult(txResponse)
+				suite.Require().NoError(err)
+				suite.Require().Len(txMsgResult.Data, len(msgs))
+				suite.Require().Len(txMsgResult.Results, len(msgs))
+
+				expSpent := sdk.ZeroInt()
+				for i, result := range txMsgResult.Results {
+					suite.Require().Equal(uint64(i), result.Index)
+					suite.Require().Equal(sdk.MsgTypeURL(msgs[i]), result.MsgTypeUrl)
+					suite.Require().Equal(expSuccesses[i], result.Success)
+
+					if !result.Success {
+						suite.Require().NotZero(result.Code)
+						suite.Require().Empty(txMsgResult.Data[i].Data)
+						continue
+					}
+
+					suite.Require().Zero(result.Code)
+
+					switch msg := msgs[i].(type) {
+					case *stakingtypes.MsgDelegate:
+						expSpent = expSpent.Add(msg.Amount.Amount)
+					case *banktypes.MsgSend:
+						expSpent = expSpent.Add(msg.Amount.AmountOf(sdk.DefaultBondDenom))
+					}
+				}
+
+				// only the state changes of successful messages are committed
+				suite.Require().Equal(balanceBefore.Amount.Sub(expSpent), balanceAfter.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(txResponse)
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgResponses() {
+	var (
+		path         *ibctesting.Path
+		msgs         []sdk.Msg
+		packetType   icatypes.Type
+		expResponses []string // expected response type URLs, empty for failed messages
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(icaAddr string, validatorAddr sdk.ValAddress)
+	}{
+		{
+			"atomic transaction",
+			func(icaAddr string, validatorAddr sdk.ValAddress) {
+				msgs = []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+					&stakingtypes.MsgUndelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))},
+				}
+				packetType = icatypes.EXECUTE_TX
+				expResponses = []string{"/cosmos.staking.v1beta1.MsgDelegateResponse", "/cosmos.bank.v1beta1.MsgSendResponse", "/cosmos.staking.v1beta1.MsgUndelegateResponse"}
+			},
+		},
+		{
+			"non-atomic transaction with failed message",
+			func(icaAddr string, validatorAddr sdk.ValAddress) {
+				msgs = []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+				}
+				packetType = icatypes.EXECUTE_TX_NON_ATOMIC
+				expResponses = []string{"/cosmos.staking.v1beta1.MsgDelegateResponse", "", "/cosmos.bank.v1beta1.MsgSendResponse"}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+
+			tc.malleate(interchainAccountAddr, validatorAddr)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: packetType,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			msgResponses, err := icatypes.UnpackAcknowledgementResponses(channeltypes.NewResultAcknowledgement(txResponse))
+			suite.Require().NoError(err)
+			suite.Require().Len(msgResponses, len(msgs))
+
+			txMsgResult, err := icatypes.UnmarshalTxMsgResult(txResponse)
+			suite.Require().NoError(err)
+
+			for i, msgResponse := range msgResponses {
+				suite.Require().Equal(expResponses[i], msgResponse.TypeUrl)
+
+				// the packed responses match the legacy response data
+				suite.Require().Equal(txMsgResult.Data[i].Data, msgResponse.Value)
+
+				switch msgResponse.TypeUrl {
+				case "/cosmos.staking.v1beta1.MsgDelegateResponse":
+					var response stakingtypes.MsgDelegateResponse
+					suite.Require().NoError(response.Unmarshal(msgResponse.Value))
+				case "/cosmos.bank.v1beta1.MsgSendResponse":
+					var response banktypes.MsgSendResponse
+					suite.Require().NoError(response.Unmarshal(msgResponse.Value))
+				case "/cosmos.staking.v1beta1.MsgUndelegateResponse":
+					var response stakingtypes.MsgUndelegateResponse
+					suite.Require().NoError(response.Unmarshal(msgResponse.Value))
+
+					unbondingTime := suite.chainB.GetSimApp().StakingKeeper.UnbondingTime(suite.chainB.GetContext())
+					suite.Require().Equal(suite.chainB.GetContext().BlockTime().Add(unbondingTime).UTC(), response.CompletionTime.UTC())
+				default:
+					suite.Require().Empty(msgResponse.Value)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketProto3JSON() {
+	var packetEncoding string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"packet data encoding does not match channel encoding",
+			func() {
+				packetEncoding = icatypes.EncodingProtobuf
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetEncoding = icatypes.EncodingProto3JSON
+
+			metadata := icatypes.NewDefaultMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+			metadata.Encoding = icatypes.EncodingProto3JSON
+			version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = version
+			path.EndpointB.ChannelConfig.Version = version
+			suite.coordinator.SetupConnections(path)
+
+			portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, version)
+			suite.Require().NoError(err)
+
+			suite.chainA.NextBlock()
+			path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+			path.EndpointA.ChannelConfig.PortID = portID
+
+			suite.Require().NoError(path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+			encoding := suite.chainB.GetSimApp().ICAHostKeeper.GetChannelEncoding(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Equal(icatypes.EncodingProto3JSON, encoding)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate()
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, packetEncoding)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMaxMsgsPerPacket() {
+	testCases := []struct {
+		name             string
+		maxMsgsPerPacket uint64
+		packetType       icatypes.Type
+		expPass          bool
+	}{
+		{"success: no limit", 0, icatypes.EXECUTE_TX, true},
+		{"success: message count equal to limit", 3, icatypes.EXECUTE_TX, true},
+		{"success: non-atomic message count equal to limit", 3, icatypes.EXECUTE_TX_NON_ATOMIC, true},
+		{"failure: message count exceeds limit", 2, icatypes.EXECUTE_TX, false},
+		{"failure: non-atomic message count exceeds limit", 2, icatypes.EXECUTE_TX_NON_ATOMIC, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg, msg, msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: tc.packetType,
+				Data: data,
+			}
+
+			params := types.Params{
+				HostEnabled:      true,
+				AllowMessages:    []string{sdk.MsgTypeURL(msg)},
+				MaxMsgsPerPacket: tc.maxMsgsPerPacket,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrMaxMsgsPerPacket)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMaxMemoLength() {
+	testCases := []struct {
+		name string
+		// lengthDelta is added to the length of the memo to obtain the max memo length param
+		lengthDelta int
+		noLimit     bool
+		expPass     bool
+	}{
+		{"success: no limit", 0, true, true},
+		{"success: memo length equal to limit", 0, false, true},
+		{"success: memo length below limit", 1, false, true},
+		{"failure: memo length exceeds limit by one byte", -1, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: strings.Repeat("m", 1024),
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			var maxMemoLength uint64
+			if !tc.noLimit {
+				maxMemoLength = uint64(len(icaPacketData.Memo) + tc.lengthDelta)
+			}
+
+			params := types.Params{
+				HostEnabled:   true,
+				AllowMessages: []string{sdk.MsgTypeURL(msg)},
+				MaxMemoLength: maxMemoLength,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrMaxMemoLength)
+				suite.Require().Nil(txResponse)
+
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeExecuteMsg, event.Type)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketAddressBlocklist() {
+	var blockedAddr sdk.AccAddress
+
+	testCases := []struct {
+		name     string
+		buildMsg func(icaAddr string) sdk.Msg
+		expPass  bool
+	}{
+		{
+			"success: recipient not blocklisted",
+			func(icaAddr string) sdk.Msg {
+				return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+			},
+			true,
+		},
+		{
+			"success: MsgDelegate to validator whose operator account is blocklisted",
+			func(icaAddr string) sdk.Msg {
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				blockedAddr = sdk.AccAddress(validatorAddr)
+
+				return &stakingtypes.MsgDelegate{
+					DelegatorAddress: icaAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				}
+			},
+			true,
+		},
+		{
+			"failure: MsgSend to blocklisted address",
+			func(icaAddr string) sdk.Msg {
+				return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), blockedAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+			},
+			false,
+		},
+		{
+			"failure: MsgMultiSend with blocklisted output",
+			func(icaAddr string) sdk.Msg {
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+				return &banktypes.MsgMultiSend{
+					Inputs: []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(icaAddr), coins.Add(coins...))},
+					Outputs: []banktypes.Output{
+						banktypes.NewOutput(suite.chainB.SenderAccount.GetAddress(), coins),
+						banktypes.NewOutput(blockedAddr, coins),
+					},
+				}
+			},
+			false,
+		},
+		{
+			"failure: MsgSend to module account",
+			func(icaAddr string) sdk.Msg {
+				moduleAcc := suite.chainB.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainB.GetContext(), disttypes.ModuleName)
+				return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), moduleAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+			},
+			false,
+		},
+		{
+			"failure: MsgSend to blocklisted address nested in MsgExec",
+			func(icaAddr string) sdk.Msg {
+				msgSend := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), blockedAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+				return nestMsgExec(icaAddr, msgSend, 2)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			blockedAddr = sdk.AccAddress("blocked_address_____")
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := tc.buildMsg(interchainAccountAddr)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetBlockedAddress(suite.chainB.GetContext(), blockedAddr.String())
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			params := types.NewParams(true, []string{"*"})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrBlockedAddress)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionFee() {
+	var (
+		executionFee sdk.Coins
+		msgAmount    sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no execution fee by default",
+			func() {
+				executionFee = nil
+			},
+			nil,
+		},
+		{
+			"success: execution fee deducted",
+			func() {},
+			nil,
+		},
+		{
+			"failure: insufficient balance for execution fee",
+			func() {
+				executionFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: insufficient balance for msgs after execution fee",
+			func() {
+				msgAmount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(9950)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			executionFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			msgAmount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, balance)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate() // malleate mutates test data
+
+			msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccount.GetAddress(), msgAmount)
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			params := types.Params{
+				HostEnabled:   true,
+				AllowMessages: []string{"*"},
+				ExecutionFee:  executionFee,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			feeCollectorAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			icaBalance := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr))
+			collectedFees := suite.chainB.GetSimApp().ICAHostKeeper.GetCollectedExecutionFees(ctx, path.EndpointB.ConnectionID)
+
+			var feeEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeExecutionFee {
+					feeEvents++
+				}
+			}
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(balance.Sub(msgAmount).Sub(executionFee), icaBalance)
+				suite.Require().True(feeCollectorBalance.Add(executionFee...).IsEqual(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, feeCollectorAddr)))
+				suite.Require().True(executionFee.IsEqual(collectedFees))
+
+				expEvents := 0
+				if !executionFee.IsZero() {
+					expEvents = 1
+				}
+				suite.Require().Equal(expEvents, feeEvents)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+
+				// the execution fee is not deducted if the transaction fails
+				suite.Require().Equal(balance, icaBalance)
+				suite.Require().True(feeCollectorBalance.IsEqual(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, feeCollectorAddr)))
+				suite.Require().True(collectedFees.IsZero())
+				suite.Require().Zero(feeEvents)
+			}
+		})
+	}
+}
+
+// tests that the execution fee is deducted once per packet for non-atomic and batch packets, regardless of the number
+// of messages or transactions of the packet and of the success of the individual messages or transactions
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionFeeOncePerPacket() {
+	executionFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := []struct {
+		name       string
+		packetType icatypes.Type
+		amounts    []int64
+		feeBalance int64
+		expSpent   int64
+		expErr     error
+	}{
+		{"non-atomic: fee deducted once for multiple messages", icatypes.EXECUTE_TX_NON_ATOMIC, []int64{100, 200}, 10000, 300, nil},
+		{"non-atomic: fee deducted once if a message fails", icatypes.EXECUTE_TX_NON_ATOMIC, []int64{100, 100000}, 10000, 100, nil},
+		{"non-atomic: insufficient balance for execution fee", icatypes.EXECUTE_TX_NON_ATOMIC, []int64{100, 200}, 50, 0, sdkerrors.ErrInsufficientFunds},
+		{"batch: fee deducted once for multiple transactions", icatypes.EXECUTE_TX_BATCH, []int64{100, 200}, 10000, 300, nil},
+		{"batch: fee deducted once if a transaction fails", icatypes.EXECUTE_TX_BATCH, []int64{100, 100000}, 10000, 100, nil},
+		{"batch: insufficient balance for execution fee", icatypes.EXECUTE_TX_BATCH, []int64{100, 200}, 50, 0, sdkerrors.ErrInsufficientFunds},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(tc.feeBalance)))
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, balance)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr := sdk.MustAccAddressFromBech32(interchainAccountAddr)
+
+			var msgGroups [][]sdk.Msg
+			for _, amount := range tc.amounts {
+				msgGroups = append(msgGroups, []sdk.Msg{
+					banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))),
+				})
+			}
+
+			var data []byte
+			if tc.packetType == icatypes.EXECUTE_TX_BATCH {
+				data, err = icatypes.SerializeCosmosTxBatch(suite.chainA.GetSimApp().AppCodec(), msgGroups, icatypes.EncodingProtobuf)
+			} else {
+				data, err = icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), append(msgGroups[0], msgGroups[1]...), icatypes.EncodingProtobuf)
+			}
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: tc.packetType,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			params := types.Params{
+				HostEnabled:   true,
+				AllowMessages: []string{"*"},
+				ExecutionFee:  executionFee,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			var feeEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeExecutionFee {
+					feeEvents++
+				}
+			}
+
+			icaBalance := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, icaAddr)
+			collectedFees := suite.chainB.GetSimApp().ICAHostKeeper.GetCollectedExecutionFees(ctx, path.EndpointB.ConnectionID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(balance.Sub(executionFee).Sub(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(tc.expSpent)))), icaBalance)
+				suite.Require().True(executionFee.IsEqual(collectedFees))
+				suite.Require().Equal(1, feeEvents)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+				suite.Require().True(collectedFees.IsZero())
+				suite.Require().Zero(feeEvents)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionFeeBankKeeperNotSet() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		suite.chainA.SenderAccount.GetSequence(),
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	// construct a host keeper without a bank keeper
+	app := suite.chainB.GetSimApp()
+	hostKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.ScopedICAHostKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter(),
+	)
+
+	params := types.Params{
+		HostEnabled:   true,
+		AllowMessages: []string{"*"},
+		ExecutionFee:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+	hostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	txResponse, err := hostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+	suite.Require().ErrorIs(err, types.ErrExecutionFeeUnsupported)
+	suite.Require().Nil(txResponse)
+}
+
+// panickingMsgServer is a bank Msg server whose Send handler panics with the provided value
+type panickingMsgServer struct {
+	*banktypes.UnimplementedMsgServer
+
+	value interface{}
+}
+
+func (s panickingMsgServer) Send(_ context.Context, _ *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	panic(s.value)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgHandlerPanic() {
+	testCases := []struct {
+		name       string
+		panicValue interface{}
+		maxTxGas   uint64
+		expPanic   bool
+		expErr     error
+	}{
+		{
+			"panic is returned as an error",
+			"third-party module panic",
+			0,
+			false,
+			types.ErrMsgHandlerPanic,
+		},
+		{
+			"panic is returned as an error with max tx gas set",
+			"third-party module panic",
+			1000000,
+			false,
+			types.ErrMsgHandlerPanic,
+		},
+		{
+			"out of gas panic is raised again",
+			sdk.ErrorOutOfGas{Descriptor: "mock handler"},
+			0,
+			true,
+			nil,
+		},
+		{
+			"out of gas panic is raised again and recovered by the max tx gas limit",
+			sdk.ErrorOutOfGas{Descriptor: "mock handler"},
+			1000000,
+			false,
+			sdkerrors.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			// construct a host keeper routing bank messages to a panicking handler
+			app := suite.chainB.GetSimApp()
+			msgRouter := baseapp.NewMsgServiceRouter()
+			msgRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+			banktypes.RegisterMsgServer(msgRouter, panickingMsgServer{value: tc.panicValue})
+
+			hostKeeper := keeper.NewKeeper(
+				app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.SubModuleName),
+				app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+				app.AccountKeeper, app.ScopedICAHostKeeper, msgRouter, app.GRPCQueryRouter(),
+			)
+
+			params := types.Params{
+				HostEnabled:   true,
+				AllowMessages: []string{sdk.MsgTypeURL(msg)},
+				MaxTxGas:      tc.maxTxGas,
+			}
+			hostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			suite.logs.reset()
+
+			if tc.expPanic {
+				suite.Require().PanicsWithValue(tc.panicValue, func() {
+					_, _ = hostKeeper.OnRecvPacket(suite.logContext(), packet)
+				})
+				suite.Require().Empty(suite.logs.entriesWithMsg("recovered panic in message handler"))
+				return
+			}
+
+			txResponse, err := hostKeeper.OnRecvPacket(suite.logContext(), packet)
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().Nil(txResponse)
+
+			// the error acknowledgement only depends on the ABCI code and codespace of the error
+			ack := channeltypes.NewErrorAcknowledgement(err)
+			suite.Require().False(ack.Success())
+
+			entries := suite.logs.entriesWithMsg("recovered panic in message handler")
+			if tc.expErr != types.ErrMsgHandlerPanic {
+				suite.Require().Empty(entries)
+				return
+			}
+
+			suite.Require().NotContains(err.Error(), "third-party module panic")
+			suite.Require().Len(entries, 1)
+
+			msgType, ok := entries[0].value("msg_type")
+			suite.Require().True(ok)
+			suite.Require().Equal(sdk.MsgTypeURL(msg), msgType)
+
+			panicValue, ok := entries[0].value("panic")
+			suite.Require().True(ok)
+			suite.Require().Equal("third-party module panic", panicValue)
+
+			stack, ok := entries[0].value("stack")
+			suite.Require().True(ok)
+			suite.Require().Contains(stack, "executeMsg")
+		})
+	}
+}
+
+var _ sdk.AnteDecorator = undelegateLimitDecorator{}
+
+// undelegateLimitDecorator is an ante decorator rejecting transactions which undelegate more than the limit
+type undelegateLimitDecorator struct {
+	limit sdk.Int
+}
+
+func (d undelegateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if msg, ok := msg.(*stakingtypes.MsgUndelegate); ok && msg.Amount.Amount.GT(d.limit) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "undelegation of %s exceeds limit %s", msg.Amount.Amount, d.limit)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgValidator() {
+	testCases := []struct {
+		name         string
+		msgs         func(icaAddr string, validatorAddr sdk.ValAddress) []sdk.Msg
+		packetType   icatypes.Type
+		msgValidator types.MsgValidator
+		expErr       error
+		expSuccesses []bool
+	}{
+		{
+			"success: no msg validator set",
+			func(icaAddr string, validatorAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&stakingtypes.MsgUndelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000))},
+				}
+			},
+			icatypes.EXECUTE_TX,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"success: undelegation does not exceed limit",
+			func(icaAddr string, validatorAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&stakingtypes.MsgUndelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))},
+				}
+			},
+			icatypes.EXECUTE_TX,
+			types.NewAnteMsgValidator(undelegateLimitDecorator{limit: sdk.NewInt(1000)}),
+			nil,
+			nil,
+		},
+		{
+			"failure: undelegation exceeds limit",
+			func(icaAddr string, validatorAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&stakingtypes.MsgUndelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000))},
+				}
+			},
+			icatypes.EXECUTE_TX,
+			types.NewAnteMsgValidator(undelegateLimitDecorator{limit: sdk.NewInt(1000)}),
+			sdkerrors.ErrUnauthorized,
+			nil,
+		},
+		{
+			"failure: undelegation nested in authz.MsgExec exceeds limit",
+			func(icaAddr string, validatorAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					nestMsgExec(icaAddr, &stakingtypes.MsgUndelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000))}, 1),
+				}
+			},
+			icatypes.EXECUTE_TX,
+			types.NewAnteMsgValidator(undelegateLimitDecorator{limit: sdk.NewInt(1000)}),
+			sdkerrors.ErrUnauthorized,
+			nil,
+		},
+		{
+			"success: non-atomic undelegation exceeding limit is reported as failed",
+			func(icaAddr string, validatorAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&stakingtypes.MsgDelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))},
+					&stakingtypes.MsgUndelegate{DelegatorAddress: icaAddr, ValidatorAddress: validatorAddr.String(), Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000))},
+				}
+			},
+			icatypes.EXECUTE_TX_NON_ATOMIC,
+			types.NewAnteMsgValidator(undelegateLimitDecorator{limit: sdk.NewInt(1000)}),
+			nil,
+			[]bool{true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+			msgs := tc.msgs(interchainAccountAddr, validatorAddr)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: tc.packetType,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			// construct a host keeper using the msg validator of the test case
+			app := suite.chainB.GetSimApp()
+			hostKeeper := keeper.NewKeeper(
+				app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.SubModuleName),
+				app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+				app.AccountKeeper, app.ScopedICAHostKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter(),
+			)
+
+			if tc.msgValidator != nil {
+				hostKeeper.SetMsgValidator(tc.msgValidator)
+			}
+
+			params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+			hostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := hostKeeper.OnRecvPacket(ctx, packet)
+
+			_, delegated := app.StakingKeeper.GetDelegation(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), validatorAddr)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+
+				// the state changes of every msg are reverted
+				suite.Require().False(delegated)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(delegated)
+
+			if tc.expSuccesses != nil {
+				txMsgResult, err := icatypes.UnmarshalTxMsgResult(txResponse)
+				suite.Require().NoError(err)
+				suite.Require().Len(txMsgResult.Results, len(tc.expSuccesses))
+
+				for i, result := range txMsgResult.Results {
+					suite.Require().Equal(tc.expSuccesses[i], result.Success)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketPausedMessageTypes() {
+	var (
+		msg    sdk.Msg
+		params types.Params
+	)
+
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name     string
+		malleate func(icaAddr string)
+		expErr   error
+	}{
+		{
+			"success: no paused message types",
+			func(icaAddr string) {},
+			nil,
+		},
+		{
+			"success: other message type paused",
+			func(icaAddr string) {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), 0))
+			},
+			nil,
+		},
+		{
+			"success: paused message type expired",
+			func(icaAddr string) {
+				expiryHeight := uint64(suite.chainB.GetContext().BlockHeight() - 1)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, expiryHeight))
+			},
+			nil,
+		},
+		{
+			"success: message type unpaused by the circuit breaker authority",
+			func(icaAddr string) {
+				authority := suite.chainB.SenderAccount.GetAddress().String()
+				params.CircuitBreakerAuthority = authority
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				msgServer := keeper.NewMsgServerImpl(suite.chainB.GetSimApp().ICAHostKeeper)
+
+				_, err := msgServer.PauseMessageType(sdk.WrapSDKContext(suite.chainB.GetContext()), types.NewMsgPauseMessageType(authority, sendTypeURL, 0))
+				suite.Require().NoError(err)
+
+				_, err = msgServer.UnpauseMessageType(sdk.WrapSDKContext(suite.chainB.GetContext()), types.NewMsgUnpauseMessageType(authority, sendTypeURL))
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: message type paused without expiry",
+			func(icaAddr string) {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, 0))
+			},
+			types.ErrMessageTypePaused,
+		},
+		{
+			"failure: message type paused until the current block height",
+			func(icaAddr string) {
+				expiryHeight := uint64(suite.chainB.GetContext().BlockHeight())
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, expiryHeight))
+			},
+			types.ErrMessageTypePaused,
+		},
+		{
+			"failure: paused message type nested in MsgExec",
+			func(icaAddr string) {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, 0))
+
+				msg = nestMsgExec(icaAddr, msg, 2)
+			},
+			types.ErrMessageTypePaused,
+		},
+		{
+			"failure: paused message types are checked before the allow messages",
+			func(icaAddr string) {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetPausedMessageType(suite.chainB.GetContext(), types.NewPausedMessageType(sendTypeURL, 0))
+
+				params.AllowMessages = []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}
+			},
+			types.ErrMessageTypePaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg = banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+			params = types.NewParams(true, []string{"*"})
+
+			tc.malleate(interchainAccountAddr)
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketBatch() {
+	var (
+		path      *ibctesting.Path
+		msgGroups [][]sdk.Msg
+		params    types.Params
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func(icaAddr string)
+		expSuccess []bool
+		expErr     error
+	}{
+		{
+			"success",
+			func(icaAddr string) {},
+			[]bool{true, true},
+			nil,
+		},
+		{
+			"success: failed transaction does not revert the other transactions",
+			func(icaAddr string) {
+				msgGroups = append(msgGroups, []sdk.Msg{
+					banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))),
+				})
+			},
+			[]bool{true, true, false},
+			nil,
+		},
+		{
+			"success: unauthorized transaction does not revert the other transactions",
+			func(icaAddr string) {
+				msgGroups[0] = append(msgGroups[0], &stakingtypes.MsgDelegate{
+					DelegatorAddress: icaAddr,
+					ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				})
+			},
+			[]bool{false, true},
+			nil,
+		},
+		{
+			"empty batch",
+			func(icaAddr string) {
+				msgGroups = nil
+			},
+			nil,
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"messages of the batch exceed max msgs per packet",
+			func(icaAddr string) {
+				params.MaxMsgsPerPacket = 1
+			},
+			nil,
+			types.ErrMaxMsgsPerPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr := sdk.MustAccAddressFromBech32(interchainAccountAddr)
+			msgGroups = [][]sdk.Msg{
+				{banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))},
+				{banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))))},
+			}
+
+			params = types.Params{
+				HostEnabled:         true,
+				AllowMessages:       []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				MaxExecutionResults: 10,
+			}
+
+			tc.malleate(interchainAccountAddr)
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTxBatch(suite.chainA.GetSimApp().AppCodec(), msgGroups, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX_BATCH,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			balanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				batchTxResult, err := icatypes.UnmarshalBatchTxResult(txResponse)
+				suite.Require().NoError(err)
+				suite.Require().Len(batchTxResult.Results, len(tc.expSuccess))
+
+				expSpent, allSucceeded := sdk.ZeroInt(), true
+				for i, txResult := range batchTxResult.Results {
+					suite.Require().Equal(uint64(i), txResult.Index)
+					suite.Require().Equal(tc.expSuccess[i], txResult.Success)
+
+					if txResult.Success {
+						suite.Require().Len(txResult.Result.Results, len(msgGroups[i]))
+						suite.Require().Zero(txResult.Code)
+
+						for _, msg := range msgGroups[i] {
+							expSpent = expSpent.Add(msg.(*banktypes.MsgSend).Amount.AmountOf(sdk.DefaultBondDenom))
+						}
+					} else {
+						suite.Require().NotZero(txResult.Code)
+						suite.Require().Nil(txResult.Result)
+						allSucceeded = false
+					}
+				}
+
+				// only the state changes of successful transactions are committed
+				balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(balanceBefore.Amount.Sub(expSpent), balance.Amount)
+
+				result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(allSucceeded, result.Success)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMaxPacketDataSize() {
+	testCases := []struct {
+		name string
+		// sizeDelta is added to the size of the packet data to obtain the max packet data size param
+		sizeDelta int
+		noLimit   bool
+		expPass   bool
+	}{
+		{"success: no limit", 0, true, true},
+		{"success: packet data size equal to limit", 0, false, true},
+		{"success: packet data size below limit", 1, false, true},
+		{"failure: packet data size exceeds limit by one byte", -1, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			var maxPacketDataSize uint64
+			if !tc.noLimit {
+				maxPacketDataSize = uint64(len(packet.GetData()) + tc.sizeDelta)
+			}
+
+			params := types.Params{
+				HostEnabled:       true,
+				AllowMessages:     []string{sdk.MsgTypeURL(msg)},
+				MaxPacketDataSize: maxPacketDataSize,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, icatypes.ErrMaxPacketDataSize)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionResults() {
+	testCases := []struct {
+		name         string
+		maxResults   uint64
+		packetType   icatypes.Type
+		amount       int64
+		expSequences []uint64
+		expCode      uint32
+	}{
+		{"storage disabled", 0, icatypes.EXECUTE_TX, 100, nil, 0},
+		{"success: results are pruned by sequence", 2, icatypes.EXECUTE_TX, 100, []uint64{2, 3}, 0},
+		{"success: all results retained", 5, icatypes.EXECUTE_TX, 100, []uint64{1, 2, 3}, 0},
+		{"failed execution acknowledged with an error is not stored", 2, icatypes.EXECUTE_TX, 1000000, nil, 0},
+		{"non-atomic execution with failed message", 2, icatypes.EXECUTE_TX_NON_ATOMIC, 1000000, []uint64{2, 3}, sdkerrors.ErrInsufficientFunds.ABCICode()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(tc.amount))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: tc.packetType,
+				Data: data,
+			}
+
+			params := types.Params{
+				HostEnabled:         true,
+				AllowMessages:       []string{sdk.MsgTypeURL(msg)},
+				MaxExecutionResults: tc.maxResults,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				packet := channeltypes.NewPacket(
+					icaPacketData.GetBytes(),
+					sequence,
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				_, _ = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			}
+
+			var sequences []uint64
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+				if !found {
+					continue
+				}
+
+				sequences = append(sequences, result.Sequence)
+				suite.Require().Equal([]string{sdk.MsgTypeURL(msg)}, result.MsgTypeUrls)
+				suite.Require().Equal(tc.expCode == 0, result.Success)
+				suite.Require().Equal(tc.expCode, result.Code)
+			}
+
+			suite.Require().Equal(tc.expSequences, sequences)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketGasByConnection() {
+	suite.SetupTest() // reset
+
+	// chainA and chainC both control interchain accounts on chainB, over distinct host connections
+	var (
+		paths   []*ibctesting.Path
+		packets []channeltypes.Packet
+	)
+	for i, controller := range []*ibctesting.TestChain{suite.chainA, suite.chainC} {
+		path := ibctesting.NewPath(controller, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+
+		err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+		suite.Require().NoError(err)
+
+		interchainAccountAddr := controller.GetICAAddress(path, TestOwnerAddress)
+		suite.Require().NotEmpty(interchainAccountAddr)
+
+		amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+		err = suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(interchainAccountAddr), amount)
+		suite.Require().NoError(err)
+
+		// the second controller executes more messages, such that it consumes more gas
+		var msgs []sdk.Msg
+		for j := 0; j <= i; j++ {
+			msgs = append(msgs, banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+		}
+
+		data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+		suite.Require().NoError(err)
+
+		icaPacketData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+
+		paths = append(paths, path)
+		packets = append(packets, channeltypes.NewPacket(
+			icaPacketData.GetBytes(),
+			1,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.NewHeight(0, 100),
+			0,
+		))
+	}
+
+	suite.Require().NotEqual(paths[0].EndpointB.ConnectionID, paths[1].EndpointB.ConnectionID)
+
+	params := types.Params{
+		HostEnabled:         true,
+		AllowMessages:       []string{"*"},
+		MaxExecutionResults: 5,
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	ctx := suite.chainB.GetContext()
+	for _, packet := range packets {
+		_, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+		suite.Require().NoError(err)
+	}
+
+	// the gas used attribute of each summary event is attributed to the connection of the executed packet
+	gasByChannel := make(map[string]uint64)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeExecuteTx {
+			continue
+		}
+
+		var channelID string
+		var gasUsed uint64
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case icatypes.AttributeKeyHostChannelID:
+				channelID = string(attr.Value)
+			case types.AttributeKeyGasUsed:
+				gas, err := strconv.ParseUint(string(attr.Value), 10, 64)
+				suite.Require().NoError(err)
+				gasUsed = gas
+			}
+		}
+
+		gasByChannel[channelID] += gasUsed
+	}
+
+	var expGasByConnection []types.ConnectionGasUsed
+	for _, path := range paths {
+		gasUsed := suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionGasUsed(ctx, path.EndpointB.ConnectionID)
+		suite.Require().NotZero(gasUsed)
+		suite.Require().Equal(gasByChannel[path.EndpointB.ChannelID], gasUsed)
+
+		result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+		suite.Require().True(found)
+		suite.Require().Equal(gasUsed, result.GasUsed)
+
+		expGasByConnection = append(expGasByConnection, types.ConnectionGasUsed{ConnectionId: path.EndpointB.ConnectionID, GasUsed: gasUsed})
+	}
+
+	suite.Require().Greater(expGasByConnection[1].GasUsed, expGasByConnection[0].GasUsed)
+	suite.Require().Equal(expGasByConnection, suite.chainB.GetSimApp().ICAHostKeeper.GetAllConnectionGasUsed(ctx))
+
+	// executing another packet over the first connection does not affect the gas used over the second connection
+	packet := packets[0]
+	packet.Sequence = 2
+	_, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Greater(suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionGasUsed(ctx, paths[0].EndpointB.ConnectionID), expGasByConnection[0].GasUsed)
+	suite.Require().Equal(expGasByConnection[1].GasUsed, suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionGasUsed(ctx, paths[1].EndpointB.ConnectionID))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketAliasedAccounts() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	version := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+
+	defaultPortID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// a single owner registers two interchain accounts over the same connection using distinct aliases
+	var packets []channeltypes.Packet
+	addresses := make(map[string]bool)
+	for _, alias := range []string{"savings", "trading"} {
+		portID, err := icatypes.NewControllerPortID(TestOwnerAddress, alias)
+		suite.Require().NoError(err)
+		suite.Require().Equal(defaultPortID+"-"+alias, portID)
+
+		channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+		err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithAlias(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, alias, version)
+		suite.Require().NoError(err)
+
+		suite.chainA.NextBlock()
+		path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+		path.EndpointA.ChannelConfig.PortID = portID
+		path.EndpointA.ChannelConfig.Version = version
+		path.EndpointB.ChannelConfig.Version = version
+		path.EndpointB.ChannelID = ""
+
+		suite.Require().NoError(path.EndpointB.ChanOpenTry())
+		suite.Require().NoError(path.EndpointA.ChanOpenAck())
+		suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+		interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+		suite.Require().True(found)
+		suite.Require().Equal(icatypes.BuildInterchainAccountAddress(path.EndpointB.ConnectionID, portID).String(), interchainAccountAddr)
+
+		controllerAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+		suite.Require().True(found)
+		suite.Require().Equal(interchainAccountAddr, controllerAddr)
+
+		suite.Require().False(addresses[interchainAccountAddr])
+		addresses[interchainAccountAddr] = true
+
+		amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+		err = suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(interchainAccountAddr), amount)
+		suite.Require().NoError(err)
+
+		msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+		data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+		suite.Require().NoError(err)
+
+		icaPacketData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+
+		packets = append(packets, channeltypes.NewPacket(
+			icaPacketData.GetBytes(),
+			1,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.NewHeight(0, 100),
+			0,
+		))
+	}
+
+	// no interchain account is registered for the port identifier derived from the owner alone
+	_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, defaultPortID)
+	suite.Require().False(found)
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// each packet is executed by the interchain account of its own aliased controller port
+	for addr := range addresses {
+		balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(addr), sdk.DefaultBondDenom)
+		suite.Require().Equal(int64(10000), balance.Amount.Int64())
+	}
+
+	for _, packet := range packets {
+		txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(txResponse)
+	}
+
+	for addr := range addresses {
+		balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(addr), sdk.DefaultBondDenom)
+		suite.Require().Equal(int64(9900), balance.Amount.Int64())
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMultiICASigners() {
+	var (
+		path                  *ibctesting.Path
+		interchainAccountAddr string
+		secondSigner          sdk.AccAddress
+		msgs                  []sdk.Msg
+		allowMultiSigners     bool
+	)
+
+	// registerInterchainAccount registers an interchain account for a second controller port on the provided connection
+	registerInterchainAccount := func(connectionID string) sdk.AccAddress {
+		portID, err := icatypes.NewControllerPortID(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String())
+		suite.Require().NoError(err)
+
+		moduleAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(icatypes.ModuleName)
+		addr := icatypes.GenerateAddress(moduleAddr, connectionID, portID)
+		suite.chainB.GetSimApp().ICAHostKeeper.RegisterInterchainAccount(suite.chainB.GetContext(), connectionID, portID, addr)
+
+		return addr
+	}
+
+	multiSend := func(signers ...string) sdk.Msg {
+		amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+		var inputs []banktypes.Input
+		for _, signer := range signers {
+			inputs = append(inputs, banktypes.NewInput(sdk.MustAccAddressFromBech32(signer), amount))
+		}
+
+		total := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(100*len(signers)))))
+		return &banktypes.MsgMultiSend{
+			Inputs:  inputs,
+			Outputs: []banktypes.Output{banktypes.NewOutput(suite.chainB.SenderAccount.GetAddress(), total)},
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: msg signed by two interchain accounts on the same connection",
+			func() {
+				secondSigner = registerInterchainAccount(path.EndpointB.ConnectionID)
+				msgs = []sdk.Msg{multiSend(interchainAccountAddr, secondSigner.String())}
+			},
+			true,
+		},
+		{
+			"success: msg signed only by the executing interchain account",
+			func() {
+				msgs = []sdk.Msg{multiSend(interchainAccountAddr)}
+			},
+			true,
+		},
+		{
+			"multi ICA signers not allowed",
+			func() {
+				allowMultiSigners = false
+
+				secondSigner = registerInterchainAccount(path.EndpointB.ConnectionID)
+				msgs = []sdk.Msg{multiSend(interchainAccountAddr, secondSigner.String())}
+			},
+			false,
+		},
+		{
+			"msg signed by an interchain account and a foreign address",
+			func() {
+				secondSigner = suite.chainB.SenderAccount.GetAddress()
+				msgs = []sdk.Msg{multiSend(interchainAccountAddr, secondSigner.String())}
+			},
+			false,
+		},
+		{
+			"msg signed by an interchain account registered on a different connection",
+			func() {
+				secondSigner = registerInterchainAccount("connection-100")
+				msgs = []sdk.Msg{multiSend(interchainAccountAddr, secondSigner.String())}
+			},
+			false,
+		},
+		{
+			"msg not signed by the executing interchain account",
+			func() {
+				secondSigner = registerInterchainAccount(path.EndpointB.ConnectionID)
+				msgs = []sdk.Msg{multiSend(secondSigner.String())}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			allowMultiSigners = true
+			secondSigner = nil
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			var found bool
+			interchainAccountAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			if secondSigner != nil {
+				// fund the second signer
+				_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), secondSigner, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))))
+				suite.Require().NoError(err)
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.Params{
+				HostEnabled:          true,
+				AllowMessages:        []string{types.AllowAllHostMsgs},
+				AllowMultiIcaSigners: allowMultiSigners,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+var _ types.ICAHostHooks = &testHooks{}
+
+// testHooks records the arguments of each hook call and returns the configured errors
+type testHooks struct {
+	beforeErr error
+	afterErr  error
+	beforeGas uint64
+	afterGas  uint64
+
+	beforeCalled bool
+	afterCalled  bool
+	connectionID string
+	portID       string
+	msgs         []sdk.Msg
+	memo         string
+	afterMemo    string
+	txResponse   []byte
+	execErr      error
+}
+
+func (h *testHooks) BeforeExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string) error {
+	ctx.GasMeter().ConsumeGas(h.beforeGas, "before execute tx hook")
+
+	h.beforeCalled = true
+	h.connectionID = connectionID
+	h.portID = portID
+	h.msgs = msgs
+	h.memo = memo
+
+	return h.beforeErr
+}
+
+func (h *testHooks) AfterExecuteTx(ctx sdk.Context, _, _ string, _ []sdk.Msg, memo string, txResponse []byte, err error) error {
+	ctx.GasMeter().ConsumeGas(h.afterGas, "after execute tx hook")
+
+	h.afterCalled = true
+	h.afterMemo = memo
+	h.txResponse = txResponse
+	h.execErr = err
+
+	return h.afterErr
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketSignerResolver() {
+	var (
+		granter      sdk.AccAddress
+		setResolver  bool
+		msgSendGrant authz.Authorization
+		expiration   time.Time
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: authz resolver accepts signer granting the interchain account a generic authorization",
+			func() {},
+			true,
+		},
+		{
+			"failure: signer other than the interchain account is rejected by default",
+			func() {
+				setResolver = false
+			},
+			false,
+		},
+		{
+			"failure: signer did not grant the interchain account an authorization",
+			func() {
+				msgSendGrant = nil
+			},
+			false,
+		},
+		{
+			"failure: signer granted an authorization for a different msg type",
+			func() {
+				msgSendGrant = authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+			},
+			false,
+		},
+		{
+			"failure: signer granted an authorization with a spend limit",
+			func() {
+				msgSendGrant = banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))))
+			},
+			false,
+		},
+		{
+			"failure: signer granted an expired authorization",
+			func() {
+				expiration = suite.chainB.GetContext().BlockTime().Add(-time.Hour)
+			},
+			false,
+		},
+		{
+			"failure: signer granted an authorization to a different grantee",
+			func() {
+				err := suite.chainB.GetSimApp().AuthzKeeper.SaveGrant(suite.chainB.GetContext(), suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(), granter, msgSendGrant, expiration)
+				suite.Require().NoError(err)
+
+				msgSendGrant = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			granter = suite.chainB.SenderAccount.GetAddress()
+			setResolver = true
+			msgSendGrant = authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+			expiration = suite.chainB.GetContext().BlockTime().Add(time.Hour)
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			if setResolver {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetSignerResolver(types.NewAuthzSignerResolver(suite.chainB.GetSimApp().AuthzKeeper))
+			}
+
+			if msgSendGrant != nil {
+				err := suite.chainB.GetSimApp().AuthzKeeper.SaveGrant(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(interchainAccountAddr), granter, msgSendGrant, expiration)
+				suite.Require().NoError(err)
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			recipient := suite.chainB.SenderAccounts[2].SenderAccount.GetAddress()
+			amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: granter.String(),
+					ToAddress:   recipient.String(),
+					Amount:      amount,
+				},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			newBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(balance.Add(amount[0]), newBalance)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				suite.Require().Nil(txResponse)
+				suite.Require().Equal(balance, newBalance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketHooks() {
+	var (
+		hooks  *testHooks
+		amount int64
+	)
+
+	testCases := []struct {
+		msg         string
+		packetType  icatypes.Type
+		malleate    func()
+		expPass     bool
+		expAfter    bool
+		expExecFail bool
+	}{
+		{
+			"success",
+			icatypes.EXECUTE_TX,
+			func() {},
+			true,
+			true,
+			false,
+		},
+		{
+			"success: non-atomic",
+			icatypes.EXECUTE_TX_NON_ATOMIC,
+			func() {},
+			true,
+			true,
+			false,
+		},
+		{
+			"failure: BeforeExecuteTx returns an error",
+			icatypes.EXECUTE_TX,
+			func() {
+				hooks.beforeErr = sdkerrors.ErrUnauthorized
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"failure: non-atomic BeforeExecuteTx returns an error",
+			icatypes.EXECUTE_TX_NON_ATOMIC,
+			func() {
+				hooks.beforeErr = sdkerrors.ErrUnauthorized
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"failure: AfterExecuteTx returns an error",
+			icatypes.EXECUTE_TX,
+			func() {
+				hooks.afterErr = sdkerrors.ErrUnauthorized
+			},
+			false,
+			true,
+			false,
+		},
+		{
+			"failure: non-atomic AfterExecuteTx returns an error",
+			icatypes.EXECUTE_TX_NON_ATOMIC,
+			func() {
+				hooks.afterErr = sdkerrors.ErrUnauthorized
+			},
+			false,
+			true,
+			false,
+		},
+		{
+			"failure: AfterExecuteTx is provided the execution error",
+			icatypes.EXECUTE_TX,
+			func() {
+				amount = 1000000
+			},
+			false,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			hooks = &testHooks{}
+			amount = 100
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetHooks(hooks)
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))),
+				},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: tc.packetType,
+				Data: data,
+				Memo: "memo",
+			}
+
+			params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			suite.Require().True(hooks.beforeCalled)
+			suite.Require().Equal(path.EndpointB.ConnectionID, hooks.connectionID)
+			suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, hooks.portID)
+			suite.Require().Len(hooks.msgs, len(msgs))
+			suite.Require().Equal(icaPacketData.Memo, hooks.memo)
+			suite.Require().Equal(tc.expAfter, hooks.afterCalled)
+
+			if tc.expExecFail {
+				suite.Require().Error(hooks.execErr)
+				suite.Require().Nil(hooks.txResponse)
+			} else if tc.expAfter {
+				suite.Require().Equal(icaPacketData.Memo, hooks.afterMemo)
+				suite.Require().NoError(hooks.execErr)
+				suite.Require().NotNil(hooks.txResponse)
+			}
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(hooks.txResponse, txResponse)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketNonAtomicOutOfGas tests that running out of gas outside of the execution of the messages of a
+// non-atomic transaction is returned as an error and reverts the execution fee and all executed messages.
+func (suite *KeeperTestSuite) TestOnRecvPacketNonAtomicOutOfGas() {
+	var (
+		hooks        *testHooks
+		executionFee sdk.Coins
+		maxTxGas     uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"out of gas deducting the execution fee",
+			func() {
+				executionFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+				maxTxGas = 1_000
+			},
+		},
+		{
+			"out of gas in BeforeExecuteTx",
+			func() {
+				hooks.beforeGas = maxTxGas + 1
+			},
+		},
+		{
+			"out of gas in AfterExecuteTx",
+			func() {
+				executionFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+				hooks.afterGas = maxTxGas
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			hooks = &testHooks{}
+			executionFee = nil
+			maxTxGas = 200_000
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetHooks(hooks)
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX_NON_ATOMIC,
+				Data: data,
+			}
+
+			params := types.Params{
+				HostEnabled:   true,
+				AllowMessages: []string{types.AllowAllHostMsgs},
+				MaxTxGas:      maxTxGas,
+				ExecutionFee:  executionFee,
+			}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+			suite.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+			suite.Require().Nil(txResponse)
+
+			// the gas consumed up to the limit is charged to the parent gas meter
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), maxTxGas)
+
+			// neither the execution fee nor the executed message is written
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+			suite.Require().Equal(sdk.NewInt(10000), balance.Amount)
+			suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.GetCollectedExecutionFees(ctx, path.EndpointB.ConnectionID).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketTelemetry() {
+	var (
+		msgs        []sdk.Msg
+		packetType  icatypes.Type
+		expCounters map[string]int
+	)
+
+	const (
+		packetReceivedKey  = "ibc.interchainaccounts.icahost.packet.received"
+		packetMsgsKey      = "ibc.interchainaccounts.icahost.packet.msgs"
+		msgSendExecutedKey = "ibc.interchainaccounts.icahost.msg.executed;msg_type_url=/cosmos.bank.v1beta1.MsgSend"
+		insufficientFunds  = "ibc.interchainaccounts.icahost.execution.failed;codespace=sdk;code=5"
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(icaAddr string)
+	}{
+		{
+			"atomic execution succeeds",
+			func(icaAddr string) {
+				msgs = []sdk.Msg{
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+				}
+				expCounters = map[string]int{
+					packetReceivedKey:  1,
+					msgSendExecutedKey: 2,
+				}
+			},
+		},
+		{
+			"atomic execution fails",
+			func(icaAddr string) {
+				msgs = []sdk.Msg{
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))},
+				}
+				expCounters = map[string]int{
+					packetReceivedKey: 1,
+					insufficientFunds: 1,
+				}
+			},
+		},
+		{
+			"non-atomic execution partially succeeds",
+			func(icaAddr string) {
+				packetType = icatypes.EXECUTE_TX_NON_ATOMIC
+				msgs = []sdk.Msg{
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))},
+					&banktypes.MsgSend{FromAddress: icaAddr, ToAddress: suite.chainB.SenderAccount.GetAddress().String(), Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))},
+				}
+				expCounters = map[string]int{
+					packetReceivedKey:  1,
+					msgSendExecutedKey: 1,
+					insufficientFunds:  1,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			packetType = icatypes.EXECUTE_TX
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate(interchainAccountAddr)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: packetType,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			// metrics are collected using an in-memory sink, the global blackhole sink is restored afterwards
+			sink := metrics.NewInmemSink(time.Hour, time.Hour)
+			cfg := metrics.DefaultConfig("")
+			cfg.EnableHostname = false
+			cfg.EnableRuntimeMetrics = false
+
+			_, err = metrics.NewGlobal(cfg, sink)
+			suite.Require().NoError(err)
+
+			defer func() {
+				_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+			}()
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.logContext(), packet)
+
+			// atomic execution failures are logged at info level as they are returned in the acknowledgement
+			if err != nil {
+				entries := suite.requirePacketLogs("transaction failed", packet, 1)
+				suite.Require().Equal(logger.LevelInfo.String(), entries[0].level)
+			} else if packetType == icatypes.EXECUTE_TX {
+				suite.requirePacketLogs("transaction executed", packet, 1)
+			}
+
+			intervals := sink.Data()
+			suite.Require().Len(intervals, 1)
+
+			counters := make(map[string]int)
+			for key, counter := range intervals[0].Counters {
+				counters[key] = counter.Count
+			}
+			suite.Require().Equal(expCounters, counters)
+
+			sample, ok := intervals[0].Samples[packetMsgsKey]
+			suite.Require().True(ok)
+			suite.Require().Equal(float64(len(msgs)), sample.Sum)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketUpdateChannelVersion() {
+	var (
+		path       *ibctesting.Path
+		hostKeeper keeper.Keeper
+		version    string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"interchain accounts metadata changed",
+			func() {
+				metadata, err := icatypes.MetadataFromVersion(path.EndpointB.GetChannel().Version)
+				suite.Require().NoError(err)
+
+				metadata.Encoding = icatypes.EncodingProto3JSON
+				appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: appVersion}))
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"fee version already added",
+			func() {
+				channel := path.EndpointB.GetChannel()
+				channel.Version = version
+				path.EndpointB.SetChannel(channel)
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"fee keeper not set",
+			func() {
+				app := suite.chainB.GetSimApp()
+				hostKeeper = keeper.NewKeeper(
+					app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.SubModuleName),
+					app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+					app.AccountKeeper, app.ScopedICAHostKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter(),
+				)
+			},
+			icatypes.ErrUnsupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			hostKeeper = suite.chainB.GetSimApp().ICAHostKeeper
+			hostVersion := path.EndpointB.GetChannel().Version
+			version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: hostVersion}))
+
+			tc.malleate() // malleate mutates test data
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.UPDATE_CHANNEL_VERSION,
+				Data: []byte(version),
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			expVersion := path.EndpointB.GetChannel().Version
+
+			txResponse, err := hostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			feeEnabled := suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]byte(version), txResponse)
+				suite.Require().Equal(version, path.EndpointB.GetChannel().Version)
+				suite.Require().True(feeEnabled)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+				suite.Require().Equal(expVersion, path.EndpointB.GetChannel().Version)
+				suite.Require().False(feeEnabled)
+			}
+		})
+	}
+}
+
+// nestMsgExec wraps the provided msg in the given number of authz MsgExec messages with the grantee as executor
+func nestMsgExec(grantee string, msg sdk.Msg, depth int) sdk.Msg {
+	for i := 0; i < depth; i++ {
+		msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(grantee), []sdk.Msg{msg})
+		msg = &msgExec
+	}
+
+	return msg
+}
+
+func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
+	suite.Require().True(found)
+
+	msgBankSend := &banktypes.MsgSend{
+		FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+		ToAddress:   interchainAccountAddr,
+		Amount:      amount,
+	}
+
+	res, err := suite.chainB.SendMsgs(msgBankSend)
+	suite.Require().NotEmpty(res)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+	var (
+		requests []icatypes.QueryRequest
+		params   types.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(interchainAccountAddr string)
+		expPass  bool
+		expErr   error
+	}{
+		{
+			"success",
+			func(interchainAccountAddr string) {},
+			true,
+			nil,
+		},
+		{
+			"success: response size equal to limit",
+			func(interchainAccountAddr string) {
+				res, err := suite.chainB.GetSimApp().BankKeeper.Balance(sdk.WrapSDKContext(suite.chainB.GetContext()), banktypes.NewQueryBalanceRequest(sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom))
+				suite.Require().NoError(err)
+
+				params.MaxQueryResponseSize = uint64(res.Size())
+			},
+			true,
+			nil,
+		},
+		{
+			"query path not allowed",
+			func(interchainAccountAddr string) {
+				params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/AllBalances"}
+			},
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"no query paths allowed",
+			func(interchainAccountAddr string) {
+				params.AllowQueries = nil
+			},
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"message type allowlist does not allow queries",
+			func(interchainAccountAddr string) {
+				params.AllowMessages = []string{types.AllowAllHostMsgs}
+				params.AllowQueries = nil
+			},
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"allowed query path is not routed",
+			func(interchainAccountAddr string) {
+				params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/Unknown"}
+				requests[0].Path = "/cosmos.bank.v1beta1.Query/Unknown"
+			},
+			false,
+			icatypes.ErrInvalidRoute,
+		},
+		{
+			"invalid query request",
+			func(interchainAccountAddr string) {
+				requests[0].Data = []byte("invalid query request")
+			},
+			false,
+			nil, // the error is returned by the query handler
+		},
+		{
+			"response size exceeds limit",
+			func(interchainAccountAddr string) {
+				params.MaxQueryResponseSize = 1
+			},
+			false,
+			types.ErrMaxQueryResponseSize,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := ibctesting.SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			request := banktypes.NewQueryBalanceRequest(sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+			requestBz, err := request.Marshal()
+			suite.Require().NoError(err)
+
+			requests = []icatypes.QueryRequest{{Path: balancePath, Data: requestBz}}
+			params = types.Params{
+				HostEnabled:  true,
+				AllowQueries: []string{balancePath},
+			}
+
+			tc.malleate(interchainAccountAddr)
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosQuery(requests, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_QUERY,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var balanceResponse banktypes.QueryBalanceResponse
+				err = icatypes.UnmarshalQueryResponses(channeltypes.NewResultAcknowledgement(queryResponse), &balanceResponse)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)), *balanceResponse.Balance)
+			} else {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+				suite.Require().Nil(queryResponse)
+			}
+		})
+	}
 }
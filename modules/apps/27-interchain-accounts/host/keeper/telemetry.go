@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"strconv"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	coretypes "github.com/cosmos/ibc-go/v4/modules/core/types"
+)
+
+// NOTE: metrics are emitted to the global go-metrics sink which discards them unless telemetry is enabled in the application config.
+
+// incrPacketReceivedTelemetry records the receipt of a packet to be executed by the host and samples the number of msgs it contains.
+func incrPacketReceivedTelemetry(msgCount int) {
+	telemetry.IncrCounter(1, "ibc", icatypes.ModuleName, types.SubModuleName, "packet", "received")
+	metrics.AddSample([]string{"ibc", icatypes.ModuleName, types.SubModuleName, "packet", "msgs"}, float32(msgCount))
+}
+
+// incrMsgExecutedTelemetry records the successful execution of a msg by the host labelled by its type URL.
+func incrMsgExecutedTelemetry(msg sdk.Msg) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", icatypes.ModuleName, types.SubModuleName, "msg", "executed"},
+		1,
+		[]metrics.Label{telemetry.NewLabel(coretypes.LabelMsgTypeURL, sdk.MsgTypeURL(msg))},
+	)
+}
+
+// incrExecutionFailedTelemetry records a failed execution labelled by the codespace and ABCI code of the error.
+func incrExecutionFailedTelemetry(err error) {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", icatypes.ModuleName, types.SubModuleName, "execution", "failed"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(coretypes.LabelCodespace, codespace),
+			telemetry.NewLabel(coretypes.LabelCode, strconv.FormatUint(uint64(code), 10)),
+		},
+	)
+}
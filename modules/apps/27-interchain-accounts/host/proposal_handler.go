@@ -0,0 +1,25 @@
+package host
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+// NewHostProposalHandler defines the interchain accounts host proposal handler
+func NewHostProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateAllowMessagesProposal:
+			return k.HandleUpdateAllowMessagesProposal(ctx, c)
+		case *types.UpdateAddressBlocklistProposal:
+			return k.HandleUpdateAddressBlocklistProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain accounts host proposal content type: %T", c)
+		}
+	}
+}
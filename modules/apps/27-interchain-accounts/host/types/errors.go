@@ -6,5 +6,18 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled         = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrInvalidAllowMessage           = sdkerrors.Register(SubModuleName, 3, "invalid allow message type URL")
+	ErrMaxNestedMsgDepth             = sdkerrors.Register(SubModuleName, 4, "max nested message depth exceeded")
+	ErrMaxMsgsPerPacket              = sdkerrors.Register(SubModuleName, 5, "max messages per packet exceeded")
+	ErrMaxQueryResponseSize          = sdkerrors.Register(SubModuleName, 6, "max query response size exceeded")
+	ErrMaxMemoLength                 = sdkerrors.Register(SubModuleName, 7, "max memo length exceeded")
+	ErrBlockedAddress                = sdkerrors.Register(SubModuleName, 8, "recipient address is blocked")
+	ErrInvalidBlocklist              = sdkerrors.Register(SubModuleName, 9, "invalid address blocklist")
+	ErrUntrustedControllerConnection = sdkerrors.Register(SubModuleName, 10, "interchain account registration over untrusted controller connection")
+	ErrMessageTypePaused             = sdkerrors.Register(SubModuleName, 11, "message type is paused")
+	ErrMessageTypeNotPaused          = sdkerrors.Register(SubModuleName, 12, "message type is not paused")
+	ErrInvalidPausedMessageType      = sdkerrors.Register(SubModuleName, 13, "invalid paused message type")
+	ErrExecutionFeeUnsupported       = sdkerrors.Register(SubModuleName, 14, "execution fee is set but no bank keeper is set")
+	ErrMsgHandlerPanic               = sdkerrors.Register(SubModuleName, 15, "message handler panicked")
 )
@@ -0,0 +1,31 @@
+package types
+
+// ICS27 Interchain Accounts host events
+const (
+	EventTypeUpdateAllowMessages  = "update_allow_messages"
+	EventTypeExecuteMsg           = "ics27_execute_msg"
+	EventTypeExecuteTx            = "ics27_execute_tx"
+	EventTypeNormalizeAllowMsgs   = "normalize_allow_messages"
+	EventTypeUpdateBlocklist      = "update_address_blocklist"
+	EventTypePauseMessageType     = "pause_message_type"
+	EventTypeUnpauseMessageType   = "unpause_message_type"
+	EventTypeExecutionFee         = "ics27_execution_fee"
+	EventTypeResetGasByConnection = "reset_gas_by_connection"
+	EventTypeHostPaused           = "ics27_host_paused"
+
+	AttributeKeyAddedMessages    = "added_messages"
+	AttributeKeyRemovedMessages  = "removed_messages"
+	AttributeKeyControllerPortID = "controller_port_id"
+	AttributeKeyMsgTypeURL       = "msg_type_url"
+	AttributeKeyMsgSuccess       = "success"
+	AttributeKeyPacketSequence   = "packet_sequence"
+	AttributeKeyMsgCount         = "msg_count"
+	AttributeKeyNormalizedMsgs   = "normalized_messages"
+	AttributeKeyAddedAddresses   = "added_addresses"
+	AttributeKeyRemovedAddresses = "removed_addresses"
+	AttributeKeyExpiryHeight     = "expiry_height"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyAccountAddress   = "account_address"
+	AttributeKeyFee              = "fee"
+	AttributeKeyGasUsed          = "gas_used"
+)
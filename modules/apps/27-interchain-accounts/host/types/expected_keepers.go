@@ -0,0 +1,18 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// BankKeeper defines the expected x/bank keeper used to deduct execution fees from interchain accounts
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// AuthzKeeper defines the expected x/authz keeper used by the AuthzSignerResolver
+type AuthzKeeper interface {
+	GetCleanAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, time.Time)
+}
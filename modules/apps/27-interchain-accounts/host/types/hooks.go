@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ICAHostHooks defines the hooks which may be used to run custom logic before and after the execution of
+// interchain accounts transactions on the host chain. An error returned by a hook aborts the execution of
+// the transaction and results in an error acknowledgement.
+type ICAHostHooks interface {
+	// BeforeExecuteTx is called once the transaction has been authenticated, prior to the execution of its msgs.
+	// The memo of the received packet is provided.
+	BeforeExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string) error
+	// AfterExecuteTx is called once the msgs of the transaction have been executed, prior to the state changes being
+	// committed. The error returned by the execution of the msgs, if any, is provided.
+	AfterExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string, txResponse []byte, err error) error
+}
+
+var _ ICAHostHooks = MultiICAHostHooks{}
+
+// MultiICAHostHooks combines multiple ICAHostHooks, calling each of them in order
+type MultiICAHostHooks []ICAHostHooks
+
+// NewMultiICAHostHooks creates a new MultiICAHostHooks instance
+func NewMultiICAHostHooks(hooks ...ICAHostHooks) MultiICAHostHooks {
+	return hooks
+}
+
+// BeforeExecuteTx implements ICAHostHooks, returning the first error returned by a hook
+func (h MultiICAHostHooks) BeforeExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string) error {
+	for _, hook := range h {
+		if err := hook.BeforeExecuteTx(ctx, connectionID, portID, msgs, memo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterExecuteTx implements ICAHostHooks, returning the first error returned by a hook
+func (h MultiICAHostHooks) AfterExecuteTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, memo string, txResponse []byte, err error) error {
+	for _, hook := range h {
+		if hookErr := hook.AfterExecuteTx(ctx, connectionID, portID, msgs, memo, txResponse, err); hookErr != nil {
+			return hookErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+var _ types.ICAHostHooks = &mockHooks{}
+
+// mockHooks records the name of each hook called and returns the configured error
+type mockHooks struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h *mockHooks) BeforeExecuteTx(_ sdk.Context, _, _ string, _ []sdk.Msg, _ string) error {
+	*h.calls = append(*h.calls, fmt.Sprintf("%s.BeforeExecuteTx", h.name))
+	return h.err
+}
+
+func (h *mockHooks) AfterExecuteTx(_ sdk.Context, _, _ string, _ []sdk.Msg, _ string, _ []byte, _ error) error {
+	*h.calls = append(*h.calls, fmt.Sprintf("%s.AfterExecuteTx", h.name))
+	return h.err
+}
+
+func TestMultiICAHostHooks(t *testing.T) {
+	var calls []string
+
+	hooks := types.NewMultiICAHostHooks(
+		&mockHooks{name: "first", calls: &calls},
+		&mockHooks{name: "second", calls: &calls},
+	)
+
+	require.NoError(t, hooks.BeforeExecuteTx(sdk.Context{}, "connection-0", "icacontroller-owner", nil, ""))
+	require.NoError(t, hooks.AfterExecuteTx(sdk.Context{}, "connection-0", "icacontroller-owner", nil, "", nil, nil))
+	require.Equal(t, []string{"first.BeforeExecuteTx", "second.BeforeExecuteTx", "first.AfterExecuteTx", "second.AfterExecuteTx"}, calls)
+}
+
+func TestMultiICAHostHooksError(t *testing.T) {
+	var calls []string
+
+	expErr := fmt.Errorf("hook error")
+	hooks := types.NewMultiICAHostHooks(
+		&mockHooks{name: "first", calls: &calls, err: expErr},
+		&mockHooks{name: "second", calls: &calls},
+	)
+
+	// hooks following a failed hook are not called
+	require.ErrorIs(t, hooks.BeforeExecuteTx(sdk.Context{}, "connection-0", "icacontroller-owner", nil, ""), expErr)
+	require.ErrorIs(t, hooks.AfterExecuteTx(sdk.Context{}, "connection-0", "icacontroller-owner", nil, "", nil, nil), expErr)
+	require.Equal(t, []string{"first.BeforeExecuteTx", "first.AfterExecuteTx"}, calls)
+}
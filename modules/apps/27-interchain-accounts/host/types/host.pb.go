@@ -5,8 +5,11 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,6 +26,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AddressScheme defines the scheme used to derive the address of an interchain account registered on the host chain
+type AddressScheme int32
+
+const (
+	// Default zero value enumeration
+	ADDRESS_SCHEME_UNSPECIFIED AddressScheme = 0
+	// The address was derived using block dependent data, or the deprecated GenerateAddress, and cannot be reproduced
+	// offline
+	ADDRESS_SCHEME_LEGACY AddressScheme = 1
+	// The address was derived from the host connection identifier and controller port identifier using
+	// BuildInterchainAccountAddress
+	ADDRESS_SCHEME_CONNECTION_PORT AddressScheme = 2
+)
+
+var AddressScheme_name = map[int32]string{
+	0: "ADDRESS_SCHEME_UNSPECIFIED",
+	1: "ADDRESS_SCHEME_LEGACY",
+	2: "ADDRESS_SCHEME_CONNECTION_PORT",
+}
+
+var AddressScheme_value = map[string]int32{
+	"ADDRESS_SCHEME_UNSPECIFIED":     0,
+	"ADDRESS_SCHEME_LEGACY":          1,
+	"ADDRESS_SCHEME_CONNECTION_PORT": 2,
+}
+
+func (x AddressScheme) String() string {
+	return proto.EnumName(AddressScheme_name, int32(x))
+}
+
+func (AddressScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{0}
+}
+
 // Params defines the set of on-chain interchain accounts parameters.
 // The following parameters may be used to disable the host submodule.
 type Params struct {
@@ -30,6 +67,49 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// max_tx_gas defines the maximum amount of gas which may be consumed executing the messages of a single
+	// interchain accounts transaction on the host chain. A value of 0 indicates no limit.
+	MaxTxGas uint64 `protobuf:"varint,3,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty" yaml:"max_tx_gas"`
+	// max_msgs_per_packet defines the maximum number of messages which may be included in a single interchain
+	// accounts packet. A value of 0 indicates no limit.
+	MaxMsgsPerPacket uint64 `protobuf:"varint,4,opt,name=max_msgs_per_packet,json=maxMsgsPerPacket,proto3" json:"max_msgs_per_packet,omitempty" yaml:"max_msgs_per_packet"`
+	// max_execution_results defines the number of recent execution results stored by the host for each channel.
+	// A value of 0 disables the storage of execution results.
+	MaxExecutionResults uint64 `protobuf:"varint,5,opt,name=max_execution_results,json=maxExecutionResults,proto3" json:"max_execution_results,omitempty" yaml:"max_execution_results"`
+	// allow_multi_ica_signers allows messages to be signed by any interchain account registered on the connection
+	// over which the packet was received, provided the interchain account executing the packet is one of the signers.
+	// When false, each signer must be the interchain account executing the packet.
+	AllowMultiIcaSigners bool `protobuf:"varint,6,opt,name=allow_multi_ica_signers,json=allowMultiIcaSigners,proto3" json:"allow_multi_ica_signers,omitempty" yaml:"allow_multi_ica_signers"`
+	// allow_all_when_empty allows all message types to be executed when the allow_messages applying to the connection
+	// over which the packet was received is empty. When false, packets are rejected if no message types are allowed.
+	AllowAllWhenEmpty bool `protobuf:"varint,7,opt,name=allow_all_when_empty,json=allowAllWhenEmpty,proto3" json:"allow_all_when_empty,omitempty" yaml:"allow_all_when_empty"`
+	// allow_queries defines a list of fully qualified gRPC query method paths, e.g. /cosmos.bank.v1beta1.Query/Balance,
+	// which may be executed on the host chain using query packets. Query packets are rejected if the list is empty.
+	AllowQueries []string `protobuf:"bytes,8,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_query_response_size defines the maximum size in bytes of the responses of the queries contained in a single
+	// query packet. A value of 0 indicates no limit.
+	MaxQueryResponseSize uint64 `protobuf:"varint,9,opt,name=max_query_response_size,json=maxQueryResponseSize,proto3" json:"max_query_response_size,omitempty" yaml:"max_query_response_size"`
+	// max_packet_data_size defines the maximum size in bytes of the data of a received interchain accounts packet.
+	// Larger packets are rejected before the packet data is decoded. A value of 0 indicates no limit.
+	MaxPacketDataSize uint64 `protobuf:"varint,10,opt,name=max_packet_data_size,json=maxPacketDataSize,proto3" json:"max_packet_data_size,omitempty" yaml:"max_packet_data_size"`
+	// max_memo_length defines the maximum length in bytes of the memo of a received interchain accounts packet.
+	// Packets with a longer memo are rejected with an error acknowledgement. A value of 0 indicates no limit.
+	MaxMemoLength uint64 `protobuf:"varint,11,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty" yaml:"max_memo_length"`
+	// trusted_controller_connections defines the host connection identifiers over which new interchain accounts may be
+	// registered by a channel handshake. Over any other connection, the handshake is only accepted if an interchain
+	// account is already registered for the controller port. New interchain accounts may be registered over any
+	// connection if the list is empty.
+	TrustedControllerConnections []string `protobuf:"bytes,12,rep,name=trusted_controller_connections,json=trustedControllerConnections,proto3" json:"trusted_controller_connections,omitempty" yaml:"trusted_controller_connections"`
+	// circuit_breaker_authority defines the bech32 address allowed to pause and unpause the execution of message types
+	// by interchain accounts. Message types cannot be paused if empty.
+	CircuitBreakerAuthority string `protobuf:"bytes,13,opt,name=circuit_breaker_authority,json=circuitBreakerAuthority,proto3" json:"circuit_breaker_authority,omitempty" yaml:"circuit_breaker_authority"`
+	// execution_fee defines the flat fee deducted from the interchain account and sent to the fee collector once for
+	// each executed packet. The fee of an atomic transaction is only deducted if the transaction is executed
+	// successfully. No fee is deducted if empty.
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee" yaml:"execution_fee"`
+	// gas_reset_authority defines the bech32 address allowed to reset the gas consumed over each connection. Gas
+	// consumption cannot be reset if empty.
+	GasResetAuthority string `protobuf:"bytes,15,opt,name=gas_reset_authority,json=gasResetAuthority,proto3" json:"gas_reset_authority,omitempty" yaml:"gas_reset_authority"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,183 +159,2344 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+func (m *Params) GetMaxTxGas() uint64 {
+	if m != nil {
+		return m.MaxTxGas
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/host.proto", fileDescriptor_48e202774f13d08e)
+func (m *Params) GetMaxMsgsPerPacket() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerPacket
+	}
+	return 0
 }
 
-var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd1, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xb2, 0xee, 0x31, 0x74, 0x01,
-	0x00, 0x00,
+func (m *Params) GetMaxExecutionResults() uint64 {
+	if m != nil {
+		return m.MaxExecutionResults
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetAllowMultiIcaSigners() bool {
+	if m != nil {
+		return m.AllowMultiIcaSigners
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetAllowAllWhenEmpty() bool {
+	if m != nil {
+		return m.AllowAllWhenEmpty
+	}
+	return false
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowMessages) > 0 {
-		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowMessages[iNdEx])
-			copy(dAtA[i:], m.AllowMessages[iNdEx])
-			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
 	}
-	if m.HostEnabled {
-		i--
-		if m.HostEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Params) GetMaxQueryResponseSize() uint64 {
+	if m != nil {
+		return m.MaxQueryResponseSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetMaxPacketDataSize() uint64 {
+	if m != nil {
+		return m.MaxPacketDataSize
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
 	}
-	var l int
-	_ = l
-	if m.HostEnabled {
-		n += 2
+	return 0
+}
+
+func (m *Params) GetTrustedControllerConnections() []string {
+	if m != nil {
+		return m.TrustedControllerConnections
 	}
-	if len(m.AllowMessages) > 0 {
-		for _, s := range m.AllowMessages {
-			l = len(s)
-			n += 1 + l + sovHost(uint64(l))
+	return nil
+}
+
+func (m *Params) GetCircuitBreakerAuthority() string {
+	if m != nil {
+		return m.CircuitBreakerAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return nil
+}
+
+// ConnectionAllowMessages defines a list of sdk message typeURLs allowed to be executed on the host chain
+// by interchain accounts registered over a specific connection. When present, it overrides the allow_messages
+// defined in the host submodule params for that connection.
+func (m *Params) GetGasResetAuthority() string {
+	if m != nil {
+		return m.GasResetAuthority
+	}
+	return ""
+}
+
+type ConnectionAllowMessages struct {
+	// connection_id is the host connection identifier the allowlist applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed over the connection
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *ConnectionAllowMessages) Reset()         { *m = ConnectionAllowMessages{} }
+func (m *ConnectionAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*ConnectionAllowMessages) ProtoMessage()    {}
+func (*ConnectionAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *ConnectionAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConnectionAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionAllowMessages.Merge(m, src)
+}
+func (m *ConnectionAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionAllowMessages.DiscardUnknown(m)
 }
 
-func sovHost(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_ConnectionAllowMessages proto.InternalMessageInfo
+
+func (m *ConnectionAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
-func sozHost(x uint64) (n int) {
-	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ConnectionAllowMessages) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHost
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+// UpdateAllowMessagesProposal is a gov Content type for replacing the list of sdk message
+// typeURLs which interchain accounts are allowed to execute on the host chain.
+type UpdateAllowMessagesProposal struct {
+	// the title of the update proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// allow_messages defines the new list of sdk message typeURLs allowed to be executed on the host chain
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *UpdateAllowMessagesProposal) Reset()         { *m = UpdateAllowMessagesProposal{} }
+func (m *UpdateAllowMessagesProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateAllowMessagesProposal) ProtoMessage()    {}
+func (*UpdateAllowMessagesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *UpdateAllowMessagesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAllowMessagesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAllowMessagesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		return b[:n], nil
+	}
+}
+func (m *UpdateAllowMessagesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAllowMessagesProposal.Merge(m, src)
+}
+func (m *UpdateAllowMessagesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAllowMessagesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAllowMessagesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAllowMessagesProposal proto.InternalMessageInfo
+
+// UpdateAddressBlocklistProposal is a gov Content type for adding and removing addresses from the host address
+// blocklist. Interchain accounts cannot execute messages sending funds to blocked addresses.
+type UpdateAddressBlocklistProposal struct {
+	// the title of the update proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// add_addresses defines the bech32 addresses to be added to the blocklist
+	AddAddresses []string `protobuf:"bytes,3,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty" yaml:"add_addresses"`
+	// remove_addresses defines the bech32 addresses to be removed from the blocklist
+	RemoveAddresses []string `protobuf:"bytes,4,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty" yaml:"remove_addresses"`
+}
+
+func (m *UpdateAddressBlocklistProposal) Reset()         { *m = UpdateAddressBlocklistProposal{} }
+func (m *UpdateAddressBlocklistProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateAddressBlocklistProposal) ProtoMessage()    {}
+func (*UpdateAddressBlocklistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *UpdateAddressBlocklistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAddressBlocklistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAddressBlocklistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		return b[:n], nil
+	}
+}
+func (m *UpdateAddressBlocklistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAddressBlocklistProposal.Merge(m, src)
+}
+func (m *UpdateAddressBlocklistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAddressBlocklistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAddressBlocklistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAddressBlocklistProposal proto.InternalMessageInfo
+
+// ExecutionResult defines the result of the execution of an interchain accounts packet by the host chain.
+type ExecutionResult struct {
+	// sequence is the sequence of the executed packet
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// msg_type_urls defines the sdk message typeURLs contained in the packet
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	// success is true if all messages contained in the packet were executed successfully
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// code is the ABCI code of the error which caused the execution to fail, or of the first failed message
+	// for non-atomic executions. A value of 0 indicates success.
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	// gas_used is the gas consumed by the execution of the transaction, or of all transactions of a batch.
+	// It is zero if the execution of the packet returned an error.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{4}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHost
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HostEnabled = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHost
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *ExecutionResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// PausedMessageType defines a message type which interchain accounts are not allowed to execute
+type PausedMessageType struct {
+	// the type URL of the paused message type
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// the block height after which the message type is no longer paused. A value of 0 indicates no expiry.
+	ExpiryHeight uint64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+}
+
+func (m *PausedMessageType) Reset()         { *m = PausedMessageType{} }
+func (m *PausedMessageType) String() string { return proto.CompactTextString(m) }
+func (*PausedMessageType) ProtoMessage()    {}
+func (*PausedMessageType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{5}
+}
+func (m *PausedMessageType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedMessageType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedMessageType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedMessageType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedMessageType.Merge(m, src)
+}
+func (m *PausedMessageType) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedMessageType) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedMessageType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedMessageType proto.InternalMessageInfo
+
+func (m *PausedMessageType) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *PausedMessageType) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// CollectedExecutionFees defines the total execution fees collected from the interchain accounts registered over a
+// connection
+type CollectedExecutionFees struct {
+	// connection_id is the host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// fees defines the total execution fees collected
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *CollectedExecutionFees) Reset()         { *m = CollectedExecutionFees{} }
+func (m *CollectedExecutionFees) String() string { return proto.CompactTextString(m) }
+func (*CollectedExecutionFees) ProtoMessage()    {}
+func (*CollectedExecutionFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{6}
+}
+func (m *CollectedExecutionFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedExecutionFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedExecutionFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectedExecutionFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedExecutionFees.Merge(m, src)
+}
+func (m *CollectedExecutionFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedExecutionFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedExecutionFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectedExecutionFees proto.InternalMessageInfo
+
+func (m *CollectedExecutionFees) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *CollectedExecutionFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// ConnectionGasUsed defines the total gas consumed by the execution of interchain accounts transactions received over
+// a connection
+type ConnectionGasUsed struct {
+	// connection_id is the host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// gas_used defines the total gas consumed
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *ConnectionGasUsed) Reset()         { *m = ConnectionGasUsed{} }
+func (m *ConnectionGasUsed) String() string { return proto.CompactTextString(m) }
+func (*ConnectionGasUsed) ProtoMessage()    {}
+func (*ConnectionGasUsed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{7}
+}
+func (m *ConnectionGasUsed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionGasUsed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionGasUsed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionGasUsed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionGasUsed.Merge(m, src)
+}
+func (m *ConnectionGasUsed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionGasUsed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionGasUsed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionGasUsed proto.InternalMessageInfo
+
+func (m *ConnectionGasUsed) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionGasUsed) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ibc.applications.interchain_accounts.host.v1.AddressScheme", AddressScheme_name, AddressScheme_value)
+	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*ConnectionAllowMessages)(nil), "ibc.applications.interchain_accounts.host.v1.ConnectionAllowMessages")
+	proto.RegisterType((*UpdateAllowMessagesProposal)(nil), "ibc.applications.interchain_accounts.host.v1.UpdateAllowMessagesProposal")
+	proto.RegisterType((*UpdateAddressBlocklistProposal)(nil), "ibc.applications.interchain_accounts.host.v1.UpdateAddressBlocklistProposal")
+	proto.RegisterType((*ExecutionResult)(nil), "ibc.applications.interchain_accounts.host.v1.ExecutionResult")
+	proto.RegisterType((*PausedMessageType)(nil), "ibc.applications.interchain_accounts.host.v1.PausedMessageType")
+	proto.RegisterType((*CollectedExecutionFees)(nil), "ibc.applications.interchain_accounts.host.v1.CollectedExecutionFees")
+	proto.RegisterType((*ConnectionGasUsed)(nil), "ibc.applications.interchain_accounts.host.v1.ConnectionGasUsed")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/host.proto", fileDescriptor_48e202774f13d08e)
+}
+
+var fileDescriptor_48e202774f13d08e = []byte{
+	// 1299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x8f, 0x13, 0xb7, 0x49, 0x26, 0x71, 0x93, 0x6c, 0x92, 0x66, 0xe3, 0x56, 0x5e, 0x6b, 0x04,
+	0x52, 0x40, 0xc4, 0x26, 0x2d, 0x52, 0xa5, 0x8a, 0x4a, 0xc4, 0x8e, 0xdb, 0x06, 0x35, 0xa9, 0x99,
+	0x24, 0x42, 0xe5, 0x32, 0x8c, 0x77, 0x5f, 0xd7, 0xab, 0xec, 0x1f, 0x77, 0x67, 0x9c, 0xda, 0x3d,
+	0xc2, 0xa5, 0x47, 0xce, 0x9c, 0x90, 0xb8, 0x71, 0xe6, 0x80, 0xc4, 0x17, 0x28, 0xb7, 0x8a, 0x13,
+	0xa7, 0x05, 0xb5, 0x47, 0x6e, 0xfe, 0x04, 0x68, 0x66, 0xd6, 0xf1, 0x3a, 0x4d, 0x0b, 0x55, 0x39,
+	0xed, 0xbe, 0xf7, 0x7b, 0xef, 0xb7, 0xef, 0xdf, 0xbc, 0x1d, 0x74, 0xc3, 0x6b, 0xd9, 0x55, 0xd6,
+	0xe9, 0xf8, 0x9e, 0xcd, 0x84, 0x17, 0x85, 0xbc, 0xea, 0x85, 0x02, 0x62, 0xbb, 0xcd, 0xbc, 0x90,
+	0x32, 0xdb, 0x8e, 0xba, 0xa1, 0xe0, 0xd5, 0x76, 0xc4, 0x45, 0xf5, 0x64, 0x4b, 0x3d, 0x2b, 0x9d,
+	0x38, 0x12, 0x91, 0xf1, 0x91, 0xd7, 0xb2, 0x2b, 0x59, 0xc7, 0xca, 0x39, 0x8e, 0x15, 0xe5, 0x70,
+	0xb2, 0x55, 0x5c, 0x71, 0x23, 0x37, 0x52, 0x8e, 0x55, 0xf9, 0xa6, 0x39, 0x8a, 0xeb, 0x76, 0xc4,
+	0x83, 0x88, 0x53, 0x0d, 0x68, 0x21, 0x85, 0x4a, 0x5a, 0xaa, 0xb6, 0x18, 0x87, 0xea, 0xc9, 0x56,
+	0x0b, 0x04, 0xdb, 0xaa, 0xda, 0x91, 0x17, 0x6a, 0x1c, 0xff, 0x3d, 0x8b, 0x2e, 0x36, 0x59, 0xcc,
+	0x02, 0x6e, 0xdc, 0x44, 0xf3, 0xf2, 0x33, 0x14, 0x42, 0xd6, 0xf2, 0xc1, 0x31, 0x73, 0xe5, 0xdc,
+	0xc6, 0x4c, 0x6d, 0x6d, 0x90, 0x58, 0xcb, 0x7d, 0x16, 0xf8, 0x37, 0x71, 0x16, 0xc5, 0x64, 0x4e,
+	0x8a, 0x0d, 0x2d, 0x19, 0x9f, 0xa1, 0x4b, 0xcc, 0xf7, 0xa3, 0xc7, 0x34, 0x00, 0xce, 0x99, 0x0b,
+	0xdc, 0x9c, 0x2c, 0x4f, 0x6d, 0xcc, 0xd6, 0xd6, 0x07, 0x89, 0xb5, 0xaa, 0xbd, 0xc7, 0x71, 0x4c,
+	0x0a, 0x4a, 0xb1, 0x97, 0xca, 0xc6, 0x75, 0x84, 0x02, 0xd6, 0xa3, 0xa2, 0x47, 0x5d, 0xc6, 0xcd,
+	0xa9, 0x72, 0x6e, 0x23, 0x5f, 0x5b, 0x1d, 0x24, 0xd6, 0x92, 0xf6, 0x1e, 0x61, 0x98, 0xcc, 0x04,
+	0xac, 0x77, 0xd8, 0xbb, 0xc3, 0xb8, 0xb1, 0x87, 0x96, 0x25, 0x10, 0x70, 0x97, 0xd3, 0x0e, 0xc4,
+	0xb4, 0xc3, 0xec, 0x63, 0x10, 0x66, 0x5e, 0x79, 0x97, 0x06, 0x89, 0x55, 0x1c, 0x79, 0x9f, 0x31,
+	0xc2, 0x64, 0x31, 0x60, 0xbd, 0x3d, 0xee, 0xf2, 0x26, 0xc4, 0x4d, 0xa5, 0x32, 0x0e, 0xd1, 0xaa,
+	0xb4, 0x84, 0x1e, 0xd8, 0x5d, 0xd9, 0x0b, 0x1a, 0x03, 0xef, 0xfa, 0x82, 0x9b, 0x17, 0x14, 0x61,
+	0x79, 0x90, 0x58, 0x57, 0x47, 0x84, 0xaf, 0x98, 0x61, 0x22, 0xa3, 0x69, 0x0c, 0xd5, 0x44, 0x6b,
+	0x8d, 0x07, 0x68, 0x2d, 0xcd, 0xbd, 0xeb, 0x0b, 0x8f, 0x7a, 0x36, 0xa3, 0xdc, 0x73, 0x43, 0x88,
+	0xb9, 0x79, 0x51, 0x95, 0x18, 0x0f, 0x12, 0xab, 0x34, 0x56, 0xa4, 0xb3, 0x86, 0x98, 0xac, 0xe8,
+	0x6a, 0x49, 0x60, 0xd7, 0x66, 0x07, 0x5a, 0x6d, 0x34, 0x91, 0xd6, 0x53, 0xe6, 0xfb, 0xf4, 0x71,
+	0x1b, 0x42, 0x0a, 0x41, 0x47, 0xf4, 0xcd, 0x69, 0xc5, 0x6b, 0x0d, 0x12, 0xeb, 0x4a, 0x96, 0x77,
+	0xdc, 0x0a, 0x93, 0x25, 0xa5, 0xde, 0xf6, 0xfd, 0x2f, 0xdb, 0x10, 0x36, 0xa4, 0xce, 0xb8, 0x85,
+	0x74, 0x5f, 0xe8, 0xa3, 0x2e, 0xc4, 0x1e, 0x70, 0x73, 0x46, 0xf5, 0xd1, 0x1c, 0x24, 0xd6, 0x4a,
+	0x96, 0x2a, 0x85, 0x31, 0x99, 0x57, 0xf2, 0x17, 0x5a, 0x94, 0xb9, 0xca, 0xd2, 0x48, 0xb4, 0x2f,
+	0xcb, 0xd2, 0x89, 0x42, 0x0e, 0x94, 0x7b, 0x4f, 0xc0, 0x9c, 0x55, 0x35, 0xcc, 0xe4, 0xfa, 0x1a,
+	0x43, 0x4c, 0x56, 0x02, 0xd6, 0x93, 0x84, 0x7d, 0x92, 0xea, 0x0f, 0xbc, 0x27, 0x20, 0x73, 0x95,
+	0x1e, 0xba, 0x7b, 0xd4, 0x61, 0x82, 0x69, 0x5e, 0xa4, 0x78, 0x33, 0xb9, 0x9e, 0x67, 0x85, 0xc9,
+	0x52, 0xc0, 0x7a, 0xba, 0xcd, 0x3b, 0x4c, 0x30, 0xc5, 0x58, 0x43, 0x0b, 0x6a, 0x30, 0x20, 0x88,
+	0xa8, 0x0f, 0xa1, 0x2b, 0xda, 0xe6, 0x9c, 0x22, 0x2b, 0x0e, 0x12, 0xeb, 0x72, 0x66, 0x72, 0x46,
+	0x06, 0x98, 0x14, 0xe4, 0xd4, 0x40, 0x10, 0xdd, 0x53, 0xb2, 0x11, 0xa1, 0x92, 0x88, 0xbb, 0x5c,
+	0x80, 0x43, 0xed, 0x28, 0x14, 0x71, 0xe4, 0xfb, 0x10, 0xcb, 0xd7, 0x10, 0x6c, 0x75, 0x9c, 0xcd,
+	0x79, 0x55, 0xc0, 0x0f, 0x06, 0x89, 0xf5, 0xbe, 0xa6, 0x7c, 0xb3, 0x3d, 0x26, 0x57, 0x53, 0x83,
+	0xfa, 0x29, 0x5e, 0x1f, 0xc1, 0xc6, 0xd7, 0x68, 0xdd, 0xf6, 0x62, 0xbb, 0xeb, 0x09, 0xda, 0x8a,
+	0x81, 0x1d, 0x43, 0x4c, 0x59, 0x57, 0xb4, 0xa3, 0xd8, 0x13, 0x7d, 0xb3, 0x50, 0xce, 0x6d, 0xcc,
+	0xd6, 0xde, 0x1b, 0x24, 0x56, 0x59, 0x7f, 0xeb, 0xb5, 0xa6, 0x98, 0xac, 0xa5, 0x58, 0x4d, 0x43,
+	0xdb, 0x43, 0xc4, 0x78, 0x9a, 0x43, 0x85, 0xd1, 0x6c, 0x3f, 0x04, 0x30, 0x2f, 0x95, 0xa7, 0x36,
+	0xe6, 0xae, 0xad, 0x57, 0xd2, 0xcd, 0x22, 0x77, 0x49, 0x25, 0xdd, 0x25, 0x95, 0x7a, 0xe4, 0x85,
+	0xb5, 0xbb, 0xcf, 0x12, 0x6b, 0x62, 0x34, 0x22, 0x63, 0xde, 0xf8, 0xa7, 0x3f, 0xad, 0x0d, 0xd7,
+	0x13, 0xed, 0x6e, 0xab, 0x62, 0x47, 0x41, 0xba, 0x9e, 0xd2, 0xc7, 0x26, 0x77, 0x8e, 0xab, 0xa2,
+	0xdf, 0x01, 0xae, 0x88, 0x38, 0x99, 0x3f, 0xf5, 0xbd, 0x0d, 0x60, 0xec, 0xa3, 0x65, 0x97, 0x71,
+	0x39, 0x1f, 0x20, 0x32, 0x69, 0x2e, 0xa8, 0x34, 0x33, 0xe7, 0xfb, 0x1c, 0x23, 0x4c, 0x96, 0x5c,
+	0xc6, 0x89, 0x54, 0x9e, 0xa6, 0x86, 0xbf, 0xcf, 0xa1, 0xb5, 0x51, 0x31, 0xb7, 0xc7, 0x16, 0xd0,
+	0x2d, 0x54, 0x18, 0xb5, 0x81, 0x7a, 0x7a, 0xff, 0x8d, 0x4d, 0xfe, 0x18, 0x8c, 0xc9, 0xfc, 0x48,
+	0xde, 0xfd, 0x1f, 0x36, 0x20, 0xfe, 0x35, 0x87, 0xae, 0x1c, 0x75, 0x1c, 0x26, 0x60, 0x2c, 0xb0,
+	0x66, 0x1c, 0x75, 0x22, 0xce, 0x7c, 0x63, 0x05, 0x5d, 0x10, 0x9e, 0xf0, 0x41, 0x07, 0x46, 0xb4,
+	0x60, 0x94, 0xd1, 0x9c, 0x03, 0xdc, 0x8e, 0xbd, 0x8e, 0x0c, 0xc4, 0x9c, 0x54, 0x58, 0x56, 0x75,
+	0x4e, 0x64, 0x53, 0x6f, 0x17, 0xd9, 0x4d, 0xfc, 0xf4, 0x07, 0x6b, 0xe2, 0xf7, 0x9f, 0x37, 0x8b,
+	0xe9, 0x00, 0xb8, 0xd1, 0x49, 0xa6, 0xff, 0xa1, 0x80, 0x50, 0xe0, 0x6f, 0x27, 0x51, 0x29, 0x8d,
+	0xde, 0x71, 0x62, 0xe0, 0xbc, 0xe6, 0x47, 0xf6, 0xb1, 0xef, 0x71, 0xf1, 0xce, 0x09, 0xc8, 0x9d,
+	0xe4, 0x38, 0x94, 0x69, 0xde, 0xd3, 0xf8, 0xb3, 0x3b, 0x29, 0x0b, 0xcb, 0x9d, 0xe4, 0x38, 0xdb,
+	0x43, 0xd1, 0xb8, 0x8d, 0x16, 0x63, 0x08, 0xa2, 0x13, 0xc8, 0x30, 0xe4, 0x15, 0xc3, 0x95, 0x41,
+	0x62, 0xad, 0x69, 0x86, 0xb3, 0x16, 0x98, 0x2c, 0x68, 0xd5, 0x29, 0xcf, 0x7f, 0xaa, 0xc2, 0x6f,
+	0x39, 0xb4, 0x70, 0xe6, 0x07, 0x60, 0x14, 0xd1, 0x0c, 0x87, 0x47, 0x5d, 0x08, 0x6d, 0x9d, 0x79,
+	0x9e, 0x9c, 0xca, 0xc6, 0xa7, 0xa8, 0x10, 0x70, 0x97, 0xca, 0x13, 0x40, 0xbb, 0xb1, 0x3f, 0x1c,
+	0x9a, 0x4c, 0x6a, 0x63, 0x30, 0x26, 0x73, 0x01, 0x77, 0x0f, 0xfb, 0x1d, 0x38, 0x8a, 0x7d, 0x6e,
+	0x98, 0x68, 0x9a, 0x77, 0x6d, 0x1b, 0xb8, 0xfe, 0x61, 0xce, 0x90, 0xa1, 0x68, 0x18, 0x28, 0x6f,
+	0x47, 0x0e, 0xa8, 0x3f, 0x61, 0x81, 0xa8, 0x77, 0xa3, 0x82, 0x66, 0xe4, 0x39, 0xe9, 0x72, 0x70,
+	0xd2, 0x1f, 0xda, 0xf2, 0x20, 0xb1, 0x16, 0x46, 0x27, 0x48, 0x22, 0x98, 0x4c, 0xbb, 0x8c, 0x1f,
+	0xc9, 0xb7, 0x6f, 0x72, 0x68, 0xa9, 0xc9, 0xa4, 0x32, 0x1d, 0x04, 0xf9, 0x5d, 0xc9, 0x32, 0x0c,
+	0x27, 0x3d, 0x21, 0x19, 0x96, 0x21, 0x82, 0xc9, 0xb4, 0xd0, 0x41, 0xca, 0xe6, 0x41, 0xaf, 0xe3,
+	0xc5, 0x7d, 0xda, 0x06, 0xcf, 0x6d, 0x0b, 0xd5, 0xe0, 0x7c, 0x36, 0xc3, 0x31, 0x18, 0x93, 0x79,
+	0x2d, 0xdf, 0xd5, 0xe2, 0x2f, 0x39, 0x74, 0xb9, 0x2e, 0x97, 0xa0, 0x2d, 0xc0, 0x69, 0x64, 0x76,
+	0xc3, 0x3b, 0x1f, 0x58, 0x8a, 0xf2, 0x0f, 0x21, 0x3d, 0xa6, 0x6f, 0x5c, 0x6e, 0x1f, 0xcb, 0xe5,
+	0xf6, 0x56, 0x4b, 0x4c, 0x11, 0xab, 0xfa, 0x8d, 0x96, 0xcd, 0x1d, 0x5d, 0xd5, 0x77, 0x8d, 0x3a,
+	0xdb, 0xc4, 0xc9, 0x7f, 0x6f, 0xe2, 0x87, 0x02, 0x15, 0xd2, 0x09, 0x3e, 0xb0, 0xdb, 0x10, 0x80,
+	0x51, 0x42, 0xc5, 0xed, 0x9d, 0x1d, 0xd2, 0x38, 0x38, 0xa0, 0x07, 0xf5, 0xbb, 0x8d, 0xbd, 0x06,
+	0x3d, 0xda, 0x3f, 0x68, 0x36, 0xea, 0xbb, 0xb7, 0x77, 0x1b, 0x3b, 0x8b, 0x13, 0xc6, 0x3a, 0x5a,
+	0x3d, 0x83, 0xdf, 0x6b, 0xdc, 0xd9, 0xae, 0x3f, 0x58, 0xcc, 0x19, 0x18, 0x95, 0xce, 0x40, 0xf5,
+	0xfb, 0xfb, 0xfb, 0x8d, 0xfa, 0xe1, 0xee, 0xfd, 0x7d, 0xda, 0xbc, 0x4f, 0x0e, 0x17, 0x27, 0x8b,
+	0xf9, 0xa7, 0x3f, 0x96, 0x26, 0x6a, 0xce, 0xb3, 0x17, 0xa5, 0xdc, 0xf3, 0x17, 0xa5, 0xdc, 0x5f,
+	0x2f, 0x4a, 0xb9, 0xef, 0x5e, 0x96, 0x26, 0x9e, 0xbf, 0x2c, 0x4d, 0xfc, 0xf1, 0xb2, 0x34, 0xf1,
+	0xd5, 0xe7, 0xaf, 0x16, 0xd1, 0x6b, 0xd9, 0x9b, 0x6e, 0x54, 0x3d, 0xf9, 0xa4, 0x1a, 0x44, 0x4e,
+	0xd7, 0x07, 0x2e, 0xef, 0xd1, 0xbc, 0x7a, 0xed, 0xc6, 0xe6, 0xe8, 0x26, 0xbc, 0x39, 0x7e, 0x85,
+	0x56, 0xc5, 0x6e, 0x5d, 0x54, 0x57, 0xd8, 0xeb, 0xff, 0x0c, 0x00, 0x47, 0xb8, 0x40, 0x58, 0x7c,
+	0x0b, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasResetAuthority) > 0 {
+		i -= len(m.GasResetAuthority)
+		copy(dAtA[i:], m.GasResetAuthority)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.GasResetAuthority)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CircuitBreakerAuthority) > 0 {
+		i -= len(m.CircuitBreakerAuthority)
+		copy(dAtA[i:], m.CircuitBreakerAuthority)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.CircuitBreakerAuthority)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.TrustedControllerConnections) > 0 {
+		for iNdEx := len(m.TrustedControllerConnections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrustedControllerConnections[iNdEx])
+			copy(dAtA[i:], m.TrustedControllerConnections[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.TrustedControllerConnections[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxPacketDataSize != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxPacketDataSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxQueryResponseSize != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxQueryResponseSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.AllowAllWhenEmpty {
+		i--
+		if m.AllowAllWhenEmpty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AllowMultiIcaSigners {
+		i--
+		if m.AllowMultiIcaSigners {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxExecutionResults != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecutionResults))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMsgsPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxMsgsPerPacket))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTxGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxTxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAllowMessagesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAllowMessagesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAllowMessagesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAddressBlocklistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAddressBlocklistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAddressBlocklistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddAddresses) > 0 {
+		for iNdEx := len(m.AddAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAddresses[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AddAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Code != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedMessageType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedMessageType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedMessageType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedExecutionFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedExecutionFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedExecutionFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionGasUsed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionGasUsed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionGasUsed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxTxGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxTxGas))
+	}
+	if m.MaxMsgsPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxMsgsPerPacket))
+	}
+	if m.MaxExecutionResults != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecutionResults))
+	}
+	if m.AllowMultiIcaSigners {
+		n += 2
+	}
+	if m.AllowAllWhenEmpty {
+		n += 2
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxQueryResponseSize != 0 {
+		n += 1 + sovHost(uint64(m.MaxQueryResponseSize))
+	}
+	if m.MaxPacketDataSize != 0 {
+		n += 1 + sovHost(uint64(m.MaxPacketDataSize))
+	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovHost(uint64(m.MaxMemoLength))
+	}
+	if len(m.TrustedControllerConnections) > 0 {
+		for _, s := range m.TrustedControllerConnections {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	l = len(m.CircuitBreakerAuthority)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	l = len(m.GasResetAuthority)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectionAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateAllowMessagesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateAddressBlocklistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovHost(uint64(m.Sequence))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Code != 0 {
+		n += 1 + sovHost(uint64(m.Code))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovHost(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *PausedMessageType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovHost(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *CollectedExecutionFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionGasUsed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovHost(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovHost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHost(x uint64) (n int) {
+	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxGas", wireType)
+			}
+			m.MaxTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerPacket", wireType)
+			}
+			m.MaxMsgsPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionResults", wireType)
+			}
+			m.MaxExecutionResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionResults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMultiIcaSigners", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowMultiIcaSigners = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAllWhenEmpty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAllWhenEmpty = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryResponseSize", wireType)
+			}
+			m.MaxQueryResponseSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryResponseSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataSize", wireType)
+			}
+			m.MaxPacketDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedControllerConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedControllerConnections = append(m.TrustedControllerConnections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasResetAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasResetAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAllowMessagesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAllowMessagesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAllowMessagesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAddressBlocklistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAddressBlocklistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAddressBlocklistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedMessageType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedMessageType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedMessageType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthHost
 			}
@@ -266,8 +2507,244 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectedExecutionFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectedExecutionFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectedExecutionFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionGasUsed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionGasUsed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionGasUsed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,17 +14,192 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts host module
+	RouterKey = SubModuleName
+
+	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
+	AllowAllHostMsgs = "*"
+
+	// MaxNestedMsgDepth defines the maximum depth of messages nested within authz MsgExec messages which
+	// may be executed by an interchain account
+	MaxNestedMsgDepth = 5
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
+var (
+	// ConnectionAllowMessagesKeyPrefix defines the key prefix used to store per connection allow messages
+	ConnectionAllowMessagesKeyPrefix = "connectionAllowMessages"
+
+	// ChannelEncodingKeyPrefix defines the key prefix used to store the encoding negotiated for a channel
+	ChannelEncodingKeyPrefix = "channelEncoding"
+
+	// ExecutionResultKeyPrefix defines the key prefix used to store recent execution results
+	ExecutionResultKeyPrefix = "executionResult"
+
+	// AddressBlocklistKeyPrefix defines the key prefix used to store the addresses interchain accounts cannot send funds to
+	AddressBlocklistKeyPrefix = "addressBlocklist"
+
+	// PausedMessageTypeKeyPrefix defines the key prefix used to store the message types interchain accounts cannot execute
+	PausedMessageTypeKeyPrefix = "pausedMessageType"
+
+	// CollectedExecutionFeesKeyPrefix defines the key prefix used to store the execution fees collected over each connection
+	CollectedExecutionFeesKeyPrefix = "collectedExecutionFees"
+
+	// ConnectionGasUsedKeyPrefix defines the key prefix used to store the gas consumed by the execution of transactions
+	// received over each connection
+	ConnectionGasUsedKeyPrefix = "connectionGasUsed"
+)
+
+// KeyConnectionAllowMessages creates and returns a new key used for per connection allow messages store operations
+func KeyConnectionAllowMessages(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ConnectionAllowMessagesKeyPrefix, connectionID))
+}
+
+// KeyChannelEncoding creates and returns a new key used for channel encoding store operations
+func KeyChannelEncoding(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ChannelEncodingKeyPrefix, portID, channelID))
+}
+
+// KeyExecutionResultPrefix creates and returns the key prefix of the execution results stored for the provided portID and channelID
+func KeyExecutionResultPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ExecutionResultKeyPrefix, portID, channelID))
+}
+
+// KeyExecutionResult creates and returns a new key used for execution result store operations. The sequence is
+// encoded in big endian to ensure the execution results are iterated in order of packet sequence.
+func KeyExecutionResult(portID, channelID string, sequence uint64) []byte {
+	return append(KeyExecutionResultPrefix(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyAddressBlocklistPrefix returns the key prefix of the blocked addresses
+func KeyAddressBlocklistPrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", AddressBlocklistKeyPrefix))
+}
+
+// KeyBlockedAddress creates and returns a new key used for address blocklist store operations
+func KeyBlockedAddress(address string) []byte {
+	return append(KeyAddressBlocklistPrefix(), []byte(address)...)
+}
+
+// KeyPausedMessageTypePrefix returns the key prefix of the paused message types
+func KeyPausedMessageTypePrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", PausedMessageTypeKeyPrefix))
+}
+
+// KeyPausedMessageType creates and returns a new key used for paused message type store operations
+func KeyPausedMessageType(typeURL string) []byte {
+	return append(KeyPausedMessageTypePrefix(), []byte(typeURL)...)
+}
+
+// KeyCollectedExecutionFeesPrefix returns the key prefix of the execution fees collected over each connection
+func KeyCollectedExecutionFeesPrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", CollectedExecutionFeesKeyPrefix))
+}
+
+// KeyCollectedExecutionFees creates and returns a new key used for collected execution fees store operations
+func KeyCollectedExecutionFees(connectionID string) []byte {
+	return append(KeyCollectedExecutionFeesPrefix(), []byte(connectionID)...)
+}
+
+// KeyConnectionGasUsedPrefix returns the key prefix of the gas consumed over each connection
+func KeyConnectionGasUsedPrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", ConnectionGasUsedKeyPrefix))
+}
+
+// KeyConnectionGasUsed creates and returns a new key used for connection gas used store operations
+func KeyConnectionGasUsed(connectionID string) []byte {
+	return append(KeyConnectionGasUsedPrefix(), []byte(connectionID)...)
+}
+
+// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false. Entries of allowMsgs
+// provided as Msg service method names are compared using their canonical type URL form, see CanonicalMsgTypeURL
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
-	if len(allowMsgs) == 1 && allowMsgs[0] == "*" {
+	if len(allowMsgs) == 1 && allowMsgs[0] == AllowAllHostMsgs {
 		return true
 	}
 
+	typeURL := sdk.MsgTypeURL(msg)
 	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+		if canonical, _ := CanonicalMsgTypeURL(v); canonical == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanonicalMsgTypeURL returns the type URL form of the provided allow messages entry. Entries may be provided as a type URL,
+// e.g. /cosmos.staking.v1beta1.MsgDelegate, or as a Msg service method name, e.g. cosmos.staking.v1beta1.Msg/Delegate.
+// Msg service method names are converted to the type URL of the request message, following the SDK convention of naming
+// the request of the Msg service method {Method} Msg{Method}. The returned bool is true if the entry was converted.
+func CanonicalMsgTypeURL(allowMsg string) (string, bool) {
+	service, method, found := strings.Cut(strings.TrimPrefix(allowMsg, "/"), "/")
+	if !found || !strings.HasSuffix(service, ".Msg") || method == "" || strings.Contains(method, "/") {
+		return allowMsg, false
+	}
+
+	return "/" + service + method, true
+}
+
+// NormalizeAllowMessages returns the provided allow messages sorted and with duplicate entries removed, such that the
+// same set of allow messages is always stored identically regardless of the order in which it was provided
+func NormalizeAllowMessages(allowMsgs []string) []string {
+	if len(allowMsgs) == 0 {
+		return allowMsgs
+	}
+
+	normalized := make([]string, len(allowMsgs))
+	copy(normalized, allowMsgs)
+	sort.Strings(normalized)
+
+	n := 1
+	for _, allowMsg := range normalized[1:] {
+		if allowMsg != normalized[n-1] {
+			normalized[n] = allowMsg
+			n++
+		}
+	}
+
+	return normalized[:n]
+}
+
+// CanonicalizeAllowMessages returns the provided allow messages with each entry converted to its canonical type URL
+// form, along with the entries which were provided as Msg service method names. The provided allow messages are
+// returned unchanged if no entry was converted
+func CanonicalizeAllowMessages(allowMsgs []string) ([]string, []string) {
+	var (
+		canonicalMsgs []string
+		normalized    []string
+	)
+
+	for i, allowMsg := range allowMsgs {
+		canonical, converted := CanonicalMsgTypeURL(allowMsg)
+		if !converted {
+			continue
+		}
+
+		if canonicalMsgs == nil {
+			canonicalMsgs = make([]string, len(allowMsgs))
+			copy(canonicalMsgs, allowMsgs)
+		}
+
+		canonicalMsgs[i] = canonical
+		normalized = append(normalized, allowMsg)
+	}
+
+	if canonicalMsgs == nil {
+		return allowMsgs, nil
+	}
+
+	return canonicalMsgs, normalized
+}
+
+// ContainsQueryPath returns true if the provided gRPC query method path is contained within the provided list of
+// allowed query paths. Wildcards are not supported, each allowed query path must be listed explicitly
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	for _, v := range allowQueries {
+		if v == path {
 			return true
 		}
 	}
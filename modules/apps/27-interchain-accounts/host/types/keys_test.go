@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestContainsMsgType(t *testing.T) {
+	msg := &banktypes.MsgSend{}
+
+	testCases := []struct {
+		name      string
+		allowMsgs []string
+		expPass   bool
+	}{
+		{"type URL", []string{sdk.MsgTypeURL(msg)}, true},
+		{"Msg service method name", []string{"cosmos.bank.v1beta1.Msg/Send"}, true},
+		{"Msg service method name with leading slash", []string{"/cosmos.bank.v1beta1.Msg/Send"}, true},
+		{"mixed type URLs and Msg service method names", []string{"/cosmos.staking.v1beta1.MsgDelegate", "cosmos.bank.v1beta1.Msg/Send"}, true},
+		{"wildcard", []string{types.AllowAllHostMsgs}, true},
+		{"empty allow messages", []string{}, false},
+		{"different Msg service method", []string{"cosmos.bank.v1beta1.Msg/MultiSend"}, false},
+		{"different Msg service", []string{"cosmos.staking.v1beta1.Msg/Send"}, false},
+		{"type URL without leading slash", []string{"cosmos.bank.v1beta1.MsgSend"}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPass, types.ContainsMsgType(tc.allowMsgs, msg))
+		})
+	}
+}
+
+func TestCanonicalizeAllowMessages(t *testing.T) {
+	testCases := []struct {
+		name          string
+		allowMsgs     []string
+		expAllowMsgs  []string
+		expNormalized []string
+	}{
+		{"nil allow messages", nil, nil, nil},
+		{"type URLs", []string{"/cosmos.bank.v1beta1.MsgSend"}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil},
+		{"wildcard", []string{types.AllowAllHostMsgs}, []string{types.AllowAllHostMsgs}, nil},
+		{
+			"mixed type URLs and Msg service method names",
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "cosmos.staking.v1beta1.Msg/Delegate", "/cosmos.gov.v1beta1.Msg/Vote"},
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.gov.v1beta1.MsgVote"},
+			[]string{"cosmos.staking.v1beta1.Msg/Delegate", "/cosmos.gov.v1beta1.Msg/Vote"},
+		},
+		{"query method names are not converted", []string{"cosmos.bank.v1beta1.Query/Balance"}, []string{"cosmos.bank.v1beta1.Query/Balance"}, nil},
+		{"empty method is not converted", []string{"cosmos.bank.v1beta1.Msg/"}, []string{"cosmos.bank.v1beta1.Msg/"}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			allowMsgs := append([]string(nil), tc.allowMsgs...)
+
+			canonicalMsgs, normalized := types.CanonicalizeAllowMessages(allowMsgs)
+			require.Equal(t, tc.expAllowMsgs, canonicalMsgs)
+			require.Equal(t, tc.expNormalized, normalized)
+
+			// the provided allow messages are not modified
+			require.Equal(t, tc.allowMsgs, allowMsgs)
+		})
+	}
+}
+
+func TestNormalizeAllowMessages(t *testing.T) {
+	testCases := []struct {
+		name         string
+		allowMsgs    []string
+		expAllowMsgs []string
+	}{
+		{"nil allow messages", nil, nil},
+		{"empty allow messages", []string{}, []string{}},
+		{"wildcard", []string{types.AllowAllHostMsgs}, []string{types.AllowAllHostMsgs}},
+		{"sorted", []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}},
+		{"unsorted", []string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.bank.v1beta1.MsgSend"}, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}},
+		{
+			"duplicates",
+			[]string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.bank.v1beta1.MsgSend"},
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			allowMsgs := append([]string(nil), tc.allowMsgs...)
+			if tc.allowMsgs != nil && allowMsgs == nil {
+				allowMsgs = []string{}
+			}
+
+			require.Equal(t, tc.expAllowMsgs, types.NormalizeAllowMessages(allowMsgs))
+
+			// the provided allow messages are not modified
+			require.Equal(t, tc.allowMsgs, allowMsgs)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgValidator defines a function which may be used to veto msgs executed by interchain accounts. Msgs executed by the
+// host submodule do not pass through the ante handler of the host chain, a MsgValidator allows chain-level policies,
+// such as those implemented by ante decorators, to be applied to msgs executed by interchain accounts. An error returned
+// by the MsgValidator fails the execution of the msg.
+type MsgValidator func(ctx sdk.Context, msg sdk.Msg) error
+
+// NewAnteMsgValidator returns a MsgValidator running the provided ante decorators in order for each msg, allowing
+// existing policy code to be reused. Each msg is provided to the decorators as a transaction containing only that msg,
+// decorators must therefore only inspect the msgs of the transaction, decorators requiring fees or signatures are not
+// supported. Changes made by the decorators to the context, other than to the gas meter and the store, are discarded.
+func NewAnteMsgValidator(decorators ...sdk.AnteDecorator) MsgValidator {
+	anteHandler := sdk.ChainAnteDecorators(decorators...)
+
+	return func(ctx sdk.Context, msg sdk.Msg) error {
+		if anteHandler == nil {
+			return nil
+		}
+
+		_, err := anteHandler(ctx, msgTx{msg: msg}, false)
+		return err
+	}
+}
+
+var _ sdk.Tx = msgTx{}
+
+// msgTx is the transaction containing a single msg provided to the ante decorators of an ante MsgValidator
+type msgTx struct {
+	msg sdk.Msg
+}
+
+// GetMsgs implements sdk.Tx
+func (tx msgTx) GetMsgs() []sdk.Msg {
+	return []sdk.Msg{tx.msg}
+}
+
+// ValidateBasic implements sdk.Tx
+func (tx msgTx) ValidateBasic() error {
+	return tx.msg.ValidateBasic()
+}
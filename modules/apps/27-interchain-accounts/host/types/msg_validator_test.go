@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+var _ sdk.AnteDecorator = &mockDecorator{}
+
+// mockDecorator records the msgs of the transactions it is called with and rejects them if configured to
+type mockDecorator struct {
+	msgs   []sdk.Msg
+	reject bool
+}
+
+func (d *mockDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	d.msgs = append(d.msgs, tx.GetMsgs()...)
+	if d.reject {
+		return ctx, sdkerrors.ErrUnauthorized
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func TestNewAnteMsgValidator(t *testing.T) {
+	var (
+		first  = &mockDecorator{}
+		second = &mockDecorator{reject: true}
+		third  = &mockDecorator{}
+	)
+
+	msg := &banktypes.MsgSend{}
+
+	// decorators following a rejecting decorator are not called
+	validator := types.NewAnteMsgValidator(first, second, third)
+	require.ErrorIs(t, validator(sdk.Context{}, msg), sdkerrors.ErrUnauthorized)
+	require.Equal(t, []sdk.Msg{msg}, first.msgs)
+	require.Equal(t, []sdk.Msg{msg}, second.msgs)
+	require.Empty(t, third.msgs)
+
+	validator = types.NewAnteMsgValidator(first, third)
+	require.NoError(t, validator(sdk.Context{}, msg))
+	require.Len(t, first.msgs, 2)
+	require.Equal(t, []sdk.Msg{msg}, third.msgs)
+
+	require.NoError(t, types.NewAnteMsgValidator()(sdk.Context{}, msg))
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgPauseMessageType{}
+	_ sdk.Msg = &MsgUnpauseMessageType{}
+	_ sdk.Msg = &MsgResetGasByConnection{}
+)
+
+// NewMsgPauseMessageType creates a new instance of MsgPauseMessageType
+func NewMsgPauseMessageType(authority, typeURL string, expiryHeight uint64) *MsgPauseMessageType {
+	return &MsgPauseMessageType{
+		Authority:    authority,
+		TypeUrl:      typeURL,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgPauseMessageType) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from authority address")
+	}
+
+	return ValidateMsgTypeURL(msg.TypeUrl)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPauseMessageType) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgUnpauseMessageType creates a new instance of MsgUnpauseMessageType
+func NewMsgUnpauseMessageType(authority, typeURL string) *MsgUnpauseMessageType {
+	return &MsgUnpauseMessageType{
+		Authority: authority,
+		TypeUrl:   typeURL,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgUnpauseMessageType) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from authority address")
+	}
+
+	return ValidateMsgTypeURL(msg.TypeUrl)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUnpauseMessageType) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgResetGasByConnection creates a new instance of MsgResetGasByConnection
+func NewMsgResetGasByConnection(authority, connectionID string) *MsgResetGasByConnection {
+	return &MsgResetGasByConnection{
+		Authority:    authority,
+		ConnectionId: connectionID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation. The connection identifier is optional.
+func (msg MsgResetGasByConnection) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from authority address")
+	}
+
+	if msg.ConnectionId != "" {
+		return host.ConnectionIdentifierValidator(msg.ConnectionId)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgResetGasByConnection) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestMsgPauseMessageTypeValidateBasic(t *testing.T) {
+	var msg *types.MsgPauseMessageType
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: expiry height",
+			func() {
+				msg.ExpiryHeight = 100
+			},
+			true,
+		},
+		{
+			"invalid authority address",
+			func() {
+				msg.Authority = "invalid-authority"
+			},
+			false,
+		},
+		{
+			"empty type URL",
+			func() {
+				msg.TypeUrl = ""
+			},
+			false,
+		},
+		{
+			"type URL without leading slash",
+			func() {
+				msg.TypeUrl = "cosmos.gov.v1beta1.MsgVote"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgPauseMessageType(ibctesting.TestAccAddress, "/cosmos.gov.v1beta1.MsgVote", 0)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPauseMessageTypeGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgPauseMessageType(ibctesting.TestAccAddress, "/cosmos.gov.v1beta1.MsgVote", 0)
+	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
+}
+
+func TestMsgUnpauseMessageTypeValidateBasic(t *testing.T) {
+	var msg *types.MsgUnpauseMessageType
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority address",
+			func() {
+				msg.Authority = "invalid-authority"
+			},
+			false,
+		},
+		{
+			"type URL with whitespace",
+			func() {
+				msg.TypeUrl = "/cosmos.gov.v1beta1.MsgVote "
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgUnpauseMessageType(ibctesting.TestAccAddress, "/cosmos.gov.v1beta1.MsgVote")
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgUnpauseMessageTypeGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgUnpauseMessageType(ibctesting.TestAccAddress, "/cosmos.gov.v1beta1.MsgVote")
+	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
+}
+
+func TestMsgResetGasByConnectionValidateBasic(t *testing.T) {
+	var msg *types.MsgResetGasByConnection
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: all connections",
+			func() {
+				msg.ConnectionId = ""
+			},
+			true,
+		},
+		{
+			"invalid authority address",
+			func() {
+				msg.Authority = "invalid-authority"
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = "invalid connection"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgResetGasByConnection(ibctesting.TestAccAddress, ibctesting.FirstConnectionID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
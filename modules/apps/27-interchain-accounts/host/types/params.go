@@ -4,12 +4,32 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultMaxTxGas is the default value for the max tx gas param (set to 0, no limit)
+	DefaultMaxTxGas = 0
+	// DefaultMaxMsgsPerPacket is the default value for the max msgs per packet param (set to 0, no limit)
+	DefaultMaxMsgsPerPacket = 0
+	// DefaultMaxExecutionResults is the default value for the max execution results param (set to 0, storage disabled)
+	DefaultMaxExecutionResults = 0
+	// DefaultAllowMultiICASigners is the default value for the allow multi ICA signers param (set to false)
+	DefaultAllowMultiICASigners = false
+	// DefaultAllowAllWhenEmpty is the default value for the allow all when empty param (set to false)
+	DefaultAllowAllWhenEmpty = false
+	// DefaultMaxQueryResponseSize is the default value for the max query response size param (set to 0, no limit)
+	DefaultMaxQueryResponseSize = 0
+	// DefaultMaxPacketDataSize is the default value for the max packet data size param (set to 256 KiB)
+	DefaultMaxPacketDataSize = 256 * 1024
+	// DefaultMaxMemoLength is the default value for the max memo length param (set to 32 KiB)
+	DefaultMaxMemoLength = 32 * 1024
 )
 
 var (
@@ -17,6 +37,32 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyMaxTxGas is the store key for the MaxTxGas Params
+	KeyMaxTxGas = []byte("MaxTxGas")
+	// KeyMaxMsgsPerPacket is the store key for the MaxMsgsPerPacket Params
+	KeyMaxMsgsPerPacket = []byte("MaxMsgsPerPacket")
+	// KeyMaxExecutionResults is the store key for the MaxExecutionResults Params
+	KeyMaxExecutionResults = []byte("MaxExecutionResults")
+	// KeyAllowMultiICASigners is the store key for the AllowMultiICASigners Params
+	KeyAllowMultiICASigners = []byte("AllowMultiICASigners")
+	// KeyAllowAllWhenEmpty is the store key for the AllowAllWhenEmpty Params
+	KeyAllowAllWhenEmpty = []byte("AllowAllWhenEmpty")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyMaxQueryResponseSize is the store key for the MaxQueryResponseSize Params
+	KeyMaxQueryResponseSize = []byte("MaxQueryResponseSize")
+	// KeyMaxPacketDataSize is the store key for the MaxPacketDataSize Params
+	KeyMaxPacketDataSize = []byte("MaxPacketDataSize")
+	// KeyMaxMemoLength is the store key for the MaxMemoLength Params
+	KeyMaxMemoLength = []byte("MaxMemoLength")
+	// KeyTrustedControllerConnections is the store key for the TrustedControllerConnections Params
+	KeyTrustedControllerConnections = []byte("TrustedControllerConnections")
+	// KeyCircuitBreakerAuthority is the store key for the CircuitBreakerAuthority Params
+	KeyCircuitBreakerAuthority = []byte("CircuitBreakerAuthority")
+	// KeyExecutionFee is the store key for the ExecutionFee Params
+	KeyExecutionFee = []byte("ExecutionFee")
+	// KeyGasResetAuthority is the store key for the GasResetAuthority Params
+	KeyGasResetAuthority = []byte("GasResetAuthority")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -34,7 +80,17 @@ func NewParams(enableHost bool, allowMsgs []string) Params {
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return Params{
+		HostEnabled:          DefaultHostEnabled,
+		MaxTxGas:             DefaultMaxTxGas,
+		MaxMsgsPerPacket:     DefaultMaxMsgsPerPacket,
+		MaxExecutionResults:  DefaultMaxExecutionResults,
+		AllowMultiIcaSigners: DefaultAllowMultiICASigners,
+		AllowAllWhenEmpty:    DefaultAllowAllWhenEmpty,
+		MaxQueryResponseSize: DefaultMaxQueryResponseSize,
+		MaxPacketDataSize:    DefaultMaxPacketDataSize,
+		MaxMemoLength:        DefaultMaxMemoLength,
+	}
 }
 
 // Validate validates all host submodule parameters
@@ -47,6 +103,58 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxTxGas(p.MaxTxGas); err != nil {
+		return err
+	}
+
+	if err := validateMaxMsgsPerPacket(p.MaxMsgsPerPacket); err != nil {
+		return err
+	}
+
+	if err := validateMaxExecutionResults(p.MaxExecutionResults); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.AllowMultiIcaSigners); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.AllowAllWhenEmpty); err != nil {
+		return err
+	}
+
+	if err := validateAllowQueries(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateMaxQueryResponseSize(p.MaxQueryResponseSize); err != nil {
+		return err
+	}
+
+	if err := validateMaxPacketDataSize(p.MaxPacketDataSize); err != nil {
+		return err
+	}
+
+	if err := validateMaxMemoLength(p.MaxMemoLength); err != nil {
+		return err
+	}
+
+	if err := validateTrustedControllerConnections(p.TrustedControllerConnections); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerAuthority(p.CircuitBreakerAuthority); err != nil {
+		return err
+	}
+
+	if err := validateExecutionFee(p.ExecutionFee); err != nil {
+		return err
+	}
+
+	if err := validateGasResetAuthority(p.GasResetAuthority); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -55,6 +163,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyMaxTxGas, p.MaxTxGas, validateMaxTxGas),
+		paramtypes.NewParamSetPair(KeyMaxMsgsPerPacket, p.MaxMsgsPerPacket, validateMaxMsgsPerPacket),
+		paramtypes.NewParamSetPair(KeyMaxExecutionResults, p.MaxExecutionResults, validateMaxExecutionResults),
+		paramtypes.NewParamSetPair(KeyAllowMultiICASigners, p.AllowMultiIcaSigners, validateEnabled),
+		paramtypes.NewParamSetPair(KeyAllowAllWhenEmpty, p.AllowAllWhenEmpty, validateEnabled),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateAllowQueries),
+		paramtypes.NewParamSetPair(KeyMaxQueryResponseSize, p.MaxQueryResponseSize, validateMaxQueryResponseSize),
+		paramtypes.NewParamSetPair(KeyMaxPacketDataSize, p.MaxPacketDataSize, validateMaxPacketDataSize),
+		paramtypes.NewParamSetPair(KeyMaxMemoLength, p.MaxMemoLength, validateMaxMemoLength),
+		paramtypes.NewParamSetPair(KeyTrustedControllerConnections, p.TrustedControllerConnections, validateTrustedControllerConnections),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerAuthority, p.CircuitBreakerAuthority, validateCircuitBreakerAuthority),
+		paramtypes.NewParamSetPair(KeyExecutionFee, p.ExecutionFee, validateExecutionFee),
+		paramtypes.NewParamSetPair(KeyGasResetAuthority, p.GasResetAuthority, validateGasResetAuthority),
 	}
 }
 
@@ -73,10 +194,244 @@ func validateAllowlist(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	seen := make(map[string]struct{}, len(allowMsgs))
 	for _, typeURL := range allowMsgs {
 		if strings.TrimSpace(typeURL) == "" {
 			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
 		}
+
+		if typeURL == AllowAllHostMsgs && len(allowMsgs) > 1 {
+			return fmt.Errorf("parameter must not contain the wildcard %s alongside other message types: %s", AllowAllHostMsgs, allowMsgs)
+		}
+
+		// entries provided as Msg service method names duplicate the entries of their type URL form
+		canonical, _ := CanonicalMsgTypeURL(typeURL)
+		if _, ok := seen[canonical]; ok {
+			return fmt.Errorf("parameter must not contain duplicate message types: %s", canonical)
+		}
+
+		seen[canonical] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMaxTxGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxMsgsPerPacket(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxExecutionResults(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateAllowQueries ensures each allowed query is a fully qualified gRPC method path of the form /package.Service/Method
+func validateAllowQueries(i interface{}) error {
+	allowQueries, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, path := range allowQueries {
+		parts := strings.Split(path, "/")
+		if len(parts) != 3 || parts[0] != "" || strings.TrimSpace(parts[1]) == "" || strings.TrimSpace(parts[2]) == "" {
+			return fmt.Errorf("parameter must only contain gRPC method paths of the form /package.Service/Method: %s", path)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxQueryResponseSize(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPacketDataSize(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxMemoLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateTrustedControllerConnections ensures each trusted controller connection is a valid connection identifier present only once
+func validateTrustedControllerConnections(i interface{}) error {
+	connectionIDs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(connectionIDs))
+	for _, connectionID := range connectionIDs {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return fmt.Errorf("invalid trusted controller connection %s: %w", connectionID, err)
+		}
+
+		if seen[connectionID] {
+			return fmt.Errorf("parameter must not contain duplicate connections: %s", connectionID)
+		}
+		seen[connectionID] = true
+	}
+
+	return nil
+}
+
+// validateCircuitBreakerAuthority ensures the circuit breaker authority is empty or a valid bech32 address
+func validateCircuitBreakerAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if authority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid circuit breaker authority %s: %w", authority, err)
+	}
+
+	return nil
+}
+
+// validateExecutionFee ensures the execution fee is a valid set of coins, which may be empty
+func validateExecutionFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid execution fee %s: %w", fee, err)
+	}
+
+	return nil
+}
+
+// validateGasResetAuthority ensures the gas reset authority is empty or a valid bech32 address
+func validateGasResetAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if authority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid gas reset authority %s: %w", authority, err)
+	}
+
+	return nil
+}
+
+// NewConnectionAllowMessages creates a new ConnectionAllowMessages instance
+func NewConnectionAllowMessages(connectionID string, allowMsgs []string) ConnectionAllowMessages {
+	return ConnectionAllowMessages{
+		ConnectionId:  connectionID,
+		AllowMessages: allowMsgs,
+	}
+}
+
+// Validate performs basic validation of the ConnectionAllowMessages
+func (c ConnectionAllowMessages) Validate() error {
+	if err := host.ConnectionIdentifierValidator(c.ConnectionId); err != nil {
+		return err
+	}
+
+	return validateAllowlist(c.AllowMessages)
+}
+
+// NewPausedMessageType creates a new PausedMessageType instance
+func NewPausedMessageType(typeURL string, expiryHeight uint64) PausedMessageType {
+	return PausedMessageType{
+		TypeUrl:      typeURL,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// Validate performs basic validation of the PausedMessageType
+func (p PausedMessageType) Validate() error {
+	return ValidateMsgTypeURL(p.TypeUrl)
+}
+
+// IsActive returns true if the message type is paused at the provided block height
+func (p PausedMessageType) IsActive(height uint64) bool {
+	return p.ExpiryHeight == 0 || height <= p.ExpiryHeight
+}
+
+// ValidatePausedMessageTypes ensures each of the provided paused message types is valid and present only once
+func ValidatePausedMessageTypes(pausedMsgTypes []PausedMessageType) error {
+	seen := make(map[string]bool, len(pausedMsgTypes))
+	for _, pausedMsgType := range pausedMsgTypes {
+		if err := pausedMsgType.Validate(); err != nil {
+			return err
+		}
+
+		if seen[pausedMsgType.TypeUrl] {
+			return sdkerrors.Wrapf(ErrInvalidPausedMessageType, "duplicate message type %s", pausedMsgType.TypeUrl)
+		}
+		seen[pausedMsgType.TypeUrl] = true
+	}
+
+	return nil
+}
+
+// ValidateMsgTypeURL ensures the provided type URL is of the form /package.Msg
+func ValidateMsgTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 || strings.ContainsAny(typeURL, " \t\n") {
+		return sdkerrors.Wrapf(ErrInvalidPausedMessageType, "message type must be a type URL of the form /package.Msg: %s", typeURL)
+	}
+
+	return nil
+}
+
+// ValidateAddressBlocklist ensures each of the provided addresses is a valid bech32 account address present only once
+func ValidateAddressBlocklist(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBlocklist, "invalid address %s: %s", address, err)
+		}
+
+		if seen[address] {
+			return sdkerrors.Wrapf(ErrInvalidBlocklist, "duplicate address %s", address)
+		}
+		seen[address] = true
 	}
 
 	return nil
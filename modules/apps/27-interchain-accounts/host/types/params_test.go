@@ -3,12 +3,98 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
+	require.Equal(t, uint64(256*1024), types.DefaultParams().MaxPacketDataSize)
+	require.Equal(t, uint64(32*1024), types.DefaultParams().MaxMemoLength)
 	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(true, []string{types.AllowAllHostMsgs}).Validate())
+	require.Error(t, types.NewParams(true, []string{types.AllowAllHostMsgs, "/cosmos.bank.v1beta1.MsgSend"}).Validate())
+	require.Error(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend", types.AllowAllHostMsgs}).Validate())
+	require.Error(t, types.NewParams(true, []string{" "}).Validate())
+	require.Error(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
+	require.Error(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend", "cosmos.bank.v1beta1.Msg/Send"}).Validate())
+	require.NoError(t, types.Params{
+		HostEnabled:          true,
+		AllowQueries:         []string{"/cosmos.bank.v1beta1.Query/Balance"},
+		MaxQueryResponseSize: 1024,
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		AllowQueries: []string{""},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		AllowQueries: []string{types.AllowAllHostMsgs},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		AllowQueries: []string{"cosmos.bank.v1beta1.Query/Balance"},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		AllowQueries: []string{"/cosmos.bank.v1beta1.Query/"},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		AllowQueries: []string{"/cosmos.bank.v1beta1.Query/Balance/extra"},
+	}.Validate())
+	require.NoError(t, types.Params{
+		HostEnabled:                  true,
+		TrustedControllerConnections: []string{"connection-0", "connection-1"},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:                  true,
+		TrustedControllerConnections: []string{""},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:                  true,
+		TrustedControllerConnections: []string{"channel-0"},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:                  true,
+		TrustedControllerConnections: []string{"connection-0", "connection-0"},
+	}.Validate())
+	require.NoError(t, types.Params{
+		HostEnabled:             true,
+		CircuitBreakerAuthority: ibctesting.TestAccAddress,
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:             true,
+		CircuitBreakerAuthority: "invalid-authority",
+	}.Validate())
+	require.NoError(t, types.Params{
+		HostEnabled:  true,
+		ExecutionFee: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		ExecutionFee: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}},
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:  true,
+		ExecutionFee: sdk.Coins{sdk.Coin{Denom: "1invalid", Amount: sdk.NewInt(100)}},
+	}.Validate())
+	require.NoError(t, types.Params{
+		HostEnabled:       true,
+		GasResetAuthority: ibctesting.TestAccAddress,
+	}.Validate())
+	require.Error(t, types.Params{
+		HostEnabled:       true,
+		GasResetAuthority: "invalid-authority",
+	}.Validate())
+}
+
+func TestPausedMessageTypeIsActive(t *testing.T) {
+	require.True(t, types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 0).IsActive(100))
+	require.True(t, types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 100).IsActive(99))
+	require.True(t, types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 100).IsActive(100))
+	require.False(t, types.NewPausedMessageType("/cosmos.gov.v1beta1.MsgVote", 100).IsActive(101))
 }
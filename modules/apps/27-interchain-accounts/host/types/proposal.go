@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateAllowMessages defines the type for an UpdateAllowMessagesProposal
+	ProposalTypeUpdateAllowMessages = "UpdateAllowMessages"
+	// ProposalTypeUpdateAddressBlocklist defines the type for an UpdateAddressBlocklistProposal
+	ProposalTypeUpdateAddressBlocklist = "UpdateAddressBlocklist"
+)
+
+var (
+	_ govtypes.Content = &UpdateAllowMessagesProposal{}
+	_ govtypes.Content = &UpdateAddressBlocklistProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateAllowMessages)
+	govtypes.RegisterProposalType(ProposalTypeUpdateAddressBlocklist)
+}
+
+// NewUpdateAllowMessagesProposal creates a new proposal for updating the host allow messages.
+func NewUpdateAllowMessagesProposal(title, description string, allowMsgs []string) govtypes.Content {
+	return &UpdateAllowMessagesProposal{
+		Title:         title,
+		Description:   description,
+		AllowMessages: allowMsgs,
+	}
+}
+
+// GetTitle returns the title of an update allow messages proposal.
+func (p *UpdateAllowMessagesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update allow messages proposal.
+func (p *UpdateAllowMessagesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update allow messages proposal.
+func (p *UpdateAllowMessagesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update allow messages proposal.
+func (p *UpdateAllowMessagesProposal) ProposalType() string { return ProposalTypeUpdateAllowMessages }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateAllowMessagesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validateAllowlist(p.AllowMessages)
+}
+
+// NewUpdateAddressBlocklistProposal creates a new proposal for adding and removing addresses from the host address blocklist.
+func NewUpdateAddressBlocklistProposal(title, description string, addAddresses, removeAddresses []string) govtypes.Content {
+	return &UpdateAddressBlocklistProposal{
+		Title:           title,
+		Description:     description,
+		AddAddresses:    addAddresses,
+		RemoveAddresses: removeAddresses,
+	}
+}
+
+// GetTitle returns the title of an update address blocklist proposal.
+func (p *UpdateAddressBlocklistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update address blocklist proposal.
+func (p *UpdateAddressBlocklistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update address blocklist proposal.
+func (p *UpdateAddressBlocklistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update address blocklist proposal.
+func (p *UpdateAddressBlocklistProposal) ProposalType() string {
+	return ProposalTypeUpdateAddressBlocklist
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateAddressBlocklistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.AddAddresses) == 0 && len(p.RemoveAddresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBlocklist, "proposal must add or remove at least one address")
+	}
+
+	if err := ValidateAddressBlocklist(append(append([]string{}, p.AddAddresses...), p.RemoveAddresses...)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestUpdateAllowMessagesProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.UpdateAllowMessagesProposal
+		expPass  bool
+	}{
+		{"success", &types.UpdateAllowMessagesProposal{Title: "title", Description: "description", AllowMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}}, true},
+		{"success: wildcard", &types.UpdateAllowMessagesProposal{Title: "title", Description: "description", AllowMessages: []string{types.AllowAllHostMsgs}}, true},
+		{"empty title", &types.UpdateAllowMessagesProposal{Title: "", Description: "description", AllowMessages: []string{types.AllowAllHostMsgs}}, false},
+		{"empty type URL", &types.UpdateAllowMessagesProposal{Title: "title", Description: "description", AllowMessages: []string{""}}, false},
+		{"wildcard alongside other entries", &types.UpdateAllowMessagesProposal{Title: "title", Description: "description", AllowMessages: []string{types.AllowAllHostMsgs, "/cosmos.bank.v1beta1.MsgSend"}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUpdateAddressBlocklistProposalValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("address1").String()
+	addr2 := sdk.AccAddress("address2").String()
+
+	testCases := []struct {
+		name     string
+		proposal *types.UpdateAddressBlocklistProposal
+		expPass  bool
+	}{
+		{"success: add", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description", AddAddresses: []string{addr}}, true},
+		{"success: remove", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description", RemoveAddresses: []string{addr}}, true},
+		{"success: add and remove", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description", AddAddresses: []string{addr}, RemoveAddresses: []string{addr2}}, true},
+		{"empty title", &types.UpdateAddressBlocklistProposal{Title: "", Description: "description", AddAddresses: []string{addr}}, false},
+		{"no addresses", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description"}, false},
+		{"invalid address", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description", AddAddresses: []string{"invalid"}}, false},
+		{"duplicate address", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description", AddAddresses: []string{addr, addr}}, false},
+		{"address both added and removed", &types.UpdateAddressBlocklistProposal{Title: "title", Description: "description", AddAddresses: []string{addr}, RemoveAddresses: []string{addr}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignerResolver defines the interface which may be used to permit msgs executed by an interchain account to be signed
+// by addresses other than the interchain account itself. By default the host submodule requires each signer of a msg to
+// be the executing interchain account. A SignerResolver may accept additional signers which are provably controlled by
+// the interchain account, for example group policies administered by the interchain account.
+type SignerResolver interface {
+	// IsSignerControlled returns true if the provided signer of the msg is controlled by the executing interchain account,
+	// such that the interchain account may execute the msg on behalf of the signer.
+	IsSignerControlled(ctx sdk.Context, interchainAccount, signer sdk.AccAddress, msg sdk.Msg) bool
+}
+
+var _ SignerResolver = MultiSignerResolver{}
+
+// MultiSignerResolver combines multiple SignerResolvers, accepting a signer if any of them accepts it
+type MultiSignerResolver []SignerResolver
+
+// NewMultiSignerResolver creates a new MultiSignerResolver instance
+func NewMultiSignerResolver(resolvers ...SignerResolver) MultiSignerResolver {
+	return resolvers
+}
+
+// IsSignerControlled implements SignerResolver, returning true if any of the resolvers accepts the signer
+func (r MultiSignerResolver) IsSignerControlled(ctx sdk.Context, interchainAccount, signer sdk.AccAddress, msg sdk.Msg) bool {
+	for _, resolver := range r {
+		if resolver.IsSignerControlled(ctx, interchainAccount, signer, msg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var _ SignerResolver = AuthzSignerResolver{}
+
+// AuthzSignerResolver is a SignerResolver accepting the signers which granted the interchain account an x/authz
+// authorization for the msg type. Only authorizations accepting the msg without being updated or deleted, such as a
+// GenericAuthorization, are considered, as the msg is executed directly rather than through an authz MsgExec.
+// Msgs relying on authorizations with limits, such as a SendAuthorization, must be executed using an authz MsgExec.
+type AuthzSignerResolver struct {
+	authzKeeper AuthzKeeper
+}
+
+// NewAuthzSignerResolver creates a new AuthzSignerResolver instance
+func NewAuthzSignerResolver(authzKeeper AuthzKeeper) AuthzSignerResolver {
+	return AuthzSignerResolver{
+		authzKeeper: authzKeeper,
+	}
+}
+
+// IsSignerControlled implements SignerResolver, returning true if the signer granted the interchain account an unexpired
+// authorization which accepts the msg without requiring an update
+func (r AuthzSignerResolver) IsSignerControlled(ctx sdk.Context, interchainAccount, signer sdk.AccAddress, msg sdk.Msg) bool {
+	authorization, _ := r.authzKeeper.GetCleanAuthorization(ctx, interchainAccount, signer, sdk.MsgTypeURL(msg))
+	if authorization == nil {
+		return false
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return false
+	}
+
+	return resp.Accept && !resp.Delete && resp.Updated == nil
+}
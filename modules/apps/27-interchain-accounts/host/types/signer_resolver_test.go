@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+var _ types.SignerResolver = &mockSignerResolver{}
+
+// mockSignerResolver records the number of times it is called and accepts signers if configured to
+type mockSignerResolver struct {
+	calls  int
+	accept bool
+}
+
+func (r *mockSignerResolver) IsSignerControlled(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Msg) bool {
+	r.calls++
+	return r.accept
+}
+
+func TestMultiSignerResolver(t *testing.T) {
+	var (
+		first  = &mockSignerResolver{}
+		second = &mockSignerResolver{accept: true}
+		third  = &mockSignerResolver{}
+	)
+
+	// resolvers following an accepting resolver are not called
+	resolver := types.NewMultiSignerResolver(first, second, third)
+	require.True(t, resolver.IsSignerControlled(sdk.Context{}, nil, nil, nil))
+	require.Equal(t, []int{1, 1, 0}, []int{first.calls, second.calls, third.calls})
+
+	resolver = types.NewMultiSignerResolver(first, third)
+	require.False(t, resolver.IsSignerControlled(sdk.Context{}, nil, nil, nil))
+	require.Equal(t, []int{2, 1, 1}, []int{first.calls, second.calls, third.calls})
+
+	require.False(t, types.NewMultiSignerResolver().IsSignerControlled(sdk.Context{}, nil, nil, nil))
+}
@@ -0,0 +1,1350 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/host/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgPauseMessageType defines the payload for Msg/PauseMessageType
+type MsgPauseMessageType struct {
+	// the circuit breaker authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the type URL of the message type to be paused
+	TypeUrl string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// the block height after which the message type is no longer paused. A value of 0 indicates no expiry.
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+}
+
+func (m *MsgPauseMessageType) Reset()         { *m = MsgPauseMessageType{} }
+func (m *MsgPauseMessageType) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMessageType) ProtoMessage()    {}
+func (*MsgPauseMessageType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{0}
+}
+func (m *MsgPauseMessageType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMessageType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMessageType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseMessageType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMessageType.Merge(m, src)
+}
+func (m *MsgPauseMessageType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMessageType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMessageType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseMessageType proto.InternalMessageInfo
+
+// MsgPauseMessageTypeResponse defines the response for Msg/PauseMessageType
+type MsgPauseMessageTypeResponse struct {
+}
+
+func (m *MsgPauseMessageTypeResponse) Reset()         { *m = MsgPauseMessageTypeResponse{} }
+func (m *MsgPauseMessageTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMessageTypeResponse) ProtoMessage()    {}
+func (*MsgPauseMessageTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{1}
+}
+func (m *MsgPauseMessageTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMessageTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMessageTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseMessageTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMessageTypeResponse.Merge(m, src)
+}
+func (m *MsgPauseMessageTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMessageTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMessageTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseMessageTypeResponse proto.InternalMessageInfo
+
+// MsgUnpauseMessageType defines the payload for Msg/UnpauseMessageType
+type MsgUnpauseMessageType struct {
+	// the circuit breaker authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the type URL of the message type to be unpaused
+	TypeUrl string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+}
+
+func (m *MsgUnpauseMessageType) Reset()         { *m = MsgUnpauseMessageType{} }
+func (m *MsgUnpauseMessageType) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseMessageType) ProtoMessage()    {}
+func (*MsgUnpauseMessageType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{2}
+}
+func (m *MsgUnpauseMessageType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseMessageType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseMessageType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseMessageType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseMessageType.Merge(m, src)
+}
+func (m *MsgUnpauseMessageType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseMessageType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseMessageType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseMessageType proto.InternalMessageInfo
+
+// MsgUnpauseMessageTypeResponse defines the response for Msg/UnpauseMessageType
+type MsgUnpauseMessageTypeResponse struct {
+}
+
+func (m *MsgUnpauseMessageTypeResponse) Reset()         { *m = MsgUnpauseMessageTypeResponse{} }
+func (m *MsgUnpauseMessageTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseMessageTypeResponse) ProtoMessage()    {}
+func (*MsgUnpauseMessageTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{3}
+}
+func (m *MsgUnpauseMessageTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseMessageTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseMessageTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseMessageTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseMessageTypeResponse.Merge(m, src)
+}
+func (m *MsgUnpauseMessageTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseMessageTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseMessageTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseMessageTypeResponse proto.InternalMessageInfo
+
+// MsgResetGasByConnection defines the payload for Msg/ResetGasByConnection
+type MsgResetGasByConnection struct {
+	// the gas reset authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the optional connection identifier of the counter to be reset, all counters are reset if empty
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *MsgResetGasByConnection) Reset()         { *m = MsgResetGasByConnection{} }
+func (m *MsgResetGasByConnection) String() string { return proto.CompactTextString(m) }
+func (*MsgResetGasByConnection) ProtoMessage()    {}
+func (*MsgResetGasByConnection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{4}
+}
+func (m *MsgResetGasByConnection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetGasByConnection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetGasByConnection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetGasByConnection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetGasByConnection.Merge(m, src)
+}
+func (m *MsgResetGasByConnection) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetGasByConnection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetGasByConnection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetGasByConnection proto.InternalMessageInfo
+
+// MsgResetGasByConnectionResponse defines the response for Msg/ResetGasByConnection
+type MsgResetGasByConnectionResponse struct {
+}
+
+func (m *MsgResetGasByConnectionResponse) Reset()         { *m = MsgResetGasByConnectionResponse{} }
+func (m *MsgResetGasByConnectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetGasByConnectionResponse) ProtoMessage()    {}
+func (*MsgResetGasByConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{5}
+}
+func (m *MsgResetGasByConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetGasByConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetGasByConnectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetGasByConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetGasByConnectionResponse.Merge(m, src)
+}
+func (m *MsgResetGasByConnectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetGasByConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetGasByConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetGasByConnectionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgPauseMessageType)(nil), "ibc.applications.interchain_accounts.host.v1.MsgPauseMessageType")
+	proto.RegisterType((*MsgPauseMessageTypeResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgPauseMessageTypeResponse")
+	proto.RegisterType((*MsgUnpauseMessageType)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUnpauseMessageType")
+	proto.RegisterType((*MsgUnpauseMessageTypeResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUnpauseMessageTypeResponse")
+	proto.RegisterType((*MsgResetGasByConnection)(nil), "ibc.applications.interchain_accounts.host.v1.MsgResetGasByConnection")
+	proto.RegisterType((*MsgResetGasByConnectionResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgResetGasByConnectionResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/tx.proto", fileDescriptor_fa437afde7f1e7ae)
+}
+
+var fileDescriptor_fa437afde7f1e7ae = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0xb6, 0x68, 0x3b, 0xb4, 0x28, 0xe9, 0x8a, 0xcb, 0x6a, 0x93, 0x9a, 0x53, 0x0f,
+	0xee, 0x0c, 0xad, 0x8a, 0x50, 0xe8, 0xc1, 0x2d, 0xa2, 0x55, 0x02, 0x12, 0xec, 0xc5, 0xcb, 0x32,
+	0x99, 0x1d, 0x26, 0x03, 0xd9, 0x4c, 0xc8, 0x9b, 0x2c, 0xcd, 0xad, 0x47, 0x8f, 0xfe, 0x09, 0x7b,
+	0xf2, 0xd4, 0x3f, 0xc4, 0x63, 0x8f, 0x9e, 0x8a, 0xec, 0x5e, 0x3c, 0xf7, 0x2f, 0x90, 0xec, 0xba,
+	0xbf, 0x68, 0x44, 0x16, 0xdb, 0xdb, 0xbc, 0xbc, 0x7c, 0xbf, 0xef, 0x93, 0xbc, 0x37, 0x0f, 0xbf,
+	0x54, 0x21, 0xa7, 0x2c, 0x4d, 0x63, 0xc5, 0x99, 0x51, 0x3a, 0x01, 0xaa, 0x12, 0x23, 0x32, 0x1e,
+	0x31, 0x95, 0xb4, 0x19, 0xe7, 0x3a, 0x4f, 0x0c, 0xd0, 0x48, 0x83, 0xa1, 0xbd, 0x3d, 0x6a, 0x4e,
+	0x49, 0x9a, 0x69, 0xa3, 0xed, 0x67, 0x2a, 0xe4, 0x64, 0x5e, 0x46, 0x2a, 0x64, 0xa4, 0x94, 0x91,
+	0xde, 0x5e, 0xa3, 0x26, 0xb5, 0xd4, 0x23, 0x21, 0x2d, 0x4f, 0x63, 0x0f, 0xef, 0x1c, 0xe1, 0x2d,
+	0x1f, 0xe4, 0x47, 0x96, 0x83, 0xf0, 0x05, 0x00, 0x93, 0xe2, 0x53, 0x91, 0x0a, 0xfb, 0x09, 0x5e,
+	0x67, 0xb9, 0x89, 0x74, 0xa6, 0x4c, 0x51, 0x47, 0x3b, 0x68, 0x77, 0x3d, 0x98, 0x3d, 0xb0, 0x09,
+	0x5e, 0x33, 0x45, 0x2a, 0xda, 0x79, 0x16, 0xd7, 0xef, 0x94, 0xc9, 0xd6, 0xd6, 0xd5, 0xa5, 0x7b,
+	0xbf, 0x60, 0xdd, 0xf8, 0xc0, 0x9b, 0x64, 0xbc, 0xe0, 0x5e, 0x79, 0x3c, 0xc9, 0x62, 0xfb, 0x10,
+	0x6f, 0x8a, 0xd3, 0x54, 0x65, 0x45, 0x3b, 0x12, 0x4a, 0x46, 0xa6, 0xbe, 0xb2, 0x83, 0x76, 0x57,
+	0x5b, 0xf5, 0xab, 0x4b, 0xb7, 0x36, 0x16, 0x2d, 0xa4, 0xbd, 0x60, 0x63, 0x1c, 0xbf, 0x1b, 0x85,
+	0x07, 0x6b, 0x5f, 0xfa, 0xae, 0xf5, 0xab, 0xef, 0x5a, 0xde, 0x36, 0x7e, 0x5c, 0x41, 0x1b, 0x08,
+	0x48, 0x75, 0x02, 0xc2, 0xd3, 0xf8, 0xa1, 0x0f, 0xf2, 0x24, 0x49, 0x6f, 0xf5, 0x73, 0xe6, 0x78,
+	0x5c, 0xbc, 0x5d, 0x59, 0x70, 0x4a, 0x74, 0x86, 0xf0, 0x23, 0x1f, 0x64, 0x20, 0x40, 0x98, 0xb7,
+	0x0c, 0x5a, 0xc5, 0x91, 0x4e, 0x12, 0xc1, 0xcb, 0x76, 0xfd, 0x03, 0xea, 0x10, 0x6f, 0xf2, 0xe9,
+	0xbb, 0x6d, 0xd5, 0xf9, 0x43, 0x36, 0xf7, 0xcf, 0x16, 0xd2, 0x5e, 0xb0, 0x31, 0x8b, 0x8f, 0x3b,
+	0x73, 0x8c, 0x4f, 0xb1, 0xfb, 0x17, 0x82, 0x09, 0xe5, 0xfe, 0xd9, 0x2a, 0x5e, 0xf1, 0x41, 0xda,
+	0x7d, 0x84, 0x1f, 0x5c, 0x1b, 0x85, 0xd7, 0x64, 0x99, 0x39, 0x23, 0x15, 0xfd, 0x69, 0x1c, 0xff,
+	0xb7, 0xc5, 0x04, 0xd5, 0xfe, 0x86, 0xb0, 0x5d, 0xd1, 0xe0, 0xa3, 0xa5, 0x2b, 0x5c, 0x37, 0x69,
+	0x7c, 0xb8, 0x01, 0x93, 0x29, 0xe8, 0x39, 0xc2, 0xb5, 0xca, 0xb6, 0xbf, 0x59, 0xba, 0x4a, 0x95,
+	0x4d, 0xc3, 0xbf, 0x11, 0x9b, 0x09, 0x6e, 0xab, 0xf3, 0x7d, 0xe0, 0xa0, 0x8b, 0x81, 0x83, 0x7e,
+	0x0e, 0x1c, 0xf4, 0x75, 0xe8, 0x58, 0x17, 0x43, 0xc7, 0xfa, 0x31, 0x74, 0xac, 0xcf, 0xef, 0xa5,
+	0x32, 0x51, 0x1e, 0x12, 0xae, 0xbb, 0x94, 0x6b, 0xe8, 0x6a, 0xa0, 0x2a, 0xe4, 0x4d, 0xa9, 0x69,
+	0xef, 0x05, 0xed, 0xea, 0x4e, 0x1e, 0x0b, 0x28, 0xb7, 0x17, 0xd0, 0xfd, 0x57, 0xcd, 0x19, 0x42,
+	0x73, 0x71, 0x71, 0x95, 0x77, 0x07, 0xc2, 0xbb, 0xa3, 0xad, 0xf3, 0xfc, 0xf7, 0x00, 0x2d, 0xcc,
+	0x5d, 0x0d, 0xf2, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// PauseMessageType defines a rpc handler for MsgPauseMessageType.
+	PauseMessageType(ctx context.Context, in *MsgPauseMessageType, opts ...grpc.CallOption) (*MsgPauseMessageTypeResponse, error)
+	// UnpauseMessageType defines a rpc handler for MsgUnpauseMessageType.
+	UnpauseMessageType(ctx context.Context, in *MsgUnpauseMessageType, opts ...grpc.CallOption) (*MsgUnpauseMessageTypeResponse, error)
+	// ResetGasByConnection defines a rpc handler for MsgResetGasByConnection.
+	ResetGasByConnection(ctx context.Context, in *MsgResetGasByConnection, opts ...grpc.CallOption) (*MsgResetGasByConnectionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PauseMessageType(ctx context.Context, in *MsgPauseMessageType, opts ...grpc.CallOption) (*MsgPauseMessageTypeResponse, error) {
+	out := new(MsgPauseMessageTypeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/PauseMessageType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseMessageType(ctx context.Context, in *MsgUnpauseMessageType, opts ...grpc.CallOption) (*MsgUnpauseMessageTypeResponse, error) {
+	out := new(MsgUnpauseMessageTypeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/UnpauseMessageType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetGasByConnection(ctx context.Context, in *MsgResetGasByConnection, opts ...grpc.CallOption) (*MsgResetGasByConnectionResponse, error) {
+	out := new(MsgResetGasByConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/ResetGasByConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// PauseMessageType defines a rpc handler for MsgPauseMessageType.
+	PauseMessageType(context.Context, *MsgPauseMessageType) (*MsgPauseMessageTypeResponse, error)
+	// UnpauseMessageType defines a rpc handler for MsgUnpauseMessageType.
+	UnpauseMessageType(context.Context, *MsgUnpauseMessageType) (*MsgUnpauseMessageTypeResponse, error)
+	// ResetGasByConnection defines a rpc handler for MsgResetGasByConnection.
+	ResetGasByConnection(context.Context, *MsgResetGasByConnection) (*MsgResetGasByConnectionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) PauseMessageType(ctx context.Context, req *MsgPauseMessageType) (*MsgPauseMessageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMessageType not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseMessageType(ctx context.Context, req *MsgUnpauseMessageType) (*MsgUnpauseMessageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseMessageType not implemented")
+}
+func (*UnimplementedMsgServer) ResetGasByConnection(ctx context.Context, req *MsgResetGasByConnection) (*MsgResetGasByConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetGasByConnection not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_PauseMessageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseMessageType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseMessageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/PauseMessageType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseMessageType(ctx, req.(*MsgPauseMessageType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseMessageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseMessageType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseMessageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/UnpauseMessageType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseMessageType(ctx, req.(*MsgUnpauseMessageType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetGasByConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetGasByConnection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetGasByConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/ResetGasByConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetGasByConnection(ctx, req.(*MsgResetGasByConnection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PauseMessageType",
+			Handler:    _Msg_PauseMessageType_Handler,
+		},
+		{
+			MethodName: "UnpauseMessageType",
+			Handler:    _Msg_UnpauseMessageType_Handler,
+		},
+		{
+			MethodName: "ResetGasByConnection",
+			Handler:    _Msg_ResetGasByConnection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
+}
+
+func (m *MsgPauseMessageType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMessageType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMessageType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseMessageTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMessageTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMessageTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseMessageType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseMessageType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseMessageType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseMessageTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseMessageTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseMessageTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetGasByConnection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetGasByConnection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetGasByConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetGasByConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetGasByConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetGasByConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPauseMessageType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgPauseMessageTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseMessageType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseMessageTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetGasByConnection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetGasByConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPauseMessageType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseMessageType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseMessageType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseMessageTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseMessageTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseMessageTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseMessageType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseMessageType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseMessageType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseMessageTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseMessageTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseMessageTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetGasByConnection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetGasByConnection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetGasByConnection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetGasByConnectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetGasByConnectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetGasByConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,43 +1,199 @@
 package logger
 
 import (
-	"log"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
-var (
-	InfoLogger  *log.Logger
-	ErrorLogger *log.Logger
+// ModuleName is the value of the module key attached to all log lines written by the interchain accounts host submodule
+const ModuleName = "x/ica-host"
+
+const (
+	// EnvLogLevel is the environment variable used to set the level of the logger, one of debug, info or error
+	EnvLogLevel = "ICA_LOG_LEVEL"
+	// EnvLogVerboseMsgs is the environment variable used to enable logging the contents of messages, one of true or false
+	EnvLogVerboseMsgs = "ICA_LOG_VERBOSE_MSGS"
 )
 
-func InitLogger() {
-	path := "logs/"
-	fileName := "ica_host.log"
+// Level defines the minimum level of the log lines written by the logger
+type Level int32
+
+const (
+	// LevelDebug writes debug, info and error log lines
+	LevelDebug Level = iota
+	// LevelInfo writes info and error log lines, this is the default level
+	LevelInfo
+	// LevelError only writes error log lines
+	LevelError
+)
+
+// level is the minimum level of the log lines written by the logger
+var level = int32(LevelInfo)
+
+// String returns the lower case name of the level
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelError:
+		return "error"
+	default:
+		return fmt.Sprintf("level(%d)", int32(l))
+	}
+}
+
+// ParseLevel returns the Level corresponding to the provided case insensitive level name
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return 0, fmt.Errorf("invalid log level %s, expected one of debug, info or error", name)
+	}
+}
+
+// SetLevel sets the minimum level of the log lines written by the logger. Log lines at or above the level are
+// additionally subject to the log level configured for the node
+func SetLevel(l Level) {
+	atomic.StoreInt32(&level, int32(l))
+}
+
+// Enabled returns true if log lines of the provided level are written by the logger, allowing callers to skip
+// computing the values of log lines which would not be written
+func Enabled(l Level) bool {
+	return int32(l) >= atomic.LoadInt32(&level)
+}
 
-	// Create directory if it doesn't exist
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		err := os.MkdirAll(path, os.ModePerm)
+// ConfigureFromEnv sets the level of the logger from the EnvLogLevel environment variable and enables logging the
+// contents of messages using the EnvLogVerboseMsgs environment variable, if set. It is not called by the host keeper
+// and should be called by the application on startup, an error is returned if a value is invalid
+func ConfigureFromEnv() error {
+	if name, ok := os.LookupEnv(EnvLogLevel); ok {
+		l, err := ParseLevel(name)
 		if err != nil {
-			log.Fatal(err)
+			return err
 		}
+
+		SetLevel(l)
+	}
+
+	if value, ok := os.LookupEnv(EnvLogVerboseMsgs); ok {
+		verbose, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %s for %s: %w", value, EnvLogVerboseMsgs, err)
+		}
+
+		SetVerboseMsgs(verbose)
+	}
+
+	return nil
+}
+
+// Logger returns the logger of the provided context, scoped to the interchain accounts host submodule
+func Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", ModuleName)
+}
+
+// LogDebug writes the provided values at debug level. The values are formatted as with fmt.Println,
+// no formatting is performed if the level of the logger is above debug
+func LogDebug(ctx sdk.Context, v ...interface{}) {
+	if Enabled(LevelDebug) {
+		Logger(ctx).Debug(sprintln(v...))
+	}
+}
+
+// LogInfo writes the provided values at info level. The values are formatted as with fmt.Println,
+// no formatting is performed if the level of the logger is above info
+func LogInfo(ctx sdk.Context, v ...interface{}) {
+	if Enabled(LevelInfo) {
+		Logger(ctx).Info(sprintln(v...))
+	}
+}
+
+// LogError writes the provided values at error level. The values are formatted as with fmt.Println
+func LogError(ctx sdk.Context, v ...interface{}) {
+	if Enabled(LevelError) {
+		Logger(ctx).Error(sprintln(v...))
+	}
+}
+
+// LogDebugKV writes the provided message and key value pairs at debug level as a structured log line. The values are
+// evaluated by the caller, values which are expensive to compute should only be computed if Enabled returns true
+func LogDebugKV(ctx sdk.Context, msg string, keyvals ...interface{}) {
+	if Enabled(LevelDebug) {
+		Logger(ctx).Debug(msg, keyvals...)
+	}
+}
+
+// LogInfoKV writes the provided message and key value pairs at info level as a structured log line
+func LogInfoKV(ctx sdk.Context, msg string, keyvals ...interface{}) {
+	if Enabled(LevelInfo) {
+		Logger(ctx).Info(msg, keyvals...)
 	}
+}
 
-	// Open the file in append mode, create it if it doesn't exist
-	file, err := os.OpenFile(path+fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
-	if err != nil {
-		log.Fatal(err)
+// LogErrorKV writes the provided message and key value pairs at error level as a structured log line
+func LogErrorKV(ctx sdk.Context, msg string, keyvals ...interface{}) {
+	if Enabled(LevelError) {
+		Logger(ctx).Error(msg, keyvals...)
 	}
+}
 
-	InfoLogger = log.New(file, "INFO: ", log.Ldate|log.Ltime)
-	ErrorLogger = log.New(file, "ERROR: ", log.Ldate|log.Ltime|log.Lshortfile)
+// ScopedLogger writes structured log lines stamped with a fixed set of key value pairs
+type ScopedLogger struct {
+	logger log.Logger
 }
 
-// Exported function for info logging
-func LogInfo(v ...interface{}) {
-	InfoLogger.Println(v...)
+// WithPacket returns a ScopedLogger stamping the source and destination port and channel identifiers and the
+// sequence of the provided packet on every log line, allowing the lifecycle of a packet to be filtered by sequence
+func WithPacket(ctx sdk.Context, packet channeltypes.Packet) ScopedLogger {
+	return ScopedLogger{
+		logger: Logger(ctx).With(
+			"source_port", packet.GetSourcePort(),
+			"source_channel", packet.GetSourceChannel(),
+			"destination_port", packet.GetDestPort(),
+			"destination_channel", packet.GetDestChannel(),
+			"sequence", packet.GetSequence(),
+		),
+	}
+}
+
+// Debug writes the provided message and key value pairs at debug level
+func (l ScopedLogger) Debug(msg string, keyvals ...interface{}) {
+	if Enabled(LevelDebug) {
+		l.logger.Debug(msg, keyvals...)
+	}
+}
+
+// Info writes the provided message and key value pairs at info level
+func (l ScopedLogger) Info(msg string, keyvals ...interface{}) {
+	if Enabled(LevelInfo) {
+		l.logger.Info(msg, keyvals...)
+	}
+}
+
+// Error writes the provided message and key value pairs at error level
+func (l ScopedLogger) Error(msg string, keyvals ...interface{}) {
+	if Enabled(LevelError) {
+		l.logger.Error(msg, keyvals...)
+	}
 }
 
-// Exported function for err logging
-func LogError(v ...interface{}) {
-	ErrorLogger.Println(v...)
+// sprintln formats the provided values as with fmt.Println, without the trailing newline
+func sprintln(v ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintln(v...), "\n")
 }
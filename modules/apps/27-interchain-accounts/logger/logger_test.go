@@ -0,0 +1,230 @@
+package logger_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
+)
+
+func newContext(buf *bytes.Buffer) sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewTMLogger(log.NewSyncWriter(buf)))
+}
+
+func TestLogInfo(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger.LogInfo(newContext(&buf), "number of messages:", 2)
+
+	output := buf.String()
+	require.Contains(t, output, "I[")
+	require.Contains(t, output, "number of messages: 2")
+	require.Contains(t, output, "module="+logger.ModuleName)
+}
+
+func TestLogError(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger.LogError(newContext(&buf), "failed to unmarshal packet data:", errors.New("invalid character"))
+
+	output := buf.String()
+	require.Contains(t, output, "E[")
+	require.Contains(t, output, "failed to unmarshal packet data: invalid character")
+	require.Contains(t, output, "module="+logger.ModuleName)
+}
+
+func TestLoggerFiltered(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx := newContext(&buf)
+	ctx = ctx.WithLogger(log.NewFilter(ctx.Logger(), log.AllowError()))
+
+	logger.LogInfo(ctx, "filtered")
+	require.Empty(t, buf.String())
+
+	logger.LogError(ctx, "not filtered")
+	require.Contains(t, buf.String(), "not filtered")
+}
+
+func TestLogConcurrent(t *testing.T) {
+	var (
+		buf bytes.Buffer
+		wg  sync.WaitGroup
+	)
+
+	ctx := newContext(&buf)
+
+	// 100 goroutines log concurrently, one of which logs at error level while the others log at info level
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if i == 0 {
+				logger.LogError(ctx, "goroutine", i)
+				return
+			}
+
+			for j := 0; j < 10; j++ {
+				logger.LogInfo(ctx, "goroutine", i, "iteration", j)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 99*10+1, strings.Count(buf.String(), "module="+logger.ModuleName))
+}
+
+// formatCounter counts the number of times it is formatted, allowing tests to assert that log lines below the level are not formatted
+type formatCounter struct {
+	count int
+}
+
+func (f *formatCounter) String() string {
+	f.count++
+	return "formatted"
+}
+
+// resetLogger restores the default level of the logger after the test completes
+func resetLogger(t *testing.T) {
+	t.Cleanup(func() {
+		logger.SetLevel(logger.LevelInfo)
+	})
+}
+
+func TestLevels(t *testing.T) {
+	testCases := []struct {
+		level    logger.Level
+		expDebug bool
+		expInfo  bool
+	}{
+		{logger.LevelDebug, true, true},
+		{logger.LevelInfo, false, true},
+		{logger.LevelError, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.level.String(), func(t *testing.T) {
+			resetLogger(t)
+
+			var buf bytes.Buffer
+			ctx := newContext(&buf)
+
+			logger.SetLevel(tc.level)
+
+			logger.LogDebug(ctx, "debug line")
+			logger.LogInfo(ctx, "info line")
+			logger.LogError(ctx, "error line")
+
+			written := buf.String()
+			require.Equal(t, tc.expDebug, strings.Contains(written, "debug line"))
+			require.Equal(t, tc.expInfo, strings.Contains(written, "info line"))
+			require.Contains(t, written, "error line")
+			require.Equal(t, strings.Count(written, "\n"), strings.Count(written, "module="+logger.ModuleName))
+		})
+	}
+}
+
+func TestNoFormattingBelowLevel(t *testing.T) {
+	resetLogger(t)
+
+	var buf bytes.Buffer
+	ctx := newContext(&buf)
+
+	logger.SetLevel(logger.LevelError)
+	require.False(t, logger.Enabled(logger.LevelDebug))
+	require.False(t, logger.Enabled(logger.LevelInfo))
+	require.True(t, logger.Enabled(logger.LevelError))
+
+	counter := &formatCounter{}
+	logger.LogDebug(ctx, counter)
+	logger.LogInfo(ctx, counter)
+	logger.LogDebugKV(ctx, "debug line", "value", counter)
+	logger.LogInfoKV(ctx, "info line", "value", counter)
+	require.Zero(t, counter.count)
+	require.Empty(t, buf.String())
+
+	logger.LogError(ctx, counter)
+	require.Equal(t, 1, counter.count)
+
+	logger.LogErrorKV(ctx, "error line", "value", counter)
+	require.Equal(t, 2, counter.count)
+}
+
+func TestParseLevel(t *testing.T) {
+	for name, expLevel := range map[string]logger.Level{"debug": logger.LevelDebug, "INFO": logger.LevelInfo, "Error": logger.LevelError} {
+		level, err := logger.ParseLevel(name)
+		require.NoError(t, err)
+		require.Equal(t, expLevel, level)
+	}
+
+	_, err := logger.ParseLevel("warn")
+	require.Error(t, err)
+}
+
+func TestConfigureFromEnv(t *testing.T) {
+	resetLogger(t)
+
+	var buf bytes.Buffer
+	ctx := newContext(&buf)
+
+	// the level is unchanged if the environment variable is not set
+	require.NoError(t, logger.ConfigureFromEnv())
+	require.False(t, logger.Enabled(logger.LevelDebug))
+
+	t.Setenv(logger.EnvLogLevel, "debug")
+	require.NoError(t, logger.ConfigureFromEnv())
+
+	logger.LogDebug(ctx, "debug line")
+	require.Contains(t, buf.String(), "debug line")
+
+	t.Setenv(logger.EnvLogLevel, "error")
+	require.NoError(t, logger.ConfigureFromEnv())
+
+	logger.LogInfo(ctx, "info line")
+	require.NotContains(t, buf.String(), "info line")
+
+	// an invalid level is rejected and leaves the level unchanged
+	t.Setenv(logger.EnvLogLevel, "verbose")
+	require.Error(t, logger.ConfigureFromEnv())
+	require.False(t, logger.Enabled(logger.LevelInfo))
+}
+
+// BenchmarkLogInfo measures the overhead of the logger on top of the logger of the context, using a no-op
+// context logger such that the cost of the output configured for the node is excluded
+func BenchmarkLogInfo(b *testing.B) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.LogInfo(ctx, "transaction executed", i)
+	}
+}
+
+func BenchmarkLogInfoBelowLevel(b *testing.B) {
+	logger.SetLevel(logger.LevelError)
+	b.Cleanup(func() {
+		logger.SetLevel(logger.LevelInfo)
+	})
+
+	var buf bytes.Buffer
+	ctx := newContext(&buf)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.LogInfo(ctx, "transaction executed", i)
+	}
+}
@@ -0,0 +1,152 @@
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Redacted replaces the value of free text fields when logging the contents of messages
+const Redacted = "[REDACTED]"
+
+// redactedFields are the names of the string fields containing free text, which are redacted at any depth
+// of a message when logging its contents
+var redactedFields = map[string]bool{
+	"Memo":          true,
+	"Metadata":      true,
+	"Details":       true,
+	"Description":   true,
+	"Summary":       true,
+	"Justification": true,
+}
+
+// verboseMsgs is non-zero if the contents of messages are logged
+var verboseMsgs int32
+
+// SetVerboseMsgs enables or disables logging the contents of messages
+func SetVerboseMsgs(verbose bool) {
+	var value int32
+	if verbose {
+		value = 1
+	}
+
+	atomic.StoreInt32(&verboseMsgs, value)
+}
+
+// SanitizeMsg returns a representation of the provided message which is safe to log. By default only the type URL,
+// the number of signers and the size in bytes of the message are included. If the contents of messages are logged,
+// the message is included with the free text fields, such as memos and descriptions, redacted
+func SanitizeMsg(msg sdk.Msg) string {
+	typeURL := sdk.MsgTypeURL(msg)
+	if atomic.LoadInt32(&verboseMsgs) == 0 {
+		return fmt.Sprintf("type_url=%s signers=%d bytes=%d", typeURL, signerCount(msg), proto.Size(msg))
+	}
+
+	redacted, err := redactMsg(msg)
+	if err != nil {
+		return fmt.Sprintf("type_url=%s msg=%s", typeURL, Redacted)
+	}
+
+	return fmt.Sprintf("type_url=%s msg=%s", typeURL, redacted.String())
+}
+
+// signerCount returns the number of signers of the provided message. GetSigners panics if a signer address is
+// invalid, which is reported when the message is authenticated rather than when it is logged, in which case zero is returned
+func signerCount(msg sdk.Msg) (count int) {
+	defer func() {
+		if r := recover(); r != nil {
+			count = 0
+		}
+	}()
+
+	return len(msg.GetSigners())
+}
+
+// redactMsg returns a copy of the provided message with the free text fields redacted. The copy is made by
+// marshaling and unmarshaling the message, as proto.Clone does not support custom types such as sdk.Int
+func redactMsg(msg proto.Message) (proto.Message, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(bz, redacted); err != nil {
+		return nil, err
+	}
+
+	redact(reflect.ValueOf(msg), reflect.ValueOf(redacted))
+
+	return redacted, nil
+}
+
+// redact walks the provided copy of a message in lockstep with the original, redacting free text fields of the copy.
+// The original is used to access the cached values of Any fields, which are not copied
+func redact(orig, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() || orig.IsNil() {
+			return
+		}
+
+		if any, ok := v.Interface().(*codectypes.Any); ok {
+			redactAny(orig.Interface().(*codectypes.Any), any)
+			return
+		}
+
+		redact(orig.Elem(), v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+
+			if field.Type.Kind() == reflect.String && redactedFields[field.Name] {
+				if v.Field(i).Len() > 0 {
+					v.Field(i).SetString(Redacted)
+				}
+
+				continue
+			}
+
+			redact(orig.Field(i), v.Field(i))
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+
+		for i := 0; i < v.Len() && i < orig.Len(); i++ {
+			redact(orig.Index(i), v.Index(i))
+		}
+	}
+}
+
+// redactAny replaces the value of the provided copy of an Any with the redacted cached value of the original.
+// The value is removed if the original has no cached value, as its contents cannot be redacted
+func redactAny(orig, any *codectypes.Any) {
+	cached, ok := orig.GetCachedValue().(proto.Message)
+	if !ok {
+		any.Value = nil
+		return
+	}
+
+	redactedMsg, err := redactMsg(cached)
+	if err != nil {
+		any.Value = nil
+		return
+	}
+
+	redacted, err := codectypes.NewAnyWithValue(redactedMsg)
+	if err != nil {
+		any.Value = nil
+		return
+	}
+
+	any.Value = redacted.Value
+}
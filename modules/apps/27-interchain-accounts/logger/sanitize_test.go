@@ -0,0 +1,179 @@
+package logger_test
+
+import (
+	"bytes"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+)
+
+const secret = "secret free text"
+
+var (
+	fromAddr = sdk.AccAddress([]byte("from_address________")).String()
+	toAddr   = sdk.AccAddress([]byte("to_address__________")).String()
+)
+
+// resetVerboseMsgs restores the default of not logging the contents of messages after the test completes
+func resetVerboseMsgs(t *testing.T) {
+	t.Cleanup(func() {
+		logger.SetVerboseMsgs(false)
+	})
+}
+
+func newMsgSubmitProposal(t *testing.T, description string) *govtypes.MsgSubmitProposal {
+	msg, err := govtypes.NewMsgSubmitProposal(
+		govtypes.NewTextProposal("title", description),
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))),
+		sdk.MustAccAddressFromBech32(fromAddr),
+	)
+	require.NoError(t, err)
+
+	return msg
+}
+
+func TestSanitizeMsg(t *testing.T) {
+	resetVerboseMsgs(t)
+
+	msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(fromAddr), sdk.MustAccAddressFromBech32(toAddr), sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+
+	sanitized := logger.SanitizeMsg(msg)
+	require.Contains(t, sanitized, "type_url=/cosmos.bank.v1beta1.MsgSend")
+	require.Contains(t, sanitized, "signers=1")
+	require.Contains(t, sanitized, "bytes=")
+	require.NotContains(t, sanitized, fromAddr)
+	require.NotContains(t, sanitized, toAddr)
+	require.NotContains(t, sanitized, "1000")
+
+	logger.SetVerboseMsgs(true)
+
+	sanitized = logger.SanitizeMsg(msg)
+	require.Contains(t, sanitized, "type_url=/cosmos.bank.v1beta1.MsgSend")
+	require.Contains(t, sanitized, fromAddr)
+	require.Contains(t, sanitized, toAddr)
+	require.Contains(t, sanitized, "1000")
+}
+
+func TestSanitizeMsgInvalidSigner(t *testing.T) {
+	resetVerboseMsgs(t)
+
+	// GetSigners panics on the invalid sender address
+	msg := &banktypes.MsgSend{FromAddress: "invalid"}
+	require.Contains(t, logger.SanitizeMsg(msg), "signers=0")
+}
+
+func TestSanitizeMsgRedactsFreeText(t *testing.T) {
+	testCases := []struct {
+		name   string
+		newMsg func(freeText string) sdk.Msg
+	}{
+		{
+			"transfer memo",
+			func(freeText string) sdk.Msg {
+				return &transfertypes.MsgTransfer{
+					SourcePort:    transfertypes.PortID,
+					SourceChannel: "channel-0",
+					Token:         sdk.NewCoin("stake", sdk.NewInt(1000)),
+					Sender:        fromAddr,
+					Receiver:      toAddr,
+					TimeoutHeight: clienttypes.NewHeight(1, 100),
+					Memo:          freeText,
+				}
+			},
+		},
+		{
+			"validator description details",
+			func(freeText string) sdk.Msg {
+				return stakingtypes.NewMsgEditValidator(
+					sdk.ValAddress(sdk.MustAccAddressFromBech32(fromAddr)),
+					stakingtypes.NewDescription("moniker", "", "", "", freeText),
+					nil, nil,
+				)
+			},
+		},
+		{
+			"proposal description packed in an Any",
+			func(freeText string) sdk.Msg {
+				return newMsgSubmitProposal(t, freeText)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resetVerboseMsgs(t)
+
+			msg := tc.newMsg(secret)
+			require.NotContains(t, logger.SanitizeMsg(msg), secret)
+
+			logger.SetVerboseMsgs(true)
+
+			// the contents are logged as if the free text was redacted by the sender
+			sanitized := logger.SanitizeMsg(msg)
+			require.NotContains(t, sanitized, secret)
+			require.Equal(t, logger.SanitizeMsg(tc.newMsg(logger.Redacted)), sanitized)
+
+			// the message itself is not modified
+			require.Equal(t, tc.newMsg(secret), msg)
+		})
+	}
+}
+
+func TestSanitizeMsgRedactsAnyWithoutCachedValue(t *testing.T) {
+	resetVerboseMsgs(t)
+	logger.SetVerboseMsgs(true)
+
+	msg := newMsgSubmitProposal(t, secret)
+	msg.Content = &codectypes.Any{TypeUrl: msg.Content.TypeUrl, Value: msg.Content.Value}
+
+	// the contents of the Any cannot be redacted and are removed
+	expMsg := newMsgSubmitProposal(t, secret)
+	expMsg.Content = &codectypes.Any{TypeUrl: msg.Content.TypeUrl}
+	require.Equal(t, logger.SanitizeMsg(expMsg), logger.SanitizeMsg(msg))
+}
+
+func TestSanitizedLogLines(t *testing.T) {
+	resetLogger(t)
+	resetVerboseMsgs(t)
+
+	logger.SetLevel(logger.LevelDebug)
+
+	msg := newMsgSubmitProposal(t, secret)
+
+	var buf bytes.Buffer
+	logger.LogDebugKV(newContext(&buf), "executing message", "msg", logger.SanitizeMsg(msg))
+
+	output := buf.String()
+	require.Contains(t, output, sdk.MsgTypeURL(msg))
+	require.NotContains(t, output, secret)
+	require.NotContains(t, output, fromAddr)
+}
+
+func TestConfigureVerboseMsgsFromEnv(t *testing.T) {
+	resetVerboseMsgs(t)
+
+	msg := newMsgSubmitProposal(t, secret)
+
+	t.Setenv(logger.EnvLogVerboseMsgs, "true")
+	require.NoError(t, logger.ConfigureFromEnv())
+	require.Contains(t, logger.SanitizeMsg(msg), fromAddr)
+	require.NotContains(t, logger.SanitizeMsg(msg), secret)
+
+	t.Setenv(logger.EnvLogVerboseMsgs, "false")
+	require.NoError(t, logger.ConfigureFromEnv())
+	require.NotContains(t, logger.SanitizeMsg(msg), fromAddr)
+
+	t.Setenv(logger.EnvLogVerboseMsgs, "sometimes")
+	require.Error(t, logger.ConfigureFromEnv())
+}
@@ -0,0 +1,88 @@
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the structured logging tests")
+
+// newJSONContext returns a context with a JSON logger without timestamps writing to the provided buffer
+func newJSONContext(buf *bytes.Buffer) sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewTMJSONLoggerNoTS(log.NewSyncWriter(buf)))
+}
+
+// requireGolden asserts that the provided output equals the contents of the provided golden file,
+// the golden file is updated instead if the update flag is set
+func requireGolden(t *testing.T, name, output string) {
+	path := filepath.Join("testdata", name)
+
+	if *update {
+		require.NoError(t, os.WriteFile(path, []byte(output), 0o600))
+	}
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(bz), output)
+}
+
+func TestLogKV(t *testing.T) {
+	resetLogger(t)
+	logger.SetLevel(logger.LevelDebug)
+
+	var buf bytes.Buffer
+	ctx := newJSONContext(&buf)
+
+	logger.LogDebugKV(ctx, "authenticating interchain account tx", "connection_id", "connection-0", "allow_messages", []string{"/cosmos.bank.v1beta1.MsgSend"})
+	logger.LogInfoKV(ctx, "transaction executed", "msg_count", 2)
+	logger.LogErrorKV(ctx, "transaction failed", "error", errors.New("insufficient funds"))
+
+	requireGolden(t, "kv.golden", buf.String())
+}
+
+func TestWithPacket(t *testing.T) {
+	resetLogger(t)
+	logger.SetLevel(logger.LevelDebug)
+
+	var buf bytes.Buffer
+	ctx := newJSONContext(&buf)
+
+	packet := channeltypes.NewPacket([]byte("data"), 7, "icacontroller-owner", "channel-0", "icahost", "channel-1", clienttypes.ZeroHeight(), 100)
+
+	packetLogger := logger.WithPacket(ctx, packet)
+	packetLogger.Debug("cosmos tx deserialized", "msg_count", 1)
+	packetLogger.Info("transaction failed", "error", errors.New("insufficient funds"))
+	packetLogger.Error("failed to unmarshal packet data", "error", errors.New("invalid character"))
+
+	requireGolden(t, "packet.golden", buf.String())
+
+	// every line of the packet lifecycle can be filtered by sequence
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		require.Equal(t, float64(packet.GetSequence()), entry["sequence"])
+		require.Equal(t, logger.ModuleName, entry["module"])
+	}
+
+	// scoped log lines below the level are not written
+	buf.Reset()
+	logger.SetLevel(logger.LevelError)
+	packetLogger.Debug("cosmos tx deserialized")
+	packetLogger.Info("transaction failed")
+	require.Empty(t, buf.String())
+}
@@ -128,3 +128,15 @@ func (suite *InterchainAccountsTestSuite) TestInitModule() {
 		})
 	}
 }
+
+func (suite *InterchainAccountsTestSuite) TestRegisterInvariants() {
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 5, simapp.MakeTestEncodingConfig(), simapp.EmptyAppOptions{})
+
+	var routes []string
+	for _, route := range app.CrisisKeeper.Routes() {
+		routes = append(routes, route.FullRoute())
+	}
+
+	suite.Require().Contains(routes, "interchainaccounts/host-active-channels")
+	suite.Require().Contains(routes, "interchainaccounts/host-interchain-accounts")
+}
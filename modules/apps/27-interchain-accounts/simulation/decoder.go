@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value of the controller and host submodule stores to the corresponding type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, []byte(types.PortKeyPrefix)):
+			return fmt.Sprintf("Port A: %s\nPort B: %s", string(kvA.Key), string(kvB.Key))
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.ActiveChannelKeyPrefix)):
+			return fmt.Sprintf("ActiveChannel A: %s\nActiveChannel B: %s", string(kvA.Value), string(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.OwnerKeyPrefix)):
+			return fmt.Sprintf("InterchainAccount A: %s\nInterchainAccount B: %s", string(kvA.Value), string(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.IsMiddlewareEnabledPrefix)):
+			return fmt.Sprintf("IsMiddlewareEnabled A: %t\nIsMiddlewareEnabled B: %t", bytes.Equal(kvA.Value, types.MiddlewareEnabled), bytes.Equal(kvB.Value, types.MiddlewareEnabled))
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.MsgCountKeyPrefix)):
+			return fmt.Sprintf("MsgCount A: %d\nMsgCount B: %d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.PendingPacketKeyPrefix)):
+			var packetDataA, packetDataB types.InterchainAccountPacketData
+			cdc.MustUnmarshal(kvA.Value, &packetDataA)
+			cdc.MustUnmarshal(kvB.Value, &packetDataB)
+			return fmt.Sprintf("PendingPacket A: %v\nPendingPacket B: %v", packetDataA, packetDataB)
+
+		case bytes.HasPrefix(kvA.Key, []byte(hosttypes.ConnectionAllowMessagesKeyPrefix)):
+			var allowMsgsA, allowMsgsB hosttypes.ConnectionAllowMessages
+			cdc.MustUnmarshal(kvA.Value, &allowMsgsA)
+			cdc.MustUnmarshal(kvB.Value, &allowMsgsB)
+			return fmt.Sprintf("ConnectionAllowMessages A: %v\nConnectionAllowMessages B: %v", allowMsgsA, allowMsgsB)
+
+		case bytes.HasPrefix(kvA.Key, []byte(hosttypes.ChannelEncodingKeyPrefix)):
+			return fmt.Sprintf("ChannelEncoding A: %s\nChannelEncoding B: %s", string(kvA.Value), string(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, []byte(hosttypes.ExecutionResultKeyPrefix)):
+			var resultA, resultB hosttypes.ExecutionResult
+			cdc.MustUnmarshal(kvA.Value, &resultA)
+			cdc.MustUnmarshal(kvB.Value, &resultB)
+			return fmt.Sprintf("ExecutionResult A: %v\nExecutionResult B: %v", resultA, resultB)
+
+		case bytes.HasPrefix(kvA.Key, []byte(hosttypes.AddressBlocklistKeyPrefix)):
+			return fmt.Sprintf("BlockedAddress A: %s\nBlockedAddress B: %s", string(kvA.Key[len(hosttypes.KeyAddressBlocklistPrefix()):]), string(kvB.Key[len(hosttypes.KeyAddressBlocklistPrefix()):]))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %s", types.ModuleName, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/simulation"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestDecodeStore(t *testing.T) {
+	var (
+		owner        = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+		portID       = types.PortPrefix + owner
+		connectionID = ibctesting.FirstConnectionID
+		channelID    = ibctesting.FirstChannelID
+		typeURL      = "/cosmos.bank.v1beta1.MsgSend"
+		address      = types.BuildInterchainAccountAddress(connectionID, portID).String()
+	)
+
+	dec := simulation.NewDecodeStore(types.ModuleCdc)
+
+	allowMsgs := hosttypes.NewConnectionAllowMessages(connectionID, []string{typeURL})
+	result := hosttypes.ExecutionResult{Sequence: 1}
+	packetData := types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data")}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{
+				Key:   types.KeyPort(portID),
+				Value: []byte{0x01},
+			},
+			{
+				Key:   types.KeyActiveChannel(portID, connectionID),
+				Value: []byte(channelID),
+			},
+			{
+				Key:   types.KeyOwnerAccount(portID, connectionID),
+				Value: []byte(address),
+			},
+			{
+				Key:   types.KeyIsMiddlewareEnabled(portID, connectionID),
+				Value: types.MiddlewareEnabled,
+			},
+			{
+				Key:   types.KeyMsgCount(portID, connectionID, typeURL),
+				Value: sdk.Uint64ToBigEndian(5),
+			},
+			{
+				Key:   types.KeyPendingPacket(portID, channelID, 1),
+				Value: types.ModuleCdc.MustMarshal(&packetData),
+			},
+			{
+				Key:   hosttypes.KeyConnectionAllowMessages(connectionID),
+				Value: types.ModuleCdc.MustMarshal(&allowMsgs),
+			},
+			{
+				Key:   hosttypes.KeyChannelEncoding(portID, channelID),
+				Value: []byte(types.EncodingProtobuf),
+			},
+			{
+				Key:   hosttypes.KeyExecutionResult(portID, channelID, result.Sequence),
+				Value: types.ModuleCdc.MustMarshal(&result),
+			},
+			{
+				Key:   hosttypes.KeyBlockedAddress(address),
+				Value: []byte{0x01},
+			},
+			{
+				Key:   []byte{0x99},
+				Value: []byte{0x99},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Port", fmt.Sprintf("Port A: %s\nPort B: %s", types.KeyPort(portID), types.KeyPort(portID))},
+		{"ActiveChannel", fmt.Sprintf("ActiveChannel A: %s\nActiveChannel B: %s", channelID, channelID)},
+		{"InterchainAccount", fmt.Sprintf("InterchainAccount A: %s\nInterchainAccount B: %s", address, address)},
+		{"IsMiddlewareEnabled", "IsMiddlewareEnabled A: true\nIsMiddlewareEnabled B: true"},
+		{"MsgCount", "MsgCount A: 5\nMsgCount B: 5"},
+		{"PendingPacket", fmt.Sprintf("PendingPacket A: %v\nPendingPacket B: %v", packetData, packetData)},
+		{"ConnectionAllowMessages", fmt.Sprintf("ConnectionAllowMessages A: %v\nConnectionAllowMessages B: %v", allowMsgs, allowMsgs)},
+		{"ChannelEncoding", fmt.Sprintf("ChannelEncoding A: %s\nChannelEncoding B: %s", types.EncodingProtobuf, types.EncodingProtobuf)},
+		{"ExecutionResult", fmt.Sprintf("ExecutionResult A: %v\nExecutionResult B: %v", result, result)},
+		{"BlockedAddress", fmt.Sprintf("BlockedAddress A: %s\nBlockedAddress B: %s", address, address)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
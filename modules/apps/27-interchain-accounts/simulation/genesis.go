@@ -0,0 +1,89 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// RandomEnabled randomized controller or host enabled param with 75% prob of being true.
+func RandomEnabled(r *rand.Rand) bool {
+	return r.Int63n(101) <= 75
+}
+
+// RandomAllowMessages returns a randomized host allow messages param. The wildcard is returned with 10% prob, otherwise
+// a random subset of commonly allowed message type URLs is returned, which may be empty.
+func RandomAllowMessages(r *rand.Rand) []string {
+	if r.Int63n(101) <= 10 {
+		return []string{hosttypes.AllowAllHostMsgs}
+	}
+
+	msgTypeURLs := []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+		sdk.MsgTypeURL(&govtypes.MsgVote{}),
+	}
+
+	allowMsgs := []string{}
+	for _, msgTypeURL := range msgTypeURLs {
+		if r.Intn(2) == 0 {
+			allowMsgs = append(allowMsgs, msgTypeURL)
+		}
+	}
+
+	return allowMsgs
+}
+
+// RandomizedGenState generates a random GenesisState for interchain accounts.
+func RandomizedGenState(simState *module.SimulationState) {
+	var controllerEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(controllertypes.KeyControllerEnabled), &controllerEnabled, simState.Rand,
+		func(r *rand.Rand) { controllerEnabled = RandomEnabled(r) },
+	)
+
+	controllerParams := controllertypes.DefaultParams()
+	controllerParams.ControllerEnabled = controllerEnabled
+
+	var hostEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(hosttypes.KeyHostEnabled), &hostEnabled, simState.Rand,
+		func(r *rand.Rand) { hostEnabled = RandomEnabled(r) },
+	)
+
+	var allowMsgs []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(hosttypes.KeyAllowMessages), &allowMsgs, simState.Rand,
+		func(r *rand.Rand) { allowMsgs = RandomAllowMessages(r) },
+	)
+
+	hostParams := hosttypes.DefaultParams()
+	hostParams.HostEnabled = hostEnabled
+	hostParams.AllowMessages = allowMsgs
+
+	icaGenesis := genesistypes.NewGenesisState(
+		genesistypes.NewControllerGenesisState(nil, nil, []string{}, controllerParams),
+		genesistypes.NewHostGenesisState(nil, nil, types.PortID, hostParams),
+	)
+
+	bz, err := json.MarshalIndent(icaGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(icaGenesis)
+}
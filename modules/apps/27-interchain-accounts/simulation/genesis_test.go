@@ -0,0 +1,83 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/genesis/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/simulation"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abonormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var icaGenesis genesistypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &icaGenesis)
+
+	require.NoError(t, icaGenesis.Validate())
+
+	require.True(t, icaGenesis.ControllerGenesisState.Params.ControllerEnabled)
+	require.Equal(t, uint64(controllertypes.DefaultMaxPacketDataSize), icaGenesis.ControllerGenesisState.Params.MaxPacketDataSize)
+	require.Len(t, icaGenesis.ControllerGenesisState.ActiveChannels, 0)
+	require.Len(t, icaGenesis.ControllerGenesisState.InterchainAccounts, 0)
+
+	require.True(t, icaGenesis.HostGenesisState.Params.HostEnabled)
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgUndelegate", "/cosmos.gov.v1beta1.MsgVote"}, icaGenesis.HostGenesisState.Params.AllowMessages)
+	require.Equal(t, types.PortID, icaGenesis.HostGenesisState.Port)
+	require.Len(t, icaGenesis.HostGenesisState.ActiveChannels, 0)
+	require.Len(t, icaGenesis.HostGenesisState.InterchainAccounts, 0)
+}
+
+// TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
+func TestRandomizedGenState1(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	// all these tests will panic
+	tests := []struct {
+		simState module.SimulationState
+		panicMsg string
+	}{
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{}, "invalid memory address or nil pointer dereference"},
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{
+				AppParams: make(simtypes.AppParams),
+				Cdc:       cdc,
+				Rand:      r,
+			}, "assignment to entry in nil map"},
+	}
+
+	for _, tt := range tests {
+		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
+	}
+}
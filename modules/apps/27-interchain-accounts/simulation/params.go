@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation. Only the params of the enabled submodules are included.
+func ParamChanges(r *rand.Rand, controller, host bool) []simtypes.ParamChange {
+	var paramChanges []simtypes.ParamChange
+	if controller {
+		paramChanges = append(paramChanges, simulation.NewSimParamChange(controllertypes.SubModuleName, string(controllertypes.KeyControllerEnabled),
+			func(r *rand.Rand) string {
+				controllerEnabled := RandomEnabled(r)
+				return fmt.Sprintf("%s", types.ModuleCdc.MustMarshalJSON(&gogotypes.BoolValue{Value: controllerEnabled}))
+			},
+		))
+	}
+
+	if host {
+		paramChanges = append(paramChanges,
+			simulation.NewSimParamChange(hosttypes.SubModuleName, string(hosttypes.KeyHostEnabled),
+				func(r *rand.Rand) string {
+					hostEnabled := RandomEnabled(r)
+					return fmt.Sprintf("%s", types.ModuleCdc.MustMarshalJSON(&gogotypes.BoolValue{Value: hostEnabled}))
+				},
+			),
+			simulation.NewSimParamChange(hosttypes.SubModuleName, string(hosttypes.KeyAllowMessages),
+				func(r *rand.Rand) string {
+					bz, err := json.Marshal(RandomAllowMessages(r))
+					if err != nil {
+						panic(err)
+					}
+
+					return string(bz)
+				},
+			),
+		)
+	}
+
+	return paramChanges
+}
@@ -0,0 +1,40 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/simulation"
+)
+
+func TestParamChanges(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	expected := []struct {
+		composedKey string
+		key         string
+		simValue    string
+		subspace    string
+	}{
+		{"icacontroller/ControllerEnabled", "ControllerEnabled", "false", "icacontroller"},
+		{"icahost/HostEnabled", "HostEnabled", "true", "icahost"},
+		{"icahost/AllowMessages", "AllowMessages", `["/cosmos.staking.v1beta1.MsgUndelegate","/cosmos.gov.v1beta1.MsgVote"]`, "icahost"},
+	}
+
+	paramChanges := simulation.ParamChanges(r, true, true)
+
+	require.Len(t, paramChanges, 3)
+
+	for i, p := range paramChanges {
+		require.Equal(t, expected[i].composedKey, p.ComposedKey())
+		require.Equal(t, expected[i].key, p.Key())
+		require.Equal(t, expected[i].simValue, p.SimValue()(r), p.Key())
+		require.Equal(t, expected[i].subspace, p.Subspace())
+	}
+
+	require.Len(t, simulation.ParamChanges(r, false, true), 2)
+	require.Len(t, simulation.ParamChanges(r, true, false), 1)
+}
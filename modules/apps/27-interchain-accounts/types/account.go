@@ -8,6 +8,7 @@ import (
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	yaml "gopkg.in/yaml.v2"
@@ -46,6 +47,22 @@ func GenerateAddress(moduleAccAddr sdk.AccAddress, connectionID, portID string)
 	return sdk.AccAddress(sdkaddress.Derive(moduleAccAddr, []byte(connectionID+portID)))
 }
 
+// AddressDerivationVersion is the version prefix of the derivation key used by BuildInterchainAccountAddress
+const AddressDerivationVersion = "ics27-address-v1"
+
+// BuildInterchainAccountAddress returns the interchain account address for the provided host connection ID and
+// controller port ID. The address is a sub-address of the host module account, derived using the key
+// "ics27-address-v1/{connectionID}/{portID}". Unlike GenerateUniqueAddress, the address does not depend on block data
+// and may be reproduced offline:
+//
+//	hostModuleAcc = sha256(sha256("module") || "interchainaccounts" || 0x00 || "icahost-accounts")
+//	address       = sha256(sha256(hostModuleAcc) || "ics27-address-v1/" || connectionID || "/" || portID)
+func BuildInterchainAccountAddress(connectionID, portID string) sdk.AccAddress {
+	hostModuleAcc := sdkaddress.Module(ModuleName, []byte(hostAccountsKey))
+
+	return sdkaddress.Derive(hostModuleAcc, []byte(AddressDerivationVersion+"/"+connectionID+"/"+portID))
+}
+
 // GenerateUniqueAddress returns an sdk.AccAddress derived using a host module account address, host connection ID, the controller portID,
 // the current block app hash, and the current block data hash. The sdk.AccAddress returned is a sub-address of the host module account.
 func GenerateUniqueAddress(ctx sdk.Context, connectionID, portID string) sdk.AccAddress {
@@ -73,6 +90,34 @@ func ValidateAccountAddress(addr string) error {
 	return nil
 }
 
+// ValidateBech32AccountAddress performs validation of an interchain account address returned by a host chain. In addition
+// to the constraints enforced by ValidateAccountAddress, the address must be a valid bech32 string encoding between 1
+// and 255 bytes. If the expected prefix is non-empty, the human readable part of the address must be equal to it.
+func ValidateBech32AccountAddress(addr, expectedPrefix string) error {
+	if strings.TrimSpace(addr) == "" {
+		return sdkerrors.Wrap(ErrInvalidAccountAddress, "interchain account address cannot be empty")
+	}
+
+	if err := ValidateAccountAddress(addr); err != nil {
+		return err
+	}
+
+	prefix, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAccountAddress, "invalid bech32 address %s: %s", addr, err)
+	}
+
+	if len(bz) == 0 || len(bz) > sdkaddress.MaxAddrLen {
+		return sdkerrors.Wrapf(ErrInvalidAccountAddress, "address length must be between 1 and %d bytes, got %d", sdkaddress.MaxAddrLen, len(bz))
+	}
+
+	if expectedPrefix != "" && prefix != expectedPrefix {
+		return sdkerrors.Wrapf(ErrInvalidAccountAddress, "expected address prefix %s, got %s", expectedPrefix, prefix)
+	}
+
+	return nil
+}
+
 // NewInterchainAccount creates and returns a new InterchainAccount type
 func NewInterchainAccount(ba *authtypes.BaseAccount, accountOwner string) *InterchainAccount {
 	return &InterchainAccount{
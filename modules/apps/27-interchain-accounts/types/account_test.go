@@ -1,12 +1,15 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
@@ -50,6 +53,52 @@ func (suite *TypesTestSuite) TestGenerateUniqueAddress() {
 	suite.Require().NotEmpty(accAddr)
 }
 
+func (suite *TypesTestSuite) TestBuildInterchainAccountAddress() {
+	testCases := []struct {
+		name         string
+		connectionID string
+		portID       string
+		expAddress   string
+	}{
+		{
+			"connection-0 and owner port",
+			"connection-0",
+			TestPortID,
+			"25e3eae91968fc34381818a83c97fda3caf9977f3fa1ad3e6b2f4d0992727ddc",
+		},
+		{
+			"connection-1 and owner port",
+			"connection-1",
+			TestPortID,
+			"4db374f8f57cb85b0ac8fa03ed58ce8166c69332a9b7526b51fbd93c34ebca6c",
+		},
+		{
+			"connection-0 and non-owner port",
+			"connection-0",
+			"icacontroller-1",
+			"1fa1bceca23e7730bfff36937e5e4e1e7f98536a83ddd2e8dd3bb580d1e77aea",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := types.BuildInterchainAccountAddress(tc.connectionID, tc.portID)
+			suite.Require().Equal(tc.expAddress, hex.EncodeToString(addr))
+
+			// the address must be reproducible using the algorithm documented on BuildInterchainAccountAddress
+			moduleKey := sha256.Sum256([]byte("module"))
+			hostModuleAcc := sha256.Sum256(append(moduleKey[:], []byte(types.ModuleName+"\x00icahost-accounts")...))
+			typ := sha256.Sum256(hostModuleAcc[:])
+			expAddr := sha256.Sum256(append(typ[:], []byte(types.AddressDerivationVersion+"/"+tc.connectionID+"/"+tc.portID)...))
+			suite.Require().Equal(sdk.AccAddress(expAddr[:]), addr)
+
+			// the address is independent of block data
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().Equal(addr, types.BuildInterchainAccountAddress(tc.connectionID, tc.portID))
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestValidateAccountAddress() {
 	testCases := []struct {
 		name    string
@@ -96,6 +145,81 @@ func (suite *TypesTestSuite) TestValidateAccountAddress() {
 	}
 }
 
+func (suite *TypesTestSuite) TestValidateBech32AccountAddress() {
+	addr := types.BuildInterchainAccountAddress(ibctesting.FirstConnectionID, TestPortID)
+
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", addr)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		address        string
+		expectedPrefix string
+		expPass        bool
+	}{
+		{
+			"success: any prefix",
+			osmoAddr,
+			"",
+			true,
+		},
+		{
+			"success: expected prefix",
+			addr.String(),
+			sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			true,
+		},
+		{
+			"empty address",
+			"",
+			"",
+			false,
+		},
+		{
+			"only spaces",
+			"     ",
+			"",
+			false,
+		},
+		{
+			"address is too long",
+			ibctesting.LongString,
+			"",
+			false,
+		},
+		{
+			"invalid bech32 checksum",
+			addr.String()[:len(addr.String())-1] + "q",
+			"",
+			false,
+		},
+		{
+			"alphanumeric address which is not bech32",
+			"invalidaddress",
+			"",
+			false,
+		},
+		{
+			"wrong prefix",
+			osmoAddr,
+			sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateBech32AccountAddress(tc.address, tc.expectedPrefix)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidAccountAddress, tc.name)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestInterchainAccount() {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -0,0 +1,247 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+const (
+	// ackErrorString defines the string constant included in interchain accounts error acknowledgements
+	// NOTE: Changing this const is state machine breaking as acknowledgements are written into state.
+	ackErrorString = "error handling packet: see events for details"
+
+	// msgIndexErrorFormat defines the format of an error acknowledgement attributed to a specific message, extending
+	// the format of channeltypes.NewErrorAcknowledgementWithCodespace with the message index
+	msgIndexErrorFormat = "ABCI code: %d: codespace: %s: msg index: %d: %s"
+)
+
+// MsgExecutionError is returned by the host when the message at Index of an interchain accounts
+// transaction fails validation or execution. The ABCI code of the wrapped error is preserved.
+type MsgExecutionError struct {
+	Index uint64
+	err   error
+}
+
+// NewMsgExecutionError returns a new MsgExecutionError wrapping the error returned by the message at the provided index
+func NewMsgExecutionError(index uint64, err error) *MsgExecutionError {
+	return &MsgExecutionError{
+		Index: index,
+		err:   err,
+	}
+}
+
+// Error implements the error interface
+func (e *MsgExecutionError) Error() string {
+	return fmt.Sprintf("msg index %d: %s", e.Index, e.err)
+}
+
+// Cause returns the wrapped error, allowing the ABCI code to be resolved by sdkerrors.ABCIInfo
+func (e *MsgExecutionError) Cause() error {
+	return e.err
+}
+
+// Unwrap implements the errors.Unwrap interface
+func (e *MsgExecutionError) Unwrap() error {
+	return e.err
+}
+
+// NewErrorAcknowledgement returns a deterministic error acknowledgement for the provided error. Only the ABCI code and
+// codespace of the error are included, the error string is redacted as it may differ across node versions and should
+// instead be emitted in events. If the error is attributed to a specific message by a MsgExecutionError, the message
+// index is included alongside the ABCI code and codespace. Otherwise the acknowledgement is identical to the one returned
+// by channeltypes.NewErrorAcknowledgementWithCodespace.
+func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	// the ABCI code and codespace are registered constants and are therefore deterministic,
+	// errors which are not registered resolve to the internal ABCI code and codespace
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-determinstic log value
+
+	var execErr *MsgExecutionError
+	if errors.As(err, &execErr) {
+		return channeltypes.Acknowledgement{
+			Response: &channeltypes.Acknowledgement_Error{
+				Error: fmt.Sprintf(msgIndexErrorFormat, code, codespace, execErr.Index, ackErrorString),
+			},
+		}
+	}
+
+	return channeltypes.NewErrorAcknowledgementWithCodespace(err)
+}
+
+// ParseErrorAcknowledgement parses the error string of an interchain accounts error acknowledgement, returning the
+// ABCI codespace and code and, if present, the index of the message which failed. Acknowledgements written by hosts
+// which do not include the codespace or the message index are supported, in which case the returned codespace is
+// empty and hasIndex is false respectively.
+func ParseErrorAcknowledgement(ackErr string) (codespace string, code uint32, index uint64, hasIndex bool, err error) {
+	if n, _ := fmt.Sscanf(ackErr, "ABCI code: %d: codespace: %s msg index: %d:", &code, &codespace, &index); n == 3 {
+		return strings.TrimSuffix(codespace, ":"), code, index, true, nil
+	}
+
+	if n, _ := fmt.Sscanf(ackErr, "ABCI code: %d: codespace: %s", &code, &codespace); n == 2 {
+		return strings.TrimSuffix(codespace, ":"), code, 0, false, nil
+	}
+
+	if n, _ := fmt.Sscanf(ackErr, "ABCI code: %d: msg index: %d:", &code, &index); n == 2 {
+		return "", code, index, true, nil
+	}
+
+	if n, _ := fmt.Sscanf(ackErr, "ABCI code: %d:", &code); n == 1 {
+		return "", code, 0, false, nil
+	}
+
+	return "", 0, 0, false, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot parse error acknowledgement: %s", ackErr)
+}
+
+// UnmarshalAcknowledgement unmarshals the provided interchain accounts acknowledgement bytes. Acknowledgements written on
+// fee enabled channels are wrapped in an ICS-29 IncentivizedAcknowledgement, in which case the wrapped application
+// acknowledgement is unmarshalled.
+func UnmarshalAcknowledgement(bz []byte) (channeltypes.Acknowledgement, error) {
+	var incentivizedAck feetypes.IncentivizedAcknowledgement
+	if err := feetypes.ModuleCdc.UnmarshalJSON(bz, &incentivizedAck); err == nil && len(incentivizedAck.AppAcknowledgement) > 0 {
+		bz = incentivizedAck.AppAcknowledgement
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return channeltypes.Acknowledgement{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	return ack, nil
+}
+
+// UnmarshalTxMsgResult unmarshals the result of a successful interchain accounts acknowledgement. Results written
+// by hosts which only populate the legacy sdk.TxMsgData fields are supported, in which case the returned Results are empty.
+func UnmarshalTxMsgResult(bz []byte) (*TxMsgResult, error) {
+	var result TxMsgResult
+	if err := proto.Unmarshal(bz, &result); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result: %s", err)
+	}
+
+	return &result, nil
+}
+
+// UnmarshalBatchTxResult unmarshals the result of a successful acknowledgement of a batch of transactions.
+func UnmarshalBatchTxResult(bz []byte) (*BatchTxResult, error) {
+	var result BatchTxResult
+	if err := proto.Unmarshal(bz, &result); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal batch acknowledgement result: %s", err)
+	}
+
+	return &result, nil
+}
+
+// UnmarshalAcknowledgementResult unmarshals the result of the provided interchain accounts acknowledgement. An error is
+// returned if the acknowledgement is an error acknowledgement.
+func UnmarshalAcknowledgementResult(ack channeltypes.Acknowledgement) (*TxMsgResult, error) {
+	if !ack.Success() {
+		return nil, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal result of error acknowledgement: %s", ack.GetError())
+	}
+
+	return UnmarshalTxMsgResult(ack.GetResult())
+}
+
+// UnmarshalMsgResponses unmarshals the response data of each message executed by the host into the provided message
+// responses, which must be provided in the order of the messages within the interchain accounts transaction.
+func UnmarshalMsgResponses(ack channeltypes.Acknowledgement, msgResponses ...proto.Message) error {
+	result, err := UnmarshalAcknowledgementResult(ack)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Data) != len(msgResponses) {
+		return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "expected %d message responses, got %d", len(msgResponses), len(result.Data))
+	}
+
+	for i, msgData := range result.Data {
+		if err := proto.Unmarshal(msgData.Data, msgResponses[i]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal response of message %d (%s): %s", i, msgData.MsgType, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackAcknowledgementResponses returns the response of each message executed by the host packed as an Any, in the order
+// of the messages within the interchain accounts transaction. The msg responses written by this host and by hosts using
+// Cosmos SDK v0.46 and later are returned as is. If the host populated both the legacy sdk.TxMsgData data field and the
+// msg responses, the msg responses are returned. For results which only populate the legacy data field, the responses
+// are packed using NewMsgResponseAny. An error is returned if the acknowledgement is an error acknowledgement.
+func UnpackAcknowledgementResponses(ack channeltypes.Acknowledgement) ([]*codectypes.Any, error) {
+	if !ack.Success() {
+		return nil, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unpack responses of error acknowledgement: %s", ack.GetError())
+	}
+
+	var msgData []*MsgData
+	if result, err := UnmarshalTxMsgResult(ack.GetResult()); err == nil && len(result.Data) > 0 {
+		if len(result.MsgResponses) == len(result.Data) {
+			return result.MsgResponses, nil
+		}
+
+		msgData = result.Data
+	} else {
+		// hosts using Cosmos SDK v0.46 and later write the msg responses under a field number which is used by
+		// the results of a TxMsgResult, such that their acknowledgement results cannot be decoded as a TxMsgResult
+		var txMsgData TxMsgData
+		if err := proto.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result: %s", err)
+		}
+
+		if len(txMsgData.MsgResponses) > 0 {
+			return txMsgData.MsgResponses, nil
+		}
+
+		msgData = txMsgData.Data
+	}
+
+	msgResponses := make([]*codectypes.Any, len(msgData))
+	for i, data := range msgData {
+		msgResponses[i] = NewMsgResponseAny(data.MsgType, data.Data)
+	}
+
+	return msgResponses, nil
+}
+
+// NewMsgResponseAny packs the response data of an executed message with the provided type URL into an Any. The type URL
+// of the response is resolved following the Cosmos SDK convention of naming the response of the Msg service method
+// request Msg{Method} as Msg{Method}Response. The type URL of the returned Any is empty if no such response type is
+// registered.
+func NewMsgResponseAny(msgTypeURL string, data []byte) *codectypes.Any {
+	responseName := strings.TrimPrefix(msgTypeURL, "/") + "Response"
+	if proto.MessageType(responseName) == nil {
+		return &codectypes.Any{Value: data}
+	}
+
+	return &codectypes.Any{TypeUrl: "/" + responseName, Value: data}
+}
+
+// UnmarshalQueryResponses unmarshals the responses of the query requests executed by the host into the provided
+// query responses, which must be provided in the order of the query requests within the query packet.
+func UnmarshalQueryResponses(ack channeltypes.Acknowledgement, queryResponses ...proto.Message) error {
+	if !ack.Success() {
+		return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal result of error acknowledgement: %s", ack.GetError())
+	}
+
+	var result CosmosQueryResponse
+	if err := proto.Unmarshal(ack.GetResult(), &result); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement result: %s", err)
+	}
+
+	if len(result.Responses) != len(queryResponses) {
+		return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "expected %d query responses, got %d", len(queryResponses), len(result.Responses))
+	}
+
+	for i, bz := range result.Responses {
+		if err := proto.Unmarshal(bz, queryResponses[i]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal response of query %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
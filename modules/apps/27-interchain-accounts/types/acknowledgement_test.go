@@ -0,0 +1,464 @@
+package types_test
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestErrorAcknowledgement() {
+	testCases := []struct {
+		name         string
+		err          error
+		expCodespace string
+		expCode      uint32
+		expIndex     uint64
+		expHasIndex  bool
+	}{
+		{
+			"error without message index",
+			types.ErrUnknownDataType,
+			types.ErrUnknownDataType.Codespace(),
+			types.ErrUnknownDataType.ABCICode(),
+			0,
+			false,
+		},
+		{
+			"error with message index",
+			types.NewMsgExecutionError(2, sdkerrors.ErrInsufficientFunds),
+			sdkerrors.ErrInsufficientFunds.Codespace(),
+			sdkerrors.ErrInsufficientFunds.ABCICode(),
+			2,
+			true,
+		},
+		{
+			"wrapped error with message index",
+			sdkerrors.Wrap(types.NewMsgExecutionError(1, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signer")), "execute tx"),
+			sdkerrors.ErrUnauthorized.Codespace(),
+			sdkerrors.ErrUnauthorized.ABCICode(),
+			1,
+			true,
+		},
+		{
+			"unregistered error",
+			fmt.Errorf("unregistered error"),
+			sdkerrors.UndefinedCodespace,
+			1, // internal ABCI code
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			ack := types.NewErrorAcknowledgement(tc.err)
+			suite.Require().False(ack.Success())
+			suite.Require().NotContains(ack.GetError(), tc.err.Error())
+
+			codespace, code, index, hasIndex, err := types.ParseErrorAcknowledgement(ack.GetError())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCodespace, codespace)
+			suite.Require().Equal(tc.expCode, code)
+			suite.Require().Equal(tc.expIndex, index)
+			suite.Require().Equal(tc.expHasIndex, hasIndex)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestErrorAcknowledgementDeterminism() {
+	testCases := []struct {
+		name string
+		errA error
+		errB error
+	}{
+		{
+			"wrapped errors with different error strings",
+			sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "100stake is smaller than 1000stake"),
+			sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", "1stake", "2stake"),
+		},
+		{
+			"message execution errors with different error strings",
+			types.NewMsgExecutionError(1, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "message type not allowed")),
+			types.NewMsgExecutionError(1, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unexpected signer address")),
+		},
+		{
+			"unregistered errors with different error strings",
+			fmt.Errorf("error from node version A"),
+			fmt.Errorf("error from node version B"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			ackA := types.NewErrorAcknowledgement(tc.errA)
+			ackB := types.NewErrorAcknowledgement(tc.errB)
+
+			suite.Require().Equal(ackA.Acknowledgement(), ackB.Acknowledgement())
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestParseErrorAcknowledgement() {
+	testCases := []struct {
+		name         string
+		ackErr       string
+		expCodespace string
+		expCode      uint32
+		expIndex     uint64
+		expHasIndex  bool
+		expPass      bool
+	}{
+		{
+			"success: codespace and message index",
+			"ABCI code: 5: codespace: sdk: msg index: 3: error handling packet: see events for details",
+			"sdk",
+			5,
+			3,
+			true,
+			true,
+		},
+		{
+			"success: codespace without message index",
+			"ABCI code: 5: codespace: sdk: error handling packet: see events for details",
+			"sdk",
+			5,
+			0,
+			false,
+			true,
+		},
+		{
+			"success: legacy acknowledgement with message index",
+			"ABCI code: 5: msg index: 3: error handling packet: see events for details",
+			"",
+			5,
+			3,
+			true,
+			true,
+		},
+		{
+			"success: legacy acknowledgement",
+			"ABCI code: 5: error handling packet: see events for details",
+			"",
+			5,
+			0,
+			false,
+			true,
+		},
+		{
+			"invalid acknowledgement",
+			"invalid acknowledgement",
+			"",
+			0,
+			0,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			codespace, code, index, hasIndex, err := types.ParseErrorAcknowledgement(tc.ackErr)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expCodespace, codespace)
+				suite.Require().Equal(tc.expCode, code)
+				suite.Require().Equal(tc.expIndex, index)
+				suite.Require().Equal(tc.expHasIndex, hasIndex)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestUnmarshalAcknowledgement() {
+	resultAck := channeltypes.NewResultAcknowledgement([]byte("result"))
+	errorAck := types.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds)
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		expAck  channeltypes.Acknowledgement
+		expPass bool
+	}{
+		{
+			"success: result acknowledgement",
+			resultAck.Acknowledgement(),
+			resultAck,
+			true,
+		},
+		{
+			"success: error acknowledgement",
+			errorAck.Acknowledgement(),
+			errorAck,
+			true,
+		},
+		{
+			"success: incentivized result acknowledgement",
+			feetypes.NewIncentivizedAcknowledgement("relayer", resultAck.Acknowledgement(), true).Acknowledgement(),
+			resultAck,
+			true,
+		},
+		{
+			"success: incentivized error acknowledgement",
+			feetypes.NewIncentivizedAcknowledgement("relayer", errorAck.Acknowledgement(), false).Acknowledgement(),
+			errorAck,
+			true,
+		},
+		{
+			"invalid acknowledgement",
+			[]byte("invalid acknowledgement"),
+			channeltypes.Acknowledgement{},
+			false,
+		},
+		{
+			"incentivized acknowledgement wrapping an invalid acknowledgement",
+			feetypes.NewIncentivizedAcknowledgement("relayer", []byte("invalid acknowledgement"), true).Acknowledgement(),
+			channeltypes.Acknowledgement{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			ack, err := types.UnmarshalAcknowledgement(tc.bz)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAck, ack)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestUnmarshalTxMsgResult() {
+	msgData := &sdk.MsgData{
+		MsgType: "/cosmos.bank.v1beta1.MsgSend",
+		Data:    []byte("data"),
+	}
+
+	// results written by hosts which only populate sdk.TxMsgData
+	bz, err := proto.Marshal(&sdk.TxMsgData{Data: []*sdk.MsgData{msgData}})
+	suite.Require().NoError(err)
+
+	result, err := types.UnmarshalTxMsgResult(bz)
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Data, 1)
+	suite.Require().Equal(msgData.MsgType, result.Data[0].MsgType)
+	suite.Require().Equal(msgData.Data, result.Data[0].Data)
+	suite.Require().Empty(result.Results)
+
+	_, err = types.UnmarshalTxMsgResult([]byte("invalid"))
+	suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+}
+
+func (suite *TypesTestSuite) TestUnmarshalMsgResponses() {
+	var ack channeltypes.Acknowledgement
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"error acknowledgement",
+			func() {
+				ack = types.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds)
+			},
+			false,
+		},
+		{
+			"invalid acknowledgement result",
+			func() {
+				ack = channeltypes.NewResultAcknowledgement([]byte("invalid"))
+			},
+			false,
+		},
+		{
+			"message response count mismatch",
+			func() {
+				bz, err := proto.Marshal(&types.TxMsgResult{})
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(bz)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			expResponse := &banktypes.MsgSendResponse{}
+			data, err := proto.Marshal(expResponse)
+			suite.Require().NoError(err)
+
+			bz, err := proto.Marshal(&types.TxMsgResult{
+				Data: []*types.MsgData{{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}), Data: data}},
+			})
+			suite.Require().NoError(err)
+
+			ack = channeltypes.NewResultAcknowledgement(bz)
+
+			tc.malleate()
+
+			var msgResponse banktypes.MsgSendResponse
+			err = types.UnmarshalMsgResponses(ack, &msgResponse)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(*expResponse, msgResponse)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestUnpackAcknowledgementResponses() {
+	var ack channeltypes.Acknowledgement
+
+	delegateResponse, err := proto.Marshal(&stakingtypes.MsgDelegateResponse{})
+	suite.Require().NoError(err)
+
+	sendResponse, err := proto.Marshal(&banktypes.MsgSendResponse{})
+	suite.Require().NoError(err)
+
+	msgData := []*types.MsgData{
+		{MsgType: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), Data: delegateResponse},
+		{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}), Data: sendResponse},
+	}
+
+	expResponses := []*codectypes.Any{
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegateResponse", Value: delegateResponse},
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSendResponse", Value: sendResponse},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: msg responses",
+			func() {
+				bz, err := proto.Marshal(&types.TxMsgResult{Data: msgData, MsgResponses: expResponses})
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(bz)
+			},
+			true,
+		},
+		{
+			"success: legacy data only",
+			func() {
+				bz, err := proto.Marshal(&sdk.TxMsgData{Data: []*sdk.MsgData{
+					{MsgType: msgData[0].MsgType, Data: msgData[0].Data},
+					{MsgType: msgData[1].MsgType, Data: msgData[1].Data},
+				}})
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(bz)
+			},
+			true,
+		},
+		{
+			"success: Cosmos SDK v0.46 msg responses only",
+			func() {
+				bz, err := proto.Marshal(&types.TxMsgData{MsgResponses: expResponses})
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(bz)
+			},
+			true,
+		},
+		{
+			"success: Cosmos SDK v0.46 data and msg responses",
+			func() {
+				bz, err := proto.Marshal(&types.TxMsgData{Data: msgData, MsgResponses: expResponses})
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(bz)
+			},
+			true,
+		},
+		{
+			"error acknowledgement",
+			func() {
+				ack = types.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds)
+			},
+			false,
+		},
+		{
+			"invalid acknowledgement result",
+			func() {
+				ack = channeltypes.NewResultAcknowledgement([]byte("invalid"))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			tc.malleate()
+
+			msgResponses, err := types.UnpackAcknowledgementResponses(ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(msgResponses, len(expResponses))
+				for i, msgResponse := range msgResponses {
+					suite.Require().Equal(expResponses[i].TypeUrl, msgResponse.TypeUrl)
+					suite.Require().Equal(len(expResponses[i].Value), len(msgResponse.Value))
+				}
+
+				var delegate stakingtypes.MsgDelegateResponse
+				suite.Require().NoError(proto.Unmarshal(msgResponses[0].Value, &delegate))
+
+				var send banktypes.MsgSendResponse
+				suite.Require().NoError(proto.Unmarshal(msgResponses[1].Value, &send))
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestNewMsgResponseAny() {
+	msgResponse := types.NewMsgResponseAny(sdk.MsgTypeURL(&banktypes.MsgSend{}), []byte("data"))
+	suite.Require().Equal("/cosmos.bank.v1beta1.MsgSendResponse", msgResponse.TypeUrl)
+	suite.Require().Equal([]byte("data"), msgResponse.Value)
+
+	// unregistered response types are left without a type URL
+	msgResponse = types.NewMsgResponseAny("/unknown.Msg", []byte("data"))
+	suite.Require().Empty(msgResponse.TypeUrl)
+	suite.Require().Equal([]byte("data"), msgResponse.Value)
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v4/testing/simapp"
+)
+
+// BenchmarkDeserializeCosmosTx benchmarks the deserialization of protobuf encoded packet data containing 1, 10 and
+// 100 bank MsgSend messages. The results before and after unpacking the messages cached by the unmarshaled CosmosTx
+// into a pre-sized slice are recorded in testdata/deserialize_cosmos_tx_old.txt and testdata/deserialize_cosmos_tx_new.txt
+// and may be compared using benchstat. The results are recorded using:
+//
+//	go test -run '^$' -bench BenchmarkDeserializeCosmosTx -benchmem -count 6
+func BenchmarkDeserializeCosmosTx(b *testing.B) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	for _, msgCount := range []int{1, 10, 100} {
+		msgs := make([]sdk.Msg, msgCount)
+		for i := range msgs {
+			msgs[i] = &banktypes.MsgSend{
+				FromAddress: TestOwnerAddress,
+				ToAddress:   TestOwnerAddress,
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+		}
+
+		data, err := types.SerializeCosmosTx(cdc, msgs, types.EncodingProtobuf)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(fmt.Sprintf("msgs=%d", msgCount), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := types.DeserializeCosmosTx(cdc, data, types.EncodingProtobuf); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
 )
 
 // ModuleCdc references the global interchain accounts module codec. Note, the codec
@@ -24,28 +26,139 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
-// packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
-// bytes are returned. Only the ProtoCodec is supported for serializing messages.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+// packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
+// using the provided encoding, either protobuf or proto3 JSON, and the resulting bytes are returned.
+// Only the ProtoCodec is supported for serializing messages.
+func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg, encoding string) (bz []byte, err error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
-	msgAnys := make([]*codectypes.Any, len(msgs))
+	cosmosTx, err := newCosmosTx(msgs)
+	if err != nil {
+		return nil, err
+	}
 
-	for i, msg := range msgs {
-		msgAnys[i], err = codectypes.NewAnyWithValue(msg)
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = protoCdc.Marshal(cosmosTx)
+	case EncodingProto3JSON:
+		bz, err = protoCdc.MarshalJSON(cosmosTx)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes encoded using the provided
+// encoding, either protobuf or proto3 JSON, into a slice of sdk.Msg's. Only the ProtoCodec is supported
+// for message deserialization.
+func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte, encoding string) ([]sdk.Msg, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
+	}
+
+	var cosmosTx CosmosTx
+	switch encoding {
+	case EncodingProtobuf:
+		if err := protoCdc.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+	case EncodingProto3JSON:
+		if err := protoCdc.UnmarshalJSON(data, &cosmosTx); err != nil {
+			return nil, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal proto3 JSON encoded CosmosTx")
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	return unpackCosmosTx(protoCdc, cosmosTx)
+}
+
+// ParseMsgsFromJSON parses the provided JSON into a slice of sdk.Msg's. The JSON may contain either a single message
+// or an array of messages, each encoded as an Any using the proto3 JSON encoding, for example:
+//
+//	[{"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": "cosmos1...", ...}]
+//
+// The type URLs of the messages are resolved using the interface registry of the provided codec. Only the ProtoCodec
+// is supported for parsing messages.
+func ParseMsgsFromJSON(cdc codec.Codec, bz []byte) ([]sdk.Msg, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for parsing messages")
+	}
+
+	var rawMsgs []json.RawMessage
+	switch trimmed := bytes.TrimSpace(bz); {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		if err := json.Unmarshal(trimmed, &rawMsgs); err != nil {
+			return nil, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal JSON array of messages: %s", err)
+		}
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		rawMsgs = []json.RawMessage{trimmed}
+	default:
+		return nil, sdkerrors.Wrap(ErrUnknownDataType, "expected a JSON object or an array of JSON objects")
+	}
+
+	if len(rawMsgs) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidOutgoingData, "no messages provided")
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var msg sdk.Msg
+		if err := protoCdc.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return nil, sdkerrors.Wrapf(ErrUnknownDataType, "cannot parse message at index %d: %s", i, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// SerializeCosmosTxBatch serializes each of the provided groups of sdk.Msg's into its own CosmosTx, as described in
+// SerializeCosmosTx, and inserts the transactions into a CosmosTxBatch. The CosmosTxBatch is marshaled using the
+// provided encoding, either protobuf or proto3 JSON, and the resulting bytes are returned.
+func SerializeCosmosTxBatch(cdc codec.BinaryCodec, msgGroups [][]sdk.Msg, encoding string) (bz []byte, err error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
+	}
+
+	cosmosTxBatch := &CosmosTxBatch{
+		Txs: make([]CosmosTx, len(msgGroups)),
+	}
+
+	for i, msgs := range msgGroups {
+		cosmosTx, err := newCosmosTx(msgs)
 		if err != nil {
 			return nil, err
 		}
+
+		cosmosTxBatch.Txs[i] = *cosmosTx
 	}
 
-	cosmosTx := &CosmosTx{
-		Messages: msgAnys,
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = protoCdc.Marshal(cosmosTxBatch)
+	case EncodingProto3JSON:
+		bz, err = protoCdc.MarshalJSON(cosmosTxBatch)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
 	if err != nil {
 		return nil, err
 	}
@@ -53,38 +166,112 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 	return bz, nil
 }
 
-// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
-// into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
+// DeserializeCosmosTxBatch unmarshals and unpacks a CosmosTxBatch encoded using the provided encoding, either protobuf
+// or proto3 JSON, into a group of sdk.Msg's for each of its transactions. Only the ProtoCodec is supported for message
 // deserialization.
-func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+func DeserializeCosmosTxBatch(cdc codec.BinaryCodec, data []byte, encoding string) ([][]sdk.Msg, error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
-	logger.InitLogger()
-
-	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
-		logger.LogInfo("I think we error because the msg inside data is not wraped by the 'Any' msg type?")
-		logger.LogInfo("The error from cdc.Unmarshal() is:", err)
-		return nil, err
+	var cosmosTxBatch CosmosTxBatch
+	switch encoding {
+	case EncodingProtobuf:
+		if err := protoCdc.Unmarshal(data, &cosmosTxBatch); err != nil {
+			return nil, err
+		}
+	case EncodingProto3JSON:
+		if err := protoCdc.UnmarshalJSON(data, &cosmosTxBatch); err != nil {
+			return nil, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal proto3 JSON encoded CosmosTxBatch")
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
 	}
 
-	logger.LogInfo("Call stack did not make it this far")
+	msgGroups := make([][]sdk.Msg, len(cosmosTxBatch.Txs))
+	for i, cosmosTx := range cosmosTxBatch.Txs {
+		msgs, err := unpackCosmosTx(protoCdc, cosmosTx)
+		if err != nil {
+			return nil, err
+		}
 
-	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+		msgGroups[i] = msgs
+	}
 
-	for i, any := range cosmosTx.Messages {
-		var msg sdk.Msg
+	return msgGroups, nil
+}
+
+// newCosmosTx packs the provided sdk.Msg's into Any's and returns the CosmosTx containing them
+func newCosmosTx(msgs []sdk.Msg) (*CosmosTx, error) {
+	msgAnys := make([]*codectypes.Any, len(msgs))
 
-		err := cdc.UnpackAny(any, &msg)
+	for i, msg := range msgs {
+		var err error
+		msgAnys[i], err = codectypes.NewAnyWithValue(msg)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		msgs[i] = msg
+	return &CosmosTx{
+		Messages: msgAnys,
+	}, nil
+}
+
+// unpackCosmosTx unpacks the messages of the provided CosmosTx into a slice of sdk.Msg's. The messages are unpacked
+// by the codec when the CosmosTx is unmarshaled, such that UnpackAny returns the message cached by each Any, which is
+// assigned in place to the pre-sized msgs slice. Messages without a type URL are rejected.
+func unpackCosmosTx(protoCdc *codec.ProtoCodec, cosmosTx CosmosTx) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+
+	for i, msgAny := range cosmosTx.Messages {
+		if msgAny == nil || msgAny.TypeUrl == "" {
+			return nil, sdkerrors.Wrapf(ErrUnknownDataType, "message at index %d has no type URL", i)
+		}
+
+		if err := protoCdc.UnpackAny(msgAny, &msgs[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	return msgs, nil
 }
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type. The CosmosQuery is
+// marshaled using the provided encoding, either protobuf or proto3 JSON, and the resulting bytes are returned.
+func SerializeCosmosQuery(requests []QueryRequest, encoding string) ([]byte, error) {
+	cosmosQuery := &CosmosQuery{
+		Requests: requests,
+	}
+
+	switch encoding {
+	case EncodingProtobuf:
+		return ModuleCdc.Marshal(cosmosQuery)
+	case EncodingProto3JSON:
+		return ModuleCdc.MarshalJSON(cosmosQuery)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query request bytes encoded using the provided encoding,
+// either protobuf or proto3 JSON, into a slice of query requests.
+func DeserializeCosmosQuery(data []byte, encoding string) ([]QueryRequest, error) {
+	var cosmosQuery CosmosQuery
+	switch encoding {
+	case EncodingProtobuf:
+		if err := ModuleCdc.Unmarshal(data, &cosmosQuery); err != nil {
+			return nil, err
+		}
+	case EncodingProto3JSON:
+		if err := ModuleCdc.UnmarshalJSON(data, &cosmosQuery); err != nil {
+			return nil, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal proto3 JSON encoded CosmosQuery")
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	return cosmosQuery.Requests, nil
+}
@@ -1,10 +1,16 @@
 package types_test
 
 import (
+	"testing"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v4/testing/simapp"
@@ -109,29 +115,262 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 		},
 	}
 
-	testCasesAny := []caseRawBytes{}
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		testCasesAny := []caseRawBytes{}
+
+		for _, tc := range testCases {
+			bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs, encoding)
+			if encoding == types.EncodingProto3JSON && !tc.expPass {
+				// unregistered msg types cannot be resolved when marshaling to proto3 JSON
+				suite.Require().Error(err, tc.name)
+				continue
+			}
+
+			suite.Require().NoError(err, tc.name)
+
+			testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
+		}
+
+		for i, tc := range testCasesAny {
+			msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz, encoding)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		}
+
+		// test deserializing unknown bytes
+		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"), encoding)
+		suite.Require().Error(err)
+		suite.Require().Empty(msgs)
+	}
+}
+
+func (suite *TypesTestSuite) TestSerializeCosmosTxEncodingMismatch() {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+		},
+	}
+
+	protoBz, err := types.SerializeCosmosTx(cdc, msgs, types.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	jsonBz, err := types.SerializeCosmosTx(cdc, msgs, types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(protoBz, jsonBz)
+
+	_, err = types.DeserializeCosmosTx(cdc, protoBz, types.EncodingProto3JSON)
+	suite.Require().Error(err)
+
+	_, err = types.DeserializeCosmosTx(cdc, jsonBz, types.EncodingProtobuf)
+	suite.Require().Error(err)
+
+	_, err = types.SerializeCosmosTx(cdc, msgs, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosTx(cdc, protoBz, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
+
+func (suite *TypesTestSuite) TestParseMsgsFromJSON() {
+	var (
+		bankMsgJSON = `{
+			"@type": "/cosmos.bank.v1beta1.MsgSend",
+			"from_address": "` + TestOwnerAddress + `",
+			"to_address": "` + TestOwnerAddress + `",
+			"amount": [{"denom": "bananas", "amount": "100"}]
+		}`
+		stakingMsgJSON = `{
+			"@type": "/cosmos.staking.v1beta1.MsgDelegate",
+			"delegator_address": "` + TestOwnerAddress + `",
+			"validator_address": "cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k",
+			"amount": {"denom": "stake", "amount": "1000"}
+		}`
+		unregisteredMsgJSON = `{"@type": "/cosmos.unregistered.v1.MsgUnregistered", "sender": "` + TestOwnerAddress + `"}`
+	)
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+	}
+
+	stakingMsg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: TestOwnerAddress,
+		ValidatorAddress: "cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k",
+		Amount:           sdk.NewCoin("stake", sdk.NewInt(1000)),
+	}
+
+	testCases := []struct {
+		name    string
+		json    string
+		expMsgs []sdk.Msg
+		expErr  string
+	}{
+		{
+			"success: single bank msg",
+			bankMsgJSON,
+			[]sdk.Msg{bankMsg},
+			"",
+		},
+		{
+			"success: single staking msg in array",
+			"[" + stakingMsgJSON + "]",
+			[]sdk.Msg{stakingMsg},
+			"",
+		},
+		{
+			"success: bank and staking msgs",
+			"\n[" + bankMsgJSON + ", " + stakingMsgJSON + "]\n",
+			[]sdk.Msg{bankMsg, stakingMsg},
+			"",
+		},
+		{
+			"unregistered type URL",
+			unregisteredMsgJSON,
+			nil,
+			"cannot parse message at index 0",
+		},
+		{
+			"unregistered type URL in array",
+			"[" + bankMsgJSON + ", " + stakingMsgJSON + ", " + unregisteredMsgJSON + "]",
+			nil,
+			"cannot parse message at index 2",
+		},
+		{
+			"malformed msg in array",
+			"[" + bankMsgJSON + `, {"@type": "/cosmos.bank.v1beta1.MsgSend", "amount": "invalid"}]`,
+			nil,
+			"cannot parse message at index 1",
+		},
+		{
+			"msg without type URL",
+			`{"from_address": "` + TestOwnerAddress + `"}`,
+			nil,
+			"cannot parse message at index 0",
+		},
+		{
+			"empty array",
+			"[]",
+			nil,
+			"no messages provided",
+		},
+		{
+			"malformed array",
+			"[" + bankMsgJSON,
+			nil,
+			"cannot unmarshal JSON array of messages",
+		},
+		{
+			"not a JSON object or array",
+			"packet_data.json",
+			nil,
+			"expected a JSON object or an array of JSON objects",
+		},
+	}
 
 	for _, tc := range testCases {
-		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
-		suite.Require().NoError(err, tc.name)
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msgs, err := types.ParseMsgsFromJSON(simapp.MakeTestEncodingConfig().Marshaler, []byte(tc.json))
 
-		testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expMsgs, msgs)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+				suite.Require().Nil(msgs)
+			}
+		})
 	}
+}
 
-	for i, tc := range testCasesAny {
-		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz)
-		if tc.expPass {
-			suite.Require().NoError(err, tc.name)
-			suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
-		} else {
-			suite.Require().Error(err, tc.name)
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	requests := []types.QueryRequest{
+		{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: []byte("request")},
+		{Path: "/cosmos.staking.v1beta1.Query/Validator", Data: []byte{}},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosQuery(requests, encoding)
+		suite.Require().NoError(err, encoding)
+
+		deserialized, err := types.DeserializeCosmosQuery(bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Len(deserialized, len(requests))
+
+		for i, request := range requests {
+			suite.Require().Equal(request.Path, deserialized[i].Path, encoding)
+			suite.Require().Equal(len(request.Data), len(deserialized[i].Data), encoding)
 		}
 	}
 
-	// test deserializing unknown bytes
-	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"))
-	suite.Require().Error(err)
-	suite.Require().Empty(msgs)
+	_, err := types.SerializeCosmosQuery(requests, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosQuery([]byte("invalid"), types.EncodingProto3JSON)
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+
+	_, err = types.DeserializeCosmosQuery([]byte{}, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTxBatch() {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	msgGroups := [][]sdk.Msg{
+		{
+			&banktypes.MsgSend{
+				FromAddress: TestOwnerAddress,
+				ToAddress:   TestOwnerAddress,
+				Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+			},
+		},
+		{
+			&banktypes.MsgSend{
+				FromAddress: TestOwnerAddress,
+				ToAddress:   TestOwnerAddress,
+				Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+			},
+			&banktypes.MsgSend{
+				FromAddress: TestOwnerAddress,
+				ToAddress:   TestOwnerAddress,
+				Amount:      sdk.NewCoins(sdk.NewCoin("apples", sdk.NewInt(100))),
+			},
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosTxBatch(cdc, msgGroups, encoding)
+		suite.Require().NoError(err, encoding)
+
+		deserialized, err := types.DeserializeCosmosTxBatch(cdc, bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(msgGroups, deserialized, encoding)
+
+		// a single transaction is not a valid batch
+		txBz, err := types.SerializeCosmosTx(cdc, msgGroups[0], encoding)
+		suite.Require().NoError(err, encoding)
+
+		_, err = types.DeserializeCosmosTxBatch(cdc, txBz, encoding)
+		suite.Require().Error(err, encoding)
+	}
+
+	_, err := types.SerializeCosmosTxBatch(cdc, msgGroups, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosTxBatch(cdc, []byte("invalid"), types.EncodingProto3JSON)
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+
+	_, err = types.DeserializeCosmosTxBatch(cdc, []byte{}, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
 }
 
 // unregistered bytes causes amino to panic.
@@ -141,11 +380,184 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	cdc := codec.NewLegacyAmino()
 	marshaler := codec.NewAminoCodec(cdc)
 
-	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}})
+	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(msgs)
 
-	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0})
+	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(bz)
 }
+
+func (suite *TypesTestSuite) TestDeserializeCosmosTxProtobufWireFormat() {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	msgSendBz, err := cdc.Marshal(msgSend)
+	suite.Require().NoError(err)
+
+	typeURL := sdk.MsgTypeURL(msgSend)
+
+	// encodeMsg encodes the provided fields as an Any and appends it to a CosmosTx as a message
+	encodeMsg := func(tx []byte, anyFields ...[]byte) []byte {
+		var anyBz []byte
+		for _, field := range anyFields {
+			anyBz = append(anyBz, field...)
+		}
+
+		tx = protowire.AppendTag(tx, 1, protowire.BytesType)
+		return protowire.AppendBytes(tx, anyBz)
+	}
+
+	typeURLField := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), typeURL)
+	valueField := protowire.AppendBytes(protowire.AppendTag(nil, 2, protowire.BytesType), msgSendBz)
+	unknownField := protowire.AppendVarint(protowire.AppendTag(nil, 5, protowire.VarintType), 1)
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		expPass bool
+	}{
+		{
+			"success: no messages",
+			[]byte{},
+			true,
+		},
+		{
+			"success: unknown fields are skipped",
+			encodeMsg(unknownField, typeURLField, unknownField, valueField),
+			true,
+		},
+		{
+			"success: fields of the Any in reverse order",
+			encodeMsg(nil, valueField, typeURLField),
+			true,
+		},
+		{
+			"failure: message without type URL",
+			encodeMsg(encodeMsg(nil, typeURLField, valueField), valueField),
+			false,
+		},
+		{
+			"failure: unregistered type URL",
+			encodeMsg(nil, protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "/invalid.MsgType"), valueField),
+			false,
+		},
+		{
+			"failure: wrong wire type for messages",
+			protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1),
+			false,
+		},
+		{
+			"failure: wrong wire type for type URL",
+			encodeMsg(nil, protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1), valueField),
+			false,
+		},
+		{
+			"failure: truncated message",
+			encodeMsg(nil, typeURLField, valueField)[:10],
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msgs, err := types.DeserializeCosmosTx(cdc, tc.bz, types.EncodingProtobuf)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+
+			for _, msg := range msgs {
+				suite.Require().Equal(msgSend, msg, tc.name)
+			}
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestDeserializeCosmosTxMatchesCosmosTxUnmarshal() {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+	msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(TestOwnerAddress), []sdk.Msg{msgSend, msgSend})
+
+	bz, err := types.SerializeCosmosTx(cdc, []sdk.Msg{msgSend, &msgExec, msgSend}, types.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	var cosmosTx types.CosmosTx
+	suite.Require().NoError(cdc.Unmarshal(bz, &cosmosTx))
+
+	expMsgs := make([]sdk.Msg, len(cosmosTx.Messages))
+	for i, msgAny := range cosmosTx.Messages {
+		expMsgs[i] = msgAny.GetCachedValue().(sdk.Msg)
+	}
+
+	// deserialize twice to ensure repeated decoding of the same message types returns equal results
+	for i := 0; i < 2; i++ {
+		msgs, err := types.DeserializeCosmosTx(cdc, bz, types.EncodingProtobuf)
+		suite.Require().NoError(err)
+		suite.Require().Len(msgs, len(expMsgs))
+
+		for j, msg := range msgs {
+			suite.Require().Equal(cdc.MustMarshal(expMsgs[j].(codec.ProtoMarshaler)), cdc.MustMarshal(msg.(codec.ProtoMarshaler)))
+		}
+
+		execMsgs, err := msgs[1].(*authz.MsgExec).GetMessages()
+		suite.Require().NoError(err)
+		suite.Require().Equal([]sdk.Msg{msgSend, msgSend}, execMsgs)
+	}
+}
+
+// FuzzDeserializeCosmosTx asserts that deserializing protobuf encoded CosmosTx messages is equivalent to
+// unmarshaling the CosmosTx using the codec and unpacking each of its messages. Messages without a type URL, which
+// are unpacked to nil messages by the codec, are rejected.
+func FuzzDeserializeCosmosTx(f *testing.F) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+	msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(TestOwnerAddress), []sdk.Msg{msgSend})
+
+	for _, msgs := range [][]sdk.Msg{{}, {msgSend}, {msgSend, &msgExec, msgSend}} {
+		bz, err := types.SerializeCosmosTx(cdc, msgs, types.EncodingProtobuf)
+		require.NoError(f, err)
+
+		f.Add(bz)
+	}
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		msgs, err := types.DeserializeCosmosTx(cdc, bz, types.EncodingProtobuf)
+
+		var cosmosTx types.CosmosTx
+		if cdc.Unmarshal(bz, &cosmosTx) != nil {
+			require.Error(t, err)
+			return
+		}
+
+		for _, msgAny := range cosmosTx.Messages {
+			if msgAny.TypeUrl == "" {
+				require.Error(t, err)
+				return
+			}
+		}
+
+		require.NoError(t, err)
+		require.Len(t, msgs, len(cosmosTx.Messages))
+
+		for i, msgAny := range cosmosTx.Messages {
+			expMsg := msgAny.GetCachedValue().(codec.ProtoMarshaler)
+			require.Equal(t, cdc.MustMarshal(expMsg), cdc.MustMarshal(msgs[i].(codec.ProtoMarshaler)))
+		}
+	})
+}
@@ -23,4 +23,12 @@ var (
 	ErrInvalidTimeoutTimestamp     = sdkerrors.Register(ModuleName, 17, "timeout timestamp must be in the future")
 	ErrInvalidCodec                = sdkerrors.Register(ModuleName, 18, "codec is not supported")
 	ErrInvalidAccountReopening     = sdkerrors.Register(ModuleName, 19, "invalid account reopening")
+	ErrInvalidAcknowledgement      = sdkerrors.Register(ModuleName, 20, "invalid acknowledgement")
+	ErrMaxPacketDataSize           = sdkerrors.Register(ModuleName, 21, "max packet data size exceeded")
 )
+
+// WrapRejectionReason wraps the provided channel handshake error with the provided rejection reason, such that the
+// reason is included in the error returned to the relayer.
+func WrapRejectionReason(err error, reason string) error {
+	return sdkerrors.Wrapf(err, "%s: %s", AttributeKeyRejectionReason, reason)
+}
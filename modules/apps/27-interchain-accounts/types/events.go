@@ -2,10 +2,43 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket                    = "ics27_packet"
+	EventTypeRegisterInterchainAccount = "register_interchain_account"
+	EventTypeUpdateChannelVersion      = "update_channel_version"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyPortID              = "port_id"
+	AttributeKeyMemo                = "memo"
+	AttributeKeyVersion             = "version"
+	AttributeKeyRejectionReason     = "rejection_reason"
+)
+
+// Reasons for the rejection of a channel handshake by the host chain, included in the returned error using the
+// rejection_reason attribute
+const (
+	// RejectionReasonHostDisabled is used when the host submodule is disabled
+	RejectionReasonHostDisabled = "host_disabled"
+	// RejectionReasonInvalidOrdering is used when the channel is not ORDERED
+	RejectionReasonInvalidOrdering = "invalid_ordering"
+	// RejectionReasonInvalidPort is used when the channel is not opened on the host port
+	RejectionReasonInvalidPort = "invalid_port"
+	// RejectionReasonPortBound is used when the host port is bound by another module
+	RejectionReasonPortBound = "port_bound"
+	// RejectionReasonCapability is used when a port or channel capability cannot be claimed
+	RejectionReasonCapability = "capability"
+	// RejectionReasonInvalidMetadata is used when the counterparty version is not valid ICS27 metadata
+	RejectionReasonInvalidMetadata = "invalid_metadata"
+	// RejectionReasonActiveChannelOpen is used when an OPEN active channel is already set for the controller port
+	RejectionReasonActiveChannelOpen = "active_channel_open"
+	// RejectionReasonMetadataMismatch is used when the metadata does not match the metadata of the previous active channel
+	RejectionReasonMetadataMismatch = "metadata_mismatch"
+	// RejectionReasonUntrustedConnection is used when a new interchain account is registered over an untrusted connection
+	RejectionReasonUntrustedConnection = "untrusted_connection"
+	// RejectionReasonInvalidAccount is used when the interchain account cannot be created or reopened
+	RejectionReasonInvalidAccount = "invalid_account"
 )
@@ -27,8 +27,24 @@ type ICS4Wrapper interface {
 // ChannelKeeper defines the expected IBC channel keeper
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	SetChannel(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetAllPacketCommitmentsAtChannel(ctx sdk.Context, portID, channelID string) []channeltypes.PacketState
 	GetConnection(ctx sdk.Context, connectionID string) (ibcexported.ConnectionI, error)
+	IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
+}
+
+// FeeKeeper defines the expected ICS29 fee keeper, used to enable fees on channels whose version is updated to include
+// the ICS29 fee version
+type FeeKeeper interface {
+	SetFeeEnabled(ctx sdk.Context, portID, channelID string)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool)
 }
 
 // PortKeeper defines the expected IBC port keeper
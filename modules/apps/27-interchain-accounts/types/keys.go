@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -39,6 +41,30 @@ var (
 
 	// PortKeyPrefix defines the key prefix used to store ports
 	PortKeyPrefix = "port"
+
+	// IsMiddlewareEnabledPrefix defines the key prefix used to store the middleware enabled flag of controller ports
+	IsMiddlewareEnabledPrefix = "isMiddlewareEnabled"
+
+	// MsgCountKeyPrefix defines the key prefix used to store the number of messages sent by type URL on controller ports
+	MsgCountKeyPrefix = "msgCount"
+
+	// PendingPacketKeyPrefix defines the key prefix used to store the data of packets sent on controller ports which have
+	// not yet been acknowledged or timed out
+	PendingPacketKeyPrefix = "pendingPacket"
+
+	// CloseChannelKeyPrefix defines the key prefix used to mark controller channels whose closing has been initiated
+	// by the controller submodule
+	CloseChannelKeyPrefix = "closeChannel"
+
+	// ChannelVersionUpdateKeyPrefix defines the key prefix used to store the sequence of the packet updating the version of
+	// a controller channel which has not yet been acknowledged or timed out
+	ChannelVersionUpdateKeyPrefix = "channelVersionUpdate"
+
+	// MiddlewareEnabled is the value used to signify that the controller middleware calls the underlying application
+	MiddlewareEnabled = []byte{0x01}
+
+	// MiddlewareDisabled is the value used to signify that the controller middleware does not call the underlying application
+	MiddlewareDisabled = []byte{0x02}
 )
 
 // KeyActiveChannel creates and returns a new key used for active channels store operations
@@ -55,3 +81,40 @@ func KeyOwnerAccount(portID, connectionID string) []byte {
 func KeyPort(portID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
 }
+
+// KeyIsMiddlewareEnabled creates and returns a new key used for signaling if the controller middleware calls the underlying application
+func KeyIsMiddlewareEnabled(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareEnabledPrefix, portID, connectionID))
+}
+
+// KeyMsgCountPrefix creates and returns a new key prefix used for iterating the message counts of a controller port on a connection
+func KeyMsgCountPrefix(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", MsgCountKeyPrefix, portID, connectionID))
+}
+
+// KeyMsgCount creates and returns a new key used for message count store operations
+func KeyMsgCount(portID, connectionID, typeURL string) []byte {
+	return append(KeyMsgCountPrefix(portID, connectionID), []byte(typeURL)...)
+}
+
+// KeyPendingPacketPrefix creates and returns a new key prefix used for iterating the pending packets of a controller port on a channel
+func KeyPendingPacketPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", PendingPacketKeyPrefix, portID, channelID))
+}
+
+// KeyPendingPacket creates and returns a new key used for pending packet store operations. The sequence is encoded in
+// big endian to ensure the pending packets are iterated in order of packet sequence.
+func KeyPendingPacket(portID, channelID string, sequence uint64) []byte {
+	return append(KeyPendingPacketPrefix(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyCloseChannel creates and returns a new key used to mark the closing of a controller channel
+func KeyCloseChannel(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", CloseChannelKeyPrefix, portID, channelID))
+}
+
+// KeyChannelVersionUpdate creates and returns a new key used to store the sequence of the packet updating the version of a
+// controller channel
+func KeyChannelVersionUpdate(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ChannelVersionUpdateKeyPrefix, portID, channelID))
+}
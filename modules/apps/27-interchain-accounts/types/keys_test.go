@@ -13,3 +13,8 @@ func (suite *TypesTestSuite) TestKeyOwnerAccount() {
 	key := types.KeyOwnerAccount("port-id", "connection-id")
 	suite.Require().Equal("owner/port-id/connection-id", string(key))
 }
+
+func (suite *TypesTestSuite) TestKeyMsgCount() {
+	key := types.KeyMsgCount("port-id", "connection-id", "/cosmos.bank.v1beta1.MsgSend")
+	suite.Require().Equal("msgCount/port-id/connection-id//cosmos.bank.v1beta1.MsgSend", string(key))
+}
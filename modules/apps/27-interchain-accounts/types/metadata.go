@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 )
 
@@ -11,8 +12,15 @@ const (
 	// EncodingProtobuf defines the protocol buffers proto3 encoding format
 	EncodingProtobuf = "proto3"
 
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
+
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
+
+	// TxTypeSDKMultiMsgBatch defines the multi message transaction type supported by the Cosmos SDK, additionally allowing
+	// batches of independent transactions to be sent in a single packet
+	TxTypeSDKMultiMsgBatch = "sdk_multi_msg_batch"
 )
 
 // NewMetadata creates and returns a new ICS27 Metadata instance
@@ -49,6 +57,62 @@ func NewDefaultMetadataString(controllerConnectionID, hostConnectionID string) s
 	return string(ModuleCdc.MustMarshalJSON(&metadata))
 }
 
+// MetadataFromVersion parses the ICS27 metadata from the provided channel version. The versions of fee enabled channels
+// are unwrapped using the ICS29 fee metadata prior to being parsed.
+func MetadataFromVersion(version string) (Metadata, error) {
+	_, metadata, err := parseVersion(version)
+	return metadata, err
+}
+
+// ValidateVersionUpdate validates the proposed version of an existing channel against its current version. Only
+// additive changes are permitted, the ICS29 fee version may be added to the version of a channel which is not fee
+// enabled while the ICS27 metadata, including the encoding and transaction type, must remain unchanged.
+func ValidateVersionUpdate(currentVersion, proposedVersion string) error {
+	currentFeeVersion, currentMetadata, err := parseVersion(currentVersion)
+	if err != nil {
+		return err
+	}
+
+	proposedFeeVersion, proposedMetadata, err := parseVersion(proposedVersion)
+	if err != nil {
+		return err
+	}
+
+	if proposedMetadata != currentMetadata {
+		return sdkerrors.Wrap(ErrInvalidVersion, "the interchain accounts metadata of a channel cannot be changed")
+	}
+
+	if proposedFeeVersion == currentFeeVersion {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "proposed version does not change the current version %s", currentVersion)
+	}
+
+	if currentFeeVersion != "" {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "fee version %s cannot be changed or removed", currentFeeVersion)
+	}
+
+	if proposedFeeVersion != feetypes.Version {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected fee version %s, got %s", feetypes.Version, proposedFeeVersion)
+	}
+
+	return nil
+}
+
+// parseVersion returns the ICS29 fee version and the ICS27 metadata of the provided channel version. The fee version is
+// empty if the version is not wrapped using the ICS29 fee metadata.
+func parseVersion(version string) (string, Metadata, error) {
+	var feeMetadata feetypes.Metadata
+	if err := feetypes.ModuleCdc.UnmarshalJSON([]byte(version), &feeMetadata); err == nil && feeMetadata.FeeVersion != "" {
+		version = feeMetadata.AppVersion
+	}
+
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return "", Metadata{}, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return feeMetadata.FeeVersion, metadata, nil
+}
+
 // IsPreviousMetadataEqual compares a metadata to a previous version string set in a channel struct.
 // It ensures all fields are equal except the Address string
 func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
@@ -141,7 +205,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf}
+	return []string{EncodingProtobuf, EncodingProto3JSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -157,7 +221,7 @@ func isSupportedTxType(txType string) bool {
 
 // getSupportedTxTypes returns a string slice of supported transaction types
 func getSupportedTxTypes() []string {
-	return []string{TxTypeSDKMultiMsg}
+	return []string{TxTypeSDKMultiMsg, TxTypeSDKMultiMsgBatch}
 }
 
 // validateConnectionParams compares the given the controller and host connection IDs to those set in the provided ICS27 Metadata
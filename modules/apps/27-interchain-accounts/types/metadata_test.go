@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -152,6 +153,20 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with batch transaction type",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsgBatch,
+				}
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -293,6 +308,20 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with batch transaction type",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsgBatch,
+				}
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -406,3 +435,67 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMetadataFromVersion() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+	version := string(types.ModuleCdc.MustMarshalJSON(&metadata))
+
+	testCases := []struct {
+		name    string
+		version string
+		expPass bool
+	}{
+		{"success", version, true},
+		{"success: fee enabled channel version", string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: version})), true},
+		{"legacy version", types.Version, false},
+		{"fee enabled channel with legacy version", string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: types.Version})), false},
+		{"empty version", "", false},
+	}
+
+	for _, tc := range testCases {
+		res, err := types.MetadataFromVersion(tc.version)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(metadata, res, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestValidateVersionUpdate() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+	version := string(types.ModuleCdc.MustMarshalJSON(&metadata))
+	feeVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: version}))
+
+	changedMetadata := metadata
+	changedMetadata.Encoding = types.EncodingProto3JSON
+	changedVersion := string(types.ModuleCdc.MustMarshalJSON(&changedMetadata))
+
+	testCases := []struct {
+		name            string
+		currentVersion  string
+		proposedVersion string
+		expErr          error
+	}{
+		{"success: fee version added", version, feeVersion, nil},
+		{"fee version added with changed encoding", version, string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: changedVersion})), types.ErrInvalidVersion},
+		{"encoding changed", version, changedVersion, types.ErrInvalidVersion},
+		{"unsupported fee version added", version, string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: "ics29-2", AppVersion: version})), types.ErrInvalidVersion},
+		{"version unchanged", version, version, types.ErrInvalidVersion},
+		{"fee version unchanged", feeVersion, feeVersion, types.ErrInvalidVersion},
+		{"fee version removed", feeVersion, version, types.ErrInvalidVersion},
+		{"fee version changed", feeVersion, string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: "ics29-2", AppVersion: version})), types.ErrInvalidVersion},
+		{"invalid current version", types.Version, feeVersion, types.ErrUnknownDataType},
+		{"invalid proposed version", version, "invalid-version", types.ErrUnknownDataType},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateVersionUpdate(tc.currentVersion, tc.proposedVersion)
+		if tc.expErr == nil {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -9,19 +9,40 @@ import (
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
 const MaxMemoCharLength = 256
 
-// ValidateBasic performs basic validation of the interchain account packet data.
+// ValidateBasic performs basic validation of the interchain account packet data. The packet data type must be a known
+// type other than UNSPECIFIED, the data must not be empty and the memo must not exceed MaxMemoCharLength characters.
 // The memo may be empty.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
+	return iapd.ValidateBasicWithMaxMemoLength(MaxMemoCharLength)
+}
+
+// ValidateBasicWithMaxMemoLength performs the validation of ValidateBasic, bounding the length of the memo by the provided
+// maximum memo length rather than MaxMemoCharLength. A maximum memo length of zero is unbounded.
+func (iapd InterchainAccountPacketData) ValidateBasicWithMaxMemoLength(maxMemoLength uint64) error {
 	if iapd.Type == UNSPECIFIED {
 		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
 	}
 
+	if _, ok := Type_name[int32(iapd.Type)]; !ok {
+		return sdkerrors.Wrapf(ErrUnknownDataType, "unknown packet data type %d", iapd.Type)
+	}
+
 	if len(iapd.Data) == 0 {
 		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data cannot be empty")
 	}
 
-	if len(iapd.Memo) > MaxMemoCharLength {
-		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	if maxMemoLength > 0 && uint64(len(iapd.Memo)) > maxMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", maxMemoLength)
+	}
+
+	return nil
+}
+
+// ValidatePacketDataSize ensures the size of the provided packet data does not exceed the provided maximum size in
+// bytes. A maximum size of zero is unbounded.
+func ValidatePacketDataSize(data []byte, maxSize uint64) error {
+	if maxSize > 0 && uint64(len(data)) > maxSize {
+		return sdkerrors.Wrapf(ErrMaxPacketDataSize, "packet data size %d bytes exceeds max packet data size %d bytes", len(data), maxSize)
 	}
 
 	return nil
@@ -48,3 +69,14 @@ func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 	return nil
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ctb CosmosTxBatch) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, tx := range ctb.Txs {
+		if err := tx.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -33,16 +33,35 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute a transaction on an interchain accounts host chain, committing the state changes of each successful
+	// message individually rather than reverting the transaction if a single message fails
+	EXECUTE_TX_NON_ATOMIC Type = 2
+	// Execute a list of whitelisted gRPC queries against the state of an interchain accounts host chain
+	EXECUTE_QUERY Type = 3
+	// Execute a batch of independent transactions on an interchain accounts host chain, each transaction is executed
+	// atomically and its state changes are committed independently of the other transactions of the batch
+	EXECUTE_TX_BATCH Type = 4
+	// Update the version of the channel to the version contained in the packet data, which may only add the ICS29 fee
+	// version to the current channel version
+	UPDATE_CHANNEL_VERSION Type = 5
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_EXECUTE_TX_NON_ATOMIC",
+	3: "TYPE_EXECUTE_QUERY",
+	4: "TYPE_EXECUTE_TX_BATCH",
+	5: "TYPE_UPDATE_CHANNEL_VERSION",
 }
 
 var Type_value = map[string]int32{
-	"TYPE_UNSPECIFIED": 0,
-	"TYPE_EXECUTE_TX":  1,
+	"TYPE_UNSPECIFIED":            0,
+	"TYPE_EXECUTE_TX":             1,
+	"TYPE_EXECUTE_TX_NON_ATOMIC":  2,
+	"TYPE_EXECUTE_QUERY":          3,
+	"TYPE_EXECUTE_TX_BATCH":       4,
+	"TYPE_UPDATE_CHANNEL_VERSION": 5,
 }
 
 func (x Type) String() string {
@@ -159,10 +178,159 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// CosmosTxBatch contains a list of CosmosTx's. It should be used when sending a batch of independent transactions to
+// an SDK host chain.
+type CosmosTxBatch struct {
+	Txs []CosmosTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+}
+
+func (m *CosmosTxBatch) Reset()         { *m = CosmosTxBatch{} }
+func (m *CosmosTxBatch) String() string { return proto.CompactTextString(m) }
+func (*CosmosTxBatch) ProtoMessage()    {}
+func (*CosmosTxBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *CosmosTxBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosTxBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosTxBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosTxBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosTxBatch.Merge(m, src)
+}
+func (m *CosmosTxBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosTxBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosTxBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosTxBatch proto.InternalMessageInfo
+
+func (m *CosmosTxBatch) GetTxs() []CosmosTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// CosmosQuery contains a list of gRPC query requests. It should be used when sending query requests to an SDK host chain.
+type CosmosQuery struct {
+	Requests []QueryRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+}
+
+func (m *CosmosQuery) Reset()         { *m = CosmosQuery{} }
+func (m *CosmosQuery) String() string { return proto.CompactTextString(m) }
+func (*CosmosQuery) ProtoMessage()    {}
+func (*CosmosQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *CosmosQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQuery.Merge(m, src)
+}
+func (m *CosmosQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQuery proto.InternalMessageInfo
+
+func (m *CosmosQuery) GetRequests() []QueryRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// QueryRequest defines a gRPC query request to be executed by an interchain accounts host chain.
+type QueryRequest struct {
+	// path is the fully qualified gRPC method path of the query, e.g. /cosmos.bank.v1beta1.Query/Balance
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data is the protobuf encoded query request
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{4}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*CosmosTxBatch)(nil), "ibc.applications.interchain_accounts.v1.CosmosTxBatch")
+	proto.RegisterType((*CosmosQuery)(nil), "ibc.applications.interchain_accounts.v1.CosmosQuery")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.v1.QueryRequest")
 }
 
 func init() {
@@ -170,32 +338,43 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xcd, 0xb8, 0x41, 0xd6, 0x59, 0xd9, 0x2d, 0x61, 0x0f, 0x31, 0x42, 0x08, 0x2b, 0x62, 0x10,
-	0x32, 0xe3, 0xd6, 0x82, 0x17, 0x2f, 0xb5, 0x8d, 0xd0, 0x8b, 0x94, 0x98, 0x42, 0xf5, 0x12, 0x26,
-	0xd3, 0x31, 0x1d, 0x6c, 0x32, 0xa1, 0x33, 0x29, 0xe6, 0x1f, 0x94, 0x9e, 0xfc, 0x03, 0x3d, 0xf9,
-	0x67, 0x3c, 0xf6, 0xe8, 0x51, 0xda, 0x3f, 0x22, 0x99, 0x60, 0xdb, 0x83, 0x07, 0x6f, 0x8f, 0xc7,
-	0xf7, 0xde, 0xf7, 0xbd, 0xef, 0xc1, 0x1e, 0x4f, 0x29, 0x26, 0x65, 0xb9, 0xe0, 0x94, 0x28, 0x2e,
-	0x0a, 0x89, 0x79, 0xa1, 0xd8, 0x92, 0xce, 0x09, 0x2f, 0x12, 0x42, 0xa9, 0xa8, 0x0a, 0x25, 0xf1,
-	0xea, 0x1e, 0x97, 0x84, 0x7e, 0x65, 0x0a, 0x95, 0x4b, 0xa1, 0x84, 0xf5, 0x82, 0xa7, 0x14, 0x9d,
-	0xab, 0xd0, 0x3f, 0x54, 0x68, 0x75, 0xef, 0x3c, 0xc9, 0x84, 0xc8, 0x16, 0x0c, 0x6b, 0x59, 0x5a,
-	0x7d, 0xc1, 0xa4, 0xa8, 0x5b, 0x0f, 0xe7, 0x36, 0x13, 0x99, 0xd0, 0x10, 0x37, 0xa8, 0x65, 0xef,
-	0xd6, 0x00, 0x3e, 0x1d, 0x1d, 0xbd, 0xfa, 0xad, 0xd5, 0x58, 0xef, 0x1e, 0x12, 0x45, 0xac, 0x3e,
-	0x34, 0x55, 0x5d, 0x32, 0x1b, 0x78, 0xc0, 0xbf, 0xee, 0x06, 0xe8, 0x3f, 0x0f, 0x41, 0x71, 0x5d,
-	0xb2, 0x48, 0x4b, 0x2d, 0x0b, 0x9a, 0x33, 0xa2, 0x88, 0xfd, 0xc0, 0x03, 0xfe, 0xe3, 0x48, 0xe3,
-	0x86, 0xcb, 0x59, 0x2e, 0xec, 0x0b, 0x0f, 0xf8, 0x8f, 0x22, 0x8d, 0xef, 0xde, 0xc2, 0xcb, 0x81,
-	0x90, 0xb9, 0x90, 0xf1, 0x37, 0xeb, 0x15, 0xbc, 0xcc, 0x99, 0x94, 0x24, 0x63, 0xd2, 0x06, 0xde,
-	0x85, 0x7f, 0xd5, 0xbd, 0x45, 0x6d, 0x34, 0xf4, 0x37, 0x1a, 0xea, 0x17, 0x75, 0x74, 0x9c, 0x7a,
-	0x39, 0x85, 0x66, 0xb3, 0xd3, 0x7a, 0x0e, 0x3b, 0xf1, 0xa7, 0x71, 0x98, 0x4c, 0x3e, 0x7c, 0x1c,
-	0x87, 0x83, 0xd1, 0xfb, 0x51, 0x38, 0xec, 0x18, 0xce, 0xcd, 0x66, 0xeb, 0x5d, 0x9d, 0x51, 0xd6,
-	0x33, 0x78, 0xa3, 0xc7, 0xc2, 0x69, 0x38, 0x98, 0xc4, 0x61, 0x12, 0x4f, 0x3b, 0xc0, 0xb9, 0xde,
-	0x6c, 0x3d, 0x78, 0x62, 0x1c, 0x73, 0xfd, 0xc3, 0x35, 0xde, 0x25, 0x3f, 0xf7, 0x2e, 0xd8, 0xed,
-	0x5d, 0xf0, 0x7b, 0xef, 0x82, 0xef, 0x07, 0xd7, 0xd8, 0x1d, 0x5c, 0xe3, 0xd7, 0xc1, 0x35, 0x3e,
-	0x87, 0x19, 0x57, 0xf3, 0x2a, 0x45, 0x54, 0xe4, 0x98, 0xea, 0xd3, 0x31, 0x4f, 0x69, 0x90, 0x09,
-	0xbc, 0xea, 0xe1, 0x5c, 0xcc, 0xaa, 0x05, 0x93, 0x4d, 0xd9, 0x12, 0x77, 0xdf, 0x04, 0xa7, 0x47,
-	0x05, 0xc7, 0x9e, 0x9b, 0xff, 0xc8, 0xf4, 0xa1, 0x8e, 0xf4, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe7, 0xf5, 0x15, 0xdd, 0x1c, 0x02, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0xec, 0xfe, 0x7e, 0x81, 0xe1, 0x5f, 0x9d, 0x80, 0x59, 0x4a, 0x52, 0x9b, 0x35,
+	0xc6, 0xd5, 0x64, 0x3b, 0x82, 0xa8, 0x31, 0x7a, 0x29, 0xa5, 0x86, 0x26, 0x5a, 0x96, 0x52, 0x14,
+	0xb8, 0x34, 0xd3, 0x32, 0x74, 0x1b, 0x69, 0xa7, 0x6e, 0xa7, 0x84, 0x7d, 0x07, 0xa4, 0x27, 0xdf,
+	0x40, 0x4f, 0x5e, 0x7d, 0x21, 0x1c, 0x39, 0x7a, 0x32, 0x06, 0xde, 0x88, 0xe9, 0xd4, 0x5d, 0xfe,
+	0x64, 0x0f, 0xdc, 0x9e, 0x7c, 0x9f, 0xe7, 0xfb, 0x79, 0xe6, 0x79, 0x66, 0x06, 0xac, 0x85, 0x9e,
+	0x8f, 0x70, 0x92, 0x1c, 0x87, 0x3e, 0x66, 0x21, 0x8d, 0x53, 0x14, 0xc6, 0x8c, 0xf4, 0xfd, 0x1e,
+	0x0e, 0x63, 0x17, 0xfb, 0x3e, 0xcd, 0x62, 0x96, 0xa2, 0x93, 0x15, 0x94, 0x60, 0xff, 0x2b, 0x61,
+	0x6a, 0xd2, 0xa7, 0x8c, 0xc2, 0xa7, 0xa1, 0xe7, 0xab, 0x37, 0x5d, 0xea, 0x18, 0x97, 0x7a, 0xb2,
+	0x22, 0x2d, 0x05, 0x94, 0x06, 0xc7, 0x04, 0x71, 0x9b, 0x97, 0x1d, 0x21, 0x1c, 0x0f, 0x2a, 0x86,
+	0xb4, 0x10, 0xd0, 0x80, 0xf2, 0x10, 0x95, 0x51, 0xa5, 0xb6, 0xce, 0x04, 0xb0, 0x6c, 0x8e, 0x58,
+	0x5a, 0x85, 0xea, 0xf2, 0xde, 0x1b, 0x98, 0x61, 0xa8, 0x81, 0x06, 0x1b, 0x24, 0xa4, 0x29, 0x28,
+	0x42, 0x7b, 0x6e, 0xb5, 0xa3, 0xde, 0xf3, 0x20, 0xaa, 0x33, 0x48, 0x88, 0xcd, 0xad, 0x10, 0x82,
+	0xc6, 0x21, 0x66, 0xb8, 0x39, 0xa1, 0x08, 0xed, 0x19, 0x9b, 0xc7, 0xa5, 0x16, 0x91, 0x88, 0x36,
+	0xeb, 0x8a, 0xd0, 0x9e, 0xb2, 0x79, 0xdc, 0x7a, 0x0f, 0x26, 0x75, 0x9a, 0x46, 0x34, 0x75, 0x4e,
+	0xe1, 0x0b, 0x30, 0x19, 0x91, 0x34, 0xc5, 0x01, 0x49, 0x9b, 0x82, 0x52, 0x6f, 0x4f, 0xaf, 0x2e,
+	0xa8, 0xd5, 0x68, 0xea, 0x70, 0x34, 0x55, 0x8b, 0x07, 0xf6, 0xa8, 0xaa, 0x75, 0x00, 0x66, 0x87,
+	0xee, 0x75, 0xcc, 0xfc, 0x1e, 0x34, 0x41, 0x9d, 0x9d, 0x0e, 0xdd, 0x2b, 0xf7, 0x3e, 0xf8, 0x08,
+	0xd2, 0x38, 0xff, 0xfd, 0xa8, 0x66, 0x97, 0x8c, 0xd6, 0x11, 0x98, 0xae, 0xe4, 0xed, 0x8c, 0xf4,
+	0x07, 0xf0, 0x0b, 0x98, 0xec, 0x93, 0x6f, 0x19, 0x49, 0xd9, 0x10, 0xff, 0xea, 0xde, 0x78, 0x4e,
+	0xb0, 0x2b, 0xf7, 0xbf, 0x16, 0x23, 0x58, 0xeb, 0x35, 0x98, 0xb9, 0x99, 0x2f, 0xb7, 0x94, 0x60,
+	0xd6, 0xe3, 0xcb, 0x9f, 0xb2, 0x79, 0x3c, 0x6e, 0x9b, 0xcf, 0x7f, 0x4e, 0x80, 0x46, 0xb9, 0x70,
+	0xf8, 0x04, 0x88, 0xce, 0x7e, 0xd7, 0x70, 0x77, 0xad, 0x9d, 0xae, 0xa1, 0x9b, 0x1f, 0x4c, 0x63,
+	0x43, 0xac, 0x49, 0xf3, 0x79, 0xa1, 0x4c, 0xdf, 0x90, 0xe0, 0x63, 0x30, 0xcf, 0xcb, 0x8c, 0x3d,
+	0x43, 0xdf, 0x75, 0x0c, 0xd7, 0xd9, 0x13, 0x05, 0x69, 0x2e, 0x2f, 0x14, 0x70, 0xad, 0xc0, 0xb7,
+	0x40, 0xba, 0x53, 0xe4, 0x5a, 0x5b, 0x96, 0xab, 0x39, 0x5b, 0x9f, 0x4c, 0x5d, 0x9c, 0x90, 0x96,
+	0xf2, 0x42, 0x59, 0x1c, 0x9b, 0x84, 0xcf, 0x00, 0xbc, 0x65, 0xdd, 0xde, 0x35, 0xec, 0x7d, 0xb1,
+	0x2e, 0x3d, 0xc8, 0x0b, 0x65, 0xf6, 0x96, 0x08, 0x11, 0x58, 0xbc, 0xdb, 0x65, 0x5d, 0x73, 0xf4,
+	0x4d, 0xb1, 0x21, 0x2d, 0xe4, 0x85, 0x22, 0xde, 0xd5, 0xe1, 0x3b, 0xb0, 0x5c, 0x8d, 0xd8, 0xdd,
+	0xd0, 0x1c, 0xc3, 0xd5, 0x37, 0x35, 0xcb, 0x32, 0x3e, 0xba, 0x9f, 0x0d, 0x7b, 0xc7, 0xdc, 0xb2,
+	0xc4, 0xff, 0x24, 0x29, 0x2f, 0x94, 0x87, 0xe3, 0xb3, 0x52, 0xe3, 0xec, 0x87, 0x5c, 0x5b, 0x77,
+	0xcf, 0x2f, 0x65, 0xe1, 0xe2, 0x52, 0x16, 0xfe, 0x5c, 0xca, 0xc2, 0xf7, 0x2b, 0xb9, 0x76, 0x71,
+	0x25, 0xd7, 0x7e, 0x5d, 0xc9, 0xb5, 0x03, 0x23, 0x08, 0x59, 0x2f, 0xf3, 0x54, 0x9f, 0x46, 0xc8,
+	0xe7, 0x37, 0x8e, 0x42, 0xcf, 0xef, 0x04, 0x14, 0x9d, 0xac, 0xa1, 0x88, 0x1e, 0x66, 0xc7, 0x24,
+	0x2d, 0x7f, 0x6f, 0x8a, 0x56, 0xdf, 0x74, 0xae, 0x6f, 0xb8, 0x33, 0xfa, 0xb8, 0xe5, 0x83, 0x4f,
+	0xbd, 0xff, 0xf9, 0x1b, 0x7d, 0xf9, 0x77, 0x00, 0x40, 0x08, 0xfd, 0x6c, 0xed, 0x03, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -277,6 +456,117 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CosmosTxBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosTxBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -323,6 +613,53 @@ func (m *CosmosTx) Size() (n int) {
 	return n
 }
 
+func (m *CosmosTxBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CosmosQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -548,6 +885,290 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CosmosTxBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosTxBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosTxBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, CosmosTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38,6 +38,15 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"unknown type",
+			types.InterchainAccountPacketData{
+				Type: types.Type(100),
+				Data: []byte("data"),
+				Memo: "memo",
+			},
+			false,
+		},
 		{
 			"empty data",
 			types.InterchainAccountPacketData{
@@ -82,3 +91,73 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestValidateBasicWithMaxMemoLength() {
+	testCases := []struct {
+		name          string
+		packetData    types.InterchainAccountPacketData
+		maxMemoLength uint64
+		expPass       bool
+	}{
+		{
+			"success, memo within limit",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: "memo",
+			},
+			4,
+			true,
+		},
+		{
+			"success, memo length is not bounded",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: largeMemo,
+			},
+			0,
+			true,
+		},
+		{
+			"memo exceeds limit",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: "memo",
+			},
+			3,
+			false,
+		},
+		{
+			"type unspecified, memo length is not bounded",
+			types.InterchainAccountPacketData{
+				Type: types.UNSPECIFIED,
+				Data: []byte("data"),
+			},
+			0,
+			false,
+		},
+		{
+			"empty data, memo length is not bounded",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+			},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.packetData.ValidateBasicWithMaxMemoLength(tc.maxMemoLength)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
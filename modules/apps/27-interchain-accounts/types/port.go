@@ -7,11 +7,43 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewControllerPortID creates and returns a new prefixed controller port identifier using the provided owner string
-func NewControllerPortID(owner string) (string, error) {
+// MaxAliasLength defines the maximum character length of an interchain account alias
+const MaxAliasLength = 32
+
+// NewControllerPortID creates and returns a new prefixed controller port identifier using the provided owner string.
+// An optional alias may be provided to derive a separate port identifier of the form icacontroller-{owner}-{alias},
+// allowing a single owner to control multiple interchain accounts on the same connection. If the alias is omitted or
+// empty, the port identifier icacontroller-{owner} is returned.
+func NewControllerPortID(owner string, alias ...string) (string, error) {
 	if strings.TrimSpace(owner) == "" {
 		return "", sdkerrors.Wrap(ErrInvalidAccountAddress, "owner address cannot be empty")
 	}
 
-	return fmt.Sprint(PortPrefix, owner), nil
+	if len(alias) > 1 {
+		return "", sdkerrors.Wrapf(ErrInvalidControllerPort, "expected at most one alias, got %d", len(alias))
+	}
+
+	if len(alias) == 0 || alias[0] == "" {
+		return fmt.Sprint(PortPrefix, owner), nil
+	}
+
+	if err := ValidateAlias(alias[0]); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprint(PortPrefix, owner, "-", alias[0]), nil
+}
+
+// ValidateAlias performs basic validation of an interchain account alias. The alias must be non empty, consist
+// strictly of alphanumeric characters and may not exceed MaxAliasLength characters.
+func ValidateAlias(alias string) error {
+	if len(alias) > MaxAliasLength {
+		return sdkerrors.Wrapf(ErrInvalidControllerPort, "alias length %d exceeds maximum of %d characters", len(alias), MaxAliasLength)
+	}
+
+	if !isValidAddr(alias) {
+		return sdkerrors.Wrapf(ErrInvalidControllerPort, "alias %s must contain strictly alphanumeric characters", alias)
+	}
+
+	return nil
 }
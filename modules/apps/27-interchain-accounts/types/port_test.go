@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
@@ -11,6 +12,7 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 	var (
 		path  *ibctesting.Path
 		owner = TestOwnerAddress
+		alias []string
 	)
 
 	testCases := []struct {
@@ -25,6 +27,22 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 			fmt.Sprint(types.PortPrefix, TestOwnerAddress),
 			true,
 		},
+		{
+			"success with alias",
+			func() {
+				alias = []string{"savings"}
+			},
+			fmt.Sprint(types.PortPrefix, TestOwnerAddress, "-savings"),
+			true,
+		},
+		{
+			"success with empty alias",
+			func() {
+				alias = []string{""}
+			},
+			fmt.Sprint(types.PortPrefix, TestOwnerAddress),
+			true,
+		},
 		{
 			"invalid owner address",
 			func() {
@@ -33,6 +51,30 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 			"",
 			false,
 		},
+		{
+			"invalid alias characters",
+			func() {
+				alias = []string{"savings-1"}
+			},
+			"",
+			false,
+		},
+		{
+			"alias exceeds maximum length",
+			func() {
+				alias = []string{strings.Repeat("a", types.MaxAliasLength+1)}
+			},
+			"",
+			false,
+		},
+		{
+			"multiple aliases",
+			func() {
+				alias = []string{"savings", "trading"}
+			},
+			"",
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -40,12 +82,15 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 		suite.Run(tc.name, func() {
 			suite.SetupTest() // reset
 
+			owner = TestOwnerAddress
+			alias = nil
+
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 			suite.coordinator.Setup(path)
 
 			tc.malleate() // malleate mutates test data
 
-			portID, err := types.NewControllerPortID(owner)
+			portID, err := types.NewControllerPortID(owner, alias...)
 
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
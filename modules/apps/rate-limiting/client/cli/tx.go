@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+)
+
+const (
+	flagMaxAmount  = "max-amount"
+	flagMaxPercent = "max-percent"
+	flagWindow     = "window"
+)
+
+// NewCmdSubmitAddRateLimitProposal implements a command handler for submitting a proposal to add a rate limit for a
+// denomination over a channel.
+func NewCmdSubmitAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to add a rate limit for a denomination over a channel",
+		Long: "Submit a proposal to add a rate limit for ibc transfers of a denomination over a channel, along with an initial deposit.\n" +
+			"The quota of the rate limit caps the net outflow of the denomination over the channel within each window, either as an\n" +
+			"absolute amount or as a percentage of the supply of the denomination at the start of the window.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal add-rate-limit channel-0 stake --max-percent=10 --window=24h --title=\"rate limit stake\" --description=\"...\" --deposit=10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddRateLimitProposal(title, description, args[1], args[0], quota)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	addQuotaFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitUpdateRateLimitProposal implements a command handler for submitting a proposal to update the quota of
+// the rate limit of a denomination over a channel.
+func NewCmdSubmitUpdateRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to update the quota of the rate limit of a denomination over a channel",
+		Long: "Submit a proposal to update the quota of the rate limit of a denomination over a channel, along with an initial deposit.\n" +
+			"The flow of the current window of the rate limit is preserved.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal update-rate-limit channel-0 stake --max-amount=1000000 --window=1h --title=\"rate limit stake\" --description=\"...\" --deposit=10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewUpdateRateLimitProposal(title, description, args[1], args[0], quota)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	addQuotaFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a proposal to remove the rate limit
+// of a denomination over a channel.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit [channel-id] [denom]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to remove the rate limit of a denomination over a channel",
+		Long:    "Submit a proposal to remove the rate limit of a denomination over a channel, along with an initial deposit.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal remove-rate-limit channel-0 stake --title=\"remove rate limit\" --description=\"...\" --deposit=10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveRateLimitProposal(title, description, args[1], args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal generates or broadcasts a transaction submitting the proposal content built from the title and
+// description flags, along with the deposit flag.
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// parseQuota returns the quota defined by the quota flags
+func parseQuota(flags *pflag.FlagSet) (types.Quota, error) {
+	maxAmountStr, err := flags.GetString(flagMaxAmount)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	maxAmount := sdk.ZeroInt()
+	if strings.TrimSpace(maxAmountStr) != "" {
+		var ok bool
+		if maxAmount, ok = sdk.NewIntFromString(maxAmountStr); !ok {
+			return types.Quota{}, fmt.Errorf("invalid max amount %s", maxAmountStr)
+		}
+	}
+
+	maxPercent, err := flags.GetUint64(flagMaxPercent)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	window, err := flags.GetDuration(flagWindow)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	quota := types.NewQuota(maxAmount, maxPercent, window)
+	if err := quota.Validate(); err != nil {
+		return types.Quota{}, err
+	}
+
+	return quota, nil
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+func addQuotaFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMaxAmount, "", "maximum net outflow within a window as an absolute amount")
+	cmd.Flags().Uint64(flagMaxPercent, 0, "maximum net outflow within a window as a percentage of the supply")
+	cmd.Flags().Duration(flagWindow, 0, "duration of a window, e.g. 24h")
+}
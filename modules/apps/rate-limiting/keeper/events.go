@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+)
+
+// EmitRateLimitEvent emits an event of the provided type for the rate limit of the provided denomination over the
+// provided channel. The quota is included in the event unless the rate limit has been removed.
+func EmitRateLimitEvent(ctx sdk.Context, eventType, denom, channelID string, quota *types.Quota) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+	}
+
+	if quota != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyMaxAmount, quota.MaxAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPercent, strconv.FormatUint(quota.MaxPercent, 10)),
+			sdk.NewAttribute(types.AttributeKeyWindow, quota.Window.String()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	ctx := suite.chainA.GetContext()
+
+	genesisState := types.NewGenesisState(
+		[]types.RateLimit{
+			types.NewRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID, defaultQuota, types.NewFlow(sdk.NewInt(100), ctx.BlockTime().UTC())),
+		},
+		[]types.PendingPacket{
+			types.NewPendingPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom, sdk.NewInt(10), ctx.BlockTime().UTC()),
+		},
+	)
+
+	suite.chainA.GetSimApp().RateLimitKeeper.InitGenesis(ctx, *genesisState)
+
+	exportedGenesis := suite.chainA.GetSimApp().RateLimitKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genesisState, exportedGenesis)
+}
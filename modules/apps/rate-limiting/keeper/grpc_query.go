@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimits implements the Query/RateLimits gRPC method. The flows of rate limits whose window has elapsed are
+// returned as the flows of new windows starting at the current block time.
+func (k Keeper) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimits := []types.RateLimit{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitKeyPrefix+"/"))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, k.rollOverWindow(ctx, rateLimit))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(goCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateRateLimitID(req.Denom, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetCurrentRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denomination %s over channel %s", req.Denom, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit:     rateLimit,
+		NetOutflow:    rateLimit.Flow.NetOutflow(),
+		MaxNetOutflow: rateLimit.MaxNetOutflow(),
+	}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryRateLimitsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				ctx := suite.chainA.GetContext()
+				for i := 0; i < 3; i++ {
+					rateLimit := types.NewRateLimit(sdk.DefaultBondDenom, fmt.Sprintf("channel-%d", i), defaultQuota, types.NewFlow(sdk.NewInt(100), ctx.BlockTime()))
+					suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(ctx, rateLimit)
+					expRateLimits = append(expRateLimits, rateLimit)
+				}
+
+				req = &types.QueryRateLimitsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expRateLimits = []types.RateLimit{}
+
+			tc.malleate()
+
+			res, err := suite.queryClient.RateLimits(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(len(expRateLimits), len(res.RateLimits))
+				for i := range expRateLimits {
+					suite.Require().Equal(expRateLimits[i].ChannelId, res.RateLimits[i].ChannelId)
+					suite.Require().Equal(expRateLimits[i].Quota, res.RateLimits[i].Quota)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var req *types.QueryRateLimitRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid denomination",
+			func() {
+				req.Denom = ""
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req.ChannelId = "channel-1"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+			suite.addRateLimit(defaultQuota)
+			suite.sendTransfer(suite.transferMsg(600, suite.chainB.SenderAccount.GetAddress().String()))
+
+			req = &types.QueryRateLimitRequest{
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitKeeper.RateLimit(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(defaultQuota, res.RateLimit.Quota)
+				suite.Require().Equal(sdk.NewInt(600), res.NetOutflow)
+				suite.Require().Equal(defaultQuota.MaxAmount, res.MaxNetOutflow)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// Keeper defines the rate limiting middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper types.ICS4Wrapper
+	bankKeeper  types.BankKeeper
+}
+
+// NewKeeper creates a new rate limiting middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, ics4Wrapper types.ICS4Wrapper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		ics4Wrapper: ics4Wrapper,
+		bankKeeper:  bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetRateLimit returns the rate limit of the provided denomination over the provided channel, as stored in state.
+// The flow of the returned rate limit may belong to a window which has since elapsed, see GetCurrentRateLimit.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// GetCurrentRateLimit returns the rate limit of the provided denomination over the provided channel. If the window of
+// the stored flow has elapsed, the returned rate limit holds the flow of a new window starting at the current block time.
+func (k Keeper) GetCurrentRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.RateLimit{}, false
+	}
+
+	return k.rollOverWindow(ctx, rateLimit), true
+}
+
+// SetRateLimit stores the provided rate limit
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRateLimit(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit deletes the rate limit of the provided denomination over the provided channel
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(channelID, denom))
+}
+
+// IterateRateLimits iterates over the stored rate limits and performs the provided callback until it returns true
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitKeyPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns the stored rate limits
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// GetPendingPacket returns the amount of the provided denomination sent in the packet with the provided channel
+// identifier and sequence which has not yet been acknowledged or timed out
+func (k Keeper) GetPendingPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) (types.PendingPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingPacket(channelID, sequence, denom))
+	if bz == nil {
+		return types.PendingPacket{}, false
+	}
+
+	var pendingPacket types.PendingPacket
+	k.cdc.MustUnmarshal(bz, &pendingPacket)
+
+	return pendingPacket, true
+}
+
+// SetPendingPacket stores the provided pending packet
+func (k Keeper) SetPendingPacket(ctx sdk.Context, pendingPacket types.PendingPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingPacket(pendingPacket.ChannelId, pendingPacket.Sequence, pendingPacket.Denom), k.cdc.MustMarshal(&pendingPacket))
+}
+
+// DeletePendingPacket deletes the pending packet of the provided denomination with the provided channel identifier and sequence
+func (k Keeper) DeletePendingPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingPacket(channelID, sequence, denom))
+}
+
+// GetAllPendingPackets returns the stored pending packets
+func (k Keeper) GetAllPendingPackets(ctx sdk.Context) []types.PendingPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingPacketKeyPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	var pendingPackets []types.PendingPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
+
+// rollOverWindow returns the provided rate limit with the flow of a new window starting at the current block time if the
+// window of its flow has elapsed. The supply of the new window is the current supply of the denomination.
+func (k Keeper) rollOverWindow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+		rateLimit.Flow = types.NewFlow(k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount, ctx.BlockTime())
+	}
+
+	return rateLimit
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+var defaultQuota = types.NewQuota(sdk.NewInt(1000), 0, time.Hour)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.path = path
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RateLimitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// addRateLimit adds a rate limit with the provided quota for the bond denomination over the channel of the
+// endpoint of chainA
+func (suite *KeeperTestSuite) addRateLimit(quota types.Quota) {
+	proposal := types.NewAddRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, quota)
+	err := suite.chainA.GetSimApp().RateLimitKeeper.HandleAddRateLimitProposal(suite.chainA.GetContext(), proposal.(*types.AddRateLimitProposal))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSetGetDeleteRateLimit() {
+	suite.coordinator.Setup(suite.path)
+
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	_, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	expRateLimits := []types.RateLimit{
+		types.NewRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID, defaultQuota, types.NewFlow(sdk.NewInt(100), ctx.BlockTime())),
+		types.NewRateLimit("uatom", ibctesting.FirstChannelID, defaultQuota, types.NewFlow(sdk.NewInt(100), ctx.BlockTime())),
+		types.NewRateLimit(sdk.DefaultBondDenom, "channel-1", defaultQuota, types.NewFlow(sdk.NewInt(100), ctx.BlockTime())),
+	}
+
+	for _, rateLimit := range expRateLimits {
+		keeper.SetRateLimit(ctx, rateLimit)
+	}
+
+	rateLimit, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(expRateLimits[0].Quota, rateLimit.Quota)
+	suite.Require().Len(keeper.GetAllRateLimits(ctx), len(expRateLimits))
+
+	keeper.DeleteRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+
+	_, found = keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+	suite.Require().Len(keeper.GetAllRateLimits(ctx), len(expRateLimits)-1)
+}
+
+func (suite *KeeperTestSuite) TestGetCurrentRateLimit() {
+	suite.coordinator.Setup(suite.path)
+	suite.addRateLimit(defaultQuota)
+
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	rateLimit, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	rateLimit.Flow.Outflow = sdk.NewInt(500)
+	keeper.SetRateLimit(ctx, rateLimit)
+
+	// the flow is preserved within the window
+	currentRateLimit, found := keeper.GetCurrentRateLimit(ctx.WithBlockTime(ctx.BlockTime().Add(defaultQuota.Window-1)), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit.Flow, currentRateLimit.Flow)
+
+	// the flow is reset once the window has elapsed
+	nextWindowCtx := ctx.WithBlockTime(ctx.BlockTime().Add(defaultQuota.Window))
+	currentRateLimit, found = keeper.GetCurrentRateLimit(nextWindowCtx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(currentRateLimit.Flow.Outflow.IsZero())
+	suite.Require().Equal(nextWindowCtx.BlockTime(), currentRateLimit.Flow.WindowStart)
+}
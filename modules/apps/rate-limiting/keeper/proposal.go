@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+)
+
+// HandleAddRateLimitProposal adds a rate limit for the denomination and channel provided by the proposal. The window of
+// the rate limit starts at the current block time. A quota defined as a percentage of the supply is rejected if the
+// current supply of the denomination is zero.
+func (k Keeper) HandleAddRateLimitProposal(ctx sdk.Context, p *types.AddRateLimitProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom); found {
+		return sdkerrors.Wrapf(types.ErrRateLimitAlreadyExists, "denomination %s over channel %s", p.Denom, p.ChannelId)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, p.Denom).Amount
+	if p.Quota.MaxPercent != 0 && supply.IsZero() {
+		return sdkerrors.Wrapf(types.ErrInvalidQuota, "supply of denomination %s is zero", p.Denom)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(p.Denom, p.ChannelId, p.Quota, types.NewFlow(supply, ctx.BlockTime())))
+
+	EmitRateLimitEvent(ctx, types.EventTypeAddRateLimit, p.Denom, p.ChannelId, &p.Quota)
+
+	return nil
+}
+
+// HandleUpdateRateLimitProposal replaces the quota of the rate limit of the denomination and channel provided by the
+// proposal. The flow of the current window is preserved.
+func (k Keeper) HandleUpdateRateLimitProposal(ctx sdk.Context, p *types.UpdateRateLimitProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denomination %s over channel %s", p.Denom, p.ChannelId)
+	}
+
+	rateLimit.Quota = p.Quota
+	k.SetRateLimit(ctx, rateLimit)
+
+	EmitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, p.Denom, p.ChannelId, &p.Quota)
+
+	return nil
+}
+
+// HandleRemoveRateLimitProposal removes the rate limit of the denomination and channel provided by the proposal.
+// Pending packets of the rate limit are deleted once they are acknowledged or timed out.
+func (k Keeper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denomination %s over channel %s", p.Denom, p.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, p.ChannelId, p.Denom)
+
+	EmitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, p.Denom, p.ChannelId, nil)
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandleAddRateLimitProposal() {
+	var proposal *types.AddRateLimitProposal
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: absolute quota",
+			func() {},
+			true,
+		},
+		{
+			"success: percent quota",
+			func() {
+				proposal.Quota = types.NewQuota(sdk.ZeroInt(), 10, time.Hour)
+			},
+			true,
+		},
+		{
+			"failure: invalid quota",
+			func() {
+				proposal.Quota.Window = 0
+			},
+			false,
+		},
+		{
+			"failure: rate limit already exists",
+			func() {
+				suite.addRateLimit(defaultQuota)
+			},
+			false,
+		},
+		{
+			"failure: percent quota of denomination without supply",
+			func() {
+				proposal.Denom = "uatom"
+				proposal.Quota = types.NewQuota(sdk.ZeroInt(), 10, time.Hour)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+
+			proposal = types.NewAddRateLimitProposal("title", "description", sdk.DefaultBondDenom, ibctesting.FirstChannelID, defaultQuota).(*types.AddRateLimitProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().RateLimitKeeper.HandleAddRateLimitProposal(ctx, proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(ctx, proposal.ChannelId, proposal.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(proposal.Quota, rateLimit.Quota)
+				suite.Require().True(rateLimit.Flow.NetOutflow().IsZero())
+				suite.Require().Equal(ctx.BlockTime(), rateLimit.Flow.WindowStart)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleUpdateRateLimitProposal() {
+	suite.coordinator.Setup(suite.path)
+
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+	quota := types.NewQuota(sdk.NewInt(2000), 0, 2*time.Hour)
+
+	proposal := types.NewUpdateRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, quota).(*types.UpdateRateLimitProposal)
+	suite.Require().ErrorIs(keeper.HandleUpdateRateLimitProposal(ctx, proposal), types.ErrRateLimitNotFound)
+
+	suite.addRateLimit(defaultQuota)
+
+	rateLimit, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	rateLimit.Flow.Outflow = sdk.NewInt(500)
+	keeper.SetRateLimit(ctx, rateLimit)
+
+	suite.Require().NoError(keeper.HandleUpdateRateLimitProposal(ctx, proposal))
+
+	// the flow of the current window is preserved
+	updatedRateLimit, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, updatedRateLimit.Quota)
+	suite.Require().Equal(rateLimit.Flow, updatedRateLimit.Flow)
+
+	proposal.Quota.MaxPercent = 10
+	suite.Require().ErrorIs(keeper.HandleUpdateRateLimitProposal(ctx, proposal), types.ErrInvalidQuota)
+}
+
+func (suite *KeeperTestSuite) TestHandleRemoveRateLimitProposal() {
+	suite.coordinator.Setup(suite.path)
+
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	proposal := types.NewRemoveRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).(*types.RemoveRateLimitProposal)
+	suite.Require().ErrorIs(keeper.HandleRemoveRateLimitProposal(ctx, proposal), types.ErrRateLimitNotFound)
+
+	suite.addRateLimit(defaultQuota)
+	suite.Require().NoError(keeper.HandleRemoveRateLimitProposal(ctx, proposal))
+
+	_, found := keeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	// transfers are no longer rate limited
+	suite.sendTransfer(suite.transferMsg(1001, suite.chainB.SenderAccount.GetAddress().String()))
+}
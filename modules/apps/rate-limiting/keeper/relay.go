@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// SendPacket updates the outflow of the rate limits of the tokens sent in the provided transfer packet and wraps the
+// SendPacket function of the ICS4Wrapper. An error is returned if sending a token would exceed the quota of its rate limit.
+// Packets which do not contain fungible token packet data are sent without being rate limited.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	for _, token := range packetTokens(packet.GetData()) {
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+		if err := k.updateOutflow(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom, token.Amount); err != nil {
+			return err
+		}
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps the WriteAcknowledgement function of the ICS4Wrapper
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion wraps the GetAppVersion function of the ICS4Wrapper
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnRecvPacket updates the inflow of the rate limits of the tokens received in the provided transfer packet. It must
+// only be called once the tokens have been successfully received.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet ibcexported.PacketI) {
+	for _, token := range packetTokens(packet.GetData()) {
+		denom := receivedDenom(packet, token.Denom)
+
+		rateLimit, found := k.GetCurrentRateLimit(ctx, packet.GetDestChannel(), denom)
+		if !found {
+			continue
+		}
+
+		rateLimit.Flow.Inflow = rateLimit.Flow.Inflow.Add(token.Amount)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// OnPacketCompleted deletes the pending packets of the tokens sent in the provided transfer packet once the packet has
+// been acknowledged or timed out. If the packet failed, the amounts sent are credited back to the outflow of their rate
+// limits, provided the packet was sent within the current window.
+func (k Keeper) OnPacketCompleted(ctx sdk.Context, packet ibcexported.PacketI, failed bool) {
+	for _, token := range packetTokens(packet.GetData()) {
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+
+		pendingPacket, found := k.GetPendingPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom)
+		if !found {
+			continue
+		}
+
+		k.DeletePendingPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom)
+
+		if !failed {
+			continue
+		}
+
+		rateLimit, found := k.GetCurrentRateLimit(ctx, packet.GetSourceChannel(), denom)
+		if !found || !rateLimit.Flow.WindowStart.Equal(pendingPacket.WindowStart) {
+			continue
+		}
+
+		rateLimit.Flow.Outflow = sdk.MaxInt(rateLimit.Flow.Outflow.Sub(pendingPacket.Amount), sdk.ZeroInt())
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// updateOutflow adds the provided amount to the outflow of the rate limit of the provided denomination over the provided
+// channel, if any, and stores a pending packet for it. An error is returned if the net outflow would exceed the quota.
+func (k Keeper) updateOutflow(ctx sdk.Context, channelID string, sequence uint64, denom string, amount sdk.Int) error {
+	rateLimit, found := k.GetCurrentRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Add(amount)
+
+	netOutflow, maxNetOutflow := rateLimit.Flow.NetOutflow(), rateLimit.MaxNetOutflow()
+	if netOutflow.GT(maxNetOutflow) {
+		return sdkerrors.Wrapf(
+			types.ErrQuotaExceeded, "sending %s%s over channel %s would result in a net outflow of %s, exceeding the maximum net outflow of %s for the window ending at %s",
+			amount, denom, channelID, netOutflow, maxNetOutflow, rateLimit.Flow.WindowStart.Add(rateLimit.Quota.Window),
+		)
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingPacket(ctx, types.NewPendingPacket(channelID, sequence, denom, amount, rateLimit.Flow.WindowStart))
+
+	return nil
+}
+
+// packetToken is a token transferred in a fungible token transfer packet
+type packetToken struct {
+	Denom  string
+	Amount sdk.Int
+}
+
+// packetTokens returns the tokens transferred in the provided FungibleTokenPacketData or MultiDenomFungibleTokenPacketData.
+// No tokens are returned if the data cannot be decoded as either, or for tokens whose amount cannot be parsed.
+func packetTokens(bz []byte) []packetToken {
+	var tokenData []transfertypes.FungibleTokenPacketData
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &data); err == nil {
+		tokenData = []transfertypes.FungibleTokenPacketData{data}
+	} else {
+		var multiDenomData transfertypes.MultiDenomFungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &multiDenomData); err != nil {
+			return nil
+		}
+
+		tokenData = multiDenomData.GetFungibleTokenPacketData()
+	}
+
+	tokens := make([]packetToken, 0, len(tokenData))
+	for _, data := range tokenData {
+		amount, ok := sdk.NewIntFromString(data.Amount)
+		if !ok {
+			continue
+		}
+
+		tokens = append(tokens, packetToken{Denom: data.Denom, Amount: amount})
+	}
+
+	return tokens
+}
+
+// receivedDenom returns the denomination on this chain of the tokens of the provided packet data denomination, as
+// received by the transfer module
+func receivedDenom(packet ibcexported.PacketI, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens are unescrowed, remove the prefix added by the sending chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// vouchers are minted, prefixed with the destination port and channel
+	sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return transfertypes.ParseDenomTrace(sourcePrefix + denom).IBCDenom()
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// transferMsg returns a MsgTransfer of the provided amount of the bond denomination from chainA to the provided
+// receiver on chainB
+func (suite *KeeperTestSuite) transferMsg(amount int64, receiver string) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(), receiver,
+		suite.chainB.GetTimeoutHeight(), 0,
+	)
+}
+
+// sendTransfer sends the provided MsgTransfer on chainA and returns the packet sent
+func (suite *KeeperTestSuite) sendTransfer(msg *transfertypes.MsgTransfer) channeltypes.Packet {
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// expectQuotaExceeded asserts that the provided MsgTransfer is rejected by the rate limit
+func (suite *KeeperTestSuite) expectQuotaExceeded(msg *transfertypes.MsgTransfer) {
+	ctx, _ := suite.chainA.GetContext().CacheContext()
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+}
+
+// rateLimit returns the current rate limit of the bond denomination over the channel of the endpoint of chainA
+func (suite *KeeperTestSuite) rateLimit() types.RateLimit {
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetCurrentRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	return rateLimit
+}
+
+func (suite *KeeperTestSuite) TestSendPacketQuotaExceeded() {
+	suite.coordinator.Setup(suite.path)
+	suite.addRateLimit(defaultQuota)
+
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	suite.sendTransfer(suite.transferMsg(600, receiver))
+	suite.expectQuotaExceeded(suite.transferMsg(401, receiver))
+
+	// the quota can be filled exactly
+	packet := suite.sendTransfer(suite.transferMsg(400, receiver))
+	suite.Require().Equal(sdk.NewInt(1000), suite.rateLimit().Flow.Outflow)
+	suite.Require().Len(suite.chainA.GetSimApp().RateLimitKeeper.GetAllPendingPackets(suite.chainA.GetContext()), 2)
+
+	pendingPacket, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingPacket(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(400), pendingPacket.Amount)
+
+	suite.expectQuotaExceeded(suite.transferMsg(1, receiver))
+
+	// other denominations are not rate limited
+	msg := suite.transferMsg(1, receiver)
+	msg.Token = sdk.NewInt64Coin("uatom", 1)
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), transfertypes.ModuleName, sdk.NewCoins(msg.Token)))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), transfertypes.ModuleName, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(msg.Token)))
+	suite.sendTransfer(msg)
+
+	// the pending packets are deleted once acknowledged, without crediting the outflow
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Equal(sdk.NewInt(1000), suite.rateLimit().Flow.Outflow)
+	suite.Require().Len(suite.chainA.GetSimApp().RateLimitKeeper.GetAllPendingPackets(suite.chainA.GetContext()), 1)
+}
+
+func (suite *KeeperTestSuite) TestTimeoutCreditsOutflow() {
+	suite.coordinator.Setup(suite.path)
+	suite.addRateLimit(defaultQuota)
+
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	msg := suite.transferMsg(1000, receiver)
+	msg.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	packet := suite.sendTransfer(msg)
+
+	suite.expectQuotaExceeded(suite.transferMsg(1, receiver))
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().True(suite.rateLimit().Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.chainA.GetSimApp().RateLimitKeeper.GetAllPendingPackets(suite.chainA.GetContext()))
+
+	// the quota is available again
+	suite.sendTransfer(suite.transferMsg(1000, receiver))
+}
+
+func (suite *KeeperTestSuite) TestErrorAcknowledgementCreditsOutflow() {
+	suite.coordinator.Setup(suite.path)
+	suite.addRateLimit(defaultQuota)
+
+	// the transfer fails on chainB as the receiver is a blocked module account
+	receiver := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	packet := suite.sendTransfer(suite.transferMsg(1000, receiver))
+	suite.Require().Equal(sdk.NewInt(1000), suite.rateLimit().Flow.Outflow)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.Require().True(suite.rateLimit().Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.chainA.GetSimApp().RateLimitKeeper.GetAllPendingPackets(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestWindowRollover() {
+	suite.coordinator.Setup(suite.path)
+	suite.addRateLimit(defaultQuota)
+
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	msg := suite.transferMsg(1000, receiver)
+	msg.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	packet := suite.sendTransfer(msg)
+	windowStart := suite.rateLimit().Flow.WindowStart
+
+	suite.expectQuotaExceeded(suite.transferMsg(1, receiver))
+
+	// the quota is available again once the window has elapsed
+	suite.coordinator.IncrementTimeBy(defaultQuota.Window)
+	suite.sendTransfer(suite.transferMsg(1000, receiver))
+
+	rateLimit := suite.rateLimit()
+	suite.Require().Equal(sdk.NewInt(1000), rateLimit.Flow.Outflow)
+	suite.Require().True(rateLimit.Flow.WindowStart.After(windowStart))
+
+	// packets sent within a previous window are not credited back to the current window
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+	suite.Require().Equal(sdk.NewInt(1000), suite.rateLimit().Flow.Outflow)
+	suite.Require().Len(suite.chainA.GetSimApp().RateLimitKeeper.GetAllPendingPackets(suite.chainA.GetContext()), 1)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketInflow() {
+	suite.coordinator.Setup(suite.path)
+	suite.addRateLimit(defaultQuota)
+
+	packet := suite.sendTransfer(suite.transferMsg(1000, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// send part of the vouchers back from chainB to chainA
+	voucher := sdk.NewInt64Coin(transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom(), 400)
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, voucher,
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0,
+	)
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the tokens received reduce the net outflow of the window
+	rateLimit := suite.rateLimit()
+	suite.Require().Equal(sdk.NewInt(400), rateLimit.Flow.Inflow)
+	suite.Require().Equal(sdk.NewInt(600), rateLimit.Flow.NetOutflow())
+
+	suite.sendTransfer(suite.transferMsg(400, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.expectQuotaExceeded(suite.transferMsg(1, suite.chainB.SenderAccount.GetAddress().String()))
+}
+
+func (suite *KeeperTestSuite) TestPercentQuota() {
+	suite.coordinator.Setup(suite.path)
+
+	supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(suite.chainA.GetContext(), sdk.DefaultBondDenom).Amount
+	suite.addRateLimit(types.NewQuota(sdk.ZeroInt(), 1, 24*time.Hour))
+
+	rateLimit := suite.rateLimit()
+	suite.Require().Equal(supply, rateLimit.Flow.Supply)
+	suite.Require().Equal(supply.QuoRaw(100), rateLimit.MaxNetOutflow())
+}
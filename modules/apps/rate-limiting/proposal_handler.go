@@ -0,0 +1,29 @@
+package ratelimiting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+)
+
+// NewProposalHandler defines the rate limiting middleware proposal handler
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return k.HandleAddRateLimitProposal(ctx, c)
+
+		case *types.UpdateRateLimitProposal:
+			return k.HandleUpdateRateLimitProposal(ctx, c)
+
+		case *types.RemoveRateLimitProposal:
+			return k.HandleRemoveRateLimitProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized rate limiting proposal content type: %T", c)
+		}
+	}
+}
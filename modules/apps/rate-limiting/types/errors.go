@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rate limiting sentinel errors
+var (
+	ErrInvalidQuota           = sdkerrors.Register(ModuleName, 2, "invalid quota")
+	ErrInvalidRateLimit       = sdkerrors.Register(ModuleName, 3, "invalid rate limit")
+	ErrRateLimitNotFound      = sdkerrors.Register(ModuleName, 4, "rate limit not found")
+	ErrRateLimitAlreadyExists = sdkerrors.Register(ModuleName, 5, "rate limit already exists")
+	ErrQuotaExceeded          = sdkerrors.Register(ModuleName, 6, "quota exceeded")
+)
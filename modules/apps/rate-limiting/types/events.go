@@ -0,0 +1,14 @@
+package types
+
+// Rate limiting middleware events
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+
+	AttributeKeyDenom      = "denom"
+	AttributeKeyChannelID  = "channel_id"
+	AttributeKeyMaxAmount  = "max_amount"
+	AttributeKeyMaxPercent = "max_percent"
+	AttributeKeyWindow     = "window"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingPackets []PendingPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:     rateLimits,
+		PendingPackets: pendingPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate limiting middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:     []RateLimit{},
+		PendingPackets: []PendingPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.ChannelId, rateLimit.Denom))
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicate rate limit for denomination %s over channel %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seenRateLimits[key] = true
+	}
+
+	for _, pendingPacket := range gs.PendingPackets {
+		if err := pendingPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
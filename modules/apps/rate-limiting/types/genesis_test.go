@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestValidateDefaultGenesis(t *testing.T) {
+	err := types.DefaultGenesisState().Validate()
+	require.NoError(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	quota := types.NewQuota(sdk.NewInt(1000), 0, time.Hour)
+	flow := types.NewFlow(sdk.NewInt(100), time.Unix(1_000_000, 0))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success - valid genesis",
+			func() {},
+			true,
+		},
+		{
+			"invalid rate limit denomination",
+			func() {
+				genState.RateLimits[0].Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid rate limit channel identifier",
+			func() {
+				genState.RateLimits[0].ChannelId = "chan"
+			},
+			false,
+		},
+		{
+			"invalid rate limit quota",
+			func() {
+				genState.RateLimits[0].Quota.MaxPercent = 10
+			},
+			false,
+		},
+		{
+			"invalid rate limit flow",
+			func() {
+				genState.RateLimits[0].Flow.Outflow = sdk.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"duplicate rate limit",
+			func() {
+				genState.RateLimits = append(genState.RateLimits, genState.RateLimits[0])
+			},
+			false,
+		},
+		{
+			"invalid pending packet sequence",
+			func() {
+				genState.PendingPackets[0].Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid pending packet amount",
+			func() {
+				genState.PendingPackets[0].Amount = sdk.ZeroInt()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		genState = types.NewGenesisState(
+			[]types.RateLimit{
+				types.NewRateLimit(sdk.DefaultBondDenom, ibctesting.FirstChannelID, quota, flow),
+				types.NewRateLimit(sdk.DefaultBondDenom, "channel-1", quota, flow),
+			},
+			[]types.PendingPacket{
+				types.NewPendingPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom, sdk.NewInt(10), flow.WindowStart),
+			},
+		)
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
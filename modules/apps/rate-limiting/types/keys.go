@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for the rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingPacketKeyPrefix is the key prefix for the pending packets stored in state
+	PendingPacketKeyPrefix = "pendingPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit of the provided denomination over the provided channel is stored.
+// The channel identifier precedes the denomination, as denominations may contain the key separator.
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyPendingPacket returns the key under which the amount of the provided denomination sent in the packet with the
+// provided channel identifier and sequence is stored.
+func KeyPendingPacket(channelID string, sequence uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/%s", PendingPacketKeyPrefix, channelID, sequence, denom))
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddRateLimit defines the type for an AddRateLimitProposal
+	ProposalTypeAddRateLimit = "AddRateLimit"
+	// ProposalTypeUpdateRateLimit defines the type for an UpdateRateLimitProposal
+	ProposalTypeUpdateRateLimit = "UpdateRateLimit"
+	// ProposalTypeRemoveRateLimit defines the type for a RemoveRateLimitProposal
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+)
+
+var (
+	_ govtypes.Content = &AddRateLimitProposal{}
+	_ govtypes.Content = &UpdateRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeUpdateRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+}
+
+// NewAddRateLimitProposal creates a new proposal for adding a rate limit for a denomination over a channel.
+func NewAddRateLimitProposal(title, description, denom, channelID string, quota Quota) govtypes.Content {
+	return &AddRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+		Quota:       quota,
+	}
+}
+
+// GetTitle returns the title of an add rate limit proposal.
+func (p *AddRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add rate limit proposal.
+func (p *AddRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add rate limit proposal.
+func (p *AddRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add rate limit proposal.
+func (p *AddRateLimitProposal) ProposalType() string { return ProposalTypeAddRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := ValidateRateLimitID(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+
+	return p.Quota.Validate()
+}
+
+// NewUpdateRateLimitProposal creates a new proposal for updating the quota of an existing rate limit.
+func NewUpdateRateLimitProposal(title, description, denom, channelID string, quota Quota) govtypes.Content {
+	return &UpdateRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+		Quota:       quota,
+	}
+}
+
+// GetTitle returns the title of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) ProposalType() string { return ProposalTypeUpdateRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := ValidateRateLimitID(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+
+	return p.Quota.Validate()
+}
+
+// NewRemoveRateLimitProposal creates a new proposal for removing an existing rate limit.
+func NewRemoveRateLimitProposal(title, description, denom, channelID string) govtypes.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateRateLimitID(p.Denom, p.ChannelId)
+}
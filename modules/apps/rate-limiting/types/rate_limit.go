@@ -0,0 +1,157 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewQuota creates a new Quota instance. Either the maximum amount or the maximum percentage must be zero.
+func NewQuota(maxAmount sdk.Int, maxPercent uint64, window time.Duration) Quota {
+	return Quota{
+		MaxAmount:  maxAmount,
+		MaxPercent: maxPercent,
+		Window:     window,
+	}
+}
+
+// Validate performs a stateless validation of the quota. Exactly one of the maximum amount and the maximum percentage
+// must be set, the maximum percentage may not exceed 100 and the window must be positive.
+func (q Quota) Validate() error {
+	hasMaxAmount := !q.MaxAmount.IsNil() && !q.MaxAmount.IsZero()
+	if hasMaxAmount == (q.MaxPercent != 0) {
+		return sdkerrors.Wrap(ErrInvalidQuota, "exactly one of the maximum amount and the maximum percentage must be set")
+	}
+
+	if hasMaxAmount && q.MaxAmount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "maximum amount %s cannot be negative", q.MaxAmount)
+	}
+
+	if q.MaxPercent > 100 {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "maximum percentage %d cannot exceed 100", q.MaxPercent)
+	}
+
+	if q.Window <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "window %s must be positive", q.Window)
+	}
+
+	return nil
+}
+
+// MaxNetOutflow returns the maximum net outflow allowed by the quota given the provided supply of the denomination
+func (q Quota) MaxNetOutflow(supply sdk.Int) sdk.Int {
+	if q.MaxPercent != 0 {
+		return supply.MulRaw(int64(q.MaxPercent)).QuoRaw(100)
+	}
+
+	return q.MaxAmount
+}
+
+// NewFlow creates a new Flow instance for a window starting at the provided time with the provided supply
+func NewFlow(supply sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:      sdk.ZeroInt(),
+		Outflow:     sdk.ZeroInt(),
+		Supply:      supply,
+		WindowStart: windowStart,
+	}
+}
+
+// NetOutflow returns the amount sent minus the amount received within the window, which may be negative
+func (f Flow) NetOutflow() sdk.Int {
+	return f.Outflow.Sub(f.Inflow)
+}
+
+// Validate performs a stateless validation of the flow
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "inflow must be set and cannot be negative")
+	}
+
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "outflow must be set and cannot be negative")
+	}
+
+	if f.Supply.IsNil() || f.Supply.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "supply must be set and cannot be negative")
+	}
+
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(denom, channelID string, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+		Flow:      flow,
+	}
+}
+
+// Validate performs a stateless validation of the rate limit
+func (rl RateLimit) Validate() error {
+	if err := ValidateRateLimitID(rl.Denom, rl.ChannelId); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// IsWindowExpired returns true if the window of the current flow has elapsed at the provided block time
+func (rl RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(rl.Flow.WindowStart.Add(rl.Quota.Window))
+}
+
+// MaxNetOutflow returns the maximum net outflow of the current window
+func (rl RateLimit) MaxNetOutflow() sdk.Int {
+	return rl.Quota.MaxNetOutflow(rl.Flow.Supply)
+}
+
+// NewPendingPacket creates a new PendingPacket instance
+func NewPendingPacket(channelID string, sequence uint64, denom string, amount sdk.Int, windowStart time.Time) PendingPacket {
+	return PendingPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		Denom:       denom,
+		Amount:      amount,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs a stateless validation of the pending packet
+func (p PendingPacket) Validate() error {
+	if err := ValidateRateLimitID(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "pending packet sequence cannot be zero")
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "pending packet amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateRateLimitID validates the denomination and channel identifier identifying a rate limit
+func ValidateRateLimitID(denom, channelID string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid denomination: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid channel identifier: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"valid absolute quota", types.NewQuota(sdk.NewInt(1000), 0, time.Hour), true},
+		{"valid percent quota", types.NewQuota(sdk.ZeroInt(), 10, time.Hour), true},
+		{"valid percent quota with nil amount", types.Quota{MaxPercent: 100, Window: time.Hour}, true},
+		{"neither amount nor percent", types.NewQuota(sdk.ZeroInt(), 0, time.Hour), false},
+		{"both amount and percent", types.NewQuota(sdk.NewInt(1000), 10, time.Hour), false},
+		{"negative amount", types.NewQuota(sdk.NewInt(-1), 0, time.Hour), false},
+		{"percent exceeds 100", types.NewQuota(sdk.ZeroInt(), 101, time.Hour), false},
+		{"zero window", types.NewQuota(sdk.NewInt(1000), 0, 0), false},
+		{"negative window", types.NewQuota(sdk.NewInt(1000), 0, -time.Hour), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidQuota, tc.name)
+		}
+	}
+}
+
+func TestQuotaMaxNetOutflow(t *testing.T) {
+	supply := sdk.NewInt(12345)
+
+	require.Equal(t, sdk.NewInt(1000), types.NewQuota(sdk.NewInt(1000), 0, time.Hour).MaxNetOutflow(supply))
+	require.Equal(t, sdk.NewInt(1234), types.NewQuota(sdk.ZeroInt(), 10, time.Hour).MaxNetOutflow(supply))
+	require.Equal(t, supply, types.NewQuota(sdk.ZeroInt(), 100, time.Hour).MaxNetOutflow(supply))
+}
+
+func TestRateLimitIsWindowExpired(t *testing.T) {
+	windowStart := time.Unix(1_000_000, 0)
+	rateLimit := types.NewRateLimit("stake", "channel-0", types.NewQuota(sdk.NewInt(1000), 0, time.Hour), types.NewFlow(sdk.NewInt(100), windowStart))
+
+	require.False(t, rateLimit.IsWindowExpired(windowStart))
+	require.False(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour-1)))
+	require.True(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour)))
+}
+
+func TestFlowNetOutflow(t *testing.T) {
+	flow := types.NewFlow(sdk.NewInt(100), time.Now())
+	flow.Outflow = sdk.NewInt(10)
+	flow.Inflow = sdk.NewInt(25)
+
+	require.Equal(t, sdk.NewInt(-15), flow.NetOutflow())
+}
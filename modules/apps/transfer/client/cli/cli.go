@@ -18,9 +18,12 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
+		GetCmdQueryDenomTracesByBaseDenom(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryDenomsEnabled(),
 	)
 
 	return queryCmd
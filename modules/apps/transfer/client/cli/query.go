@@ -17,7 +17,7 @@ func GetCmdQueryDenomTrace() *cobra.Command {
 		Use:     "denom-trace [hash/denom]",
 		Short:   "Query the denom trace info from a given trace hash or ibc denom",
 		Long:    "Query the denom trace info from a given trace hash or ibc denom",
-		Example: fmt.Sprintf("%s query ibc-transfer denom-trace 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Example: fmt.Sprintf("%[1]s query ibc-transfer denom-trace 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C\n%[1]s query ibc-transfer denom-trace ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -82,6 +82,46 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomTracesByBaseDenom defines the command to query all the denomination trace infos
+// of a base denomination that this chain mantains.
+func GetCmdQueryDenomTracesByBaseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-base-denom [base-denom]",
+		Short:   "Query the trace info for all token denominations with a base denomination",
+		Long:    "Query the trace info for all token denominations with a base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-base-denom uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByBaseDenomRequest{
+				BaseDenom:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByBaseDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -111,23 +151,32 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
-// GetCmdParams returns the command handler for ibc-transfer parameter querying.
+// GetCmdQueryEscrowAddress returns the command handler for ibc-transfer escrow address querying.
 func GetCmdQueryEscrowAddress() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "escrow-address",
+		Use:     "escrow-address [port] [channel-id]",
 		Short:   "Get the escrow address for a channel",
-		Long:    "Get the escrow address for a channel",
+		Long:    "Get the escrow address for an existing channel. An error is returned if the channel does not exist.",
 		Args:    cobra.ExactArgs(2),
-		Example: fmt.Sprintf("%s query ibc-transfer escrow-address [port] [channel-id]", version.AppName),
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-address transfer channel-0", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			port := args[0]
-			channel := args[1]
-			addr := types.GetEscrowAddress(port, channel)
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", addr.String()))
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowAddress(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -167,3 +216,73 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTotalEscrowForDenom defines the command to query the total amount of tokens in escrow for a denom.
+func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [denom]",
+		Short:   "Query the total amount of tokens in escrow for a denom",
+		Long:    "Query the total amount of tokens in escrow for a denom",
+		Example: fmt.Sprintf("%s query ibc-transfer total-escrow uosmo", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowForDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalEscrowForDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomsEnabled defines the command to query the per denomination send and receive enabled overrides.
+func GetCmdQueryDenomsEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denoms-enabled",
+		Short:   "Query the per denomination send and receive enabled overrides",
+		Long:    "Query the per denomination send and receive enabled overrides",
+		Example: fmt.Sprintf("%s query ibc-transfer denoms-enabled", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsEnabledRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomsEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms enabled")
+
+	return cmd
+}
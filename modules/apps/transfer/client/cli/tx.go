@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -23,6 +25,9 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagRefundAddress          = "refund-address"
+	flagSendEnabled            = "send-enabled"
+	flagReceiveEnabled         = "receive-enabled"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -35,7 +40,8 @@ as absolute or relative using the "absolute-timeouts" flag. Timeout height can b
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
+corresponding to the counterparty channel. Any timeout set to 0 is disabled. Multiple comma separated coins
+may be transferred in a single packet on channels which negotiated the multi-denom channel version.`),
 		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,14 +54,20 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			if len(coins) == 0 {
+				return fmt.Errorf("invalid amount %s: at least one coin must be transferred", args[3])
+			}
+
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
+				}
 			}
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
@@ -82,6 +94,11 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			refundAddress, err := cmd.Flags().GetString(flagRefundAddress)
+			if err != nil {
+				return err
+			}
+
 			// if the timeouts are not absolute, retrieve latest block height and block timestamp
 			// for the consensus state connected to the destination port/channel
 			if !absoluteTimeouts {
@@ -116,10 +133,20 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
-			)
+			var msg *types.MsgTransfer
+			if len(coins) == 1 {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp,
+				)
+			} else {
+				// multiple tokens are transferred in a single packet, which requires a multi-denom channel
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, sdk.Coin{}, sender, receiver, timeoutHeight, timeoutTimestamp,
+				)
+				msg.Tokens = coins
+			}
 			msg.Memo = memo
+			msg.RefundAddress = refundAddress
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -129,7 +156,81 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagRefundAddress, "", "Address to be refunded instead of the sender if the packet times out or fails on the receiving chain.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewCmdSubmitUpdateDenomEnabledProposal implements a command handler for submitting a proposal to update the send
+// and receive enabled override of a denomination.
+func NewCmdSubmitUpdateDenomEnabledProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-ibc-transfer-denom-enabled [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to enable or disable ibc transfers of a denomination",
+		Long: "Submit a proposal to enable or disable sending and receiving ibc transfers of a denomination, along with an initial deposit.\n" +
+			"The denomination is either a base denomination, matching the native token and all of its vouchers, or an ibc/{hash} denomination.\n" +
+			"Enabling both sending and receiving removes the override of the denomination.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal update-ibc-transfer-denom-enabled ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --send-enabled=false --receive-enabled=false --title=\"freeze token\" --description=\"...\" --deposit=10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			sendEnabled, err := cmd.Flags().GetBool(flagSendEnabled)
+			if err != nil {
+				return err
+			}
+
+			receiveEnabled, err := cmd.Flags().GetBool(flagReceiveEnabled)
+			if err != nil {
+				return err
+			}
+
+			denomEnabled := types.NewDenomEnabled(args[0], sendEnabled, receiveEnabled)
+			content := types.NewUpdateDenomEnabledProposal(title, description, []types.DenomEnabled{denomEnabled})
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(flagSendEnabled, true, "enable sending ibc transfers of the denomination")
+	cmd.Flags().Bool(flagReceiveEnabled, true, "enable receiving ibc transfers of the denomination")
+
+	return cmd
+}
@@ -80,8 +80,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s or %s", version, types.Version, types.VersionMultiDenom)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -107,8 +107,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s or %s", counterpartyVersion, types.Version, types.VersionMultiDenom)
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -116,7 +116,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	// the multi-denom feature is only enabled if proposed by the counterparty
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -127,8 +128,9 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	// the counterparty may fall back to the version without the multi-denom feature
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s or %s", counterpartyVersion, types.Version, types.VersionMultiDenom)
 	}
 	return nil
 }
@@ -171,32 +173,33 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
+	data, multiDenomData, err := unmarshalPacketData(packet.GetData())
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	if err != nil {
 		ackErr = sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = channeltypes.NewErrorAcknowledgementWithCodespace(ackErr)
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		err := im.keeper.OnRecvPacket(ctx, packet, data)
+		if multiDenomData != nil {
+			err = im.keeper.OnRecvMultiDenomPacket(ctx, packet, *multiDenomData)
+		} else {
+			err = im.keeper.OnRecvPacket(ctx, packet, data)
+		}
+
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ack = channeltypes.NewErrorAcknowledgementWithCodespace(err)
 			ackErr = err
 		}
 	}
 
 	eventAttributes := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
-		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
 	}
+	eventAttributes = append(eventAttributes, packetDataAttributes(data, multiDenomData)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())))
 
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
@@ -224,25 +227,30 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, multiDenomData, err := unmarshalPacketData(packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
-	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+	if multiDenomData != nil {
+		err = im.keeper.OnAcknowledgementMultiDenomPacket(ctx, packet, *multiDenomData, ack)
+	} else {
+		err = im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+	}
+	if err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+	}
+	eventAttributes = append(eventAttributes, packetDataAttributes(data, multiDenomData)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAck, ack.String()))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 	)
 
@@ -272,25 +280,96 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, multiDenomData, err := unmarshalPacketData(packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
+
+	// the refund address stored for the packet is removed when the tokens are refunded
+	refundReceiver, err := im.keeper.GetRefundReceiver(ctx, packet, data.Sender)
+	if err != nil {
+		return err
+	}
+
 	// refund tokens
-	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+	if multiDenomData != nil {
+		err = im.keeper.OnTimeoutMultiDenomPacket(ctx, packet, *multiDenomData)
+	} else {
+		err = im.keeper.OnTimeoutPacket(ctx, packet, data)
+	}
+	if err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, refundReceiver.String()),
+	}
+	for _, tokenData := range packetTokenData(data, multiDenomData) {
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributeKeyRefundDenom, tokenData.Denom),
+			sdk.NewAttribute(types.AttributeKeyRefundAmount, tokenData.Amount),
+		)
+	}
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			eventAttributes...,
 		),
 	)
 
 	return nil
 }
+
+// unmarshalPacketData decodes the data of a transfer packet, which is either a FungibleTokenPacketData or,
+// on channels which negotiated the multi-denom channel version, a MultiDenomFungibleTokenPacketData. The
+// returned MultiDenomFungibleTokenPacketData is nil if the packet data is a FungibleTokenPacketData. Unknown
+// fields are rejected when decoding, such that the packet data can only be decoded as one of the two. The
+// sender, receiver and memo of the returned FungibleTokenPacketData are always set.
+func unmarshalPacketData(bz []byte) (types.FungibleTokenPacketData, *types.MultiDenomFungibleTokenPacketData, error) {
+	var data types.FungibleTokenPacketData
+	err := types.ModuleCdc.UnmarshalJSON(bz, &data)
+	if err == nil {
+		return data, nil, nil
+	}
+
+	var multiDenomData types.MultiDenomFungibleTokenPacketData
+	if multiDenomErr := types.ModuleCdc.UnmarshalJSON(bz, &multiDenomData); multiDenomErr != nil {
+		return types.FungibleTokenPacketData{}, nil, err
+	}
+
+	// the fields shared by all the tokens are set for use in events
+	data.Sender = multiDenomData.Sender
+	data.Receiver = multiDenomData.Receiver
+	data.Memo = multiDenomData.Memo
+
+	return data, &multiDenomData, nil
+}
+
+// packetTokenData returns the transfer of each token of the packet data as a FungibleTokenPacketData.
+func packetTokenData(data types.FungibleTokenPacketData, multiDenomData *types.MultiDenomFungibleTokenPacketData) []types.FungibleTokenPacketData {
+	if multiDenomData != nil {
+		return multiDenomData.GetFungibleTokenPacketData()
+	}
+
+	return []types.FungibleTokenPacketData{data}
+}
+
+// packetDataAttributes returns the event attributes of the packet data, including the denomination and
+// amount of each token.
+func packetDataAttributes(data types.FungibleTokenPacketData, multiDenomData *types.MultiDenomFungibleTokenPacketData) []sdk.Attribute {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	for _, tokenData := range packetTokenData(data, multiDenomData) {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyDenom, tokenData.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, tokenData.Amount),
+		)
+	}
+
+	return append(attributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+}
@@ -33,6 +33,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, true,
 		},
+		{
+			"success: multi-denom version", func() {
+				channel.Version = types.VersionMultiDenom
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -92,7 +97,12 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+
+				expVersion := channel.Version
+				if expVersion == "" {
+					expVersion = types.Version
+				}
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -118,6 +128,11 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: counterparty proposes multi-denom version", func() {
+				counterpartyVersion = types.VersionMultiDenom
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -183,7 +198,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -203,6 +218,11 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: counterparty accepts multi-denom version", func() {
+				counterpartyVersion = types.VersionMultiDenom
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
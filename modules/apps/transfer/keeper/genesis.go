@@ -28,13 +28,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	k.SetParams(ctx, state.Params)
+
+	for _, totalEscrow := range state.TotalEscrowed {
+		k.SetTotalEscrowForDenom(ctx, totalEscrow)
+	}
+
+	for _, denomEnabled := range state.DenomsEnabled {
+		k.SetDenomEnabled(ctx, denomEnabled)
+	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info, total escrow amounts and denom enabled overrides into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:        k.GetPort(ctx),
+		DenomTraces:   k.GetAllDenomTraces(ctx),
+		Params:        k.GetParams(ctx),
+		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		DenomsEnabled: k.GetAllDenomsEnabled(ctx),
 	}
 }
@@ -13,6 +13,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -72,6 +74,47 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomTracesByBaseDenom implements the Query/DenomTracesByBaseDenom gRPC method
+func (q Keeper) DenomTracesByBaseDenom(c context.Context, req *types.QueryDenomTracesByBaseDenomRequest) (*types.QueryDenomTracesByBaseDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denomination cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomTraceKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		result, err := q.UnmarshalDenomTrace(value)
+		if err != nil {
+			return false, err
+		}
+
+		if result.BaseDenom != req.BaseDenom {
+			return false, nil
+		}
+
+		if accumulate {
+			traces = append(traces, result)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByBaseDenomResponse{
+		DenomTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -115,9 +158,73 @@ func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRe
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.channelKeeper.GetChannel(ctx, req.PortId, req.ChannelId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port-id: %s, channel-id: %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
 	addr := types.GetEscrowAddress(req.PortId, req.ChannelId)
 
 	return &types.QueryEscrowAddressResponse{
 		EscrowAddress: addr.String(),
 	}, nil
 }
+
+// DenomsEnabled implements the Query/DenomsEnabled gRPC method
+func (q Keeper) DenomsEnabled(c context.Context, req *types.QueryDenomsEnabledRequest) (*types.QueryDenomsEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var denomsEnabled []types.DenomEnabled
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomEnabledKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var denomEnabled types.DenomEnabled
+		if err := q.cdc.Unmarshal(value, &denomEnabled); err != nil {
+			return err
+		}
+
+		denomsEnabled = append(denomsEnabled, denomEnabled)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsEnabledResponse{
+		DenomsEnabled: denomsEnabled,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// TotalEscrowForDenom implements the TotalEscrowForDenom gRPC method.
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amount := q.GetTotalEscrowForDenom(ctx, req.Denom)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: amount,
+	}, nil
+}
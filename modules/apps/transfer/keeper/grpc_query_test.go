@@ -47,6 +47,18 @@ func (suite *KeeperTestSuite) TestQueryDenomTrace() {
 			},
 			true,
 		},
+		{
+			"success: multi-hop ibc denom",
+			func() {
+				expTrace = types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom")
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryDenomTraceRequest{
+					Hash: expTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
 		{
 			"failure: invalid hash",
 			func() {
@@ -149,6 +161,120 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomTracesByBaseDenom() {
+	var (
+		req       *types.QueryDenomTracesByBaseDenomRequest
+		expTraces types.Traces
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no traces for base denom",
+			func() {
+				req = &types.QueryDenomTracesByBaseDenomRequest{
+					BaseDenom: "uosmo",
+				}
+			},
+			true,
+		},
+		{
+			"success: multi-hop traces",
+			func() {
+				expTraces = types.Traces{
+					types.ParseDenomTrace("transfer/channel-0/uatom"),
+					types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom"),
+					types.ParseDenomTrace("transfer/channel-1/transfer/channel-0/transfer/channel-5/uatom"),
+				}
+
+				req = &types.QueryDenomTracesByBaseDenomRequest{
+					BaseDenom: "uatom",
+				}
+			},
+			true,
+		},
+		{
+			"success: paginated multi-hop traces",
+			func() {
+				expTraces = types.Traces{
+					types.ParseDenomTrace("transfer/channel-0/uatom"),
+					types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom"),
+					types.ParseDenomTrace("transfer/channel-1/transfer/channel-0/transfer/channel-5/uatom"),
+				}
+
+				req = &types.QueryDenomTracesByBaseDenomRequest{
+					BaseDenom: "uatom",
+					Pagination: &query.PageRequest{
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"failure: empty base denom",
+			func() {
+				req = &types.QueryDenomTracesByBaseDenomRequest{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expTraces = nil
+
+			// traces with other base denoms must not be returned
+			otherTraces := types.Traces{
+				types.ParseDenomTrace("transfer/channel-0/uosmo/uatom"),
+				types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/stake"),
+			}
+			for _, trace := range otherTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			}
+
+			tc.malleate()
+
+			for _, trace := range expTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			}
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				if req.Pagination == nil {
+					suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+					return
+				}
+
+				suite.Require().Len(res.DenomTraces, int(req.Pagination.Limit))
+				suite.Require().Equal(uint64(len(expTraces)), res.Pagination.Total)
+
+				// query the next page
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+				nextRes, err := suite.queryClient.DenomTracesByBaseDenom(ctx, req)
+				suite.Require().NoError(err)
+				suite.Require().Nil(nextRes.Pagination.NextKey)
+
+				suite.Require().Equal(expTraces.Sort(), append(res.DenomTraces, nextRes.DenomTraces...).Sort())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
@@ -195,12 +321,26 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 			func() {},
 			true,
 		},
+		{
+			"success: multi-hop trace",
+			func() {
+				multiHopTrace := types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom")
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), multiHopTrace)
+
+				req = &types.QueryDenomHashRequest{
+					Trace: "transfer/channel-0/transfer/channel-5/uatom",
+				}
+				expHash = multiHopTrace.Hash().String()
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			expHash = reqTrace.Hash().String()
 			req = &types.QueryDenomHashRequest{
 				Trace: reqTrace.GetFullDenomPath(),
 			}
@@ -224,7 +364,8 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 
 func (suite *KeeperTestSuite) TestEscrowAddress() {
 	var (
-		req *types.QueryEscrowAddressRequest
+		req  *types.QueryEscrowAddressRequest
+		path *ibctesting.Path
 	)
 
 	testCases := []struct {
@@ -236,18 +377,51 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 			"success",
 			func() {
 				req = &types.QueryEscrowAddressRequest{
-					PortId:    ibctesting.TransferPort,
-					ChannelId: ibctesting.FirstChannelID,
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
 			true,
 		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: "channel-100",
+				}
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    "",
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: "(invalid-channel)",
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
 			tc.malleate()
 			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 
@@ -255,7 +429,7 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				expected := types.GetEscrowAddress(ibctesting.TransferPort, ibctesting.FirstChannelID).String()
+				expected := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID).String()
 				suite.Require().Equal(expected, res.EscrowAddress)
 			} else {
 				suite.Require().Error(err)
@@ -263,3 +437,110 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
+	var (
+		req             *types.QueryTotalEscrowForDenomRequest
+		expEscrowAmount sdk.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid native denom with escrow amount",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+
+				expEscrowAmount = sdk.NewInt(100)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, expEscrowAmount))
+			},
+			true,
+		},
+		{
+			"valid ibc denom with escrow amount",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      "transfer/channel-0",
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+
+				expEscrowAmount = sdk.NewInt(100)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denomTrace.IBCDenom(), expEscrowAmount))
+			},
+			true,
+		},
+		{
+			"valid denom without escrow amount",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "uatom",
+				}
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "??𓃠🐾??",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expEscrowAmount = sdk.ZeroInt()
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TotalEscrowForDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoin(req.Denom, expEscrowAmount), res.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomsEnabled() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.queryClient.DenomsEnabled(ctx, &types.QueryDenomsEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DenomsEnabled)
+
+	expDenomsEnabled := []types.DenomEnabled{
+		types.NewDenomEnabled(types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom").IBCDenom(), false, true),
+		types.NewDenomEnabled("stake", true, false),
+		types.NewDenomEnabled("uatom", false, false),
+	}
+	for _, denomEnabled := range expDenomsEnabled {
+		suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), denomEnabled)
+	}
+
+	res, err = suite.queryClient.DenomsEnabled(ctx, &types.QueryDenomsEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expDenomsEnabled, res.DenomsEnabled)
+
+	res, err = suite.queryClient.DenomsEnabled(ctx, &types.QueryDenomsEnabledRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expDenomsEnabled[:1], res.DenomsEnabled)
+	suite.Require().Equal(uint64(len(expDenomsEnabled)), res.Pagination.Total)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// afterRecvTransfer calls the AfterRecvTransfer hook of the registered hooks if any.
+func (k Keeper) afterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, recipientCoins sdk.Coins) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.callHook(ctx, "AfterRecvTransfer", func(cacheCtx sdk.Context) error {
+		return k.hooks.AfterRecvTransfer(cacheCtx, packet, data, recipientCoins)
+	})
+}
+
+// afterRefundTransfer calls the AfterRefundTransfer hook of the registered hooks if any.
+func (k Keeper) afterRefundTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.callHook(ctx, "AfterRefundTransfer", func(cacheCtx sdk.Context) error {
+		return k.hooks.AfterRefundTransfer(cacheCtx, packet, data)
+	})
+}
+
+// callHook calls the provided hook using a cached context. The state changes and events of the hook are only
+// committed if it succeeds. If the hook fails, its error is returned in strict mode, reverting the transfer,
+// and is otherwise logged and discarded such that the transfer itself is unaffected.
+func (k Keeper) callHook(ctx sdk.Context, name string, hook func(cacheCtx sdk.Context) error) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		if k.strictHooks {
+			return sdkerrors.Wrapf(err, "%s hook failed", name)
+		}
+
+		k.Logger(ctx).Error("transfer hook failed", "hook", name, "error", err.Error())
+		return nil
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
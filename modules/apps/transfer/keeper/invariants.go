@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom",
+		TotalEscrowPerDenomInvariants(k))
+}
+
+// TotalEscrowPerDenomInvariants checks that the total amount escrowed for each denom is not
+// greater than the sum of the balances of the escrow accounts of all transfer channels. The
+// escrow balances may exceed the stored totals since tokens can be sent directly to an escrow
+// account outside of the transfer module.
+func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrowedBalances := k.getEscrowedBalances(ctx)
+
+		k.IterateTokensInEscrow(ctx, func(totalEscrow sdk.Coin) bool {
+			balance := escrowedBalances.AmountOf(totalEscrow.Denom)
+			if balance.LT(totalEscrow.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\tdenom: %s, total escrow tracked: %s, escrow accounts balance: %s\n",
+					totalEscrow.Denom, totalEscrow.Amount, balance)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"total escrow per denom invariance",
+			fmt.Sprintf("found a mismatch between the total escrow tracked and the escrow accounts balance\n%s", msg),
+		), broken
+	}
+}
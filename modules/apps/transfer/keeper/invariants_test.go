@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/cosmos/ibc-go/v4/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success: no tokens in escrow",
+			func() {},
+			false,
+		},
+		{
+			"success: total escrow matches escrow account balance",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0)
+				_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"success: escrow account balance exceeds total escrow",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			},
+			false,
+		},
+		{
+			"failure: total escrow exceeds escrow account balance",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			true,
+		},
+		{
+			"failure: escrow account of a non-transfer channel is not counted",
+			func() {
+				escrow := types.GetEscrowAddress(ibctesting.MockPort, path.EndpointA.ChannelID)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			msg, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(suite.chainA.GetContext())
+
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
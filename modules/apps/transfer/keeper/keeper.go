@@ -26,6 +26,11 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	hooks       types.TransferHooks
+	strictHooks bool
+
+	allowBlockedReceivers bool
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -57,6 +62,30 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the hooks called once fungible tokens have been received or refunded. If strict is true, an
+// error returned by a hook reverts the transfer, otherwise the error is logged and the transfer proceeds.
+// The hooks must be set prior to the keeper being passed to the transfer IBCModule.
+func (k *Keeper) SetHooks(hooks types.TransferHooks, strict bool) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set transfer hooks twice")
+	}
+
+	k.hooks = hooks
+	k.strictHooks = strict
+
+	return k
+}
+
+// SetAllowBlockedReceivers sets whether tokens may be received by addresses blocked by the bank keeper, such as module
+// accounts. By default such transfers are rejected with an error acknowledgement, refunding the tokens on the sending
+// chain. Chains which intentionally receive tokens into module accounts may allow it. The option must be set prior to
+// the keeper being passed to the transfer IBCModule.
+func (k *Keeper) SetAllowBlockedReceivers(allow bool) *Keeper {
+	k.allowBlockedReceivers = allow
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
@@ -139,6 +168,203 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// GetTotalEscrowForDenom gets the total amount of source chain tokens that
+// are in escrow, keyed by the denomination.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalEscrowForDenomKey(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetTotalEscrowForDenom stores the total amount of source chain tokens that are in escrow.
+// The entry is removed from the store if the amount is zero.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.TotalEscrowForDenomKey(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set(key, bz)
+}
+
+// GetAllTotalEscrowed returns the escrow information for all the denominations.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	var escrows sdk.Coins
+	k.IterateTokensInEscrow(ctx, func(coin sdk.Coin) bool {
+		escrows = escrows.Add(coin)
+		return false
+	})
+
+	return escrows
+}
+
+// IterateTokensInEscrow iterates over the denomination escrows in the store
+// and performs a callback function.
+func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, cb func(coin sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalEscrowKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.TotalEscrowKey):])
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		if cb(sdk.NewCoin(denom, amount.Int)) {
+			break
+		}
+	}
+}
+
+// GetDenomEnabled returns the send and receive enabled override stored for the provided denomination.
+func (k Keeper) GetDenomEnabled(ctx sdk.Context, denom string) (types.DenomEnabled, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomEnabledStoreKey(denom))
+	if bz == nil {
+		return types.DenomEnabled{}, false
+	}
+
+	var denomEnabled types.DenomEnabled
+	k.cdc.MustUnmarshal(bz, &denomEnabled)
+
+	return denomEnabled, true
+}
+
+// SetDenomEnabled stores the send and receive enabled override of a denomination. The override is removed
+// from the store if it enables both sending and receiving.
+func (k Keeper) SetDenomEnabled(ctx sdk.Context, denomEnabled types.DenomEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DenomEnabledStoreKey(denomEnabled.Denom)
+
+	if denomEnabled.IsDefault() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&denomEnabled))
+}
+
+// GetAllDenomsEnabled returns the send and receive enabled overrides of all the denominations.
+func (k Keeper) GetAllDenomsEnabled(ctx sdk.Context) []types.DenomEnabled {
+	var denomsEnabled []types.DenomEnabled
+	k.IterateDenomsEnabled(ctx, func(denomEnabled types.DenomEnabled) bool {
+		denomsEnabled = append(denomsEnabled, denomEnabled)
+		return false
+	})
+
+	return denomsEnabled
+}
+
+// IterateDenomsEnabled iterates over the send and receive enabled overrides in the store
+// and performs a callback function.
+func (k Keeper) IterateDenomsEnabled(ctx sdk.Context, cb func(denomEnabled types.DenomEnabled) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomEnabledKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var denomEnabled types.DenomEnabled
+		k.cdc.MustUnmarshal(iterator.Value(), &denomEnabled)
+
+		if cb(denomEnabled) {
+			break
+		}
+	}
+}
+
+// GetRefundAddress returns the address to be refunded if the packet sent on the provided port and channel
+// with the provided sequence times out or is acknowledged with an error.
+func (k Keeper) GetRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RefundAddressStoreKey(portID, channelID, sequence))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetRefundAddress stores the address to be refunded if the packet sent on the provided port and channel
+// with the provided sequence times out or is acknowledged with an error.
+func (k Keeper) SetRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64, refundAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RefundAddressStoreKey(portID, channelID, sequence), refundAddress)
+}
+
+// DeleteRefundAddress removes the refund address of the packet sent on the provided port and channel
+// with the provided sequence.
+func (k Keeper) DeleteRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RefundAddressStoreKey(portID, channelID, sequence))
+}
+
+// IsSendEnabledDenom returns false if an override disables sending for the base denomination or the ibc
+// denomination of the provided denomination trace. The send enabled param is not taken into account.
+func (k Keeper) IsSendEnabledDenom(ctx sdk.Context, denomTrace types.DenomTrace) bool {
+	for _, denomEnabled := range k.getMatchingDenomsEnabled(ctx, denomTrace) {
+		if !denomEnabled.SendEnabled {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsReceiveEnabledDenom returns false if an override disables receiving for the base denomination or the ibc
+// denomination of the provided denomination trace. The receive enabled param is not taken into account.
+func (k Keeper) IsReceiveEnabledDenom(ctx sdk.Context, denomTrace types.DenomTrace) bool {
+	for _, denomEnabled := range k.getMatchingDenomsEnabled(ctx, denomTrace) {
+		if !denomEnabled.ReceiveEnabled {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getMatchingDenomsEnabled returns the overrides stored for the base denomination and the ibc denomination of
+// the provided denomination trace.
+func (k Keeper) getMatchingDenomsEnabled(ctx sdk.Context, denomTrace types.DenomTrace) []types.DenomEnabled {
+	var denomsEnabled []types.DenomEnabled
+	for _, denom := range []string{denomTrace.BaseDenom, denomTrace.IBCDenom()} {
+		if denomEnabled, found := k.GetDenomEnabled(ctx, denom); found {
+			denomsEnabled = append(denomsEnabled, denomEnabled)
+		}
+	}
+
+	return denomsEnabled
+}
+
+// getEscrowedBalances returns the sum of the balances of the escrow accounts of all the
+// channels bound to the transfer port.
+func (k Keeper) getEscrowedBalances(ctx sdk.Context) sdk.Coins {
+	portID := k.GetPort(ctx)
+
+	var balances sdk.Coins
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(portID, channel.ChannelId)
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return balances
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -44,6 +45,89 @@ func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	return path
 }
 
+// NewMultiDenomTransferPath returns a transfer path between the provided chains which negotiates the
+// multi-denom channel version.
+func NewMultiDenomTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewTransferPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Version = types.VersionMultiDenom
+	path.EndpointB.ChannelConfig.Version = types.VersionMultiDenom
+
+	return path
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestSetGetTotalEscrowForDenom() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), transferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	transferKeeper.SetTotalEscrowForDenom(ctx, coin)
+	suite.Require().Equal(coin, transferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+
+	// setting a zero amount removes the entry from the store
+	transferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()))
+	suite.Require().False(ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)).Has(types.TotalEscrowForDenomKey(sdk.DefaultBondDenom)))
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), transferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestGetAllTotalEscrowed() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	suite.Require().Empty(transferKeeper.GetAllTotalEscrowed(ctx))
+
+	expEscrowed := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		sdk.NewCoin("uatom", sdk.NewInt(50)),
+		sdk.NewCoin("uosmo", sdk.NewInt(1)),
+	)
+	for _, coin := range expEscrowed {
+		transferKeeper.SetTotalEscrowForDenom(ctx, coin)
+	}
+
+	suite.Require().Equal(expEscrowed, transferKeeper.GetAllTotalEscrowed(ctx))
+}
+
+func (suite *KeeperTestSuite) TestDenomEnabled() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	nativeTrace := types.ParseDenomTrace("uatom")
+	voucherTrace := types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom")
+	otherVoucherTrace := types.ParseDenomTrace("transfer/channel-1/uatom")
+
+	suite.Require().Empty(transferKeeper.GetAllDenomsEnabled(ctx))
+	suite.Require().True(transferKeeper.IsSendEnabledDenom(ctx, nativeTrace))
+	suite.Require().True(transferKeeper.IsReceiveEnabledDenom(ctx, voucherTrace))
+
+	// an override of an ibc denom applies to that voucher only
+	transferKeeper.SetDenomEnabled(ctx, types.NewDenomEnabled(voucherTrace.IBCDenom(), false, true))
+	suite.Require().False(transferKeeper.IsSendEnabledDenom(ctx, voucherTrace))
+	suite.Require().True(transferKeeper.IsReceiveEnabledDenom(ctx, voucherTrace))
+	suite.Require().True(transferKeeper.IsSendEnabledDenom(ctx, otherVoucherTrace))
+	suite.Require().True(transferKeeper.IsSendEnabledDenom(ctx, nativeTrace))
+
+	// an override of a base denom applies to the native token and all of its vouchers
+	transferKeeper.SetDenomEnabled(ctx, types.NewDenomEnabled("uatom", true, false))
+	suite.Require().False(transferKeeper.IsReceiveEnabledDenom(ctx, nativeTrace))
+	suite.Require().False(transferKeeper.IsReceiveEnabledDenom(ctx, voucherTrace))
+	suite.Require().False(transferKeeper.IsReceiveEnabledDenom(ctx, otherVoucherTrace))
+	suite.Require().True(transferKeeper.IsSendEnabledDenom(ctx, otherVoucherTrace))
+
+	denomEnabled, found := transferKeeper.GetDenomEnabled(ctx, "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewDenomEnabled("uatom", true, false), denomEnabled)
+	suite.Require().Len(transferKeeper.GetAllDenomsEnabled(ctx), 2)
+
+	// an override enabling both sending and receiving is removed
+	transferKeeper.SetDenomEnabled(ctx, types.NewDenomEnabled("uatom", true, true))
+	_, found = transferKeeper.GetDenomEnabled(ctx, "uatom")
+	suite.Require().False(found)
+	suite.Require().True(transferKeeper.IsReceiveEnabledDenom(ctx, nativeTrace))
+	suite.Require().Equal([]types.DenomEnabled{types.NewDenomEnabled(voucherTrace.IBCDenom(), false, true)}, transferKeeper.GetAllDenomsEnabled(ctx))
+}
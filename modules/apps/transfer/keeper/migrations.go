@@ -54,6 +54,33 @@ func (m Migrator) MigrateTraces(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateTotalEscrowForDenom initializes the total amount of tokens in escrow per denom from
+// the balances of the escrow accounts of all the channels bound to the transfer port.
+func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
+	totalEscrowed := m.keeper.getEscrowedBalances(ctx)
+	for _, totalEscrow := range totalEscrowed {
+		m.keeper.SetTotalEscrowForDenom(ctx, totalEscrow)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set total escrow for denoms", "number of denoms", len(totalEscrowed))
+	return nil
+}
+
+// MigrateDenomMetadata sets the bank denomination metadata of the voucher denominations of all the
+// denomination traces. Existing metadata is not overwritten.
+func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
+	m.keeper.IterateDenomTraces(ctx,
+		func(dt types.DenomTrace) (stop bool) {
+			if _, found := m.keeper.bankKeeper.GetDenomMetaData(ctx, dt.IBCDenom()); !found {
+				m.keeper.setDenomMetadata(ctx, dt)
+			}
+			return false
+		})
+
+	m.keeper.Logger(ctx).Info("successfully set denom metadata of IBC vouchers")
+	return nil
+}
+
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
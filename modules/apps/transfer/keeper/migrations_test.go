@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	transferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/cosmos/ibc-go/v4/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
@@ -121,3 +126,116 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTracesCorruptionDetection() {
 		migrator.MigrateTraces(suite.chainA.GetContext())
 	})
 }
+
+func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
+	var (
+		path           *ibctesting.Path
+		expectedEscrow sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: no escrowed tokens",
+			func() {
+				expectedEscrow = nil
+			},
+		},
+		{
+			"success: escrow balances of multiple transfer channels are summed",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				escrow := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+
+				// create a second transfer channel with escrowed tokens
+				path2 := NewTransferPath(suite.chainA, suite.chainC)
+				suite.coordinator.Setup(path2)
+
+				escrow = transfertypes.GetEscrowAddress(path2.EndpointA.ChannelConfig.PortID, path2.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin, sdk.NewCoin("uatom", sdk.NewInt(50)))))
+
+				expectedEscrow = sdk.NewCoins(coin.Add(coin), sdk.NewCoin("uatom", sdk.NewInt(50)))
+			},
+		},
+		{
+			"success: escrow balances of non-transfer channels are ignored",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				escrow := transfertypes.GetEscrowAddress(ibctesting.MockPort, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+
+				expectedEscrow = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			err := migrator.MigrateTotalEscrowForDenom(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			totalEscrowed := suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(suite.chainA.GetContext())
+			suite.Require().Equal(expectedEscrow, totalEscrowed)
+
+			// the migrated state must satisfy the total escrow invariant
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			msg, broken := transferkeeper.TotalEscrowPerDenomInvariants(&transferKeeper)(suite.chainA.GetContext())
+			suite.Require().False(broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateDenomMetadata() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	denomTrace := transfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	transferKeeper.SetDenomTrace(ctx, denomTrace)
+
+	// metadata of an existing trace is not overwritten
+	existingTrace := transfertypes.ParseDenomTrace("transfer/channel-1/gamm/pool/1")
+	transferKeeper.SetDenomTrace(ctx, existingTrace)
+
+	existingMetadata := banktypes.Metadata{
+		Description: "existing metadata",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: existingTrace.IBCDenom(), Exponent: 0}},
+		Base:        existingTrace.IBCDenom(),
+		Display:     existingTrace.IBCDenom(),
+		Name:        "pool",
+		Symbol:      "POOL",
+	}
+	bankKeeper.SetDenomMetaData(ctx, existingMetadata)
+
+	migrator := transferkeeper.NewMigrator(transferKeeper)
+	suite.Require().NoError(migrator.MigrateDenomMetadata(ctx))
+
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, denomTrace.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().NoError(metadata.Validate())
+	suite.Require().Equal(denomTrace.IBCDenom(), metadata.Base)
+	suite.Require().Equal("UATOM", metadata.Symbol)
+	suite.Require().Equal([]string{"uatom", "transfer/channel-0/uatom"}, metadata.DenomUnits[0].Aliases)
+	suite.Require().Contains(metadata.Description, denomTrace.GetFullDenomPath())
+
+	metadata, found = bankKeeper.GetDenomMetaData(ctx, existingTrace.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(existingMetadata, metadata)
+}
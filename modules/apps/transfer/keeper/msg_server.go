@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 )
@@ -19,14 +20,40 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
-	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo)
+	var refundAddress sdk.AccAddress
+	if msg.RefundAddress != "" {
+		refundAddress, err = sdk.AccAddressFromBech32(msg.RefundAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// refunds are sent from the module account when vouchers are minted back, which fails for blocked addresses
+		if k.bankKeeper.BlockedAddr(refundAddress) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive refunds", refundAddress)
+		}
+	}
+
+	var sequence uint64
+	if len(msg.Tokens) > 0 {
+		sequence, err = k.sendMultiDenomTransfer(
+			ctx, msg.SourcePort, msg.SourceChannel, msg.Tokens, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+			msg.Memo)
+	} else {
+		sequence, err = k.sendTransfer(
+			ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+			msg.Memo)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	if refundAddress != nil {
+		k.SetRefundAddress(ctx, msg.SourcePort, msg.SourceChannel, sequence, refundAddress)
+	}
+
+	for _, token := range msg.GetTokens() {
+		k.Logger(ctx).Info("IBC fungible token transfer", "token", token.Denom, "amount", token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
 func (suite *KeeperTestSuite) TestMsgTransfer() {
@@ -56,6 +61,51 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"success: memo length at max memo length",
+			func() {
+				params := types.NewParams(true, true, 4, types.DefaultDefaultTimeoutDuration)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"success: memo length unbounded",
+			func() {
+				params := types.NewParams(true, true, 0, types.DefaultDefaultTimeoutDuration)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+				msg.Memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+			},
+			true,
+		},
+		{
+			"memo exceeds max memo length",
+			func() {
+				msg.Memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+			},
+			false,
+		},
+		{
+			"success: refund address provided",
+			func() {
+				msg.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			true,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = "address"
+			},
+			false,
+		},
+		{
+			"refund address is a blocked address",
+			func() {
+				msg.RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			},
+			false,
+		},
 		{
 			"channel does not exist",
 			func() {
@@ -88,9 +138,122 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			suite.Require().NotEqual(res.Sequence, uint64(0))
 			suite.Require().NoError(err)
 			suite.Require().NotNil(res)
+
+			refundAddress, found := suite.chainA.GetSimApp().TransferKeeper.GetRefundAddress(suite.chainA.GetContext(), msg.SourcePort, msg.SourceChannel, res.Sequence)
+			suite.Require().Equal(msg.RefundAddress != "", found)
+			if found {
+				suite.Require().Equal(msg.RefundAddress, refundAddress.String())
+			}
 		} else {
 			suite.Require().Error(err)
 			suite.Require().Nil(res)
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTransferTimeoutTimestamp() {
+	var (
+		path             *ibctesting.Path
+		timeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		name                string
+		malleate            func()
+		expTimeoutTimestamp func(ctx sdk.Context) uint64
+		expPass             bool
+	}{
+		{
+			"success: timeout timestamp in nanoseconds",
+			func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			},
+			func(sdk.Context) uint64 { return timeoutTimestamp },
+			true,
+		},
+		{
+			"success: default timeout timestamp",
+			func() {
+				timeoutTimestamp = types.DefaultTimeoutTimestamp
+			},
+			func(ctx sdk.Context) uint64 {
+				return uint64(ctx.BlockTime().Add(types.DefaultDefaultTimeoutDuration).UnixNano())
+			},
+			true,
+		},
+		{
+			"success: default timeout timestamp with updated default timeout duration",
+			func() {
+				timeoutTimestamp = types.DefaultTimeoutTimestamp
+				params := types.NewParams(true, true, types.DefaultMaxMemoLength, time.Hour)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			func(ctx sdk.Context) uint64 {
+				return uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+			},
+			true,
+		},
+		{
+			"default timeout timestamp with no default timeout duration set",
+			func() {
+				timeoutTimestamp = types.DefaultTimeoutTimestamp
+				params := types.NewParams(true, true, types.DefaultMaxMemoLength, 0)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			nil,
+			false,
+		},
+		{
+			"timeout timestamp in seconds",
+			func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+			},
+			nil,
+			false,
+		},
+		{
+			"timeout timestamp in milliseconds",
+			func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixMilli())
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(), timeoutTimestamp, // only use timeout timestamp
+			)
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTimeoutTimestamp(ctx), packet.GetTimeoutTimestamp())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 )
@@ -20,12 +23,59 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetMaxMemoLength retrieves the maximum memo length in bytes from the paramstore. Zero is returned if no limit is
+// set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetMaxMemoLength(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxMemoLength, &res)
+	return res
+}
+
+// GetDefaultTimeoutDuration retrieves the default timeout duration from the paramstore. Zero is returned if no default
+// is set, including when the param has not been initialized by a chain upgrade.
+func (k Keeper) GetDefaultTimeoutDuration(ctx sdk.Context) time.Duration {
+	var res time.Duration
+	k.paramSpace.GetIfExists(ctx, types.KeyDefaultTimeoutDuration, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetMaxMemoLength(ctx), k.GetDefaultTimeoutDuration(ctx))
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// validateMemoLength ensures the length of the memo does not exceed the MaxMemoLength param. A limit of zero is unbounded.
+func (k Keeper) validateMemoLength(ctx sdk.Context, memo string) error {
+	maxLength := k.GetMaxMemoLength(ctx)
+	if maxLength > 0 && uint64(len(memo)) > maxLength {
+		return sdkerrors.Wrapf(types.ErrInvalidMemo, "memo length %d bytes exceeds max memo length %d bytes", len(memo), maxLength)
+	}
+
+	return nil
+}
+
+// resolveTimeoutTimestamp returns the provided timeout timestamp, unless it is the DefaultTimeoutTimestamp sentinel
+// value in which case the current host time plus the DefaultTimeoutDuration param is returned. An error is returned
+// if the sentinel value is provided and no default timeout duration is set, or if the timeout timestamp is not
+// expressed in nanoseconds.
+func (k Keeper) resolveTimeoutTimestamp(ctx sdk.Context, timeoutTimestamp uint64) (uint64, error) {
+	if timeoutTimestamp == types.DefaultTimeoutTimestamp {
+		duration := k.GetDefaultTimeoutDuration(ctx)
+		if duration == 0 {
+			return 0, sdkerrors.Wrap(types.ErrInvalidPacketTimeout, "default timeout timestamp requested but no default timeout duration is set")
+		}
+
+		return uint64(ctx.BlockTime().Add(duration).UnixNano()), nil
+	}
+
+	if err := types.ValidateTimeoutTimestamp(timeoutTimestamp); err != nil {
+		return 0, err
+	}
+
+	return timeoutTimestamp, nil
+}
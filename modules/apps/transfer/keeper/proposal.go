@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// HandleUpdateDenomEnabledProposal sets the per denomination send and receive enabled overrides provided by the
+// proposal. An override enabling both sending and receiving removes the override of the denomination. An event is
+// emitted for each override set.
+func (k Keeper) HandleUpdateDenomEnabledProposal(ctx sdk.Context, p *types.UpdateDenomEnabledProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, denomEnabled := range p.DenomsEnabled {
+		k.SetDenomEnabled(ctx, denomEnabled)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDenomEnabled,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyDenom, denomEnabled.Denom),
+				sdk.NewAttribute(types.AttributeKeySendEnabled, strconv.FormatBool(denomEnabled.SendEnabled)),
+				sdk.NewAttribute(types.AttributeKeyReceiveEnabled, strconv.FormatBool(denomEnabled.ReceiveEnabled)),
+			),
+		)
+	}
+
+	return nil
+}
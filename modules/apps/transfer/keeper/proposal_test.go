@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleUpdateDenomEnabledProposal() {
+	var proposal *types.UpdateDenomEnabledProposal
+
+	testCases := []struct {
+		name             string
+		malleate         func()
+		expDenomsEnabled []types.DenomEnabled
+		expPass          bool
+	}{
+		{
+			"success: overrides set",
+			func() {},
+			[]types.DenomEnabled{
+				types.NewDenomEnabled("stake", true, false),
+				types.NewDenomEnabled("uatom", false, false),
+			},
+			true,
+		},
+		{
+			"success: override removed",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), types.NewDenomEnabled("uosmo", false, false))
+				proposal.DenomsEnabled = []types.DenomEnabled{types.NewDenomEnabled("uosmo", true, true)}
+			},
+			nil,
+			true,
+		},
+		{
+			"failure: invalid proposal",
+			func() {
+				proposal.DenomsEnabled = nil
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			proposal = types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{
+				types.NewDenomEnabled("uatom", false, false),
+				types.NewDenomEnabled("stake", true, false),
+			}).(*types.UpdateDenomEnabledProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().TransferKeeper.HandleUpdateDenomEnabledProposal(ctx, proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDenomsEnabled, suite.chainA.GetSimApp().TransferKeeper.GetAllDenomsEnabled(ctx))
+
+				events := ctx.EventManager().Events()
+				suite.Require().Len(events, len(proposal.DenomsEnabled))
+				for _, event := range events {
+					suite.Require().Equal(types.EventTypeDenomEnabled, event.Type)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -86,18 +87,70 @@ func (k Keeper) sendTransfer(
 	timeoutTimestamp uint64,
 	memo string,
 ) (uint64, error) {
-	if !k.GetSendEnabled(ctx) {
-		return 0, types.ErrSendDisabled
+	return k.sendTransferTokens(
+		ctx, sourcePort, sourceChannel, []sdk.Coin{token}, false, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+	)
+}
+
+// sendMultiDenomTransfer handles the sending logic of a transfer of multiple tokens in a single
+// packet. The channel must have negotiated the multi-denom channel version.
+func (k Keeper) sendMultiDenomTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens []sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
-	if !k.bankKeeper.IsSendEnabledCoin(ctx, token) {
-		return 0, sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", token.Denom)
+	if version != types.VersionMultiDenom {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel version %s does not support the transfer of multiple tokens, expected %s", version, types.VersionMultiDenom)
+	}
+
+	return k.sendTransferTokens(
+		ctx, sourcePort, sourceChannel, tokens, true, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+	)
+}
+
+// sendTransferTokens escrows or burns each of the provided tokens and sends a packet transferring them.
+// The packet data is a MultiDenomFungibleTokenPacketData if multiDenom is true, otherwise it is the
+// FungibleTokenPacketData of the single token provided.
+func (k Keeper) sendTransferTokens(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens []sdk.Coin,
+	multiDenom bool,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	if !k.GetSendEnabled(ctx) {
+		return 0, types.ErrSendDisabled
 	}
 
 	if k.bankKeeper.BlockedAddr(sender) {
 		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	if err := k.validateMemoLength(ctx, memo); err != nil {
+		return 0, err
+	}
+
+	timeoutTimestamp, err := k.resolveTimeoutTimestamp(ctx, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
@@ -122,6 +175,88 @@ func (k Keeper) sendTransfer(
 		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	// NOTE: SendTransfer simply sends the denomination as it exists on its own
+	// chain inside the packet data. The receiving chain will perform denom
+	// prefixing as necessary.
+	packetTokens := make([]types.Token, len(tokens))
+	senderIsSource := make([]bool, len(tokens))
+	for i, token := range tokens {
+		fullDenomPath, isSource, err := k.escrowOrBurnToken(ctx, sourcePort, sourceChannel, token, sender)
+		if err != nil {
+			return 0, err
+		}
+
+		packetTokens[i] = types.Token{Denom: fullDenomPath, Amount: token.Amount.String()}
+		senderIsSource[i] = isSource
+	}
+
+	var packetDataBz []byte
+	if multiDenom {
+		packetData := types.NewMultiDenomFungibleTokenPacketData(packetTokens, sender.String(), receiver)
+		packetData.Memo = memo
+		packetDataBz = packetData.GetBytes()
+	} else {
+		packetData := types.NewFungibleTokenPacketData(
+			packetTokens[0].Denom, packetTokens[0].Amount, sender.String(), receiver,
+		)
+		packetData.Memo = memo
+		packetDataBz = packetData.GetBytes()
+	}
+
+	packet := channeltypes.NewPacket(
+		packetDataBz,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		for i, token := range tokens {
+			if token.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, packetTokens[i].Denom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				[]metrics.Label{
+					telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+					telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+					telemetry.NewLabel(coretypes.LabelSource, fmt.Sprintf("%t", senderIsSource[i])),
+				},
+			)
+		}
+	}()
+
+	return sequence, nil
+}
+
+// escrowOrBurnToken escrows the provided token if the sender chain is the source of the token,
+// otherwise the token is burned. The full denomination path of the token and whether the sender
+// chain is its source are returned.
+func (k Keeper) escrowOrBurnToken(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+) (string, bool, error) {
+	if !k.bankKeeper.IsSendEnabledCoin(ctx, token) {
+		return "", false, sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", token.Denom)
+	}
+
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
@@ -132,22 +267,15 @@ func (k Keeper) sendTransfer(
 	if strings.HasPrefix(token.Denom, "ibc/") {
 		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
 		if err != nil {
-			return 0, err
+			return "", false, err
 		}
 	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	if !k.IsSendEnabledDenom(ctx, types.ParseDenomTrace(fullDenomPath)) {
+		return "", false, sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", token.Denom)
 	}
 
-	// NOTE: SendTransfer simply sends the denomination as it exists on its own
-	// chain inside the packet data. The receiving chain will perform denom
-	// prefixing as necessary.
-
 	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
-
 		// create the escrow address for the tokens
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
@@ -155,73 +283,43 @@ func (k Keeper) sendTransfer(
 		if err := k.bankKeeper.SendCoins(
 			ctx, sender, escrowAddress, sdk.NewCoins(token),
 		); err != nil {
-			return 0, err
+			return "", false, err
 		}
 
-	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(token))
 
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return 0, err
-		}
-
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balace
-			// to burn.
-			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
-		}
+		return fullDenomPath, true, nil
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
-	)
-	packetData.Memo = memo
-
-	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
-		timeoutTimestamp,
-	)
-
-	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
-		return 0, err
+	// transfer the coins to the module account and burn them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		return "", false, err
 	}
 
-	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
-			)
-		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
-	}()
+	if err := k.bankKeeper.BurnCoins(
+		ctx, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
 
-	return sequence, nil
+	return fullDenomPath, false, nil
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer. If the
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
+// unescrowed and sent to the receiving address. The AfterRecvTransfer hook
+// of the registered TransferHooks, if any, is called once the tokens are sent.
+// Receivers blocked by the bank keeper, such as module accounts, are rejected
+// unless the keeper allows blocked receivers.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
@@ -232,12 +330,20 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return types.ErrReceiveDisabled
 	}
 
+	if err := k.validateMemoLength(ctx, data.Memo); err != nil {
+		return err
+	}
+
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
 		return err
 	}
 
+	if err := k.validateReceiver(receiver); err != nil {
+		return err
+	}
+
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
@@ -275,8 +381,8 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		}
 		token := sdk.NewCoin(denom, transferAmount)
 
-		if k.bankKeeper.BlockedAddr(receiver) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+		if !k.IsReceiveEnabledDenom(ctx, denomTrace) {
+			return sdkerrors.Wrapf(types.ErrReceiveDisabled, "%s transfers are currently disabled", denom)
 		}
 
 		// unescrow tokens
@@ -289,6 +395,14 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Sub(token))
+
+		if err := k.afterRecvTransfer(ctx, packet, data, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -320,12 +434,20 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
 
+	if !k.IsReceiveEnabledDenom(ctx, denomTrace) {
+		return sdkerrors.Wrapf(types.ErrReceiveDisabled, "%s transfers are currently disabled", denomTrace.IBCDenom())
+	}
+
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
 	}
 
 	voucherDenom := denomTrace.IBCDenom()
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, voucherDenom); !found {
+		k.setDenomMetadata(ctx, denomTrace)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDenomTrace,
@@ -342,13 +464,17 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
-	// send to receiver
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
+	// send to receiver, SendCoinsFromModuleToAccount is not used as it rejects blocked receivers regardless of the keeper option
+	if err := k.bankKeeper.SendCoins(
+		ctx, k.authKeeper.GetModuleAddress(types.ModuleName), receiver, sdk.NewCoins(voucher),
 	); err != nil {
 		return err
 	}
 
+	if err := k.afterRecvTransfer(ctx, packet, data, sdk.NewCoins(voucher)); err != nil {
+		return err
+	}
+
 	defer func() {
 		if transferAmount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -370,17 +496,29 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	return nil
 }
 
+// validateReceiver returns an error if the provided receiver is blocked by the bank keeper, such as a module account,
+// unless the keeper allows blocked receivers. Tokens received by such addresses would otherwise be unrecoverable.
+func (k Keeper) validateReceiver(receiver sdk.AccAddress) error {
+	if !k.allowBlockedReceivers && k.bankKeeper.BlockedAddr(receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	return nil
+}
+
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
-// was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
+// was a success then only the refund address stored for the packet, if any,
+// is removed. If the acknowledgement failed, then the sender is refunded
+// their tokens using the refundPacketToken function.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		return k.refundPacketToken(ctx, packet, data)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
+		// needs to be refunded and no error needs to be returned
+		k.DeleteRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 		return nil
 	}
 }
@@ -391,13 +529,100 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, dat
 	return k.refundPacketToken(ctx, packet, data)
 }
 
+// OnRecvMultiDenomPacket processes a cross chain transfer of multiple tokens. Each token is
+// received as the token of a FungibleTokenPacketData, see OnRecvPacket. The tokens are received
+// atomically: if any of them fails to be received, the state changes of all of them are discarded.
+func (k Keeper) OnRecvMultiDenomPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	if version != types.VersionMultiDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "channel version %s does not support the transfer of multiple tokens, expected %s", version, types.VersionMultiDenom)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, tokenData := range data.GetFungibleTokenPacketData() {
+		if err := k.OnRecvPacket(cacheCtx, packet, tokenData); err != nil {
+			return sdkerrors.Wrapf(err, "failed to receive %s%s", tokenData.Amount, tokenData.Denom)
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// OnAcknowledgementMultiDenomPacket responds to the success or failure of the acknowledgement
+// of a transfer of multiple tokens, see OnAcknowledgementPacket. If the acknowledgement failed,
+// all the tokens are refunded.
+func (k Keeper) OnAcknowledgementMultiDenomPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundMultiDenomPacketTokens(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be refunded and no error needs to be returned
+		k.DeleteRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+}
+
+// OnTimeoutMultiDenomPacket refunds all the tokens of a transfer of multiple tokens since the
+// packet sent was never received and has been timed out.
+func (k Keeper) OnTimeoutMultiDenomPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData) error {
+	return k.refundMultiDenomPacketTokens(ctx, packet, data)
+}
+
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
+// the sending address. If a refund address was provided when the packet
+// was sent, the tokens are refunded to it instead of the sender. The
+// AfterRefundTransfer hook of the registered TransferHooks, if any, is
+// called once the tokens are refunded.
 func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// NOTE: packet data type already checked in handler.go
 
+	sender, err := k.GetRefundReceiver(ctx, packet, data.Sender)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	return k.refundToken(ctx, packet, data, sender)
+}
+
+// refundMultiDenomPacketTokens refunds each token of a transfer of multiple tokens as the token
+// of a FungibleTokenPacketData, see refundPacketToken.
+func (k Keeper) refundMultiDenomPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData) error {
+	sender, err := k.GetRefundReceiver(ctx, packet, data.Sender)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	for _, tokenData := range data.GetFungibleTokenPacketData() {
+		if err := k.refundToken(ctx, packet, tokenData, sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundToken unescrows or mints the token of the provided packet data and sends it to the
+// provided refund receiver.
+func (k Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, sender sdk.AccAddress) error {
 	// parse the denomination from the full denom path
 	trace := types.ParseDenomTrace(data.Denom)
 
@@ -408,12 +633,6 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	}
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
-	if err != nil {
-		return err
-	}
-
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
@@ -425,7 +644,11 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
-		return nil
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Sub(token))
+
+		return k.afterRefundTransfer(ctx, packet, data)
 	}
 
 	// mint vouchers back to sender
@@ -439,7 +662,19 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 		panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 	}
 
-	return nil
+	return k.afterRefundTransfer(ctx, packet, data)
+}
+
+// GetRefundReceiver returns the address refunded if the provided packet times out or is
+// acknowledged with an error. It is the refund address stored for the packet if one was
+// provided when the packet was sent, otherwise the provided sender of the packet data.
+func (k Keeper) GetRefundReceiver(ctx sdk.Context, packet channeltypes.Packet, sender string) (sdk.AccAddress, error) {
+	if refundAddress, found := k.GetRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); found {
+		return refundAddress, nil
+	}
+
+	// decode the sender address
+	return sdk.AccAddressFromBech32(sender)
 }
 
 // DenomPathFromHash returns the full denomination path prefix from an ibc denom with a hash
@@ -461,3 +696,32 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	fullDenomPath := denomTrace.GetFullDenomPath()
 	return fullDenomPath, nil
 }
+
+// setDenomMetadata sets the bank denomination metadata of the voucher denomination of the provided
+// denomination trace. The decimals of the tokens on the source chain are unknown, so the voucher
+// denomination is the only denomination unit, aliased by the base denomination and the full
+// denomination path of the trace.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
+	voucherDenom := denomTrace.IBCDenom()
+	fullDenomPath := denomTrace.GetFullDenomPath()
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", fullDenomPath),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    voucherDenom,
+				Exponent: 0,
+				Aliases:  []string{denomTrace.BaseDenom, fullDenomPath},
+			},
+		},
+		// the voucher denomination is used as base denomination since it is the key of the metadata
+		// in the bank store and uniquely identifies the token on this chain
+		Base: voucherDenom,
+		// the display denomination must be a denomination unit with an exponent greater than the one of
+		// the base denomination unit, which cannot be derived without knowing the decimals of the tokens
+		Display: voucherDenom,
+		Name:    fmt.Sprintf("%s IBC token", fullDenomPath),
+		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+}
@@ -4,12 +4,18 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
 	"github.com/cosmos/ibc-go/v4/testing/simapp"
 )
 
@@ -44,6 +50,46 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
 			}, false, true,
 		},
+		{
+			"successful transfer with receive disabled for denom",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), types.NewDenomEnabled(sdk.DefaultBondDenom, true, false))
+			}, true, true,
+		},
+		{
+			"successful transfer with send disabled for another denom",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), types.NewDenomEnabled("uatom", false, false))
+			}, true, true,
+		},
+		{
+			"transfer failed - send disabled for native denom",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), types.NewDenomEnabled(sdk.DefaultBondDenom, false, true))
+			}, true, false,
+		},
+		{
+			"transfer failed - send disabled for base denom of voucher",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), types.NewDenomEnabled(sdk.DefaultBondDenom, false, true))
+			}, false, false,
+		},
+		{
+			"transfer failed - send disabled for ibc denom of voucher",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainA.GetContext(), types.NewDenomEnabled(amount.Denom, false, true))
+			}, false, false,
+		},
 		{
 			"source channel not found",
 			func() {
@@ -163,6 +209,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		amount   sdk.Int
 		receiver string
 		memo     string
+		path     *ibctesting.Path
 	)
 
 	testCases := []struct {
@@ -179,6 +226,27 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"success receive with coin from another chain as source with memo", func() {
 			memo = "memo"
 		}, false, true},
+		{"memo exceeds max memo length", func() {
+			params := types.NewParams(true, true, 3, types.DefaultDefaultTimeoutDuration)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			memo = "memo"
+		}, true, false},
+		{"success receive with send disabled for denom", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainB.GetContext(), types.NewDenomEnabled(sdk.DefaultBondDenom, false, true))
+		}, false, true},
+		{"success receive with receive disabled for another denom", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainB.GetContext(), types.NewDenomEnabled("uatom", false, false))
+		}, false, true},
+		{"failure: receive disabled for base denom of voucher", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainB.GetContext(), types.NewDenomEnabled(sdk.DefaultBondDenom, true, false))
+		}, false, false},
+		{"failure: receive disabled for ibc denom of voucher", func() {
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainB.GetContext(), types.NewDenomEnabled(voucherDenom, true, false))
+		}, false, false},
+		{"failure: receive disabled for native denom on source chain", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainB.GetContext(), types.NewDenomEnabled(sdk.DefaultBondDenom, true, false))
+		}, true, false},
 		{"empty coin", func() {
 			trace = types.DenomTrace{}
 			amount = sdk.ZeroInt()
@@ -207,6 +275,23 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+		{"failure: receive on blocked distribution module account", func() {
+			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+		}, false, false},
+		{"failure: receive on blocked bonded tokens pool on source chain", func() {
+			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName).String()
+		}, true, false},
+		{"success: receive on module account which is not blocked", func() {
+			receiver = authtypes.NewModuleAddress(ibcmock.ModuleName).String()
+		}, false, true},
+		{"success: receive on blocked module account with blocked receivers allowed", func() {
+			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			suite.chainB.GetSimApp().TransferKeeper.SetAllowBlockedReceivers(true)
+		}, false, true},
+		{"success: receive on blocked module account on source chain with blocked receivers allowed", func() {
+			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			suite.chainB.GetSimApp().TransferKeeper.SetAllowBlockedReceivers(true)
+		}, true, true},
 	}
 
 	for _, tc := range testCases {
@@ -215,7 +300,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := NewTransferPath(suite.chainA, suite.chainB)
+			path = NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.Setup(path)
 			receiver = suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
 
@@ -296,6 +381,8 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			// set escrow amount that would have been stored after successful send
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{
 			"unsuccessful refund from source", failedAck,
@@ -343,6 +430,9 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 					suite.Require().Equal(amount, deltaAmount, "failed ack did not trigger refund")
 				}
 
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero(), "total escrow not reduced by refund")
+
 			} else {
 				suite.Require().Error(err)
 			}
@@ -375,6 +465,8 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				// set escrow amount that would have been stored after successful send
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 			}, true,
 		},
 		{
@@ -435,6 +527,533 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
+
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero(), "total escrow not reduced by refund")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestTotalEscrowSendRefundReceiveCycle tests that the total escrow tracked for a denom is
+// increased when tokens are sent from the source chain and decreased when the tokens are
+// refunded or sent back to the source chain.
+func (suite *KeeperTestSuite) TestTotalEscrowSendRefundReceiveCycle() {
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	getTotalEscrow := func() sdk.Coin {
+		return suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+	}
+
+	// send tokens from chainA to chainB, escrowing them on chainA
+	transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0)
+	res, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin, getTotalEscrow())
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+	suite.Require().Equal(coin, getTotalEscrow())
+
+	// send tokens from chainA to a module account on chainB, the error acknowledgement refunds the escrowed tokens
+	transferMsg.Receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+	res, err = suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin.Add(coin), getTotalEscrow())
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+	suite.Require().Equal(coin, getTotalEscrow())
+
+	// send the vouchers back from chainB to chainA, unescrowing the tokens on chainA
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	transferMsg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(voucherDenom, coin.Amount), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0)
+	res, err = suite.chainB.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+	suite.Require().True(getTotalEscrow().IsZero())
+
+	// vouchers sent from chainB are not tracked as escrowed on chainB
+	suite.Require().True(suite.chainB.GetSimApp().TransferKeeper.GetAllTotalEscrowed(suite.chainB.GetContext()).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketSetsDenomMetadata() {
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	voucherDenom := denomTrace.IBCDenom()
+	getMetadata := func() (banktypes.Metadata, bool) {
+		return suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherDenom)
+	}
+
+	_, found := getMetadata()
+	suite.Require().False(found)
+
+	transfer := func() {
+		coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+		transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0)
+		res, err := suite.chainA.SendMsgs(transferMsg)
+		suite.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+		suite.Require().NoError(path.RelayPacket(packet))
+	}
+
+	// the metadata is set when the voucher is minted for the first time
+	transfer()
+
+	metadata, found := getMetadata()
+	suite.Require().True(found)
+	suite.Require().NoError(metadata.Validate())
+	suite.Require().Equal(voucherDenom, metadata.Base)
+	suite.Require().Equal([]string{sdk.DefaultBondDenom, denomTrace.GetFullDenomPath()}, metadata.DenomUnits[0].Aliases)
+	suite.Require().Contains(metadata.Description, denomTrace.GetFullDenomPath())
+
+	// existing metadata is not overwritten on subsequent receives
+	metadata.Description = "custom description"
+	suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), metadata)
+
+	transfer()
+
+	updatedMetadata, found := getMetadata()
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, updatedMetadata)
+}
+
+func (suite *KeeperTestSuite) TestRefundAddress() {
+	var (
+		path          *ibctesting.Path
+		transferMsg   *types.MsgTransfer
+		refundAddress sdk.AccAddress
+		expRefund     bool
+	)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		settlePacket func(packet channeltypes.Packet)
+	}{
+		{
+			"success ack removes the refund address",
+			func() {
+				expRefund = false
+			},
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+		},
+		{
+			"error ack refunds the refund address",
+			func() {
+				// the transfer to a blocked address on chainB is acknowledged with an error
+				transferMsg.Receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+				expRefund = true
+			},
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+		},
+		{
+			"timeout refunds the refund address",
+			func() {
+				transferMsg.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				expRefund = true
+			},
+			func(packet channeltypes.Packet) {
+				// need to update chainA's client representing chainB to prove missing receipt
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			refundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			transferMsg = types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0)
+			transferMsg.RefundAddress = refundAddress.String()
+
+			tc.malleate()
+
+			preSenderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			preRefundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddress, sdk.DefaultBondDenom)
+
+			res, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			storedAddress, found := suite.chainA.GetSimApp().TransferKeeper.GetRefundAddress(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(refundAddress, storedAddress)
+
+			tc.settlePacket(packet)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetRefundAddress(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().False(found)
+
+			// the sender is not refunded when a refund address is provided
+			postSenderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(preSenderBalance.Sub(coin), postSenderBalance)
+
+			postRefundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddress, sdk.DefaultBondDenom)
+			if expRefund {
+				suite.Require().Equal(preRefundBalance.Add(coin), postRefundBalance)
+			} else {
+				suite.Require().Equal(preRefundBalance, postRefundBalance)
+			}
+		})
+	}
+}
+
+var _ types.TransferHooks = &testHooks{}
+
+// testHooks records the arguments of each hook call, writes to the transfer store and emits an event
+// before returning the configured error
+type testHooks struct {
+	storeKey sdk.StoreKey
+	err      error
+
+	recvCalled     bool
+	refundCalled   bool
+	recipientCoins sdk.Coins
+	data           types.FungibleTokenPacketData
+}
+
+var (
+	testHookStoreKey = []byte("testHook")
+	testHookEvent    = "test_hook"
+)
+
+func (h *testHooks) AfterRecvTransfer(ctx sdk.Context, _ channeltypes.Packet, data types.FungibleTokenPacketData, recipientCoins sdk.Coins) error {
+	h.recvCalled = true
+	h.data = data
+	h.recipientCoins = recipientCoins
+
+	return h.write(ctx)
+}
+
+func (h *testHooks) AfterRefundTransfer(ctx sdk.Context, _ channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	h.refundCalled = true
+	h.data = data
+
+	return h.write(ctx)
+}
+
+func (h *testHooks) write(ctx sdk.Context) error {
+	ctx.KVStore(h.storeKey).Set(testHookStoreKey, []byte{1})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(testHookEvent))
+
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestTransferHooks() {
+	var (
+		hooks *testHooks
+		path  *ibctesting.Path
+	)
+
+	amount := sdk.NewInt(100)
+
+	testCases := []struct {
+		msg       string
+		refund    bool
+		hookErr   error
+		strict    bool
+		expPass   bool
+		expCommit bool
+	}{
+		{"success: recv", false, nil, false, true, true},
+		{"success: recv hook error is discarded", false, sdkerrors.ErrUnauthorized, false, true, false},
+		{"failure: recv hook error in strict mode", false, sdkerrors.ErrUnauthorized, true, false, false},
+		{"success: refund", true, nil, false, true, true},
+		{"success: refund hook error is discarded", true, sdkerrors.ErrUnauthorized, false, true, false},
+		{"failure: refund hook error in strict mode", true, sdkerrors.ErrUnauthorized, true, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			var (
+				chain        *ibctesting.TestChain
+				receiver     sdk.AccAddress
+				expDenom     string
+				handlePacket func(ctx sdk.Context) error
+			)
+
+			if tc.refund {
+				chain = suite.chainA
+				receiver = suite.chainA.SenderAccount.GetAddress()
+				expDenom = sdk.DefaultBondDenom
+
+				// fund the escrow account as it would have been on send
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(chain.GetSimApp(), chain.GetContext(), escrow, sdk.NewCoins(coin)))
+				chain.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(chain.GetContext(), coin)
+
+				handlePacket = func(ctx sdk.Context) error {
+					return chain.GetSimApp().TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+				}
+			} else {
+				chain = suite.chainB
+				receiver = suite.chainB.SenderAccount.GetAddress()
+				expDenom = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+				handlePacket = func(ctx sdk.Context) error {
+					return chain.GetSimApp().TransferKeeper.OnRecvPacket(ctx, packet, data)
+				}
+			}
+
+			hooks = &testHooks{storeKey: chain.GetSimApp().GetKey(types.StoreKey), err: tc.hookErr}
+			chain.GetSimApp().TransferKeeper.SetHooks(hooks, tc.strict)
+
+			preBalance := chain.GetSimApp().BankKeeper.GetBalance(chain.GetContext(), receiver, expDenom)
+
+			ctx := chain.GetContext()
+			err := handlePacket(ctx)
+
+			suite.Require().Equal(!tc.refund, hooks.recvCalled)
+			suite.Require().Equal(tc.refund, hooks.refundCalled)
+			suite.Require().Equal(data, hooks.data)
+			if !tc.refund {
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(expDenom, amount)), hooks.recipientCoins)
+			}
+
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			// the transfer itself succeeds regardless of the hook error
+			postBalance := chain.GetSimApp().BankKeeper.GetBalance(ctx, receiver, expDenom)
+			suite.Require().Equal(amount, postBalance.Amount.Sub(preBalance.Amount))
+
+			// the state changes and events of the hook are only committed if it succeeds
+			suite.Require().Equal(tc.expCommit, ctx.KVStore(hooks.storeKey).Has(testHookStoreKey))
+
+			var hookEventEmitted bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == testHookEvent {
+					hookEventEmitted = true
+				}
+			}
+			suite.Require().Equal(tc.expCommit, hookEventEmitted)
+		})
+	}
+}
+
+// TestMultiDenomTransfer tests the transfer of a native token and a voucher in a single packet from chainA to
+// chainB, as well as the refund of both tokens on timeout.
+func (suite *KeeperTestSuite) TestMultiDenomTransfer() {
+	suite.SetupTest() // reset
+
+	path := NewMultiDenomTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	suite.Require().Equal(types.VersionMultiDenom, path.EndpointA.GetChannel().Version)
+
+	amount := sdk.NewInt(100)
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+
+	// send the native token of chainB to chainA in a single token packet to obtain a voucher on chainA
+	transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), receiverB.String(), senderA.String(), suite.chainA.GetTimeoutHeight(), 0)
+	res, err := suite.chainB.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	voucherA := sdk.NewCoin(types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom(), amount)
+	suite.Require().Equal(voucherA, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, voucherA.Denom))
+
+	// send the native token of chainA and the voucher back to chainB in a single packet
+	nativeA := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	getBalancesA := func() sdk.Coins {
+		return sdk.NewCoins(
+			suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, nativeA.Denom),
+			suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, voucherA.Denom),
+		)
+	}
+	preBalancesA := getBalancesA()
+
+	transferMsg = types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, senderA.String(), receiverB.String(), suite.chainB.GetTimeoutHeight(), 0)
+	transferMsg.Tokens = []sdk.Coin{nativeA, voucherA}
+
+	// the tokens are refunded if the packet times out
+	transferMsg.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	res, err = suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(preBalancesA.Sub(sdk.NewCoins(nativeA, voucherA)), getBalancesA())
+	suite.Require().Equal(nativeA, suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), nativeA.Denom))
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var packetData types.MultiDenomFungibleTokenPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Equal([]types.Token{
+		{Denom: sdk.DefaultBondDenom, Amount: amount.String()},
+		{Denom: types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom), Amount: amount.String()},
+	}, packetData.Tokens)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+	suite.Require().Equal(preBalancesA, getBalancesA())
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), nativeA.Denom).IsZero())
+
+	// the tokens are received on chainB once the packet is relayed
+	preNativeB := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiverB, sdk.DefaultBondDenom)
+
+	transferMsg.TimeoutHeight = suite.chainB.GetTimeoutHeight()
+	res, err = suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	// the native token of chainA is received as a voucher and the voucher is unescrowed as the native token of chainB
+	voucherB := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().Equal(amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiverB, voucherB).Amount)
+	suite.Require().Equal(preNativeB.Amount.Add(amount), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiverB, sdk.DefaultBondDenom).Amount)
+	suite.Require().True(suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSendMultiDenomTransferVersion() {
+	suite.SetupTest() // reset
+
+	// the multi-denom channel version is not negotiated
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0)
+	transferMsg.Tokens = []sdk.Coin{coin}
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), transferMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+
+	// single token transfers are still supported
+	transferMsg.Token = coin
+	transferMsg.Tokens = nil
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), transferMsg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvMultiDenomPacket() {
+	var (
+		path *ibctesting.Path
+		data types.MultiDenomFungibleTokenPacketData
+	)
+
+	amount := sdk.NewInt(100)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"receive disabled for a single token rolls back all tokens", func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomEnabled(suite.chainB.GetContext(), types.NewDenomEnabled("uatom", true, false))
+			}, false,
+		},
+		{
+			"invalid amount of a single token", func() {
+				data.Tokens[1].Amount = "-1"
+			}, false,
+		},
+		{
+			"duplicate token", func() {
+				data.Tokens[1] = data.Tokens[0]
+			}, false,
+		},
+		{
+			"channel did not negotiate the multi-denom version", func() {
+				path.EndpointB.SetChannel(channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), []string{path.EndpointB.ConnectionID}, types.Version))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewMultiDenomTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// both tokens are native to chainA, vouchers are minted on chainB
+			data = types.NewMultiDenomFungibleTokenPacketData([]types.Token{
+				{Denom: sdk.DefaultBondDenom, Amount: amount.String()},
+				{Denom: "uatom", Amount: amount.String()},
+			}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvMultiDenomPacket(suite.chainB.GetContext(), packet, data)
+
+			for _, denom := range []string{sdk.DefaultBondDenom, "uatom"} {
+				trace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, denom))
+				supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), trace.IBCDenom())
+				_, found := suite.chainB.GetSimApp().TransferKeeper.GetDenomTrace(suite.chainB.GetContext(), trace.Hash())
+
+				if tc.expPass {
+					suite.Require().Equal(amount, supply.Amount)
+					suite.Require().True(found)
+				} else {
+					suite.Require().True(supply.IsZero(), "vouchers minted despite failed receive")
+					suite.Require().False(found)
+				}
+			}
+
+			if tc.expPass {
+				suite.Require().NoError(err)
 			} else {
 				suite.Require().Error(err)
 			}
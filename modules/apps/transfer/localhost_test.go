@@ -0,0 +1,120 @@
+package transfer_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	localhosttypes "github.com/cosmos/ibc-go/v4/modules/light-clients/09-localhost/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// setupLocalhost creates the localhost client and sentinel localhost connection on chainA and
+// opens a pair of transfer channels on the localhost connection in a single transaction. The
+// identifiers of the channel opened with ChanOpenInit and ChanOpenTry are returned.
+func (suite *TransferTestSuite) setupLocalhost() (string, string) {
+	ctx := suite.chainA.GetContext()
+
+	params := clienttypes.DefaultParams()
+	params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+	suite.Require().NoError(suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateLocalhostClient(ctx))
+	suite.Require().NoError(suite.chainA.App.GetIBCKeeper().ConnectionKeeper.CreateSentinelLocalhostConnection(ctx))
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	sequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	initChannelID := channeltypes.FormatChannelIdentifier(sequence)
+	tryChannelID := channeltypes.FormatChannelIdentifier(sequence + 1)
+
+	proofHeight := suite.localhostHeight()
+	connectionHops := []string{exported.LocalhostConnectionID}
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+
+	_, err := suite.chainA.SendMsgs(
+		channeltypes.NewMsgChannelOpenInit(ibctesting.TransferPort, types.Version, channeltypes.UNORDERED, connectionHops, ibctesting.TransferPort, signer),
+		channeltypes.NewMsgChannelOpenTry(ibctesting.TransferPort, types.Version, channeltypes.UNORDERED, connectionHops, ibctesting.TransferPort, initChannelID, types.Version, localhosttypes.SentinelProof, proofHeight, signer),
+		channeltypes.NewMsgChannelOpenAck(ibctesting.TransferPort, initChannelID, tryChannelID, types.Version, localhosttypes.SentinelProof, proofHeight, signer),
+		channeltypes.NewMsgChannelOpenConfirm(ibctesting.TransferPort, tryChannelID, localhosttypes.SentinelProof, proofHeight, signer),
+	)
+	suite.Require().NoError(err)
+
+	for _, channelID := range []string{initChannelID, tryChannelID} {
+		channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), ibctesting.TransferPort, channelID)
+		suite.Require().True(found)
+		suite.Require().Equal(channeltypes.OPEN, channel.State)
+	}
+
+	return initChannelID, tryChannelID
+}
+
+// localhostHeight returns the latest height of the localhost client on chainA.
+func (suite *TransferTestSuite) localhostHeight() clienttypes.Height {
+	return suite.chainA.GetClientState(exported.Localhost).GetLatestHeight().(clienttypes.Height)
+}
+
+// transfers tokens from the sender account on chainA to itself over the localhost connection and
+// sends them back. The first transfer is sent, received and acknowledged within a single
+// transaction, the return transfer is received in the block after it was sent.
+func (suite *TransferTestSuite) TestLocalhostTransfer() {
+	initChannelID, tryChannelID := suite.setupLocalhost()
+
+	// the sender receives the vouchers so that it is able to sign the return transfer
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := sender
+	signer := sender.String()
+
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the packet sent by MsgTransfer is constructed ahead of time to be received in the same transaction
+	sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), ibctesting.TransferPort, initChannelID)
+	suite.Require().True(found)
+
+	packetData := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), receiver.String())
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, ibctesting.TransferPort, initChannelID, ibctesting.TransferPort, tryChannelID, timeoutHeight, 0)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	_, err := suite.chainA.SendMsgs(
+		types.NewMsgTransfer(ibctesting.TransferPort, initChannelID, coin, sender.String(), receiver.String(), timeoutHeight, 0),
+		localhosttypes.NewMsgRecvPacket(packet, suite.localhostHeight(), signer),
+		localhosttypes.NewMsgAcknowledgement(packet, ack.Acknowledgement(), suite.localhostHeight(), signer),
+	)
+	suite.Require().NoError(err)
+
+	// the packet has been received and acknowledged
+	ctx := suite.chainA.GetContext()
+	suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(ctx, ibctesting.TransferPort, initChannelID, sequence))
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(ctx, ibctesting.TransferPort, tryChannelID, sequence)
+	suite.Require().True(found)
+
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(ibctesting.TransferPort, tryChannelID, sdk.DefaultBondDenom))
+	voucher := sdk.NewCoin(voucherDenomTrace.IBCDenom(), coin.Amount)
+	suite.Require().Equal(voucher, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, receiver, voucher.Denom))
+	suite.Require().Equal(senderBalance.Sub(coin), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+
+	escrowAddress := types.GetEscrowAddress(ibctesting.TransferPort, initChannelID)
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom))
+
+	// send the vouchers back over the localhost connection
+	res, err := suite.chainA.SendMsgs(types.NewMsgTransfer(ibctesting.TransferPort, tryChannelID, voucher, receiver.String(), sender.String(), timeoutHeight, 0))
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.SendMsgs(
+		localhosttypes.NewMsgRecvPacket(packet, suite.localhostHeight(), signer),
+		localhosttypes.NewMsgAcknowledgement(packet, ack.Acknowledgement(), suite.localhostHeight(), signer),
+	)
+	suite.Require().NoError(err)
+
+	ctx = suite.chainA.GetContext()
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, receiver, voucher.Denom).IsZero())
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom).IsZero())
+}
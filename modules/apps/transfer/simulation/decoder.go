@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -15,7 +16,7 @@ type TransferUnmarshaler interface {
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding DenomTrace type.
+// Value to the corresponding DenomTrace, total escrow amount, DenomEnabled or refund address type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -27,6 +28,30 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.TotalEscrowKey):
+			var amountA, amountB sdk.IntProto
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			denom := string(kvA.Key[len(types.TotalEscrowKey):])
+			return fmt.Sprintf("TotalEscrow A: %s\nTotalEscrow B: %s", sdk.NewCoin(denom, amountA.Int), sdk.NewCoin(denom, amountB.Int))
+
+		case bytes.Equal(kvA.Key[:1], types.DenomEnabledKey):
+			var denomEnabledA, denomEnabledB types.DenomEnabled
+			if err := denomEnabledA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := denomEnabledB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("DenomEnabled A: %s\nDenomEnabled B: %s", denomEnabledA.String(), denomEnabledB.String())
+
+		case bytes.Equal(kvA.Key[:1], types.RefundAddressKey):
+			return fmt.Sprintf("RefundAddress A: %s\nRefundAddress B: %s", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/stretchr/testify/require"
 
@@ -21,6 +22,16 @@ func TestDecodeStore(t *testing.T) {
 		Path:      "transfer/channelToA",
 	}
 
+	escrow := sdk.NewCoin("uatom", sdk.NewInt(100))
+	escrowBz, err := (&sdk.IntProto{Int: escrow.Amount}).Marshal()
+	require.NoError(t, err)
+
+	denomEnabled := types.NewDenomEnabled("uatom", false, true)
+	denomEnabledBz, err := denomEnabled.Marshal()
+	require.NoError(t, err)
+
+	refundAddress := sdk.AccAddress("refundAddress")
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -31,6 +42,18 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.TotalEscrowForDenomKey(escrow.Denom),
+				Value: escrowBz,
+			},
+			{
+				Key:   types.DenomEnabledStoreKey(denomEnabled.Denom),
+				Value: denomEnabledBz,
+			},
+			{
+				Key:   types.RefundAddressStoreKey(types.PortID, "channel-0", 1),
+				Value: refundAddress,
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +66,9 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"TotalEscrow", fmt.Sprintf("TotalEscrow A: %s\nTotalEscrow B: %s", escrow, escrow)},
+		{"DenomEnabled", fmt.Sprintf("DenomEnabled A: %s\nDenomEnabled B: %s", denomEnabled.String(), denomEnabled.String())},
+		{"RefundAddress", fmt.Sprintf("RefundAddress A: %s\nRefundAddress B: %s", refundAddress, refundAddress)},
 		{"other", ""},
 	}
 
@@ -43,7 +43,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	transferGenesis := types.GenesisState{
 		PortId:      portID,
 		DenomTraces: types.Traces{},
-		Params:      types.NewParams(sendEnabled, receiveEnabled),
+		Params:      types.NewParams(sendEnabled, receiveEnabled, types.DefaultMaxMemoLength, types.DefaultDefaultTimeoutDuration),
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDenomEnabled creates a new DenomEnabled instance
+func NewDenomEnabled(denom string, sendEnabled, receiveEnabled bool) DenomEnabled {
+	return DenomEnabled{
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// IsDefault returns true if the override enables both sending and receiving, leaving transfers of the
+// denomination subject only to the send_enabled and receive_enabled params.
+func (de DenomEnabled) IsDefault() bool {
+	return de.SendEnabled && de.ReceiveEnabled
+}
+
+// Validate performs a basic validation of the DenomEnabled fields.
+func (de DenomEnabled) Validate() error {
+	if strings.TrimSpace(de.Denom) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomEnabled, "denomination cannot be blank")
+	}
+
+	if err := ValidateIBCDenom(de.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomEnabled, "invalid denomination %s: %s", de.Denom, err)
+	}
+
+	return nil
+}
+
+// ValidateDenomsEnabled ensures each of the provided overrides is valid and present only once per denomination
+func ValidateDenomsEnabled(denomsEnabled []DenomEnabled) error {
+	seen := make(map[string]bool, len(denomsEnabled))
+	for _, de := range denomsEnabled {
+		if err := de.Validate(); err != nil {
+			return err
+		}
+
+		if seen[de.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomEnabled, "duplicate denomination %s", de.Denom)
+		}
+		seen[de.Denom] = true
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ const (
 	EventTypeTransfer     = "ibc_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeDenomEnabled = "update_denom_enabled"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -19,4 +20,6 @@ const (
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeySendEnabled    = "send_enabled"
+	AttributeKeyReceiveEnabled = "receive_enabled"
 )
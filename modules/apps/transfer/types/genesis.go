@@ -1,24 +1,28 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(portID string, denomTraces Traces, params Params, totalEscrowed sdk.Coins) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:        portID,
+		DenomTraces:   denomTraces,
+		Params:        params,
+		TotalEscrowed: totalEscrowed,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:        PortID,
+		DenomTraces:   Traces{},
+		Params:        DefaultParams(),
+		TotalEscrowed: sdk.Coins{},
 	}
 }
 
@@ -31,5 +35,11 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalEscrowed.Validate(); err != nil {
+		return err
+	}
+	return ValidateDenomsEnabled(gs.DenomsEnabled)
 }
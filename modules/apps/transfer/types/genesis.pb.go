@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,11 @@ type GenesisState struct {
 	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
 	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
 	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// total_escrowed contains the total amount of tokens escrowed
+	// by the transfer module
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed" yaml:"total_escrowed"`
+	// denoms_enabled contains the per denomination overrides of the send_enabled and receive_enabled params
+	DenomsEnabled []DenomEnabled `protobuf:"bytes,5,rep,name=denoms_enabled,json=denomsEnabled,proto3" json:"denoms_enabled" yaml:"denoms_enabled"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +91,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomsEnabled() []DenomEnabled {
+	if m != nil {
+		return m.DenomsEnabled
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -93,28 +114,35 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x27, 0x15, 0xbb, 0xe1, 0xa1, 0x7a, 0x18, 0x43, 0xda, 0x51, 0x14, 0x8a, 0xc3,
-	0x84, 0x4d, 0x41, 0xf0, 0x58, 0x04, 0xf1, 0xa6, 0xd3, 0x93, 0x97, 0x91, 0xa6, 0xb1, 0x06, 0xd6,
-	0xa6, 0xe4, 0x9f, 0x0d, 0xf6, 0x16, 0x3e, 0x87, 0x4f, 0xb2, 0xe3, 0x8e, 0x9e, 0xa6, 0x6c, 0x6f,
-	0x30, 0x5f, 0x40, 0xd2, 0xcd, 0xb1, 0x53, 0x6f, 0x1f, 0xc9, 0xef, 0xfb, 0xbe, 0x3f, 0x9f, 0x73,
-	0x21, 0x62, 0x46, 0x68, 0x51, 0x0c, 0x05, 0xa3, 0x5a, 0xc8, 0x1c, 0x88, 0x56, 0x34, 0x87, 0x37,
-	0xae, 0xc8, 0xb8, 0x4b, 0x52, 0x9e, 0x73, 0x10, 0x80, 0x0b, 0x25, 0xb5, 0x74, 0x4f, 0x45, 0xcc,
-	0xf0, 0x2e, 0x8b, 0xff, 0x59, 0x3c, 0xee, 0xb6, 0x3a, 0x95, 0x49, 0x5b, 0xb2, 0x8c, 0x6a, 0x9d,
-	0xa4, 0x32, 0x95, 0xa5, 0x24, 0x46, 0xad, 0x5f, 0x83, 0x5f, 0xe4, 0x34, 0xee, 0xd7, 0x95, 0xcf,
-	0x9a, 0x6a, 0xee, 0x76, 0x9c, 0x83, 0x42, 0x2a, 0x3d, 0x10, 0x49, 0x13, 0xb5, 0x51, 0x78, 0x18,
-	0xb9, 0xab, 0xb9, 0x7f, 0x34, 0xa1, 0xd9, 0xf0, 0x36, 0xd8, 0x7c, 0x04, 0x7d, 0xdb, 0xa8, 0x87,
-	0xc4, 0x55, 0x4e, 0x23, 0xe1, 0xb9, 0xcc, 0x06, 0x5a, 0x51, 0xc6, 0xa1, 0xb9, 0xd7, 0xae, 0x85,
-	0xf5, 0x5e, 0x88, 0xab, 0xae, 0xc6, 0x77, 0xc6, 0xf1, 0x62, 0x0c, 0xd1, 0xf9, 0x74, 0xee, 0x5b,
-	0xab, 0xb9, 0x7f, 0xbc, 0xce, 0xdf, 0xcd, 0x0a, 0x3e, 0xbf, 0x7d, 0xbb, 0xa4, 0xa0, 0x5f, 0x4f,
-	0xb6, 0x16, 0x70, 0x23, 0xc7, 0x2e, 0xa8, 0xa2, 0x19, 0x34, 0x6b, 0x6d, 0x14, 0xd6, 0x7b, 0x67,
-	0xd5, 0x6d, 0x8f, 0x25, 0x1b, 0xed, 0x9b, 0xa6, 0xfe, 0xc6, 0x19, 0x3d, 0x4d, 0x17, 0x1e, 0x9a,
-	0x2d, 0x3c, 0xf4, 0xb3, 0xf0, 0xd0, 0xc7, 0xd2, 0xb3, 0x66, 0x4b, 0xcf, 0xfa, 0x5a, 0x7a, 0xd6,
-	0xeb, 0x4d, 0x2a, 0xf4, 0xfb, 0x28, 0xc6, 0x4c, 0x66, 0x84, 0x49, 0xc8, 0x24, 0x10, 0x11, 0xb3,
-	0xcb, 0x54, 0x92, 0xf1, 0x35, 0xc9, 0x64, 0x32, 0x1a, 0x72, 0x30, 0x93, 0xef, 0x4c, 0xad, 0x27,
-	0x05, 0x87, 0xd8, 0x2e, 0xf7, 0xbc, 0xfa, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x96, 0x72, 0x43, 0x93,
-	0xde, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xe8, 0x28, 0x22, 0xdd, 0x7a, 0x08, 0x20, 0x85, 0x09, 0xd2, 0x2a, 0x02, 0x29, 0xda,
+	0x34, 0x5b, 0x1d, 0x48, 0x48, 0x1c, 0x03, 0x13, 0xda, 0x0d, 0x02, 0x27, 0x2e, 0x95, 0xe3, 0x98,
+	0x60, 0x91, 0xe4, 0x8b, 0xfc, 0x79, 0x45, 0xfb, 0x0d, 0x5c, 0xf8, 0x1d, 0xfc, 0x92, 0x1d, 0x77,
+	0xe4, 0x54, 0x50, 0xfb, 0x0f, 0xc6, 0x1f, 0x40, 0xb6, 0xb3, 0xa9, 0x15, 0x52, 0xc5, 0xc9, 0x9f,
+	0xec, 0xf7, 0x9e, 0xdf, 0x7b, 0xfa, 0xfc, 0x03, 0x99, 0x73, 0xca, 0xda, 0xb6, 0x92, 0x9c, 0x69,
+	0x09, 0x0d, 0x52, 0xad, 0x58, 0x83, 0x9f, 0x84, 0xa2, 0xf3, 0x29, 0x2d, 0x45, 0x23, 0x50, 0x22,
+	0x69, 0x15, 0x68, 0x08, 0x1e, 0xc9, 0x9c, 0x93, 0x75, 0x2c, 0xb9, 0xc6, 0x92, 0xf9, 0x74, 0xff,
+	0x70, 0xab, 0xd2, 0x0d, 0xd2, 0x4a, 0xed, 0xdf, 0x2f, 0xa1, 0x04, 0x3b, 0x52, 0x33, 0x75, 0xb7,
+	0x11, 0x07, 0xac, 0x01, 0x69, 0xce, 0x50, 0xd0, 0xf9, 0x34, 0x17, 0x9a, 0x4d, 0x29, 0x07, 0xd9,
+	0xb8, 0xf7, 0xf8, 0x4f, 0xdf, 0xdf, 0x7d, 0xe3, 0x2c, 0xbd, 0xd7, 0x4c, 0x8b, 0xe0, 0xd0, 0xbf,
+	0xd3, 0x82, 0xd2, 0x33, 0x59, 0x84, 0xde, 0xc4, 0x4b, 0xee, 0xa6, 0xc1, 0xd5, 0x62, 0x3c, 0x3a,
+	0x67, 0x75, 0xf5, 0x32, 0xee, 0x1e, 0xe2, 0x6c, 0x60, 0xa6, 0xd3, 0x22, 0x50, 0xfe, 0x6e, 0x21,
+	0x1a, 0xa8, 0x67, 0x5a, 0x31, 0x2e, 0x30, 0xbc, 0x35, 0xe9, 0x27, 0xc3, 0xe3, 0x84, 0x6c, 0x4b,
+	0x45, 0x5e, 0x1b, 0xc6, 0x07, 0x43, 0x48, 0x9f, 0x5e, 0x2c, 0xc6, 0xbd, 0xab, 0xc5, 0xf8, 0x9e,
+	0xd3, 0x5f, 0xd7, 0x8a, 0x7f, 0xfc, 0x1a, 0x0f, 0x2c, 0x0a, 0xb3, 0x61, 0x71, 0x43, 0xc1, 0x20,
+	0xf5, 0x07, 0x2d, 0x53, 0xac, 0xc6, 0xb0, 0x3f, 0xf1, 0x92, 0xe1, 0xf1, 0x93, 0xed, 0xbf, 0xbd,
+	0xb5, 0xd8, 0x74, 0xc7, 0xfc, 0x94, 0x75, 0xcc, 0xe0, 0x9b, 0xe7, 0x8f, 0x34, 0x68, 0x56, 0xcd,
+	0x04, 0x72, 0x05, 0x5f, 0x45, 0x11, 0xee, 0x58, 0xeb, 0x0f, 0x89, 0xeb, 0x8b, 0x98, 0xbe, 0x48,
+	0xd7, 0x17, 0x79, 0x05, 0xb2, 0x49, 0x4f, 0x3b, 0xaf, 0x0f, 0x9c, 0xd7, 0x4d, 0xba, 0x71, 0x9b,
+	0x94, 0x52, 0x7f, 0x3e, 0xcb, 0x09, 0x87, 0x9a, 0x76, 0xad, 0xbb, 0xe3, 0x08, 0x8b, 0x2f, 0x54,
+	0x9f, 0xb7, 0x02, 0xad, 0x12, 0x66, 0x7b, 0x96, 0x7c, 0xd2, 0x71, 0x83, 0xd6, 0x1f, 0xd9, 0x80,
+	0x38, 0x13, 0x0d, 0xcb, 0x2b, 0x51, 0x84, 0xb7, 0xad, 0x99, 0x83, 0xff, 0xe8, 0xf1, 0xc4, 0x31,
+	0xd2, 0xc7, 0x9b, 0xee, 0x36, 0xf5, 0xe2, 0x6c, 0xcf, 0x5d, 0x5c, 0xa3, 0xdf, 0x5d, 0x2c, 0x23,
+	0xef, 0x72, 0x19, 0x79, 0xbf, 0x97, 0x91, 0xf7, 0x7d, 0x15, 0xf5, 0x2e, 0x57, 0x51, 0xef, 0xe7,
+	0x2a, 0xea, 0x7d, 0x7c, 0xf1, 0x6f, 0x08, 0x99, 0xf3, 0xa3, 0x12, 0xe8, 0xfc, 0x39, 0xad, 0xa1,
+	0x38, 0xab, 0x04, 0x9a, 0x95, 0x5c, 0x5b, 0x45, 0x9b, 0x2c, 0x1f, 0xd8, 0x7d, 0x7a, 0xf6, 0x77,
+	0x00, 0x43, 0xb2, 0xdf, 0x6d, 0xfe, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +165,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomsEnabled) > 0 {
+		for iNdEx := len(m.DenomsEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -200,6 +256,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomsEnabled) > 0 {
+		for _, e := range m.DenomsEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +405,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsEnabled = append(m.DenomsEnabled, DenomEnabled{})
+			if err := m.DenomsEnabled[len(m.DenomsEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -26,6 +27,37 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with total escrowed",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))),
+			true,
+		},
+		{
+			"invalid total escrowed: unsorted denoms",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin("stake", sdk.NewInt(100))}),
+			false,
+		},
+		{
+			"invalid total escrowed: zero amount",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewCoin("stake", sdk.ZeroInt())}),
+			false,
+		},
+		{
+			"valid genesis with denoms enabled",
+			&types.GenesisState{
+				PortId:        types.PortID,
+				DenomsEnabled: []types.DenomEnabled{types.NewDenomEnabled("uatom", false, true)},
+			},
+			true,
+		},
+		{
+			"invalid denoms enabled: duplicate denom",
+			&types.GenesisState{
+				PortId:        types.PortID,
+				DenomsEnabled: []types.DenomEnabled{types.NewDenomEnabled("uatom", false, true), types.NewDenomEnabled("uatom", true, false)},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
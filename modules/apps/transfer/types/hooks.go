@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// TransferHooks defines the hooks which may be used to run custom logic once fungible tokens have been
+// received or refunded, for example to trigger a contract call or a swap. Whether an error returned by
+// a hook reverts the transfer depends on the mode in which the hooks are registered on the Keeper.
+type TransferHooks interface {
+	// AfterRecvTransfer is called once the tokens of a received packet have been unescrowed or minted and sent to
+	// the receiver. The coins credited to the receiver are provided.
+	AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, recipientCoins sdk.Coins) error
+	// AfterRefundTransfer is called once the tokens of a packet which timed out or was acknowledged with an error
+	// have been refunded.
+	AfterRefundTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData) error
+}
+
+var _ TransferHooks = MultiTransferHooks{}
+
+// MultiTransferHooks combines multiple TransferHooks, calling each of them in order
+type MultiTransferHooks []TransferHooks
+
+// NewMultiTransferHooks creates a new MultiTransferHooks instance
+func NewMultiTransferHooks(hooks ...TransferHooks) MultiTransferHooks {
+	return hooks
+}
+
+// AfterRecvTransfer implements TransferHooks, returning the first error returned by a hook
+func (h MultiTransferHooks) AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, recipientCoins sdk.Coins) error {
+	for _, hook := range h {
+		if err := hook.AfterRecvTransfer(ctx, packet, data, recipientCoins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterRefundTransfer implements TransferHooks, returning the first error returned by a hook
+func (h MultiTransferHooks) AfterRefundTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData) error {
+	for _, hook := range h {
+		if err := hook.AfterRefundTransfer(ctx, packet, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+var _ types.TransferHooks = &mockHooks{}
+
+// mockHooks records the name of each hook called and returns the configured error
+type mockHooks struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h *mockHooks) AfterRecvTransfer(_ sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ sdk.Coins) error {
+	*h.calls = append(*h.calls, fmt.Sprintf("%s.AfterRecvTransfer", h.name))
+	return h.err
+}
+
+func (h *mockHooks) AfterRefundTransfer(_ sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData) error {
+	*h.calls = append(*h.calls, fmt.Sprintf("%s.AfterRefundTransfer", h.name))
+	return h.err
+}
+
+func TestMultiTransferHooks(t *testing.T) {
+	var calls []string
+
+	hooks := types.NewMultiTransferHooks(
+		&mockHooks{name: "first", calls: &calls},
+		&mockHooks{name: "second", calls: &calls},
+	)
+
+	require.NoError(t, hooks.AfterRecvTransfer(sdk.Context{}, channeltypes.Packet{}, types.FungibleTokenPacketData{}, nil))
+	require.NoError(t, hooks.AfterRefundTransfer(sdk.Context{}, channeltypes.Packet{}, types.FungibleTokenPacketData{}))
+	require.Equal(t, []string{"first.AfterRecvTransfer", "second.AfterRecvTransfer", "first.AfterRefundTransfer", "second.AfterRefundTransfer"}, calls)
+}
+
+func TestMultiTransferHooksError(t *testing.T) {
+	var calls []string
+
+	expErr := fmt.Errorf("hook error")
+	hooks := types.NewMultiTransferHooks(
+		&mockHooks{name: "first", calls: &calls, err: expErr},
+		&mockHooks{name: "second", calls: &calls},
+	)
+
+	// hooks following a failed hook are not called
+	require.ErrorIs(t, hooks.AfterRecvTransfer(sdk.Context{}, channeltypes.Packet{}, types.FungibleTokenPacketData{}, nil), expErr)
+	require.ErrorIs(t, hooks.AfterRefundTransfer(sdk.Context{}, channeltypes.Packet{}, types.FungibleTokenPacketData{}), expErr)
+	require.Equal(t, []string{"first.AfterRecvTransfer", "first.AfterRefundTransfer"}, calls)
+}
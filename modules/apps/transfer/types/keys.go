@@ -15,6 +15,15 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// MultiDenomFeature is the channel version feature flag enabling the transfer of multiple
+	// tokens in a single packet
+	MultiDenomFeature = "multi-denom"
+
+	// VersionMultiDenom defines the channel version negotiated by chains supporting the transfer
+	// of multiple tokens in a single packet. Channels fall back to Version if the counterparty
+	// does not support it.
+	VersionMultiDenom = Version + "+" + MultiDenomFeature
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -36,8 +45,24 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TotalEscrowKey defines the key prefix to store the total amount of tokens in escrow per denom in store
+	TotalEscrowKey = []byte{0x03}
+	// DenomEnabledKey defines the key prefix to store the per denomination send and receive enabled overrides in store
+	DenomEnabledKey = []byte{0x04}
+	// RefundAddressKey defines the key prefix to store the refund address of in-flight packets in store
+	RefundAddressKey = []byte{0x05}
 )
 
+// IsSupportedVersion returns true if the provided channel version is supported by the transfer module.
+func IsSupportedVersion(version string) bool {
+	return version == Version || version == VersionMultiDenom
+}
+
+// TotalEscrowForDenomKey returns the store key under which the total amount of tokens in escrow for a denom is stored.
+func TotalEscrowForDenomKey(denom string) []byte {
+	return append(append([]byte{}, TotalEscrowKey...), denom...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -53,3 +78,14 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// DenomEnabledStoreKey returns the store key under which the send and receive enabled override of a denom is stored.
+func DenomEnabledStoreKey(denom string) []byte {
+	return append(append([]byte{}, DenomEnabledKey...), denom...)
+}
+
+// RefundAddressStoreKey returns the store key under which the refund address of the packet sent on the
+// provided port and channel with the provided sequence is stored.
+func RefundAddressStoreKey(portID, channelID string, sequence uint64) []byte {
+	return append(append([]byte{}, RefundAddressKey...), fmt.Sprintf("%s/%s/%d", portID, channelID, sequence)...)
+}
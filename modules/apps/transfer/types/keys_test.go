@@ -1,8 +1,15 @@
 package types_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -22,3 +29,34 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+// escrowAddressVector is a single entry of testdata/escrow_address_vectors.json.
+type escrowAddressVector struct {
+	PortID     string `json:"port_id"`
+	ChannelID  string `json:"channel_id"`
+	AddressHex string `json:"address_hex"`
+	Address    string `json:"address"`
+}
+
+// TestGetEscrowAddressVectors checks GetEscrowAddress against the fixed vectors in
+// testdata/escrow_address_vectors.json. The vectors are part of the escrow address
+// derivation spec and may be used by external implementations to verify their
+// derivation, so they must never be regenerated to match a changed implementation.
+func TestGetEscrowAddressVectors(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join("testdata", "escrow_address_vectors.json"))
+	require.NoError(t, err)
+
+	var vectors []escrowAddressVector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		addr := types.GetEscrowAddress(v.PortID, v.ChannelID)
+
+		require.Equal(t, v.AddressHex, strings.ToUpper(hex.EncodeToString(addr)), "port-id: %s, channel-id: %s", v.PortID, v.ChannelID)
+
+		bech32Addr, err := sdk.Bech32ifyAddressBytes("cosmos", addr)
+		require.NoError(t, err)
+		require.Equal(t, v.Address, bech32Addr, "port-id: %s, channel-id: %s", v.PortID, v.ChannelID)
+	}
+}
@@ -15,6 +15,17 @@ const (
 	TypeMsgTransfer = "transfer"
 )
 
+const (
+	// DefaultTimeoutTimestamp is the sentinel timeout timestamp which is replaced, when the transfer is sent, by the
+	// current host time plus the DefaultTimeoutDuration param
+	DefaultTimeoutTimestamp uint64 = 1
+
+	// MinNanosecondsTimeoutTimestamp is the smallest non-sentinel timeout timestamp accepted. It corresponds to March
+	// 1973 in nanoseconds, while any date before the year 5000 expressed in seconds, milliseconds or microseconds is
+	// below it, so that timeout timestamps provided in the wrong unit are rejected rather than sent.
+	MinNanosecondsTimeoutTimestamp uint64 = 100_000_000_000_000_000
+)
+
 // NewMsgTransfer creates a new MsgTransfer instance
 //
 //nolint:interfacer
@@ -47,7 +58,8 @@ func (MsgTransfer) Type() string {
 // ValidateBasic performs a basic check of the MsgTransfer fields.
 // NOTE: timeout height or timestamp values can be 0 to disable the timeout.
 // NOTE: The recipient addresses format is not validated as the format defined by
-// the chain is not known to IBC.
+// the chain is not known to IBC. The optional refund address is validated as it is
+// an address on the sending chain.
 func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
 		return sdkerrors.Wrap(err, "invalid source port ID")
@@ -55,12 +67,34 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Token.String())
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "timeout height and timeout timestamp cannot both be zero")
+	}
+	if err := ValidateTimeoutTimestamp(msg.TimeoutTimestamp); err != nil {
+		return err
 	}
-	if !msg.Token.IsPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Token.String())
+	if len(msg.Tokens) > 0 && (msg.Token.Denom != "" || !(msg.Token.Amount.IsNil() || msg.Token.Amount.IsZero())) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "token and tokens cannot both be set")
 	}
+
+	seenDenoms := make(map[string]bool, len(msg.Tokens))
+	for _, token := range msg.GetTokens() {
+		if !token.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, token.String())
+		}
+		if !token.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, token.String())
+		}
+		if seenDenoms[token.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate denomination %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+
+		if err := ValidateIBCDenom(token.Denom); err != nil {
+			return err
+		}
+	}
+
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -69,7 +103,22 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "refund address could not be parsed as address: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetTokens returns the tokens to be transferred, which are the tokens of the msg if set and
+// otherwise its single token.
+func (msg MsgTransfer) GetTokens() []sdk.Coin {
+	if len(msg.Tokens) > 0 {
+		return msg.Tokens
+	}
+
+	return []sdk.Coin{msg.Token}
 }
 
 // GetSignBytes implements sdk.Msg.
@@ -85,3 +134,22 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateTimeoutTimestamp returns an error if a non-zero timeout timestamp, other than the DefaultTimeoutTimestamp
+// sentinel value, is below MinNanosecondsTimeoutTimestamp. Such timestamps are most likely expressed in seconds,
+// milliseconds or microseconds rather than nanoseconds.
+func ValidateTimeoutTimestamp(timeoutTimestamp uint64) error {
+	if timeoutTimestamp == 0 || timeoutTimestamp == DefaultTimeoutTimestamp {
+		return nil
+	}
+
+	if timeoutTimestamp < MinNanosecondsTimeoutTimestamp {
+		return sdkerrors.Wrapf(
+			ErrInvalidPacketTimeout,
+			"timeout timestamp %d is below the minimum of %d, timeout timestamps must be expressed in nanoseconds since the Unix epoch",
+			timeoutTimestamp, MinNanosecondsTimeoutTimestamp,
+		)
+	}
+
+	return nil
+}
@@ -83,6 +83,19 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing sender address", NewMsgTransfer(validPort, validChannel, coin, emptyAddr, addr2, timeoutHeight, 0), false},
 		{"missing recipient address", NewMsgTransfer(validPort, validChannel, coin, addr1, "", timeoutHeight, 0), false},
 		{"empty coin", NewMsgTransfer(validPort, validChannel, sdk.Coin{}, addr1, addr2, timeoutHeight, 0), false},
+		{"valid msg with refund address", newMsgTransferWithRefundAddress(addr1), true},
+		{"invalid refund address", newMsgTransferWithRefundAddress("refund"), false},
+		{"valid msg with tokens", newMsgTransferWithTokens(sdk.Coin{}, coin, ibcCoin), true},
+		{"token and tokens both set", newMsgTransferWithTokens(coin, ibcCoin), false},
+		{"duplicate tokens", newMsgTransferWithTokens(sdk.Coin{}, coin, coin), false},
+		{"invalid ibc denom in tokens", newMsgTransferWithTokens(sdk.Coin{}, coin, invalidIBCCoin), false},
+		{"zero coin in tokens", newMsgTransferWithTokens(sdk.Coin{}, coin, zeroCoin), false},
+		{"valid msg with timeout timestamp only", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, clienttypes.ZeroHeight(), MinNanosecondsTimeoutTimestamp), true},
+		{"valid msg with default timeout timestamp", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, clienttypes.ZeroHeight(), DefaultTimeoutTimestamp), true},
+		{"timeout height and timeout timestamp both zero", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, clienttypes.ZeroHeight(), 0), false},
+		{"timeout timestamp in seconds", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 1_800_000_000), false},
+		{"timeout timestamp in milliseconds", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 1_800_000_000_000), false},
+		{"timeout timestamp in microseconds", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 1_800_000_000_000_000), false},
 	}
 
 	for i, tc := range testCases {
@@ -95,6 +108,18 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+func newMsgTransferWithRefundAddress(refundAddress string) *MsgTransfer {
+	msg := NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0)
+	msg.RefundAddress = refundAddress
+	return msg
+}
+
+func newMsgTransferWithTokens(token sdk.Coin, tokens ...sdk.Coin) *MsgTransfer {
+	msg := NewMsgTransfer(validPort, validChannel, token, addr1, addr2, timeoutHeight, 0)
+	msg.Tokens = tokens
+	return msg
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
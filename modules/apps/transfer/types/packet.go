@@ -58,3 +58,56 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
 }
+
+// NewMultiDenomFungibleTokenPacketData contructs a new MultiDenomFungibleTokenPacketData instance
+func NewMultiDenomFungibleTokenPacketData(
+	tokens []Token,
+	sender, receiver string,
+) MultiDenomFungibleTokenPacketData {
+	return MultiDenomFungibleTokenPacketData{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// ValidateBasic is used for validating the transfer of multiple tokens. Each token is validated
+// as the token of a FungibleTokenPacketData and a denomination may only be transferred once.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (mftpd MultiDenomFungibleTokenPacketData) ValidateBasic() error {
+	if len(mftpd.Tokens) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+
+	seenDenoms := make(map[string]bool, len(mftpd.Tokens))
+	for _, data := range mftpd.GetFungibleTokenPacketData() {
+		if seenDenoms[data.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "duplicate denomination %s", data.Denom)
+		}
+		seenDenoms[data.Denom] = true
+
+		if err := data.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetFungibleTokenPacketData returns the transfer of each token as a FungibleTokenPacketData
+// sharing the sender, receiver and memo of the packet data.
+func (mftpd MultiDenomFungibleTokenPacketData) GetFungibleTokenPacketData() []FungibleTokenPacketData {
+	packetData := make([]FungibleTokenPacketData, len(mftpd.Tokens))
+	for i, token := range mftpd.Tokens {
+		packetData[i] = NewFungibleTokenPacketData(token.Denom, token.Amount, mftpd.Sender, mftpd.Receiver)
+		packetData[i].Memo = mftpd.Memo
+	}
+
+	return packetData
+}
+
+// GetBytes is a helper for serialising
+func (mftpd MultiDenomFungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&mftpd))
+}
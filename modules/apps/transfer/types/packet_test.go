@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -40,3 +41,79 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataLegacyJSON tests that packet data without a memo is encoded identically to packet data
+// created before the memo field was introduced and that legacy packet data JSON can still be decoded.
+func TestFungibleTokenPacketDataLegacyJSON(t *testing.T) {
+	legacyBz := []byte(fmt.Sprintf(`{"amount":"%s","denom":"%s","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1))
+
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2)
+	require.Equal(t, legacyBz, packetData.GetBytes())
+
+	var decoded FungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(legacyBz, &decoded))
+	require.Equal(t, packetData, decoded)
+	require.Empty(t, decoded.Memo)
+	require.Equal(t, legacyBz, decoded.GetBytes())
+
+	memoBz := []byte(fmt.Sprintf(`{"amount":"%s","denom":"%s","memo":"memo","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1))
+
+	packetData.Memo = "memo"
+	require.Equal(t, memoBz, packetData.GetBytes())
+
+	decoded = FungibleTokenPacketData{}
+	require.NoError(t, ModuleCdc.UnmarshalJSON(memoBz, &decoded))
+	require.Equal(t, packetData, decoded)
+}
+
+// TestMultiDenomFungibleTokenPacketDataValidateBasic tests ValidateBasic for MultiDenomFungibleTokenPacketData
+func TestMultiDenomFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	token := Token{Denom: denom, Amount: amount}
+	nativeToken := Token{Denom: "atom", Amount: largeAmount}
+
+	testCases := []struct {
+		name       string
+		packetData MultiDenomFungibleTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewMultiDenomFungibleTokenPacketData([]Token{token, nativeToken}, addr1, addr2), true},
+		{"valid packet with single token", NewMultiDenomFungibleTokenPacketData([]Token{token}, addr1, addr2), true},
+		{"empty tokens", NewMultiDenomFungibleTokenPacketData(nil, addr1, addr2), false},
+		{"duplicate denom", NewMultiDenomFungibleTokenPacketData([]Token{token, token}, addr1, addr2), false},
+		{"invalid denom", NewMultiDenomFungibleTokenPacketData([]Token{token, {Denom: "", Amount: amount}}, addr1, addr2), false},
+		{"invalid zero amount", NewMultiDenomFungibleTokenPacketData([]Token{token, {Denom: "atom", Amount: "0"}}, addr1, addr2), false},
+		{"missing sender address", NewMultiDenomFungibleTokenPacketData([]Token{token}, emptyAddr, addr2), false},
+		{"missing recipient address", NewMultiDenomFungibleTokenPacketData([]Token{token}, addr1, emptyAddr), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMultiDenomFungibleTokenPacketDataJSON tests that the packet data of single and multi-denom transfers cannot
+// be decoded as one another, as the transfer module relies on it to determine the type of the packet data.
+func TestMultiDenomFungibleTokenPacketDataJSON(t *testing.T) {
+	packetData := NewMultiDenomFungibleTokenPacketData([]Token{{Denom: denom, Amount: amount}}, addr1, addr2)
+	packetData.Memo = "memo"
+
+	bz := packetData.GetBytes()
+	require.Equal(t, fmt.Sprintf(`{"memo":"memo","receiver":"%s","sender":"%s","tokens":[{"amount":"%s","denom":"%s"}]}`, addr2, addr1, amount, denom), string(bz))
+
+	var decoded MultiDenomFungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, packetData, decoded)
+
+	var singleDenom FungibleTokenPacketData
+	require.Error(t, ModuleCdc.UnmarshalJSON(bz, &singleDenom))
+
+	singleDenomBz := NewFungibleTokenPacketData(denom, amount, addr1, addr2).GetBytes()
+	require.Error(t, ModuleCdc.UnmarshalJSON(singleDenomBz, &decoded))
+
+	require.Equal(t, []FungibleTokenPacketData{{Denom: denom, Amount: amount, Sender: addr1, Receiver: addr2, Memo: "memo"}}, packetData.GetFungibleTokenPacketData())
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -11,6 +12,10 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultMaxMemoLength is the default value for the max memo length param (set to 32 KiB)
+	DefaultMaxMemoLength = 32 * 1024
+	// DefaultDefaultTimeoutDuration is the default value for the default timeout duration param
+	DefaultDefaultTimeoutDuration = 10 * time.Minute
 )
 
 var (
@@ -18,6 +23,10 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyMaxMemoLength is store's key for MaxMemoLength Params
+	KeyMaxMemoLength = []byte("MaxMemoLength")
+	// KeyDefaultTimeoutDuration is store's key for DefaultTimeoutDuration Params
+	KeyDefaultTimeoutDuration = []byte("DefaultTimeoutDuration")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +35,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive bool, maxMemoLength uint64, defaultTimeoutDuration time.Duration) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:            enableSend,
+		ReceiveEnabled:         enableReceive,
+		MaxMemoLength:          maxMemoLength,
+		DefaultTimeoutDuration: defaultTimeoutDuration,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, DefaultMaxMemoLength, DefaultDefaultTimeoutDuration)
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +55,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMaxMemoLength(p.MaxMemoLength); err != nil {
+		return err
+	}
+
+	return validateDefaultTimeoutDuration(p.DefaultTimeoutDuration)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +71,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMaxMemoLength, p.MaxMemoLength, validateMaxMemoLength),
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutDuration, p.DefaultTimeoutDuration, validateDefaultTimeoutDuration),
 	}
 }
 
@@ -63,3 +84,25 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxMemoLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDefaultTimeoutDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("default timeout duration cannot be negative: %s", duration)
+	}
+
+	return nil
+}
@@ -2,11 +2,14 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, false).Validate())
+	require.NoError(t, NewParams(true, false, 0, 0).Validate())
+	require.NoError(t, NewParams(true, false, DefaultMaxMemoLength, DefaultDefaultTimeoutDuration).Validate())
+	require.Error(t, NewParams(true, false, DefaultMaxMemoLength, -time.Second).Validate())
 }
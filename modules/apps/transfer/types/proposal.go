@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateDenomEnabled defines the type for an UpdateDenomEnabledProposal
+	ProposalTypeUpdateDenomEnabled = "UpdateDenomEnabled"
+)
+
+var _ govtypes.Content = &UpdateDenomEnabledProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateDenomEnabled)
+}
+
+// NewUpdateDenomEnabledProposal creates a new proposal for setting per denomination send and receive enabled overrides.
+func NewUpdateDenomEnabledProposal(title, description string, denomsEnabled []DenomEnabled) govtypes.Content {
+	return &UpdateDenomEnabledProposal{
+		Title:         title,
+		Description:   description,
+		DenomsEnabled: denomsEnabled,
+	}
+}
+
+// GetTitle returns the title of an update denom enabled proposal.
+func (p *UpdateDenomEnabledProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update denom enabled proposal.
+func (p *UpdateDenomEnabledProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update denom enabled proposal.
+func (p *UpdateDenomEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update denom enabled proposal.
+func (p *UpdateDenomEnabledProposal) ProposalType() string { return ProposalTypeUpdateDenomEnabled }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateDenomEnabledProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.DenomsEnabled) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDenomEnabled, "proposal must set at least one denomination override")
+	}
+
+	return ValidateDenomsEnabled(p.DenomsEnabled)
+}
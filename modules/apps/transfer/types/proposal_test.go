@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+func TestUpdateDenomEnabledProposalValidateBasic(t *testing.T) {
+	ibcDenom := types.ParseDenomTrace("transfer/channel-0/transfer/channel-5/uatom").IBCDenom()
+
+	testCases := []struct {
+		name     string
+		proposal *types.UpdateDenomEnabledProposal
+		expPass  bool
+	}{
+		{
+			"success: base denom",
+			types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{types.NewDenomEnabled("uatom", false, true)}).(*types.UpdateDenomEnabledProposal),
+			true,
+		},
+		{
+			"success: ibc denom",
+			types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{types.NewDenomEnabled(ibcDenom, false, false)}).(*types.UpdateDenomEnabledProposal),
+			true,
+		},
+		{
+			"success: removing an override",
+			types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{types.NewDenomEnabled("uatom", true, true)}).(*types.UpdateDenomEnabledProposal),
+			true,
+		},
+		{
+			"failure: empty title",
+			types.NewUpdateDenomEnabledProposal("", "description", []types.DenomEnabled{types.NewDenomEnabled("uatom", false, true)}).(*types.UpdateDenomEnabledProposal),
+			false,
+		},
+		{
+			"failure: no overrides",
+			types.NewUpdateDenomEnabledProposal("title", "description", nil).(*types.UpdateDenomEnabledProposal),
+			false,
+		},
+		{
+			"failure: empty denom",
+			types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{types.NewDenomEnabled("", false, true)}).(*types.UpdateDenomEnabledProposal),
+			false,
+		},
+		{
+			"failure: invalid ibc denom hash",
+			types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{types.NewDenomEnabled("ibc/invalidhash", false, true)}).(*types.UpdateDenomEnabledProposal),
+			false,
+		},
+		{
+			"failure: duplicate denom",
+			types.NewUpdateDenomEnabledProposal("title", "description", []types.DenomEnabled{types.NewDenomEnabled("uatom", false, true), types.NewDenomEnabled("uatom", true, false)}).(*types.UpdateDenomEnabledProposal),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+				require.Equal(t, types.ProposalTypeUpdateDenomEnabled, tc.proposal.ProposalType())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -7,15 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -91,6 +96,12 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// max_memo_length is the maximum length in bytes of the memo of a fungible token packet sent from or received by
+	// this chain. A value of zero places no limit on the memo length.
+	MaxMemoLength uint64 `protobuf:"varint,3,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty" yaml:"max_memo_length"`
+	// default_timeout_duration is the duration added to the current host time to compute the timeout timestamp of a
+	// transfer whose timeout timestamp is set to the sentinel value 1.
+	DefaultTimeoutDuration time.Duration `protobuf:"bytes,4,opt,name=default_timeout_duration,json=defaultTimeoutDuration,proto3,stdduration" json:"default_timeout_duration" yaml:"default_timeout_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +151,135 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.DefaultTimeoutDuration
+	}
+	return 0
+}
+
+// DenomEnabled defines a per denomination override of the send_enabled and receive_enabled params. A denomination
+// disabled for sending or receiving cannot be transferred in that direction, regardless of the params.
+type DenomEnabled struct {
+	// denom is either a base denomination, matching the native token and all vouchers of the base denomination, or an
+	// ibc/{hash} denomination, matching a single voucher
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// send_enabled enables or disables cross-chain transfers of the denomination from this chain
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled"`
+	// receive_enabled enables or disables cross-chain transfers of the denomination to this chain
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+}
+
+func (m *DenomEnabled) Reset()         { *m = DenomEnabled{} }
+func (m *DenomEnabled) String() string { return proto.CompactTextString(m) }
+func (*DenomEnabled) ProtoMessage()    {}
+func (*DenomEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *DenomEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomEnabled.Merge(m, src)
+}
+func (m *DenomEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomEnabled proto.InternalMessageInfo
+
+func (m *DenomEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomEnabled) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *DenomEnabled) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// UpdateDenomEnabledProposal is a gov Content type for setting per denomination overrides of the send_enabled and
+// receive_enabled params. An override enabling both sending and receiving removes the override for the denomination.
+type UpdateDenomEnabledProposal struct {
+	// the title of the update proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// denoms_enabled defines the per denomination overrides to be set
+	DenomsEnabled []DenomEnabled `protobuf:"bytes,3,rep,name=denoms_enabled,json=denomsEnabled,proto3" json:"denoms_enabled" yaml:"denoms_enabled"`
+}
+
+func (m *UpdateDenomEnabledProposal) Reset()         { *m = UpdateDenomEnabledProposal{} }
+func (m *UpdateDenomEnabledProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateDenomEnabledProposal) ProtoMessage()    {}
+func (*UpdateDenomEnabledProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *UpdateDenomEnabledProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDenomEnabledProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDenomEnabledProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDenomEnabledProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDenomEnabledProposal.Merge(m, src)
+}
+func (m *UpdateDenomEnabledProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDenomEnabledProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDenomEnabledProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDenomEnabledProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*DenomEnabled)(nil), "ibc.applications.transfer.v1.DenomEnabled")
+	proto.RegisterType((*UpdateDenomEnabledProposal)(nil), "ibc.applications.transfer.v1.UpdateDenomEnabledProposal")
 }
 
 func init() {
@@ -150,26 +287,43 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0x51, 0x10, 0xba, 0x11, 0xaa, 0xee, 0xb5, 0xb8, 0x72, 0x53, 0x92, 0xcc, 0x71, 0x1a, 0x98,
-	0x4c, 0x86, 0x24, 0x1d, 0xe8, 0x23, 0xb8, 0xf3, 0xb1, 0x5c, 0x76, 0xe9, 0xaa, 0x48, 0xfb, 0x06,
-	0x7d, 0x02, 0x99, 0xb4, 0x94, 0xc1, 0xdd, 0x7f, 0xce, 0xf9, 0xbe, 0xb3, 0xf8, 0xf1, 0x95, 0x12,
-	0x92, 0xf1, 0xa2, 0xc8, 0x94, 0xe4, 0x5e, 0x99, 0xdc, 0x31, 0x6f, 0x79, 0xee, 0xde, 0xc1, 0xb2,
-	0xb2, 0xbf, 0xcb, 0x71, 0x61, 0x8d, 0x37, 0xe4, 0x4c, 0x09, 0x19, 0xd7, 0xe1, 0x78, 0x07, 0x94,
-	0xfd, 0xee, 0x71, 0x6a, 0x52, 0x13, 0x40, 0x56, 0xa5, 0x8d, 0x13, 0xdd, 0x63, 0xfc, 0x08, 0xb9,
-	0xd1, 0xaf, 0x96, 0x4b, 0x20, 0x04, 0xff, 0x2b, 0xb8, 0x9f, 0x74, 0xd0, 0x05, 0xba, 0x6c, 0x8f,
-	0x42, 0x26, 0xe7, 0x18, 0x0b, 0xee, 0x60, 0x9c, 0x54, 0x58, 0xa7, 0x19, 0x2e, 0xed, 0x6a, 0x13,
-	0xbc, 0xe8, 0x03, 0xe1, 0xd6, 0x33, 0xb7, 0x5c, 0x3b, 0x32, 0xc0, 0xfb, 0x0e, 0xf2, 0x64, 0x0c,
-	0x39, 0x17, 0x19, 0x24, 0xe1, 0xcb, 0xff, 0xe1, 0xe9, 0x7a, 0xd1, 0x3b, 0x9a, 0x71, 0x9d, 0x0d,
-	0xa2, 0xfa, 0x35, 0x1a, 0xed, 0x55, 0xe3, 0xd3, 0x66, 0x22, 0x0f, 0xf8, 0xd0, 0x82, 0x04, 0x55,
-	0xc2, 0x4e, 0x6f, 0x06, 0xbd, 0xbb, 0x5e, 0xf4, 0x4e, 0x36, 0xfa, 0x1f, 0x20, 0x1a, 0x1d, 0x6c,
-	0x37, 0xdb, 0x27, 0xc3, 0x97, 0xaf, 0x25, 0x45, 0xf3, 0x25, 0x45, 0x3f, 0x4b, 0x8a, 0x3e, 0x57,
-	0xb4, 0x31, 0x5f, 0xd1, 0xc6, 0xf7, 0x8a, 0x36, 0xde, 0xee, 0x52, 0xe5, 0x27, 0x53, 0x11, 0x4b,
-	0xa3, 0x99, 0x34, 0x4e, 0x1b, 0xc7, 0x94, 0x90, 0xd7, 0xa9, 0x61, 0xe5, 0x2d, 0xd3, 0x26, 0x99,
-	0x66, 0xe0, 0xaa, 0x9e, 0x6b, 0xfd, 0xfa, 0x59, 0x01, 0x4e, 0xb4, 0x42, 0x4d, 0x37, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x71, 0xb6, 0xa1, 0xba, 0x89, 0x01, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8e, 0xd3, 0xfe, 0xaa, 0x5f, 0x2f, 0xfd, 0x23, 0x99, 0x52, 0xd2, 0x88, 0xda, 0x91, 0xa7,
+	0x88, 0xaa, 0x67, 0xa5, 0x20, 0x21, 0x65, 0x41, 0x4a, 0xcb, 0x06, 0x52, 0xb1, 0xca, 0xc2, 0x62,
+	0x9d, 0xed, 0x37, 0x8e, 0x25, 0x9f, 0xcf, 0xf2, 0x9d, 0xad, 0x76, 0x63, 0x64, 0x64, 0x64, 0x64,
+	0xe1, 0x1b, 0xf0, 0x21, 0x3a, 0x46, 0x4c, 0x4c, 0x01, 0x25, 0x1b, 0x63, 0x3e, 0x01, 0xf2, 0x9d,
+	0x1d, 0x59, 0x45, 0x20, 0x21, 0xb6, 0x7b, 0xde, 0xe7, 0x79, 0x1f, 0xbf, 0x7e, 0xee, 0x5e, 0x74,
+	0x12, 0x79, 0xbe, 0x4d, 0xd2, 0x34, 0x8e, 0x7c, 0x22, 0x22, 0x96, 0x70, 0x5b, 0x64, 0x24, 0xe1,
+	0x13, 0xc8, 0xec, 0x62, 0xb8, 0x3e, 0xe3, 0x34, 0x63, 0x82, 0xe9, 0x0f, 0x23, 0xcf, 0xc7, 0x4d,
+	0x31, 0x5e, 0x0b, 0x8a, 0x61, 0xef, 0x20, 0x64, 0x21, 0x93, 0x42, 0xbb, 0x3c, 0xa9, 0x9e, 0xde,
+	0x91, 0xcf, 0x38, 0x65, 0xdc, 0x55, 0x84, 0x02, 0x15, 0x65, 0x84, 0x8c, 0x85, 0x31, 0xd8, 0x12,
+	0x79, 0xf9, 0xc4, 0x0e, 0xf2, 0x4c, 0xfa, 0x2a, 0xde, 0x7a, 0x86, 0xd0, 0x05, 0x24, 0x8c, 0x5e,
+	0x65, 0xc4, 0x07, 0x5d, 0x47, 0x9b, 0x29, 0x11, 0xd3, 0xae, 0xd6, 0xd7, 0x06, 0xdb, 0x8e, 0x3c,
+	0xeb, 0xc7, 0x08, 0x79, 0x84, 0x83, 0x1b, 0x94, 0xb2, 0x6e, 0x5b, 0x32, 0xdb, 0x65, 0x45, 0xf6,
+	0x59, 0xb3, 0x36, 0xda, 0xba, 0x24, 0x19, 0xa1, 0x5c, 0x1f, 0xa1, 0x1d, 0x0e, 0x49, 0xe0, 0x42,
+	0x42, 0xbc, 0x18, 0x02, 0xe9, 0xf2, 0xff, 0xf8, 0xc1, 0x6a, 0x6e, 0xde, 0xbb, 0x21, 0x34, 0x1e,
+	0x59, 0x4d, 0xd6, 0x72, 0x3a, 0x25, 0x7c, 0xae, 0x90, 0x7e, 0x8e, 0xf6, 0x33, 0xf0, 0x21, 0x2a,
+	0x60, 0xdd, 0xde, 0x96, 0xed, 0xbd, 0xd5, 0xdc, 0x3c, 0x54, 0xed, 0x77, 0x04, 0x96, 0xb3, 0x57,
+	0x55, 0x6a, 0x93, 0x31, 0xda, 0xa7, 0xe4, 0xda, 0xa5, 0x40, 0x99, 0x1b, 0x43, 0x12, 0x8a, 0x69,
+	0x77, 0xa3, 0xaf, 0x0d, 0x36, 0x9b, 0x26, 0x77, 0x04, 0x96, 0xb3, 0x4b, 0xc9, 0xf5, 0x4b, 0xa0,
+	0xec, 0x85, 0xc4, 0xfa, 0x5b, 0x0d, 0x75, 0x03, 0x98, 0x90, 0x3c, 0x16, 0xae, 0x88, 0x28, 0xb0,
+	0x5c, 0xb8, 0x75, 0x66, 0xdd, 0xcd, 0xbe, 0x36, 0xe8, 0x9c, 0x1d, 0x61, 0x15, 0x2a, 0xae, 0x43,
+	0xc5, 0x17, 0x95, 0x60, 0x7c, 0x72, 0x3b, 0x37, 0x5b, 0xab, 0xb9, 0x69, 0xaa, 0x8f, 0xfd, 0xce,
+	0xc8, 0xfa, 0xf0, 0xcd, 0xd4, 0x9c, 0xc3, 0x8a, 0xbe, 0x52, 0x6c, 0x6d, 0x62, 0x7d, 0xd2, 0xd0,
+	0x8e, 0x0c, 0xb7, 0xfe, 0xaf, 0x03, 0xf4, 0x9f, 0x4a, 0x5f, 0xdd, 0x8b, 0x02, 0xbf, 0xc4, 0xdd,
+	0xfe, 0xb7, 0xb8, 0x37, 0xfe, 0x36, 0x6e, 0xeb, 0x87, 0x86, 0x7a, 0xaf, 0xd3, 0x80, 0x08, 0x68,
+	0x4e, 0x7b, 0x99, 0xb1, 0x94, 0x71, 0x12, 0x97, 0x53, 0x8b, 0x48, 0xc4, 0x50, 0x4f, 0x2d, 0x81,
+	0xde, 0x47, 0x9d, 0x00, 0xb8, 0x9f, 0x45, 0xa9, 0x4c, 0x54, 0xbd, 0xa7, 0x66, 0x49, 0x4f, 0xd1,
+	0x9e, 0xfc, 0x41, 0xde, 0x18, 0x6d, 0x63, 0xd0, 0x39, 0x7b, 0x84, 0xff, 0xb4, 0x1a, 0xb8, 0x39,
+	0xc3, 0xf8, 0xb8, 0xba, 0x87, 0xfb, 0xf5, 0x3d, 0x34, 0xfd, 0x2c, 0x67, 0x57, 0x15, 0x2a, 0xf5,
+	0xc8, 0x7a, 0xf7, 0xd1, 0x6c, 0x7d, 0xf9, 0x7c, 0xda, 0xab, 0x56, 0x27, 0x64, 0x05, 0x2e, 0x86,
+	0x1e, 0x08, 0x32, 0xc4, 0xe7, 0x2c, 0x11, 0x90, 0x88, 0xf1, 0xab, 0xdb, 0x85, 0xa1, 0xcd, 0x16,
+	0x86, 0xf6, 0x7d, 0x61, 0x68, 0xef, 0x97, 0x46, 0x6b, 0xb6, 0x34, 0x5a, 0x5f, 0x97, 0x46, 0xeb,
+	0xcd, 0xd3, 0x30, 0x12, 0xd3, 0xdc, 0xc3, 0x3e, 0xa3, 0xd5, 0xee, 0xd9, 0x91, 0xe7, 0x9f, 0x86,
+	0xcc, 0x2e, 0x9e, 0xd8, 0x94, 0x05, 0x79, 0x0c, 0xbc, 0x5c, 0xff, 0xc6, 0xda, 0x8b, 0x9b, 0x14,
+	0xb8, 0xb7, 0x25, 0xdf, 0xcf, 0xe3, 0x9f, 0x03, 0x00, 0xfa, 0x85, 0xde, 0x01, 0x20, 0x04, 0x00,
+	0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +383,19 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DefaultTimeoutDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DefaultTimeoutDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTransfer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,6 +419,107 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDenomEnabledProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDenomEnabledProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDenomEnabledProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomsEnabled) > 0 {
+		for iNdEx := len(m.DenomsEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -292,6 +560,53 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxMemoLength))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DefaultTimeoutDuration)
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *DenomEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateDenomEnabledProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.DenomsEnabled) > 0 {
+		for _, e := range m.DenomsEnabled {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -484,6 +799,328 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DefaultTimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDenomEnabledProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDenomEnabledProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDenomEnabledProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsEnabled = append(m.DenomsEnabled, DenomEnabled{})
+			if err := m.DenomsEnabled[len(m.DenomsEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
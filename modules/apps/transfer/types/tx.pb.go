@@ -52,6 +52,12 @@ type MsgTransfer struct {
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
 	// optional memo
 	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// optional address on the source chain that receives the refunded tokens if the
+	// packet times out or is acknowledged with an error. Defaults to the sender.
+	RefundAddress string `protobuf:"bytes,9,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
+	// optional tokens to be transferred in a single packet, used instead of token. Only supported
+	// on channels which negotiated the multi-denom channel version.
+	Tokens []types.Coin `protobuf:"bytes,10,rep,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -143,40 +149,43 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x49, 0x1a, 0xc2, 0x46, 0xad, 0x60, 0x81, 0xca, 0x8d, 0x8a, 0x1d, 0x59, 0x42, 0x0a,
-	0x07, 0x76, 0xe5, 0x02, 0xaa, 0xd4, 0x13, 0x4a, 0x2f, 0x70, 0xa8, 0x04, 0x56, 0x4f, 0x5c, 0x8a,
-	0xbd, 0x1d, 0x9c, 0x15, 0xb1, 0xc7, 0x78, 0x37, 0x16, 0xfd, 0x03, 0x8e, 0x7c, 0x42, 0xbf, 0x84,
-	0x73, 0x8f, 0x3d, 0x72, 0x8a, 0x50, 0x72, 0xe1, 0x9c, 0x2f, 0x40, 0x6b, 0x3b, 0x21, 0xb9, 0x20,
-	0x4e, 0x9e, 0x99, 0xf7, 0xc6, 0x6f, 0xdf, 0xce, 0x2c, 0x79, 0x2a, 0x63, 0xc1, 0xa3, 0x3c, 0x9f,
-	0x48, 0x11, 0x69, 0x89, 0x99, 0xe2, 0xba, 0x88, 0x32, 0xf5, 0x09, 0x0a, 0x5e, 0x06, 0x5c, 0x7f,
-	0x65, 0x79, 0x81, 0x1a, 0xe9, 0xa1, 0x8c, 0x05, 0xdb, 0xa4, 0xb1, 0x15, 0x8d, 0x95, 0x41, 0xff,
-	0x51, 0x82, 0x09, 0x56, 0x44, 0x6e, 0xa2, 0xba, 0xa7, 0xef, 0x0a, 0x54, 0x29, 0x2a, 0x1e, 0x47,
-	0x0a, 0x78, 0x19, 0xc4, 0xa0, 0xa3, 0x80, 0x0b, 0x94, 0x59, 0x83, 0x7b, 0x46, 0x5a, 0x60, 0x01,
-	0x5c, 0x4c, 0x24, 0x64, 0xda, 0x08, 0xd6, 0x51, 0x4d, 0xf0, 0x7f, 0xb4, 0x48, 0xef, 0x4c, 0x25,
-	0xe7, 0x8d, 0x12, 0x3d, 0x26, 0x3d, 0x85, 0xd3, 0x42, 0xc0, 0x45, 0x8e, 0x85, 0x76, 0xec, 0x81,
-	0x3d, 0xbc, 0x37, 0xda, 0x5f, 0xce, 0x3c, 0x7a, 0x15, 0xa5, 0x93, 0x13, 0x7f, 0x03, 0xf4, 0x43,
-	0x52, 0x67, 0xef, 0xb0, 0xd0, 0xf4, 0x35, 0xd9, 0x6b, 0x30, 0x31, 0x8e, 0xb2, 0x0c, 0x26, 0xce,
-	0x9d, 0xaa, 0xf7, 0x60, 0x39, 0xf3, 0x1e, 0x6f, 0xf5, 0x36, 0xb8, 0x1f, 0xee, 0xd6, 0x85, 0xd3,
-	0x3a, 0xa7, 0xaf, 0xc8, 0x8e, 0xc6, 0xcf, 0x90, 0x39, 0xad, 0x81, 0x3d, 0xec, 0x1d, 0x1d, 0xb0,
-	0xda, 0x1b, 0x33, 0xde, 0x58, 0xe3, 0x8d, 0x9d, 0xa2, 0xcc, 0x46, 0xed, 0x9b, 0x99, 0x67, 0x85,
-	0x35, 0x9b, 0xee, 0x93, 0x8e, 0x82, 0xec, 0x12, 0x0a, 0xa7, 0x6d, 0x04, 0xc3, 0x26, 0xa3, 0x7d,
-	0xd2, 0x2d, 0x40, 0x80, 0x2c, 0xa1, 0x70, 0x76, 0x2a, 0x64, 0x9d, 0xd3, 0x8f, 0x64, 0x4f, 0xcb,
-	0x14, 0x70, 0xaa, 0x2f, 0xc6, 0x20, 0x93, 0xb1, 0x76, 0x3a, 0x95, 0x66, 0x9f, 0x99, 0x19, 0x98,
-	0xfb, 0x62, 0xcd, 0x2d, 0x95, 0x01, 0x7b, 0x53, 0x31, 0x46, 0x4f, 0x8c, 0xe8, 0x5f, 0x33, 0xdb,
-	0xfd, 0x7e, 0xb8, 0xdb, 0x14, 0x6a, 0x36, 0x7d, 0x4b, 0x1e, 0xac, 0x18, 0xe6, 0xab, 0x74, 0x94,
-	0xe6, 0xce, 0xdd, 0x81, 0x3d, 0x6c, 0x8f, 0x0e, 0x97, 0x33, 0xcf, 0xd9, 0xfe, 0xc9, 0x9a, 0xe2,
-	0x87, 0xf7, 0x9b, 0xda, 0xf9, 0xaa, 0x44, 0x29, 0x69, 0xa7, 0x90, 0xa2, 0xd3, 0xad, 0x4c, 0x54,
-	0xf1, 0x49, 0xf7, 0xdb, 0xb5, 0x67, 0xfd, 0xbe, 0xf6, 0x2c, 0x3f, 0x20, 0x0f, 0x37, 0xe6, 0x17,
-	0x82, 0xca, 0x31, 0x53, 0x60, 0xdc, 0x2b, 0xf8, 0x32, 0x85, 0x4c, 0x40, 0x35, 0xc4, 0x76, 0xb8,
-	0xce, 0x8f, 0x90, 0xb4, 0xce, 0x54, 0x42, 0xc7, 0xa4, 0xbb, 0x1e, 0xfb, 0x33, 0xf6, 0xaf, 0xe5,
-	0x63, 0x1b, 0x0a, 0xfd, 0xe0, 0xbf, 0xa9, 0xab, 0xc3, 0x8c, 0xde, 0xdf, 0xcc, 0x5d, 0xfb, 0x76,
-	0xee, 0xda, 0xbf, 0xe6, 0xae, 0xfd, 0x7d, 0xe1, 0x5a, 0xb7, 0x0b, 0xd7, 0xfa, 0xb9, 0x70, 0xad,
-	0x0f, 0xc7, 0x89, 0xd4, 0xe3, 0x69, 0xcc, 0x04, 0xa6, 0xbc, 0x59, 0x65, 0x19, 0x8b, 0xe7, 0x09,
-	0xf2, 0xf2, 0x25, 0x4f, 0xf1, 0x72, 0x3a, 0x01, 0x65, 0x9e, 0xce, 0xc6, 0x93, 0xd1, 0x57, 0x39,
-	0xa8, 0xb8, 0x53, 0xad, 0xef, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x02, 0xa3, 0x4c,
-	0x5c, 0x03, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0xff, 0xa6, 0xf9, 0xd3, 0xad, 0x5a, 0x95, 0x05, 0x2a, 0x37, 0x2a, 0x76, 0x64, 0x09,
+	0xa9, 0x48, 0xb0, 0x2b, 0x17, 0x50, 0xa5, 0x9e, 0x20, 0xbd, 0x80, 0x50, 0x25, 0xb0, 0x7a, 0xe2,
+	0x52, 0xec, 0xcd, 0xd4, 0x59, 0x11, 0xef, 0x9a, 0xdd, 0x4d, 0x44, 0xdf, 0x80, 0x23, 0x8f, 0xd0,
+	0xc7, 0xe9, 0x05, 0xa9, 0x47, 0x4e, 0x11, 0x6a, 0x2f, 0x88, 0x63, 0x9e, 0x00, 0xad, 0xd7, 0x09,
+	0x09, 0x87, 0x8a, 0x93, 0x77, 0x66, 0xbe, 0xd9, 0xcf, 0xdf, 0xb7, 0x33, 0xe8, 0x21, 0xcf, 0x18,
+	0x4d, 0xcb, 0x72, 0xc8, 0x59, 0x6a, 0xb8, 0x14, 0x9a, 0x1a, 0x95, 0x0a, 0x7d, 0x06, 0x8a, 0x8e,
+	0x63, 0x6a, 0x3e, 0x93, 0x52, 0x49, 0x23, 0xf1, 0x2e, 0xcf, 0x18, 0x59, 0x84, 0x91, 0x19, 0x8c,
+	0x8c, 0xe3, 0xce, 0xbd, 0x5c, 0xe6, 0xb2, 0x02, 0x52, 0x7b, 0x72, 0x3d, 0x9d, 0x80, 0x49, 0x5d,
+	0x48, 0x4d, 0xb3, 0x54, 0x03, 0x1d, 0xc7, 0x19, 0x98, 0x34, 0xa6, 0x4c, 0x72, 0x51, 0xd7, 0x43,
+	0x4b, 0xcd, 0xa4, 0x02, 0xca, 0x86, 0x1c, 0x84, 0xb1, 0x84, 0xee, 0xe4, 0x00, 0xd1, 0xb7, 0x26,
+	0x5a, 0x3f, 0xd6, 0xf9, 0x49, 0xcd, 0x84, 0x0f, 0xd0, 0xba, 0x96, 0x23, 0xc5, 0xe0, 0xb4, 0x94,
+	0xca, 0xf8, 0x5e, 0xd7, 0xdb, 0x5b, 0xeb, 0x6d, 0x4f, 0x27, 0x21, 0x3e, 0x4f, 0x8b, 0xe1, 0x61,
+	0xb4, 0x50, 0x8c, 0x12, 0xe4, 0xa2, 0xb7, 0x52, 0x19, 0xfc, 0x02, 0x6d, 0xd6, 0x35, 0x36, 0x48,
+	0x85, 0x80, 0xa1, 0xff, 0x5f, 0xd5, 0xbb, 0x33, 0x9d, 0x84, 0xf7, 0x97, 0x7a, 0xeb, 0x7a, 0x94,
+	0x6c, 0xb8, 0xc4, 0x91, 0x8b, 0xf1, 0x73, 0xb4, 0x6a, 0xe4, 0x47, 0x10, 0xfe, 0x4a, 0xd7, 0xdb,
+	0x5b, 0xdf, 0xdf, 0x21, 0x4e, 0x1b, 0xb1, 0xda, 0x48, 0xad, 0x8d, 0x1c, 0x49, 0x2e, 0x7a, 0xcd,
+	0xcb, 0x49, 0xd8, 0x48, 0x1c, 0x1a, 0x6f, 0xa3, 0x96, 0x06, 0xd1, 0x07, 0xe5, 0x37, 0x2d, 0x61,
+	0x52, 0x47, 0xb8, 0x83, 0xda, 0x0a, 0x18, 0xf0, 0x31, 0x28, 0x7f, 0xb5, 0xaa, 0xcc, 0x63, 0xfc,
+	0x01, 0x6d, 0x1a, 0x5e, 0x80, 0x1c, 0x99, 0xd3, 0x01, 0xf0, 0x7c, 0x60, 0xfc, 0x56, 0xc5, 0xd9,
+	0x21, 0xf6, 0x0d, 0xac, 0x5f, 0xa4, 0x76, 0x69, 0x1c, 0x93, 0x57, 0x15, 0xa2, 0xf7, 0xc0, 0x92,
+	0xfe, 0x11, 0xb3, 0xdc, 0x1f, 0x25, 0x1b, 0x75, 0xc2, 0xa1, 0xf1, 0x6b, 0x74, 0x67, 0x86, 0xb0,
+	0x5f, 0x6d, 0xd2, 0xa2, 0xf4, 0xff, 0xef, 0x7a, 0x7b, 0xcd, 0xde, 0xee, 0x74, 0x12, 0xfa, 0xcb,
+	0x97, 0xcc, 0x21, 0x51, 0xb2, 0x55, 0xe7, 0x4e, 0x66, 0x29, 0x8c, 0x51, 0xb3, 0x80, 0x42, 0xfa,
+	0xed, 0x4a, 0x44, 0x75, 0xb6, 0x6e, 0x2b, 0x38, 0x1b, 0x89, 0xfe, 0x69, 0xda, 0xef, 0x2b, 0xd0,
+	0xda, 0x5f, 0xfb, 0xdb, 0xed, 0xe5, 0x7a, 0x94, 0x6c, 0xb8, 0xc4, 0x4b, 0x17, 0xe3, 0x37, 0xa8,
+	0x55, 0xf9, 0xa7, 0x7d, 0xd4, 0x5d, 0xb9, 0xdd, 0x6e, 0xdf, 0x2a, 0xff, 0x35, 0x09, 0xb7, 0x5c,
+	0xc3, 0x63, 0x59, 0x70, 0x03, 0x45, 0x69, 0xce, 0x93, 0xfa, 0x8a, 0xc3, 0xf6, 0x97, 0x8b, 0xb0,
+	0xf1, 0xf3, 0x22, 0x6c, 0x44, 0x31, 0xba, 0xbb, 0x30, 0x4e, 0x09, 0xe8, 0x52, 0x0a, 0x0d, 0xf6,
+	0x31, 0x34, 0x7c, 0x1a, 0x81, 0x60, 0x50, 0xcd, 0x54, 0x33, 0x99, 0xc7, 0xfb, 0x12, 0xad, 0x1c,
+	0xeb, 0x1c, 0x0f, 0x50, 0x7b, 0x3e, 0x85, 0x8f, 0xc8, 0x6d, 0xbb, 0x40, 0x16, 0x18, 0x3a, 0xf1,
+	0x3f, 0x43, 0x67, 0x3f, 0xd3, 0x7b, 0x77, 0x79, 0x1d, 0x78, 0x57, 0xd7, 0x81, 0xf7, 0xe3, 0x3a,
+	0xf0, 0xbe, 0xde, 0x04, 0x8d, 0xab, 0x9b, 0xa0, 0xf1, 0xfd, 0x26, 0x68, 0xbc, 0x3f, 0xc8, 0xb9,
+	0x19, 0x8c, 0x32, 0xc2, 0x64, 0x41, 0xeb, 0xcd, 0xe2, 0x19, 0x7b, 0x92, 0x4b, 0x3a, 0x7e, 0x46,
+	0x0b, 0xd9, 0x1f, 0x0d, 0x41, 0xdb, 0x4d, 0x5e, 0xd8, 0x60, 0x73, 0x5e, 0x82, 0xce, 0x5a, 0xd5,
+	0x36, 0x3d, 0xfd, 0x3d, 0x00, 0xaf, 0xe0, 0x35, 0x46, 0xeb, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -281,6 +290,27 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -416,6 +446,16 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -711,6 +751,72 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
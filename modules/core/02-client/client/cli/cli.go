@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryFrozenClients(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
@@ -57,6 +57,45 @@ func GetCmdQueryClientStates() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryFrozenClients defines the command to query all the light clients
+// which have been frozen due to misbehaviour.
+func GetCmdQueryFrozenClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-clients",
+		Short:   "Query all frozen light clients",
+		Long:    "Query all light clients which have been frozen due to misbehaviour, with the record of the misbehaviour submission",
+		Example: fmt.Sprintf("%s query %s %s frozen-clients", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFrozenClientsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FrozenClients(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen clients")
+
+	return cmd
+}
+
 // GetCmdQueryClientState defines the command to query the state of a client with
 // a given id as defined in https://github.com/cosmos/ibc/tree/master/spec/core/ics-002-client-semantics#query
 func GetCmdQueryClientState() *cobra.Command {
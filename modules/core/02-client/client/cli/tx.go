@@ -294,6 +294,100 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdSubmitCreateClientProposal implements a command handler for submitting a create IBC client proposal transaction.
+func NewCmdSubmitCreateClientProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-client [path/to/client_state.json] [path/to/consensus_state.json] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a create IBC client proposal",
+		Long: "Submit a create IBC client proposal along with an initial deposit.\n" +
+			"Please specify the client state and consensus state of the client to be created if the proposal passes.\n" +
+			"When permissioned client creation is enabled, clients may only be created through this proposal.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			// attempt to unmarshal client state argument
+			var clientState exported.ClientState
+			clientContentOrFileName := args[0]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(clientContentOrFileName), &clientState); err != nil {
+
+				// check for file path if JSON input is not provided
+				contents, err := ioutil.ReadFile(clientContentOrFileName)
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for client state were provided: %w", err)
+				}
+
+				if err := cdc.UnmarshalInterfaceJSON(contents, &clientState); err != nil {
+					return fmt.Errorf("error unmarshalling client state file: %w", err)
+				}
+			}
+
+			// attempt to unmarshal consensus state argument
+			var consensusState exported.ConsensusState
+			consensusContentOrFileName := args[1]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(consensusContentOrFileName), &consensusState); err != nil {
+
+				// check for file path if JSON input is not provided
+				contents, err := ioutil.ReadFile(consensusContentOrFileName)
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for consensus state were provided: %w", err)
+				}
+
+				if err := cdc.UnmarshalInterfaceJSON(contents, &consensusState); err != nil {
+					return fmt.Errorf("error unmarshalling consensus state file: %w", err)
+				}
+			}
+
+			content, err := types.NewCreateClientProposal(title, description, clientState, consensusState)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
 // NewCmdSubmitUpgradeProposal implements a command handler for submitting an upgrade IBC client proposal transaction.
 func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -13,6 +13,7 @@ import (
 
 var (
 	UpdateClientProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateClientProposal, emptyRestHandler)
+	CreateClientProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCreateClientProposal, emptyRestHandler)
 	UpgradeProposalHandler      = govclient.NewProposalHandler(cli.NewCmdSubmitUpgradeProposal, emptyRestHandler)
 )
 
@@ -21,11 +21,23 @@ import (
 func QueryClientState(
 	clientCtx client.Context, clientID string, prove bool,
 ) (*types.QueryClientStateResponse, error) {
+	queryClient := types.NewQueryClient(clientCtx)
 	if prove {
-		return QueryClientStateABCI(clientCtx, clientID)
+		res, err := QueryClientStateABCI(clientCtx, clientID)
+		if err != nil {
+			return nil, err
+		}
+
+		// the client status cannot be proven, it is retrieved using the gRPC query client
+		statusRes, err := queryClient.ClientStatus(context.Background(), &types.QueryClientStatusRequest{ClientId: clientID})
+		if err != nil {
+			return nil, err
+		}
+
+		res.Status = statusRes.Status
+		return res, nil
 	}
 
-	queryClient := types.NewQueryClient(clientCtx)
 	req := &types.QueryClientStateRequest{
 		ClientId: clientID,
 	}
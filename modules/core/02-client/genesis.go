@@ -47,8 +47,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
-	// NOTE: localhost creation is specifically disallowed for the time being.
-	// Issue: https://github.com/cosmos/cosmos-sdk/issues/7871
+	// the localhost client may already be included in the imported clients
+	if _, found := k.GetClientState(ctx, exported.Localhost); gs.CreateLocalhost && !found {
+		if err := k.CreateLocalhostClient(ctx); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	localhosttypes "github.com/cosmos/ibc-go/v4/modules/light-clients/09-localhost/types"
 )
 
 // CreateClient creates a new client state and populates it with a given consensus
@@ -56,6 +57,31 @@ func (k Keeper) CreateClient(
 	return clientID, nil
 }
 
+// CreateLocalhostClient creates the localhost client of the running chain. Unlike other clients,
+// the client type is used as the client identifier and no consensus state is stored. The localhost
+// client is updated to the latest block height in BeginBlock.
+func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
+	if !k.GetParams(ctx).IsAllowedClient(exported.Localhost) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidClientType,
+			"client state type %s is not registered in the allowlist", exported.Localhost,
+		)
+	}
+
+	if _, found := k.GetClientState(ctx, exported.Localhost); found {
+		return sdkerrors.Wrapf(types.ErrClientExists, "client %s already exists", exported.Localhost)
+	}
+
+	clientState := localhosttypes.NewClientState(ctx.ChainID(), types.GetSelfHeight(ctx))
+	k.SetClientState(ctx, exported.Localhost, clientState)
+
+	k.Logger(ctx).Info("client created at height", "client-id", exported.Localhost, "height", clientState.GetLatestHeight().String())
+
+	EmitCreateClientEvent(ctx, exported.Localhost, clientState)
+
+	return nil
+}
+
 // UpdateClient updates the consensus state and the state root from a provided header.
 func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error {
 	clientState, found := k.GetClientState(ctx, clientID)
@@ -66,9 +92,20 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	clientStore := k.ClientStore(ctx, clientID)
 
 	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		if status == exported.Expired {
+			EmitUpdateExpiredClientEvent(ctx, clientID, clientState)
+		}
+
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
+	// a header conflicting with an existing consensus state is duplicate header misbehaviour,
+	// any other misbehaviour detected on update is a violation of monotonic time
+	misbehaviourType := types.MisbehaviourTypeTimeViolation
+	if header != nil && k.HasClientConsensusState(ctx, clientID, header.GetHeight()) {
+		misbehaviourType = types.MisbehaviourTypeDuplicateHeader
+	}
+
 	// Any writes made in CheckHeaderAndUpdateState are persisted on both valid updates and misbehaviour updates.
 	// Light client implementations are responsible for writing the correct metadata (if any) in either case.
 	newClientState, newConsensusState, err := clientState.CheckHeaderAndUpdateState(ctx, k.cdc, clientStore, header)
@@ -137,7 +174,9 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 			)
 		}()
 
-		EmitSubmitMisbehaviourEventOnUpdate(ctx, clientID, newClientState, consensusHeight, headerStr)
+		k.SetMisbehaviourRecord(ctx, clientID, types.NewMisbehaviourRecord(ctx, misbehaviourType, consensusHeight, ""))
+
+		EmitSubmitMisbehaviourEventOnUpdate(ctx, clientID, newClientState, consensusHeight, headerStr, misbehaviourType)
 	}
 
 	return nil
@@ -187,8 +226,15 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 	return nil
 }
 
+// misbehaviourDetails is implemented by misbehaviour which reports the height at
+// which it occurred and the type of the misbehaviour.
+type misbehaviourDetails interface {
+	GetHeight() exported.Height
+	GetMisbehaviourType() string
+}
+
 // CheckMisbehaviourAndUpdateState checks for client misbehaviour and freezes the
-// client if so.
+// client if so. A record of the submission is stored for the frozen client.
 func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour exported.Misbehaviour) error {
 	clientState, found := k.GetClientState(ctx, misbehaviour.GetClientID())
 	if !found {
@@ -205,6 +251,12 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return err
 	}
 
+	// light clients which do not report the misbehaviour height are recorded as frozen at their latest height
+	misbehaviourHeight, misbehaviourType := clientState.GetLatestHeight(), ""
+	if details, ok := misbehaviour.(misbehaviourDetails); ok {
+		misbehaviourHeight, misbehaviourType = details.GetHeight(), details.GetMisbehaviourType()
+	}
+
 	clientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, k.cdc, clientStore, misbehaviour)
 	if err != nil {
 		return err
@@ -224,7 +276,9 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		)
 	}()
 
-	EmitSubmitMisbehaviourEvent(ctx, misbehaviour.GetClientID(), clientState)
+	k.SetMisbehaviourRecord(ctx, misbehaviour.GetClientID(), types.NewMisbehaviourRecord(ctx, misbehaviourType, misbehaviourHeight, ""))
+
+	EmitSubmitMisbehaviourEvent(ctx, misbehaviour.GetClientID(), clientState, misbehaviourHeight, misbehaviourType)
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -41,6 +42,35 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateClientDisallowedClientType() {
+	clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+
+	suite.keeper.SetParams(suite.ctx, clienttypes.NewParams(exported.Solomachine))
+
+	clientID, err := suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidClientType)
+	suite.Require().Empty(clientID)
+}
+
+func (suite *KeeperTestSuite) TestCreateLocalhostClient() {
+	// localhost is not an allowed client type by default
+	err := suite.keeper.CreateLocalhostClient(suite.ctx)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidClientType)
+
+	suite.keeper.SetParams(suite.ctx, clienttypes.NewParams(exported.Tendermint, exported.Localhost))
+
+	err = suite.keeper.CreateLocalhostClient(suite.ctx)
+	suite.Require().NoError(err)
+
+	clientState, found := suite.keeper.GetClientState(suite.ctx, exported.Localhost)
+	suite.Require().True(found)
+	suite.Require().Equal(exported.Localhost, clientState.ClientType())
+	suite.Require().Equal(clienttypes.GetSelfHeight(suite.ctx), clientState.GetLatestHeight())
+
+	err = suite.keeper.CreateLocalhostClient(suite.ctx)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientExists)
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -62,10 +92,11 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	}
 
 	cases := []struct {
-		name      string
-		malleate  func()
-		expPass   bool
-		expFreeze bool
+		name                string
+		malleate            func()
+		expPass             bool
+		expFreeze           bool
+		expMisbehaviourType string
 	}{
 		{"valid update", func() {
 			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
@@ -75,7 +106,7 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			path.EndpointA.UpdateClient()
 
 			updateHeader = createFutureUpdateFn(trustHeight)
-		}, true, false},
+		}, true, false, ""},
 		{"valid past update", func() {
 			clientState := path.EndpointA.GetClientState()
 			trustedHeight := clientState.GetLatestHeight().(types.Height)
@@ -96,7 +127,7 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			// updateHeader will fill in consensus state between prevConsState and suite.consState
 			// clientState should not be updated
 			updateHeader = createPastUpdateFn(fillHeight, trustedHeight)
-		}, true, false},
+		}, true, false, ""},
 		{"valid duplicate update", func() {
 			clientID := path.EndpointA.ClientID
 
@@ -123,7 +154,7 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			updateHeader = createPastUpdateFn(height3, height1)
 			// set updateHeader's consensus state in store to create duplicate UpdateClient scenario
 			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientID, updateHeader.GetHeight(), updateHeader.ConsensusState())
-		}, true, false},
+		}, true, false, ""},
 		{"misbehaviour detection: conflicting header", func() {
 			clientID := path.EndpointA.ClientID
 
@@ -151,7 +182,7 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			conflictConsState := updateHeader.ConsensusState()
 			conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
 			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientID, updateHeader.GetHeight(), conflictConsState)
-		}, true, true},
+		}, true, true, types.MisbehaviourTypeDuplicateHeader},
 		{"misbehaviour detection: monotonic time violation", func() {
 			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
 			clientID := path.EndpointA.ClientID
@@ -173,12 +204,33 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
 
 			updateHeader = createFutureUpdateFn(trustedHeight)
-		}, true, true},
+			// the intermediate consensus state is stored at the header height, so it is also a conflicting header
+		}, true, true, types.MisbehaviourTypeDuplicateHeader},
+		{"misbehaviour detection: monotonic time violation with next consensus state", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			clientID := path.EndpointA.ClientID
+			trustedHeight := clientState.GetLatestHeight().(types.Height)
+
+			// store next consensus state at a time before the updateHeader time
+			// this will break time monotonicity
+			nextHeight := trustedHeight.Increment().Increment().(types.Height)
+			nextConsState := &ibctmtypes.ConsensusState{
+				Timestamp:          suite.past,
+				NextValidatorsHash: suite.chainB.Vals.Hash(),
+			}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientID, nextHeight, nextConsState)
+			// set iteration key
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+			ibctmtypes.SetIterationKey(clientStore, nextHeight)
+
+			updateHeader = createFutureUpdateFn(trustedHeight)
+			suite.Require().True(updateHeader.GetHeight().LT(nextHeight))
+		}, true, true, types.MisbehaviourTypeTimeViolation},
 		{"client state not found", func() {
 			updateHeader = createFutureUpdateFn(path.EndpointA.GetClientState().GetLatestHeight().(types.Height))
 
 			path.EndpointA.ClientID = ibctesting.InvalidID
-		}, false, false},
+		}, false, false, ""},
 		{"consensus state not found", func() {
 			clientState := path.EndpointA.GetClientState()
 			tmClient, ok := clientState.(*ibctmtypes.ClientState)
@@ -187,17 +239,17 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 
 			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
 			updateHeader = createFutureUpdateFn(clientState.GetLatestHeight().(types.Height))
-		}, false, false},
+		}, false, false, ""},
 		{"client is not active", func() {
 			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
 			clientState.FrozenHeight = types.NewHeight(0, 1)
 			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
 			updateHeader = createFutureUpdateFn(clientState.GetLatestHeight().(types.Height))
-		}, false, false},
+		}, false, false, ""},
 		{"invalid header", func() {
 			updateHeader = createFutureUpdateFn(path.EndpointA.GetClientState().GetLatestHeight().(types.Height))
 			updateHeader.TrustedHeight = updateHeader.TrustedHeight.Increment().(types.Height)
-		}, false, false},
+		}, false, false, ""},
 	}
 
 	for _, tc := range cases {
@@ -214,7 +266,8 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 				clientState = path.EndpointA.GetClientState()
 			}
 
-			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, updateHeader)
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, updateHeader)
 
 			if tc.expPass {
 				suite.Require().NoError(err, err)
@@ -223,6 +276,15 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 
 				if tc.expFreeze {
 					suite.Require().True(!newClientState.(*ibctmtypes.ClientState).FrozenHeight.IsZero(), "client did not freeze after conflicting header was submitted to UpdateClient")
+
+					record, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetMisbehaviourRecord(ctx, path.EndpointA.ClientID)
+					suite.Require().True(found)
+					suite.Require().Equal(tc.expMisbehaviourType, record.MisbehaviourType)
+					suite.Require().Equal(updateHeader.GetHeight(), record.MisbehaviourHeight)
+					suite.Require().Equal(types.GetSelfHeight(ctx), record.SubmitHeight)
+					suite.Require().Equal(ctx.BlockTime(), record.SubmitTime)
+
+					suite.requireMisbehaviourEvent(ctx.EventManager().Events(), path.EndpointA.ClientID, updateHeader.GetHeight(), tc.expMisbehaviourType)
 				} else {
 					expConsensusState := &ibctmtypes.ConsensusState{
 						Timestamp:          updateHeader.GetTime(),
@@ -457,10 +519,11 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 	heightPlus5 := types.NewHeight(0, height+5)
 
 	testCases := []struct {
-		name         string
-		misbehaviour *ibctmtypes.Misbehaviour
-		malleate     func() error
-		expPass      bool
+		name                string
+		misbehaviour        *ibctmtypes.Misbehaviour
+		malleate            func() error
+		expPass             bool
+		expMisbehaviourType string
 	}{
 		{
 			"trusting period misbehavior should pass",
@@ -477,6 +540,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			true,
+			types.MisbehaviourTypeDuplicateHeader,
 		},
 		{
 			"time misbehavior should pass",
@@ -493,6 +557,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			true,
+			types.MisbehaviourTypeTimeViolation,
 		},
 		{
 			"misbehavior at later height should pass",
@@ -519,6 +584,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			true,
+			types.MisbehaviourTypeDuplicateHeader,
 		},
 		{
 			"misbehavior at later height with different trusted heights should pass",
@@ -545,6 +611,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			true,
+			types.MisbehaviourTypeDuplicateHeader,
 		},
 		{
 			"misbehavior ValidateBasic fails: misbehaviour height is at same height as trusted height",
@@ -561,6 +628,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			false,
+			"",
 		},
 		{
 			"trusted ConsensusState1 not found",
@@ -577,6 +645,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			false,
+			"",
 		},
 		{
 			"trusted ConsensusState2 not found",
@@ -593,12 +662,14 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			false,
+			"",
 		},
 		{
 			"client state not found",
 			&ibctmtypes.Misbehaviour{},
 			func() error { return nil },
 			false,
+			"",
 		},
 		{
 			"client already is not active - client is frozen",
@@ -618,6 +689,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			false,
+			"",
 		},
 		{
 			"misbehaviour check failed",
@@ -636,6 +708,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				return err
 			},
 			false,
+			"",
 		},
 	}
 
@@ -660,6 +733,15 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				clientState, found := suite.keeper.GetClientState(suite.ctx, clientID)
 				suite.Require().True(found, "valid test case %d failed: %s", i, tc.name)
 				suite.Require().True(!clientState.(*ibctmtypes.ClientState).FrozenHeight.IsZero(), "valid test case %d failed: %s", i, tc.name)
+
+				record, found := suite.keeper.GetMisbehaviourRecord(suite.ctx, clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expMisbehaviourType, record.MisbehaviourType)
+				suite.Require().Equal(tc.misbehaviour.Header1.GetHeight(), record.MisbehaviourHeight)
+				suite.Require().Equal(types.GetSelfHeight(suite.ctx), record.SubmitHeight)
+				suite.Require().Empty(record.Submitter)
+
+				suite.requireMisbehaviourEvent(suite.ctx.EventManager().Events(), clientID, tc.misbehaviour.Header1.GetHeight(), tc.expMisbehaviourType)
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
 			}
@@ -667,6 +749,28 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 	}
 }
 
+// requireMisbehaviourEvent asserts a misbehaviour event was emitted for the client
+// with the expected frozen height and misbehaviour type.
+func (suite *KeeperTestSuite) requireMisbehaviourEvent(events sdk.Events, clientID string, frozenHeight exported.Height, misbehaviourType string) {
+	var found bool
+	for _, event := range events {
+		if event.Type != clienttypes.EventTypeSubmitMisbehaviour {
+			continue
+		}
+
+		found = true
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		suite.Require().Equal(clientID, attributes[clienttypes.AttributeKeyClientID])
+		suite.Require().Equal(frozenHeight.String(), attributes[clienttypes.AttributeKeyFrozenHeight])
+		suite.Require().Equal(misbehaviourType, attributes[clienttypes.AttributeKeyMisbehaviourType])
+	}
+	suite.Require().True(found, "misbehaviour event not emitted")
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
 	suite.coordinator.SetupClients(path)
@@ -700,3 +804,33 @@ func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 	}
 	suite.Require().True(contains)
 }
+
+func (suite *KeeperTestSuite) TestUpdateExpiredClientEventEmission() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+	suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotActive)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != clienttypes.EventTypeUpdateExpiredClient {
+			continue
+		}
+
+		found = true
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == clienttypes.AttributeKeyClientID {
+				suite.Require().Equal(path.EndpointA.ClientID, string(attr.Value))
+			}
+		}
+	}
+	suite.Require().True(found, "update expired client event not emitted")
+}
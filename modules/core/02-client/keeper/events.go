@@ -42,6 +42,22 @@ func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientState exporte
 	})
 }
 
+// EmitUpdateExpiredClientEvent emits an event signalling an attempt to update an expired client
+func EmitUpdateExpiredClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateExpiredClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // EmitUpdateClientEvent emits an upgrade client event
 func EmitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -59,12 +75,14 @@ func EmitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState export
 }
 
 // EmitUpdateClientProposalEvent emits an update client proposal event
-func EmitUpdateClientProposalEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+func EmitUpdateClientProposalEvent(ctx sdk.Context, subjectClientID, substituteClientID string, previousHeight exported.Height, clientState exported.ClientState) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeUpdateClientProposal,
-			sdk.NewAttribute(types.AttributeKeySubjectClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeySubjectClientID, subjectClientID),
+			sdk.NewAttribute(types.AttributeKeySubstituteClientID, substituteClientID),
 			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyPreviousConsensusHeight, previousHeight.String()),
 			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
 		),
 	)
@@ -82,18 +100,20 @@ func EmitUpgradeClientProposalEvent(ctx sdk.Context, title string, height int64)
 }
 
 // EmitSubmitMisbehaviourEvent emits a client misbehaviour event
-func EmitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+func EmitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, frozenHeight exported.Height, misbehaviourType string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitMisbehaviour,
 			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
 			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyFrozenHeight, frozenHeight.String()),
+			sdk.NewAttribute(types.AttributeKeyMisbehaviourType, misbehaviourType),
 		),
 	)
 }
 
 // EmitSubmitMisbehaviourEventOnUpdate emits a client misbehaviour event on a client update event
-func EmitSubmitMisbehaviourEventOnUpdate(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusHeight exported.Height, headerStr string) {
+func EmitSubmitMisbehaviourEventOnUpdate(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusHeight exported.Height, headerStr, misbehaviourType string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitMisbehaviour,
@@ -101,6 +121,8 @@ func EmitSubmitMisbehaviourEventOnUpdate(ctx sdk.Context, clientID string, clien
 			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
 			sdk.NewAttribute(types.AttributeKeyConsensusHeight, consensusHeight.String()),
 			sdk.NewAttribute(types.AttributeKeyHeader, headerStr),
+			sdk.NewAttribute(types.AttributeKeyFrozenHeight, consensusHeight.String()),
+			sdk.NewAttribute(types.AttributeKeyMisbehaviourType, misbehaviourType),
 		),
 	)
 }
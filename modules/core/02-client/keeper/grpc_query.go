@@ -49,6 +49,7 @@ func (q Keeper) ClientState(c context.Context, req *types.QueryClientStateReques
 	return &types.QueryClientStateResponse{
 		ClientState: any,
 		ProofHeight: proofHeight,
+		Status:      q.GetClientStatus(ctx, clientState, req.ClientId).String(),
 	}, nil
 }
 
@@ -89,9 +90,75 @@ func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequ
 
 	sort.Sort(clientStates)
 
+	statuses := make([]string, len(clientStates))
+	for i, identifiedClient := range clientStates {
+		clientState, err := types.UnpackClientState(identifiedClient.ClientState)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		statuses[i] = q.GetClientStatus(ctx, clientState, identifiedClient.ClientId).String()
+	}
+
 	return &types.QueryClientStatesResponse{
 		ClientStates: clientStates,
 		Pagination:   pageRes,
+		Statuses:     statuses,
+	}, nil
+}
+
+// FrozenClients implements the Query/FrozenClients gRPC method
+func (q Keeper) FrozenClients(c context.Context, req *types.QueryFrozenClientsRequest) (*types.QueryFrozenClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clientStates := types.IdentifiedClientStates{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.KeyClientStorePrefix)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		keySplit := strings.Split(string(key), "/")
+		if keySplit[len(keySplit)-1] != "clientState" {
+			return false, nil
+		}
+
+		clientState, err := q.UnmarshalClientState(value)
+		if err != nil {
+			return false, err
+		}
+
+		clientID := keySplit[1]
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return false, err
+		}
+
+		if q.GetClientStatus(ctx, clientState, clientID) != exported.Frozen {
+			return false, nil
+		}
+
+		if accumulate {
+			clientStates = append(clientStates, types.NewIdentifiedClientState(clientID, clientState))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(clientStates)
+
+	records := make([]types.MisbehaviourRecord, len(clientStates))
+	for i, identifiedClient := range clientStates {
+		records[i], _ = q.GetMisbehaviourRecord(ctx, identifiedClient.ClientId)
+	}
+
+	return &types.QueryFrozenClientsResponse{
+		ClientStates:        clientStates,
+		Pagination:          pageRes,
+		MisbehaviourRecords: records,
 	}, nil
 }
 
@@ -124,6 +191,13 @@ func (q Keeper) ConsensusState(c context.Context, req *types.QueryConsensusState
 	}
 
 	if !found {
+		if !req.LatestHeight && q.IsConsensusStatePruned(ctx, req.ClientId, height) {
+			return nil, status.Error(
+				codes.OutOfRange,
+				sdkerrors.Wrapf(types.ErrConsensusStatePruned, "client-id: %s, height: %s", req.ClientId, height).Error(),
+			)
+		}
+
 		return nil, status.Error(
 			codes.NotFound,
 			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %s", req.ClientId, height).Error(),
@@ -245,8 +319,7 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 		)
 	}
 
-	clientStore := q.ClientStore(ctx, req.ClientId)
-	status := clientState.Status(ctx, clientStore, q.cdc)
+	status := q.GetClientStatus(ctx, clientState, req.ClientId)
 
 	return &types.QueryClientStatusResponse{
 		Status: status.String(),
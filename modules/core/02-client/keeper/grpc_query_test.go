@@ -7,7 +7,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
@@ -79,6 +81,7 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expClientState, res.ClientState)
+				suite.Require().Equal(exported.Active.String(), res.Status)
 
 				// ensure UnpackInterfaces is defined
 				cachedValue := res.ClientState.GetCachedValue()
@@ -181,6 +184,145 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryFrozenClients() {
+	var (
+		req                *types.QueryFrozenClientsRequest
+		expClientStates    types.IdentifiedClientStates
+		expRecords         []types.MisbehaviourRecord
+		expTotal           uint64
+		expNextKeyNotEmpty bool
+	)
+
+	// setupClients creates three clients and freezes the first two, storing a misbehaviour record
+	// for the first frozen client only.
+	setupClients := func() []string {
+		var clientIDs []string
+		for i := 0; i < 3; i++ {
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientIDs = append(clientIDs, path.EndpointA.ClientID)
+		}
+
+		ctx := suite.chainA.GetContext()
+		for _, clientID := range clientIDs[:2] {
+			clientState := suite.chainA.GetClientState(clientID).(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = ibctmtypes.FrozenHeight
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(ctx, clientID, clientState)
+		}
+
+		record := types.NewMisbehaviourRecord(ctx, types.MisbehaviourTypeDuplicateHeader, types.NewHeight(0, 10), suite.chainA.SenderAccount.GetAddress().String())
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetMisbehaviourRecord(ctx, clientIDs[0], record)
+
+		return clientIDs
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success, no frozen clients",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				req = &types.QueryFrozenClientsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      3,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, two of three clients frozen",
+			func() {
+				clientIDs := setupClients()
+
+				ctx := suite.chainA.GetContext()
+				record, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetMisbehaviourRecord(ctx, clientIDs[0])
+				suite.Require().True(found)
+
+				expClientStates = types.IdentifiedClientStates{
+					types.NewIdentifiedClientState(clientIDs[0], suite.chainA.GetClientState(clientIDs[0])),
+					types.NewIdentifiedClientState(clientIDs[1], suite.chainA.GetClientState(clientIDs[1])),
+				}
+				expRecords = []types.MisbehaviourRecord{record, {}}
+				expTotal = 2
+
+				req = &types.QueryFrozenClientsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      20,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, paginated",
+			func() {
+				clientIDs := setupClients()
+
+				ctx := suite.chainA.GetContext()
+				record, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetMisbehaviourRecord(ctx, clientIDs[0])
+				suite.Require().True(found)
+
+				expClientStates = types.IdentifiedClientStates{
+					types.NewIdentifiedClientState(clientIDs[0], suite.chainA.GetClientState(clientIDs[0])),
+				}
+				expRecords = []types.MisbehaviourRecord{record}
+				expTotal = 2
+				expNextKeyNotEmpty = true
+
+				req = &types.QueryFrozenClientsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      1,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expClientStates = types.IdentifiedClientStates{}
+			expRecords = []types.MisbehaviourRecord{}
+			expTotal = 0
+			expNextKeyNotEmpty = false
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.FrozenClients(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expClientStates, res.ClientStates)
+				suite.Require().Equal(expRecords, res.MisbehaviourRecords)
+				suite.Require().Equal(expTotal, res.Pagination.Total)
+				suite.Require().Equal(expNextKeyNotEmpty, len(res.Pagination.NextKey) != 0)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	var (
 		req               *types.QueryConsensusStateRequest
@@ -294,6 +436,41 @@ func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConsensusStatePruned() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	prunedHeight := path.EndpointA.GetClientState().GetLatestHeight()
+
+	// expire the initial consensus state and prune it on the next update
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod / 2)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod / 2)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	queryConsensusState := func(height exported.Height) (*types.QueryConsensusStateResponse, error) {
+		return suite.chainA.QueryServer.ConsensusState(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryConsensusStateRequest{
+			ClientId:       path.EndpointA.ClientID,
+			RevisionNumber: height.GetRevisionNumber(),
+			RevisionHeight: height.GetRevisionHeight(),
+		})
+	}
+
+	_, err := queryConsensusState(prunedHeight)
+	suite.Require().Error(err)
+	suite.Require().Equal(codes.OutOfRange, status.Code(err))
+	suite.Require().Contains(err.Error(), types.ErrConsensusStatePruned.Error())
+
+	// heights above the highest pruned height which were never stored are not reported as pruned
+	_, err = queryConsensusState(path.EndpointA.GetClientState().GetLatestHeight().Increment())
+	suite.Require().Error(err)
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	res, err := queryConsensusState(path.EndpointA.GetClientState().GetLatestHeight())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ConsensusState)
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusStates() {
 	var (
 		req                *types.QueryConsensusStatesRequest
@@ -583,6 +760,41 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+// TestQueryClientStatusExpiry advances the block time past the trusting period of a client and
+// asserts that the status reported by the client queries flips from Active to Expired.
+func (suite *KeeperTestSuite) TestQueryClientStatusExpiry() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	assertStatus := func(expStatus exported.Status) {
+		ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+		statusRes, err := suite.chainA.QueryServer.ClientStatus(ctx, &types.QueryClientStatusRequest{ClientId: path.EndpointA.ClientID})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expStatus.String(), statusRes.Status)
+
+		clientStateRes, err := suite.chainA.QueryServer.ClientState(ctx, &types.QueryClientStateRequest{ClientId: path.EndpointA.ClientID})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expStatus.String(), clientStateRes.Status)
+
+		clientStatesRes, err := suite.chainA.QueryServer.ClientStates(ctx, &types.QueryClientStatesRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Len(clientStatesRes.Statuses, len(clientStatesRes.ClientStates))
+		for i, identifiedClient := range clientStatesRes.ClientStates {
+			if identifiedClient.ClientId == path.EndpointA.ClientID {
+				suite.Require().Equal(expStatus.String(), clientStatesRes.Statuses[i])
+			}
+		}
+	}
+
+	assertStatus(exported.Active)
+
+	clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+	suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+	assertStatus(exported.Expired)
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 	var (
 		req               *types.QueryUpgradedConsensusStateRequest
@@ -651,4 +863,9 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	expParams := types.DefaultParams()
 	res, _ := suite.chainA.QueryServer.ClientParams(ctx, &types.QueryClientParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
+
+	expParams.PermissionedClientCreation = true
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	res, _ = suite.chainA.QueryServer.ClientParams(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryClientParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
 }
@@ -80,6 +80,25 @@ func (k Keeper) SetClientState(ctx sdk.Context, clientID string, clientState exp
 	store.Set(host.ClientStateKey(), k.MustMarshalClientState(clientState))
 }
 
+// GetMisbehaviourRecord gets the record of the misbehaviour submission which froze a client.
+func (k Keeper) GetMisbehaviourRecord(ctx sdk.Context, clientID string) (types.MisbehaviourRecord, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get([]byte(types.KeyMisbehaviourRecord))
+	if bz == nil {
+		return types.MisbehaviourRecord{}, false
+	}
+
+	var record types.MisbehaviourRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetMisbehaviourRecord sets the record of the misbehaviour submission which froze a client.
+func (k Keeper) SetMisbehaviourRecord(ctx sdk.Context, clientID string, record types.MisbehaviourRecord) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set([]byte(types.KeyMisbehaviourRecord), k.cdc.MustMarshal(&record))
+}
+
 // GetClientConsensusState gets the stored consensus state from a client at a given height.
 func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool) {
 	store := k.ClientStore(ctx, clientID)
@@ -92,6 +111,12 @@ func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height
 	return consensusState, true
 }
 
+// IsConsensusStatePruned returns true if the consensus state of a client at a given height
+// has been pruned by the light client.
+func (k Keeper) IsConsensusStatePruned(ctx sdk.Context, clientID string, height exported.Height) bool {
+	return ibctmtypes.IsConsensusStatePruned(k.ClientStore(ctx, clientID), height)
+}
+
 // SetClientConsensusState sets a ConsensusState to a particular client at the given
 // height
 func (k Keeper) SetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height, consensusState exported.ConsensusState) {
@@ -164,18 +189,23 @@ func (k Keeper) GetAllClientMetadata(ctx sdk.Context, genClients []types.Identif
 		if err != nil {
 			return nil, err
 		}
-		gms := cs.ExportMetadata(k.ClientStore(ctx, ic.ClientId))
-		if len(gms) == 0 {
-			continue
-		}
-		clientMetadata := make([]types.GenesisMetadata, len(gms))
-		for i, metadata := range gms {
+		clientStore := k.ClientStore(ctx, ic.ClientId)
+		gms := cs.ExportMetadata(clientStore)
+		clientMetadata := make([]types.GenesisMetadata, 0, len(gms)+1)
+		for _, metadata := range gms {
 			cmd, ok := metadata.(types.GenesisMetadata)
 			if !ok {
 				return nil, sdkerrors.Wrapf(types.ErrInvalidClientMetadata, "expected metadata type: %T, got: %T",
 					types.GenesisMetadata{}, cmd)
 			}
-			clientMetadata[i] = cmd
+			clientMetadata = append(clientMetadata, cmd)
+		}
+		// the misbehaviour record is stored by the client keeper rather than the light client
+		if bz := clientStore.Get([]byte(types.KeyMisbehaviourRecord)); bz != nil {
+			clientMetadata = append(clientMetadata, types.NewGenesisMetadata([]byte(types.KeyMisbehaviourRecord), bz))
+		}
+		if len(clientMetadata) == 0 {
+			continue
 		}
 		genMetadata = append(genMetadata, types.NewIdentifiedGenesisMetadata(
 			ic.ClientId,
@@ -381,6 +411,12 @@ func (k Keeper) GetAllClients(ctx sdk.Context) (states []exported.ClientState) {
 	return states
 }
 
+// GetClientStatus returns the status of the client with the given identifier using the client
+// state's Status function and the client's prefixed store.
+func (k Keeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	return clientState.Status(ctx, k.ClientStore(ctx, clientID), k.cdc)
+}
+
 // ClientStore returns isolated prefix store for each client so they can read/write in separate
 // namespace without being able to read/write other client's data
 func (k Keeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
@@ -184,7 +184,7 @@ func (suite *KeeperTestSuite) TestValidateSelfClient() {
 		},
 		{
 			"frozen client",
-			&ibctmtypes.ClientState{suite.chainA.ChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false},
+			&ibctmtypes.ClientState{suite.chainA.ChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false, nil},
 			false,
 		},
 		{
@@ -281,6 +281,13 @@ func (suite KeeperTestSuite) TestGetAllGenesisMetadata() {
 			[]types.GenesisMetadata{
 				types.NewGenesisMetadata(ibctmtypes.ProcessedTimeKey(types.NewHeight(1, 100)), []byte("val1")),
 				types.NewGenesisMetadata(ibctmtypes.ProcessedTimeKey(types.NewHeight(2, 300)), []byte("val2")),
+				types.NewGenesisMetadata([]byte(types.KeyMisbehaviourRecord), []byte("record")),
+			},
+		),
+		types.NewIdentifiedGenesisMetadata(
+			"clientC",
+			[]types.GenesisMetadata{
+				types.NewGenesisMetadata([]byte(types.KeyMisbehaviourRecord), []byte("record")),
 			},
 		),
 	}
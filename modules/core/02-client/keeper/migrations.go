@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v100 "github.com/cosmos/ibc-go/v4/modules/core/02-client/legacy/v100"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +27,22 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v100.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration adds the iteration key, processed height and processed time for every tendermint
+// consensus state which is missing them, so that all existing consensus states can be pruned.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var clientIDs []string
+	m.keeper.IterateClients(ctx, func(clientID string, clientState exported.ClientState) bool {
+		if _, ok := clientState.(*ibctmtypes.ClientState); ok {
+			clientIDs = append(clientIDs, clientID)
+		}
+		return false
+	})
+
+	for _, clientID := range clientIDs {
+		ibctmtypes.BackfillConsensusMetadata(ctx, m.keeper.ClientStore(ctx, clientID))
+	}
+
+	return nil
+}
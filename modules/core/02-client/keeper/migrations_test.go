@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/02-client/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// TestMigrate2to3 tests that consensus metadata is backfilled for consensus states
+// which are missing it and that the backfilled consensus states are pruned on update.
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	var heights []exported.Height
+	for i := 0; i < 3; i++ {
+		heights = append(heights, path.EndpointA.GetClientState().GetLatestHeight())
+		suite.coordinator.IncrementTimeBy(time.Hour)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+	}
+
+	// remove the consensus metadata of all but the latest consensus state
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+	for _, height := range heights {
+		clientStore.Delete(ibctmtypes.IterationKey(height))
+		clientStore.Delete(ibctmtypes.ProcessedHeightKey(height))
+		clientStore.Delete(ibctmtypes.ProcessedTimeKey(height))
+	}
+
+	latestHeight := path.EndpointA.GetClientState().GetLatestHeight()
+	expProcessedTime, ok := ibctmtypes.GetProcessedTime(clientStore, latestHeight)
+	suite.Require().True(ok)
+
+	migrator := keeper.NewMigrator(suite.chainA.App.GetIBCKeeper().ClientKeeper)
+	err := migrator.Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	for _, height := range heights {
+		suite.Require().NotNil(ibctmtypes.GetIterationKey(clientStore, height))
+
+		processedHeight, ok := ibctmtypes.GetProcessedHeight(clientStore, height)
+		suite.Require().True(ok)
+		suite.Require().Equal(ctx.BlockHeight(), int64(processedHeight.GetRevisionHeight()))
+
+		processedTime, ok := ibctmtypes.GetProcessedTime(clientStore, height)
+		suite.Require().True(ok)
+		suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), processedTime)
+	}
+
+	// existing metadata is not overwritten
+	processedTime, ok := ibctmtypes.GetProcessedTime(clientStore, latestHeight)
+	suite.Require().True(ok)
+	suite.Require().Equal(expProcessedTime, processedTime)
+
+	// the backfilled consensus states are pruned once they have passed the trusting period
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod - time.Hour)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	for _, height := range heights {
+		_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, height)
+		suite.Require().False(found)
+	}
+
+	_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, latestHeight)
+	suite.Require().True(found)
+}
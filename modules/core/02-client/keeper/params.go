@@ -13,9 +13,19 @@ func (k Keeper) GetAllowedClients(ctx sdk.Context) []string {
 	return res
 }
 
+// GetPermissionedClientCreation retrieves the permissioned client creation flag from the paramstore.
+// Client creation is permissionless if the parameter has not been set.
+func (k Keeper) GetPermissionedClientCreation(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyPermissionedClientCreation, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-client parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetAllowedClients(ctx)...)
+	params := types.NewParams(k.GetAllowedClients(ctx)...)
+	params.PermissionedClientCreation = k.GetPermissionedClientCreation(ctx)
+	return params
 }
 
 // SetParams sets the total set of ibc-client parameters.
@@ -14,4 +14,10 @@ func (suite *KeeperTestSuite) TestParams() {
 	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Empty(expParams.AllowedClients)
+
+	expParams.PermissionedClientCreation = true
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().True(params.PermissionedClientCreation)
+	suite.Require().True(suite.chainA.App.GetIBCKeeper().ClientKeeper.GetPermissionedClientCreation(suite.chainA.GetContext()))
 }
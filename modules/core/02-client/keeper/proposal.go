@@ -70,7 +70,31 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 	}()
 
 	// emitting events in the keeper for proposal updates to clients
-	EmitUpdateClientProposalEvent(ctx, p.SubjectClientId, clientState)
+	EmitUpdateClientProposalEvent(ctx, p.SubjectClientId, p.SubstituteClientId, subjectClientState.GetLatestHeight(), clientState)
+
+	return nil
+}
+
+// CreateClientProposal creates a new client with the client state and consensus state
+// provided by the proposal. Clients created through governance are subject to the
+// same allowlist as clients created with MsgCreateClient.
+func (k Keeper) CreateClientProposal(ctx sdk.Context, p *types.CreateClientProposal) error {
+	clientState, err := types.UnpackClientState(p.ClientState)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not unpack client state")
+	}
+
+	consensusState, err := types.UnpackConsensusState(p.ConsensusState)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not unpack consensus state")
+	}
+
+	clientID, err := k.CreateClient(ctx, clientState, consensusState)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("client created after governance proposal passed", "client-id", clientID)
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -96,6 +97,16 @@ func (suite *KeeperTestSuite) TestClientUpdateProposal() {
 				content = types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, substitute)
 			}, false,
 		},
+		{
+			"subject and substitute have different chain IDs", func() {
+				tmClientState, ok := substituteClientState.(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.ChainId = "different-chain"
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+
+				content = types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, substitute)
+			}, false,
+		},
 		{
 			"substitute is frozen", func() {
 				tmClientState, ok := substituteClientState.(*ibctmtypes.ClientState)
@@ -156,6 +167,144 @@ func (suite *KeeperTestSuite) TestClientUpdateProposal() {
 	}
 }
 
+// TestClientUpdateProposalExpiredSubject expires the subject client by advancing the block time
+// past its trusting period and recovers it using a substitute client created afterwards.
+func (suite *KeeperTestSuite) TestClientUpdateProposalExpiredSubject() {
+	subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(subjectPath)
+	subject := subjectPath.EndpointA.ClientID
+	subjectClientState := subjectPath.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+
+	suite.coordinator.IncrementTimeBy(subjectClientState.TrustingPeriod)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().Equal(exported.Expired, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, subjectClientState, subject))
+
+	substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(substitutePath)
+	substitute := substitutePath.EndpointA.ClientID
+	substituteClientState := substitutePath.EndpointA.GetClientState()
+
+	ctx = suite.chainA.GetContext()
+	content := &types.ClientUpdateProposal{
+		Title:              ibctesting.Title,
+		Description:        ibctesting.Description,
+		SubjectClientId:    subject,
+		SubstituteClientId: substitute,
+	}
+	err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientUpdateProposal(ctx, content)
+	suite.Require().NoError(err)
+
+	// the subject is re-activated using the latest consensus state of the substitute
+	clientState := suite.chainA.GetClientState(subject)
+	suite.Require().Equal(substituteClientState.GetLatestHeight(), clientState.GetLatestHeight())
+	suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, clientState, subject))
+
+	expEvent := sdk.NewEvent(
+		types.EventTypeUpdateClientProposal,
+		sdk.NewAttribute(types.AttributeKeySubjectClientID, subject),
+		sdk.NewAttribute(types.AttributeKeySubstituteClientID, substitute),
+		sdk.NewAttribute(types.AttributeKeyClientType, exported.Tendermint),
+		sdk.NewAttribute(types.AttributeKeyPreviousConsensusHeight, subjectClientState.GetLatestHeight().String()),
+		sdk.NewAttribute(types.AttributeKeyConsensusHeight, substituteClientState.GetLatestHeight().String()),
+	)
+	suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+
+	// the recovered subject can be updated again
+	suite.Require().NoError(subjectPath.EndpointA.UpdateClient())
+}
+
+func (suite *KeeperTestSuite) TestCreateClientProposal() {
+	var (
+		clientState    exported.ClientState
+		consensusState exported.ConsensusState
+		content        govtypes.Content
+		err            error
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid create client proposal", func() {
+				content, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, consensusState)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"valid create client proposal with permissioned client creation", func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.PermissionedClientCreation = true
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				content, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, consensusState)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"client type not in allowlist", func() {
+				params := types.NewParams(exported.Solomachine)
+				params.PermissionedClientCreation = true
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				content, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, consensusState)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"cannot unpack client state", func() {
+				any, err := types.PackConsensusState(consensusState)
+				suite.Require().NoError(err)
+				content = &types.CreateClientProposal{
+					Title:          ibctesting.Title,
+					Description:    ibctesting.Description,
+					ClientState:    any,
+					ConsensusState: any,
+				}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientState = path.EndpointA.GetClientState()
+			consensusState = path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+			tc.malleate()
+
+			createProp, ok := content.(*types.CreateClientProposal)
+			suite.Require().True(ok)
+
+			ctx := suite.chainA.GetContext()
+			expClientID := types.FormatClientIdentifier(exported.Tendermint, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetNextClientSequence(ctx))
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClientProposal(ctx, createProp)
+
+			storedClientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, expClientID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(clientState, storedClientState)
+
+				storedConsensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, expClientID, clientState.GetLatestHeight())
+				suite.Require().True(found)
+				suite.Require().Equal(consensusState, storedConsensusState)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestHandleUpgradeProposal() {
 	var (
 		upgradedClientState *ibctmtypes.ClientState
@@ -178,6 +178,15 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyPacketCommitmentAbsence panics!
+func (cs ClientState) VerifyPacketCommitmentAbsence(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // VerifyNextSequenceRecv panics!
 func (cs ClientState) VerifyNextSequenceRecv(
 	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
@@ -15,6 +15,8 @@ func NewClientProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.ClientUpdateProposal:
 			return k.ClientUpdateProposal(ctx, c)
+		case *types.CreateClientProposal:
+			return k.CreateClientProposal(ctx, c)
 		case *types.UpgradeProposal:
 			return k.HandleUpgradeProposal(ctx, c)
 
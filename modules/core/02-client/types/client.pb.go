@@ -9,16 +9,20 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -243,6 +247,54 @@ func (m *ClientUpdateProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientUpdateProposal proto.InternalMessageInfo
 
+// CreateClientProposal is a governance proposal to create a new IBC client. When
+// permissioned client creation is enabled, clients may only be created through
+// this proposal.
+type CreateClientProposal struct {
+	// the title of the create client proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// light client state
+	ClientState *types.Any `protobuf:"bytes,3,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty" yaml:"client_state"`
+	// consensus state associated with the client that corresponds to a given
+	// height.
+	ConsensusState *types.Any `protobuf:"bytes,4,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty" yaml:"consensus_state"`
+}
+
+func (m *CreateClientProposal) Reset()         { *m = CreateClientProposal{} }
+func (m *CreateClientProposal) String() string { return proto.CompactTextString(m) }
+func (*CreateClientProposal) ProtoMessage()    {}
+func (*CreateClientProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{4}
+}
+func (m *CreateClientProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateClientProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateClientProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateClientProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClientProposal.Merge(m, src)
+}
+func (m *CreateClientProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateClientProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClientProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClientProposal proto.InternalMessageInfo
+
 // UpgradeProposal is a gov Content type for initiating an IBC breaking
 // upgrade.
 type UpgradeProposal struct {
@@ -261,7 +313,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{4}
+	return fileDescriptor_b6bc4c8185546947, []int{5}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +362,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{6}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,17 +391,103 @@ func (m *Height) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Height proto.InternalMessageInfo
 
+// MisbehaviourRecord records the submission of the misbehaviour evidence which
+// froze a client.
+type MisbehaviourRecord struct {
+	// type of the submitted misbehaviour (duplicate_header or time_violation)
+	MisbehaviourType string `protobuf:"bytes,1,opt,name=misbehaviour_type,json=misbehaviourType,proto3" json:"misbehaviour_type,omitempty" yaml:"misbehaviour_type"`
+	// height of the counterparty chain at which the misbehaviour occurred
+	MisbehaviourHeight Height `protobuf:"bytes,2,opt,name=misbehaviour_height,json=misbehaviourHeight,proto3" json:"misbehaviour_height" yaml:"misbehaviour_height"`
+	// height of this chain at which the misbehaviour was submitted
+	SubmitHeight Height `protobuf:"bytes,3,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height" yaml:"submit_height"`
+	// block time of this chain at which the misbehaviour was submitted
+	SubmitTime time.Time `protobuf:"bytes,4,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
+	// address of the account which submitted the misbehaviour
+	Submitter string `protobuf:"bytes,5,opt,name=submitter,proto3" json:"submitter,omitempty"`
+}
+
+func (m *MisbehaviourRecord) Reset()         { *m = MisbehaviourRecord{} }
+func (m *MisbehaviourRecord) String() string { return proto.CompactTextString(m) }
+func (*MisbehaviourRecord) ProtoMessage()    {}
+func (*MisbehaviourRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{7}
+}
+func (m *MisbehaviourRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MisbehaviourRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MisbehaviourRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MisbehaviourRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MisbehaviourRecord.Merge(m, src)
+}
+func (m *MisbehaviourRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MisbehaviourRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MisbehaviourRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MisbehaviourRecord proto.InternalMessageInfo
+
+func (m *MisbehaviourRecord) GetMisbehaviourType() string {
+	if m != nil {
+		return m.MisbehaviourType
+	}
+	return ""
+}
+
+func (m *MisbehaviourRecord) GetMisbehaviourHeight() Height {
+	if m != nil {
+		return m.MisbehaviourHeight
+	}
+	return Height{}
+}
+
+func (m *MisbehaviourRecord) GetSubmitHeight() Height {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return Height{}
+}
+
+func (m *MisbehaviourRecord) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+func (m *MisbehaviourRecord) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
 // Params defines the set of IBC light client parameters.
 type Params struct {
 	// allowed_clients defines the list of allowed client state types.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty" yaml:"allowed_clients"`
+	// permissioned_client_creation defines whether clients may only be created
+	// through a governance proposal.
+	PermissionedClientCreation bool `protobuf:"varint,2,opt,name=permissioned_client_creation,json=permissionedClientCreation,proto3" json:"permissioned_client_creation,omitempty" yaml:"permissioned_client_creation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{8}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,66 +523,89 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetPermissionedClientCreation() bool {
+	if m != nil {
+		return m.PermissionedClientCreation
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
+	proto.RegisterType((*CreateClientProposal)(nil), "ibc.core.client.v1.CreateClientProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
+	proto.RegisterType((*MisbehaviourRecord)(nil), "ibc.core.client.v1.MisbehaviourRecord")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0x13, 0x4b,
-	0x18, 0xf5, 0x3a, 0xbe, 0x56, 0x3c, 0xbe, 0x8a, 0x73, 0x37, 0xce, 0x8d, 0xaf, 0x6f, 0xe4, 0xb1,
-	0x46, 0x14, 0x16, 0x22, 0xbb, 0xd8, 0x20, 0x14, 0xb9, 0xc3, 0x6e, 0x92, 0x02, 0x64, 0x16, 0x45,
-	0x08, 0x1a, 0x6b, 0x7f, 0x26, 0xeb, 0x89, 0xd6, 0x3b, 0xd6, 0xce, 0xac, 0xc1, 0x6f, 0x40, 0x07,
-	0x25, 0x48, 0x29, 0xf2, 0x06, 0x34, 0x3c, 0x02, 0x45, 0xca, 0x88, 0x8a, 0x6a, 0x85, 0x92, 0x86,
-	0xda, 0x4f, 0x80, 0x3c, 0x33, 0x9b, 0xd8, 0xf9, 0x01, 0x04, 0xdd, 0xcc, 0x99, 0xb3, 0x67, 0xce,
-	0x77, 0xbc, 0xc7, 0x0b, 0x20, 0x71, 0x5c, 0xd3, 0xa5, 0x11, 0x36, 0xdd, 0x80, 0xe0, 0x90, 0x9b,
-	0xe3, 0xa6, 0x5a, 0x19, 0xa3, 0x88, 0x72, 0xaa, 0xeb, 0xc4, 0x71, 0x8d, 0x19, 0xc1, 0x50, 0xf0,
-	0xb8, 0x59, 0x2d, 0xfb, 0xd4, 0xa7, 0xe2, 0xd8, 0x9c, 0xad, 0x24, 0xb3, 0xfa, 0x9f, 0x4f, 0xa9,
-	0x1f, 0x60, 0x53, 0xec, 0x9c, 0x78, 0xdf, 0xb4, 0xc3, 0x89, 0x3a, 0xba, 0xe5, 0x52, 0x36, 0xa4,
-	0xcc, 0x8c, 0x47, 0x7e, 0x64, 0x7b, 0xd8, 0x1c, 0x37, 0x1d, 0xcc, 0xed, 0x66, 0xba, 0x4f, 0x05,
-	0x24, 0xab, 0x2f, 0x95, 0xe5, 0x46, 0x1e, 0xa1, 0x43, 0x0d, 0xac, 0xef, 0x7a, 0x38, 0xe4, 0x64,
-	0x9f, 0x60, 0xaf, 0x2b, 0x9c, 0x3c, 0xe5, 0x36, 0xc7, 0x7a, 0x13, 0x14, 0xa4, 0xb1, 0x3e, 0xf1,
-	0x2a, 0x5a, 0x5d, 0x6b, 0x14, 0x3a, 0xe5, 0x69, 0x02, 0x57, 0x27, 0xf6, 0x30, 0x68, 0xa3, 0xf3,
-	0x23, 0x64, 0x2d, 0xcb, 0xf5, 0xae, 0xa7, 0xf7, 0xc0, 0xdf, 0x0a, 0x67, 0x33, 0x89, 0x4a, 0xb6,
-	0xae, 0x35, 0x8a, 0xad, 0xb2, 0x21, 0xfd, 0x1b, 0xa9, 0x7f, 0xe3, 0x61, 0x38, 0xe9, 0x6c, 0x4c,
-	0x13, 0xb8, 0xb6, 0xa0, 0x25, 0x9e, 0x41, 0x56, 0xd1, 0xbd, 0x30, 0x81, 0x3e, 0x68, 0xa0, 0xd2,
-	0xa5, 0x21, 0xc3, 0x21, 0x8b, 0x99, 0x80, 0x9e, 0x11, 0x3e, 0xd8, 0xc1, 0xc4, 0x1f, 0x70, 0x7d,
-	0x1b, 0xe4, 0x07, 0x62, 0x25, 0xec, 0x15, 0x5b, 0x55, 0xe3, 0x6a, 0xa4, 0x86, 0xe4, 0x76, 0x72,
-	0xc7, 0x09, 0xcc, 0x58, 0x8a, 0xaf, 0x3f, 0x07, 0x25, 0x37, 0x55, 0xfd, 0x05, 0xaf, 0xd5, 0x69,
-	0x02, 0xff, 0x55, 0x5e, 0x17, 0x1f, 0x43, 0xd6, 0x8a, 0xbb, 0x60, 0x0f, 0x7d, 0xd2, 0xc0, 0xba,
-	0x8c, 0x71, 0xd1, 0x37, 0xfb, 0x9d, 0x40, 0x5f, 0x81, 0xd5, 0x4b, 0x17, 0xb2, 0x4a, 0xb6, 0xbe,
-	0xd4, 0x28, 0xb6, 0xee, 0x5c, 0x37, 0xeb, 0x4d, 0x49, 0x75, 0xe0, 0x6c, 0xfa, 0x69, 0x02, 0x37,
-	0xae, 0x1d, 0x82, 0x21, 0xab, 0xb4, 0x38, 0x05, 0x43, 0x6f, 0xb2, 0xa0, 0x2c, 0xc7, 0xd8, 0x1b,
-	0x79, 0x36, 0xc7, 0xbd, 0x88, 0x8e, 0x28, 0xb3, 0x03, 0xbd, 0x0c, 0xfe, 0xe2, 0x84, 0x07, 0x58,
-	0x4e, 0x60, 0xc9, 0x8d, 0x5e, 0x07, 0x45, 0x0f, 0x33, 0x37, 0x22, 0x23, 0x4e, 0x68, 0x28, 0xc2,
-	0x2c, 0x58, 0xf3, 0x90, 0xbe, 0x03, 0xfe, 0x61, 0xb1, 0x73, 0x80, 0x5d, 0xde, 0xbf, 0x48, 0x61,
-	0x49, 0xa4, 0xb0, 0x39, 0x4d, 0x60, 0x45, 0x3a, 0xbb, 0x42, 0x41, 0x56, 0x49, 0x61, 0xdd, 0x34,
-	0x94, 0x27, 0xa0, 0xcc, 0x62, 0x87, 0x71, 0xc2, 0x63, 0x8e, 0xe7, 0xc4, 0x72, 0x42, 0x0c, 0x4e,
-	0x13, 0xf8, 0xff, 0xb9, 0xd8, 0x15, 0x16, 0xb2, 0xf4, 0x0b, 0x38, 0x95, 0x6c, 0xa3, 0xd7, 0x47,
-	0x30, 0xf3, 0xf9, 0xe3, 0x56, 0x55, 0x75, 0xc3, 0xa7, 0x63, 0x43, 0x55, 0x69, 0x16, 0x2a, 0xc7,
-	0x21, 0x47, 0xef, 0xb3, 0xa0, 0xb4, 0x27, 0x6b, 0xf5, 0xc7, 0x61, 0x3c, 0x00, 0xb9, 0x51, 0x60,
-	0x87, 0x62, 0xfe, 0x62, 0x6b, 0xd3, 0x50, 0xd7, 0xa6, 0xad, 0x4d, 0xaf, 0xee, 0x05, 0x76, 0xa8,
-	0xde, 0x5c, 0xc1, 0xd7, 0x0f, 0xc0, 0xba, 0xe2, 0x78, 0xfd, 0x85, 0xa6, 0xe5, 0x7e, 0xf0, 0xf6,
-	0xd6, 0xa7, 0x09, 0xdc, 0x94, 0x89, 0x5c, 0xfb, 0x30, 0xb2, 0xd6, 0x52, 0x7c, 0xae, 0xff, 0xed,
-	0xdb, 0xb3, 0x4c, 0xde, 0x1d, 0xc1, 0xcc, 0xb7, 0x23, 0xa8, 0xfd, 0x24, 0x9b, 0x43, 0x0d, 0xe4,
-	0x55, 0x29, 0xbb, 0xa0, 0x14, 0xe1, 0x31, 0x61, 0x84, 0x86, 0xfd, 0x30, 0x1e, 0x3a, 0x38, 0x12,
-	0xe1, 0xe4, 0xe6, 0x4b, 0x74, 0x89, 0x80, 0xac, 0x95, 0x14, 0x79, 0x2c, 0x80, 0x05, 0x11, 0x55,
-	0xf1, 0xec, 0x8d, 0x22, 0x92, 0x30, 0x27, 0x22, 0x9d, 0xb4, 0x97, 0xd3, 0x01, 0xd0, 0x23, 0x90,
-	0xef, 0xd9, 0x91, 0x3d, 0x64, 0x33, 0x61, 0x3b, 0x08, 0xe8, 0xcb, 0xf3, 0x08, 0x58, 0x45, 0xab,
-	0x2f, 0x35, 0x0a, 0xf3, 0xc2, 0x97, 0x08, 0xc8, 0x5a, 0x51, 0x88, 0x4c, 0x87, 0x75, 0xac, 0xe3,
-	0xd3, 0x9a, 0x76, 0x72, 0x5a, 0xd3, 0xbe, 0x9e, 0xd6, 0xb4, 0xb7, 0x67, 0xb5, 0xcc, 0xc9, 0x59,
-	0x2d, 0xf3, 0xe5, 0xac, 0x96, 0x79, 0xb1, 0xed, 0x13, 0x3e, 0x88, 0x1d, 0xc3, 0xa5, 0x43, 0xf5,
-	0x37, 0x6b, 0x12, 0xc7, 0xdd, 0xf2, 0xa9, 0x39, 0xbe, 0x6f, 0x0e, 0xa9, 0x17, 0x07, 0x98, 0xc9,
-	0x8f, 0xc2, 0xdd, 0xd6, 0x96, 0xfa, 0x2e, 0xf0, 0xc9, 0x08, 0x33, 0x27, 0x2f, 0x7e, 0xb2, 0x7b,
-	0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x24, 0x2c, 0x1c, 0x7b, 0x37, 0x06, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
+	0x1b, 0x8e, 0x93, 0x6c, 0xd5, 0x4c, 0xf6, 0xd7, 0x74, 0xa7, 0xe9, 0x6f, 0x43, 0x88, 0xe2, 0x68,
+	0x40, 0xa2, 0x42, 0xd4, 0x26, 0x05, 0xa1, 0x55, 0x6f, 0xa4, 0x97, 0xed, 0x01, 0x54, 0x86, 0x5d,
+	0x21, 0x40, 0x28, 0xf2, 0x9f, 0xd9, 0x64, 0x56, 0xb6, 0xc7, 0xf2, 0x8c, 0x03, 0xf9, 0x06, 0xdc,
+	0xd8, 0x23, 0x48, 0x2b, 0xd1, 0x33, 0x17, 0x2e, 0x1c, 0xf8, 0x00, 0x1c, 0xf6, 0xb8, 0xe2, 0xc4,
+	0xc9, 0xa0, 0xf6, 0xc2, 0x39, 0x9f, 0x00, 0xd9, 0x33, 0x6e, 0xec, 0x24, 0xdb, 0x5d, 0x51, 0x6e,
+	0x9e, 0xe7, 0x7d, 0xe6, 0xfd, 0xf3, 0xf8, 0x7d, 0x5f, 0x1b, 0xe8, 0xd4, 0x76, 0x4c, 0x87, 0x45,
+	0xc4, 0x74, 0x3c, 0x4a, 0x02, 0x61, 0xce, 0x86, 0xea, 0xc9, 0x08, 0x23, 0x26, 0x18, 0x84, 0xd4,
+	0x76, 0x8c, 0x94, 0x60, 0x28, 0x78, 0x36, 0xec, 0xb6, 0x27, 0x6c, 0xc2, 0x32, 0xb3, 0x99, 0x3e,
+	0x49, 0x66, 0xf7, 0xb5, 0x09, 0x63, 0x13, 0x8f, 0x98, 0xd9, 0xc9, 0x8e, 0x1f, 0x99, 0x56, 0x30,
+	0x57, 0x26, 0x7d, 0xd5, 0x24, 0xa8, 0x4f, 0xb8, 0xb0, 0xfc, 0x50, 0x11, 0xde, 0x74, 0x18, 0xf7,
+	0x19, 0x37, 0xe3, 0x70, 0x12, 0x59, 0x2e, 0x31, 0x67, 0x43, 0x9b, 0x08, 0x6b, 0x98, 0x9f, 0xf3,
+	0x08, 0x92, 0x35, 0x96, 0xa1, 0xe5, 0x41, 0x9a, 0xd0, 0x53, 0x0d, 0xec, 0x9f, 0xba, 0x24, 0x10,
+	0xf4, 0x11, 0x25, 0xee, 0x49, 0x96, 0xea, 0xa7, 0xc2, 0x12, 0x04, 0x0e, 0x41, 0x43, 0x66, 0x3e,
+	0xa6, 0x6e, 0x47, 0x1b, 0x68, 0x07, 0x8d, 0x51, 0x7b, 0x91, 0xe8, 0xbb, 0x73, 0xcb, 0xf7, 0x8e,
+	0xd1, 0x95, 0x09, 0xe1, 0x6d, 0xf9, 0x7c, 0xea, 0xc2, 0x33, 0x70, 0x5b, 0xe1, 0x3c, 0x75, 0xd1,
+	0xa9, 0x0e, 0xb4, 0x83, 0xe6, 0x51, 0xdb, 0x90, 0x55, 0x18, 0x79, 0x15, 0xc6, 0x87, 0xc1, 0x7c,
+	0x74, 0x77, 0x91, 0xe8, 0x7b, 0x25, 0x5f, 0xd9, 0x1d, 0x84, 0x9b, 0xce, 0x32, 0x09, 0xf4, 0xb3,
+	0x06, 0x3a, 0x27, 0x2c, 0xe0, 0x24, 0xe0, 0x31, 0xcf, 0xa0, 0xcf, 0xa8, 0x98, 0xde, 0x27, 0x74,
+	0x32, 0x15, 0xf0, 0x1e, 0xd8, 0x9a, 0x66, 0x4f, 0x59, 0x7a, 0xcd, 0xa3, 0xae, 0xb1, 0xae, 0xb9,
+	0x21, 0xb9, 0xa3, 0xfa, 0xb3, 0x44, 0xaf, 0x60, 0xc5, 0x87, 0x9f, 0x83, 0x96, 0x93, 0x7b, 0x7d,
+	0x85, 0x5c, 0xbb, 0x8b, 0x44, 0xff, 0xbf, 0xca, 0xb5, 0x7c, 0x0d, 0xe1, 0x1d, 0xa7, 0x94, 0x1e,
+	0xfa, 0x4d, 0x03, 0xfb, 0x52, 0xc6, 0x72, 0xde, 0xfc, 0xdf, 0x08, 0xfa, 0x0d, 0xd8, 0x5d, 0x09,
+	0xc8, 0x3b, 0xd5, 0x41, 0xed, 0xa0, 0x79, 0xf4, 0xce, 0xa6, 0x5a, 0x5f, 0xa4, 0xd4, 0x48, 0x4f,
+	0xab, 0x5f, 0x24, 0xfa, 0xdd, 0x8d, 0x45, 0x70, 0x84, 0x5b, 0xe5, 0x2a, 0x38, 0xfa, 0xae, 0x0a,
+	0xda, 0xb2, 0x8c, 0x87, 0xa1, 0x6b, 0x09, 0x72, 0x16, 0xb1, 0x90, 0x71, 0xcb, 0x83, 0x6d, 0x70,
+	0x4b, 0x50, 0xe1, 0x11, 0x59, 0x01, 0x96, 0x07, 0x38, 0x00, 0x4d, 0x97, 0x70, 0x27, 0xa2, 0xa1,
+	0xa0, 0x2c, 0xc8, 0xc4, 0x6c, 0xe0, 0x22, 0x04, 0xef, 0x83, 0x3b, 0x3c, 0xb6, 0x1f, 0x13, 0x47,
+	0x8c, 0x97, 0x2a, 0xd4, 0x32, 0x15, 0x7a, 0x8b, 0x44, 0xef, 0xc8, 0xcc, 0xd6, 0x28, 0x08, 0xb7,
+	0x14, 0x76, 0x92, 0x8b, 0xf2, 0x09, 0x68, 0xf3, 0xd8, 0xe6, 0x82, 0x8a, 0x58, 0x90, 0x82, 0xb3,
+	0x7a, 0xe6, 0x4c, 0x5f, 0x24, 0xfa, 0xeb, 0x57, 0xce, 0xd6, 0x58, 0x08, 0xc3, 0x25, 0x9c, 0xbb,
+	0x3c, 0x46, 0xdf, 0x9e, 0xeb, 0x95, 0xdf, 0x7f, 0x39, 0xec, 0xaa, 0xd9, 0x98, 0xb0, 0x99, 0xa1,
+	0x46, 0x29, 0x15, 0x55, 0x90, 0x40, 0xa0, 0x1f, 0x53, 0x45, 0x22, 0x62, 0xe5, 0xd7, 0x6e, 0xac,
+	0xc8, 0xea, 0xb4, 0xd4, 0x6e, 0x3a, 0x2d, 0x9b, 0xda, 0xba, 0xfe, 0xdf, 0xb4, 0xf5, 0x2b, 0x29,
+	0xf4, 0x43, 0x15, 0xb4, 0x1e, 0xca, 0xc5, 0x73, 0x63, 0x71, 0x3e, 0x00, 0xf5, 0xd0, 0xb3, 0x02,
+	0x25, 0x4a, 0xcf, 0x50, 0x61, 0xf3, 0xbd, 0x96, 0x87, 0x3e, 0xf3, 0xac, 0x40, 0xcd, 0x76, 0xc6,
+	0x87, 0x8f, 0xc1, 0xbe, 0xe2, 0xb8, 0xe3, 0x92, 0xba, 0xd7, 0x09, 0x31, 0x58, 0x24, 0x7a, 0x4f,
+	0x0a, 0xb1, 0xf1, 0x32, 0xc2, 0x7b, 0x39, 0x5e, 0xd8, 0x90, 0xc7, 0x6f, 0xa7, 0x9a, 0x7c, 0x7f,
+	0xae, 0x57, 0xfe, 0x3e, 0xd7, 0xb5, 0x97, 0x68, 0xf3, 0x54, 0x03, 0x5b, 0x6a, 0x6d, 0x9d, 0x80,
+	0x56, 0x44, 0x66, 0x94, 0x53, 0x16, 0x8c, 0x83, 0xd8, 0xb7, 0x49, 0x94, 0x89, 0x53, 0x2f, 0xbe,
+	0x8f, 0x15, 0x02, 0xc2, 0x3b, 0x39, 0xf2, 0x71, 0x06, 0x94, 0x9c, 0xa8, 0x25, 0x58, 0x7d, 0xa1,
+	0x13, 0x49, 0x28, 0x38, 0x91, 0x99, 0x1c, 0x6f, 0xe7, 0x05, 0xa0, 0x9f, 0x6a, 0x00, 0x7e, 0x44,
+	0xb9, 0x4d, 0xa6, 0xd6, 0x8c, 0xb2, 0x38, 0xc2, 0xc4, 0x61, 0x91, 0x0b, 0x4f, 0xc1, 0x1d, 0xbf,
+	0x80, 0x8e, 0xc5, 0x3c, 0x54, 0x6f, 0xb2, 0x38, 0xb4, 0x6b, 0x14, 0x84, 0x77, 0x8b, 0xd8, 0x83,
+	0x79, 0x48, 0x20, 0x03, 0x7b, 0x25, 0x5e, 0x21, 0xe9, 0xeb, 0x37, 0x37, 0x52, 0xbb, 0xab, 0xbb,
+	0x21, 0x58, 0x5e, 0x18, 0x2c, 0xa2, 0x4a, 0xe6, 0xaf, 0xc0, 0xff, 0x78, 0x6c, 0xfb, 0x54, 0xe4,
+	0xa1, 0x6a, 0x2f, 0x0d, 0xd5, 0x53, 0xa1, 0xda, 0x57, 0xfb, 0x63, 0x79, 0x1d, 0xe1, 0xdb, 0xf2,
+	0xac, 0xdc, 0x7f, 0x09, 0x9a, 0xca, 0x9e, 0x7e, 0x93, 0x55, 0x7b, 0x75, 0xd7, 0xda, 0xeb, 0x41,
+	0xfe, 0xc1, 0x1e, 0xf5, 0x95, 0x73, 0x58, 0x72, 0x9e, 0x5e, 0x46, 0x4f, 0xfe, 0xd4, 0x35, 0x0c,
+	0x24, 0x92, 0x5e, 0x80, 0x3d, 0xd0, 0x90, 0x27, 0x41, 0xa2, 0xce, 0xad, 0x6c, 0x3a, 0x96, 0x00,
+	0xfa, 0x55, 0x03, 0x5b, 0x67, 0x56, 0x64, 0xf9, 0x3c, 0x6d, 0x03, 0xcb, 0xf3, 0xd8, 0xd7, 0x57,
+	0x0d, 0xcb, 0x3b, 0xda, 0xa0, 0x76, 0xd0, 0x28, 0xb6, 0xc1, 0x0a, 0x01, 0xe1, 0x1d, 0x85, 0xc8,
+	0x5e, 0xe6, 0x90, 0x82, 0x5e, 0x48, 0x22, 0x9f, 0xf2, 0xb4, 0x35, 0x96, 0xad, 0xef, 0xa4, 0xcb,
+	0x2e, 0x1f, 0xcf, 0xed, 0xd1, 0x5b, 0x8b, 0x44, 0x7f, 0x43, 0x7a, 0xbc, 0x8e, 0x8d, 0x70, 0xb7,
+	0x68, 0x56, 0x9f, 0x42, 0x65, 0x1c, 0xe1, 0x67, 0x17, 0x7d, 0xed, 0xf9, 0x45, 0x5f, 0xfb, 0xeb,
+	0xa2, 0xaf, 0x3d, 0xb9, 0xec, 0x57, 0x9e, 0x5f, 0xf6, 0x2b, 0x7f, 0x5c, 0xf6, 0x2b, 0x5f, 0xdc,
+	0x9b, 0x50, 0x31, 0x8d, 0x6d, 0xc3, 0x61, 0xbe, 0xfa, 0x43, 0x31, 0xa9, 0xed, 0x1c, 0x4e, 0x98,
+	0x39, 0x7b, 0xdf, 0xf4, 0x99, 0x1b, 0x7b, 0x84, 0xcb, 0x1f, 0xae, 0x77, 0x8f, 0x0e, 0xd5, 0x3f,
+	0x57, 0xda, 0x6b, 0xdc, 0xde, 0xca, 0xc4, 0x7e, 0xef, 0x9f, 0x01, 0x00, 0x39, 0x9e, 0x85, 0x94,
+	0x93, 0x09, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -662,6 +823,67 @@ func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateClientProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateClientProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateClientProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -754,6 +976,71 @@ func (m *Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MisbehaviourRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MisbehaviourRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MisbehaviourRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintClient(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.SubmitHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MisbehaviourHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MisbehaviourType) > 0 {
+		i -= len(m.MisbehaviourType)
+		copy(dAtA[i:], m.MisbehaviourType)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.MisbehaviourType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -774,6 +1061,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PermissionedClientCreation {
+		i--
+		if m.PermissionedClientCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -873,6 +1170,31 @@ func (m *ClientUpdateProposal) Size() (n int) {
 	return n
 }
 
+func (m *CreateClientProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
 func (m *UpgradeProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -911,6 +1233,29 @@ func (m *Height) Size() (n int) {
 	return n
 }
 
+func (m *MisbehaviourRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MisbehaviourType)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.MisbehaviourHeight.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = m.SubmitHeight.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovClient(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -923,6 +1268,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if m.PermissionedClientCreation {
+		n += 2
+	}
 	return n
 }
 
@@ -1463,7 +1811,7 @@ func (m *ClientUpdateProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
+func (m *CreateClientProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,10 +1834,10 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateClientProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateClientProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1558,7 +1906,7 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1585,13 +1933,16 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1618,10 +1969,10 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpgradedClientState == nil {
-				m.UpgradedClientState = &types.Any{}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
 			}
-			if err := m.UpgradedClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1646,7 +1997,7 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Height) Unmarshal(dAtA []byte) error {
+func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,17 +2020,200 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.RevisionNumber = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradedClientState == nil {
+				m.UpgradedClientState = &types.Any{}
+			}
+			if err := m.UpgradedClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Height) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1734,6 +2268,219 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MisbehaviourRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MisbehaviourRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MisbehaviourRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisbehaviourType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MisbehaviourHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmitHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1795,6 +2542,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedClientCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionedClientCreation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
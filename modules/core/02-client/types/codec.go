@@ -37,6 +37,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&ClientUpdateProposal{},
+		&CreateClientProposal{},
 		&UpgradeProposal{},
 	)
 	registry.RegisterImplementations(
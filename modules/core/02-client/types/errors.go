@@ -34,4 +34,6 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrConsensusStatePruned                   = sdkerrors.Register(SubModuleName, 30, "consensus state pruned")
+	ErrClientCreationNotPermitted             = sdkerrors.Register(SubModuleName, 31, "client creation is only permitted through governance")
 )
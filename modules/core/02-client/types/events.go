@@ -8,19 +8,24 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID          = "client_id"
-	AttributeKeySubjectClientID   = "subject_client_id"
-	AttributeKeyClientType        = "client_type"
-	AttributeKeyConsensusHeight   = "consensus_height"
-	AttributeKeyHeader            = "header"
-	AttributeKeyUpgradePlanTitle  = "title"
-	AttributeKeyUpgradePlanHeight = "height"
+	AttributeKeyClientID                = "client_id"
+	AttributeKeySubjectClientID         = "subject_client_id"
+	AttributeKeySubstituteClientID      = "substitute_client_id"
+	AttributeKeyPreviousConsensusHeight = "previous_consensus_height"
+	AttributeKeyClientType              = "client_type"
+	AttributeKeyConsensusHeight         = "consensus_height"
+	AttributeKeyHeader                  = "header"
+	AttributeKeyUpgradePlanTitle        = "title"
+	AttributeKeyUpgradePlanHeight       = "height"
+	AttributeKeyFrozenHeight            = "frozen_height"
+	AttributeKeyMisbehaviourType        = "misbehaviour_type"
 )
 
 // IBC client events vars
 var (
 	EventTypeCreateClient          = "create_client"
 	EventTypeUpdateClient          = "update_client"
+	EventTypeUpdateExpiredClient   = "update_expired_client"
 	EventTypeUpgradeClient         = "upgrade_client"
 	EventTypeSubmitMisbehaviour    = "client_misbehaviour"
 	EventTypeUpdateClientProposal  = "update_client_proposal"
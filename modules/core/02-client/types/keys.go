@@ -24,6 +24,10 @@ const (
 	// KeyNextClientSequence is the key used to store the next client sequence in
 	// the keeper.
 	KeyNextClientSequence = "nextClientSequence"
+
+	// KeyMisbehaviourRecord is the key used to store the record of the misbehaviour
+	// submission which froze a client in the client store.
+	KeyMisbehaviourRecord = "misbehaviourRecord"
 )
 
 // FormatClientIdentifier returns the client identifier with the sequence appended.
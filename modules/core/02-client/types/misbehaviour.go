@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// Misbehaviour types reported in misbehaviour events and records
+const (
+	// MisbehaviourTypeDuplicateHeader is misbehaviour in which two conflicting headers
+	// were committed at the same height.
+	MisbehaviourTypeDuplicateHeader = "duplicate_header"
+
+	// MisbehaviourTypeTimeViolation is misbehaviour in which two headers at different
+	// heights violate monotonically increasing time.
+	MisbehaviourTypeTimeViolation = "time_violation"
+)
+
+// NewMisbehaviourRecord creates a new MisbehaviourRecord for misbehaviour submitted
+// at the current block.
+func NewMisbehaviourRecord(ctx sdk.Context, misbehaviourType string, misbehaviourHeight exported.Height, submitter string) MisbehaviourRecord {
+	return MisbehaviourRecord{
+		MisbehaviourType:   misbehaviourType,
+		MisbehaviourHeight: NewHeight(misbehaviourHeight.GetRevisionNumber(), misbehaviourHeight.GetRevisionHeight()),
+		SubmitHeight:       GetSelfHeight(ctx),
+		SubmitTime:         ctx.BlockTime(),
+		Submitter:          submitter,
+	}
+}
@@ -15,6 +15,8 @@ var (
 
 	// KeyAllowedClients is store's key for AllowedClients Params
 	KeyAllowedClients = []byte("AllowedClients")
+	// KeyPermissionedClientCreation is store's key for PermissionedClientCreation Params
+	KeyPermissionedClientCreation = []byte("PermissionedClientCreation")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -36,13 +38,18 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	return validatePermissionedClientCreation(p.PermissionedClientCreation)
 }
 
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedClients, p.AllowedClients, validateClients),
+		paramtypes.NewParamSetPair(KeyPermissionedClientCreation, p.PermissionedClientCreation, validatePermissionedClientCreation),
 	}
 }
 
@@ -70,3 +77,11 @@ func validateClients(i interface{}) error {
 
 	return nil
 }
+
+func validatePermissionedClientCreation(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
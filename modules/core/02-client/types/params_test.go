@@ -17,6 +17,7 @@ func TestValidateParams(t *testing.T) {
 		{"default params", DefaultParams(), true},
 		{"custom params", NewParams(exported.Tendermint), true},
 		{"blank client", NewParams(" "), false},
+		{"permissioned client creation", Params{AllowedClients: DefaultAllowedClients, PermissionedClientCreation: true}, true},
 	}
 
 	for _, tc := range testCases {
@@ -14,17 +14,22 @@ import (
 const (
 	// ProposalTypeClientUpdate defines the type for a ClientUpdateProposal
 	ProposalTypeClientUpdate = "ClientUpdate"
+	// ProposalTypeCreateClient defines the type for a CreateClientProposal
+	ProposalTypeCreateClient = "CreateClient"
 	ProposalTypeUpgrade      = "IBCUpgrade"
 )
 
 var (
 	_ govtypes.Content                   = &ClientUpdateProposal{}
+	_ govtypes.Content                   = &CreateClientProposal{}
 	_ govtypes.Content                   = &UpgradeProposal{}
+	_ codectypes.UnpackInterfacesMessage = &CreateClientProposal{}
 	_ codectypes.UnpackInterfacesMessage = &UpgradeProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeClientUpdate)
+	govtypes.RegisterProposalType(ProposalTypeCreateClient)
 	govtypes.RegisterProposalType(ProposalTypeUpgrade)
 }
 
@@ -70,6 +75,80 @@ func (cup *ClientUpdateProposal) ValidateBasic() error {
 	return nil
 }
 
+// NewCreateClientProposal creates a new create client proposal.
+func NewCreateClientProposal(title, description string, clientState exported.ClientState, consensusState exported.ConsensusState) (govtypes.Content, error) {
+	anyClientState, err := PackClientState(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	anyConsensusState, err := PackConsensusState(consensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateClientProposal{
+		Title:          title,
+		Description:    description,
+		ClientState:    anyClientState,
+		ConsensusState: anyConsensusState,
+	}, nil
+}
+
+// GetTitle returns the title of a create client proposal.
+func (ccp *CreateClientProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a create client proposal.
+func (ccp *CreateClientProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a create client proposal.
+func (ccp *CreateClientProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a create client proposal.
+func (ccp *CreateClientProposal) ProposalType() string { return ProposalTypeCreateClient }
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp *CreateClientProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ccp); err != nil {
+		return err
+	}
+
+	clientState, err := UnpackClientState(ccp.ClientState)
+	if err != nil {
+		return err
+	}
+	if err := clientState.Validate(); err != nil {
+		return err
+	}
+	if clientState.ClientType() == exported.Localhost {
+		return sdkerrors.Wrap(ErrInvalidClient, "localhost client can only be created on chain initialization")
+	}
+
+	consensusState, err := UnpackConsensusState(ccp.ConsensusState)
+	if err != nil {
+		return err
+	}
+	if clientState.ClientType() != consensusState.ClientType() {
+		return sdkerrors.Wrap(ErrInvalidClientType, "client type for client state and consensus state do not match")
+	}
+	if err := ValidateClientType(clientState.ClientType()); err != nil {
+		return sdkerrors.Wrap(err, "client type does not meet naming constraints")
+	}
+
+	return consensusState.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ccp CreateClientProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var clientState exported.ClientState
+	if err := unpacker.UnpackAny(ccp.ClientState, &clientState); err != nil {
+		return err
+	}
+
+	var consensusState exported.ConsensusState
+	return unpacker.UnpackAny(ccp.ConsensusState, &consensusState)
+}
+
 // NewUpgradeProposal creates a new IBC breaking upgrade proposal.
 func NewUpgradeProposal(title, description string, plan upgradetypes.Plan, upgradedClientState exported.ClientState) (govtypes.Content, error) {
 	any, err := PackClientState(upgradedClientState)
@@ -88,6 +88,122 @@ func (suite *TypesTestSuite) TestMarshalClientUpdateProposalProposal() {
 	suite.Require().NoError(err)
 }
 
+func (suite *TypesTestSuite) TestCreateClientProposalValidateBasic() {
+	var (
+		proposal govtypes.Content
+		err      error
+	)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	clientState := path.EndpointA.GetClientState()
+	consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {
+				proposal, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, consensusState)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"fails validate abstract - empty title", func() {
+				proposal, err = types.NewCreateClientProposal("", ibctesting.Description, clientState, consensusState)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"invalid client state", func() {
+				invalidClientState := *clientState.(*ibctmtypes.ClientState)
+				invalidClientState.ChainId = ""
+				proposal, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, &invalidClientState, consensusState)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"invalid consensus state", func() {
+				proposal, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, &ibctmtypes.ConsensusState{})
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"client state and consensus state types do not match", func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", 1)
+				proposal, err = types.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, solomachine.ConsensusState())
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"client state is nil", func() {
+				any, err := types.PackConsensusState(consensusState)
+				suite.Require().NoError(err)
+				proposal = &types.CreateClientProposal{
+					Title:          ibctesting.Title,
+					Description:    ibctesting.Description,
+					ConsensusState: any,
+				}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		tc.malleate()
+
+		err := proposal.ValidateBasic()
+
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+// tests a create client proposal can be marshaled and unmarshaled, and client state and consensus state can be unpacked
+func (suite *TypesTestSuite) TestMarshalCreateClientProposal() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	clientState := path.EndpointA.GetClientState()
+	consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+	content, err := types.NewCreateClientProposal("title", "description", clientState, consensusState)
+	suite.Require().NoError(err)
+
+	ccp, ok := content.(*types.CreateClientProposal)
+	suite.Require().True(ok)
+
+	// create codec
+	ir := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(ir)
+	govtypes.RegisterInterfaces(ir)
+	ibctmtypes.RegisterInterfaces(ir)
+	cdc := codec.NewProtoCodec(ir)
+
+	// marshal message
+	bz, err := cdc.MarshalJSON(ccp)
+	suite.Require().NoError(err)
+
+	// unmarshal proposal
+	newCcp := &types.CreateClientProposal{}
+	err = cdc.UnmarshalJSON(bz, newCcp)
+	suite.Require().NoError(err)
+
+	// unpack client state and consensus state
+	unpackedClientState, err := types.UnpackClientState(newCcp.ClientState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clientState, unpackedClientState)
+
+	unpackedConsensusState, err := types.UnpackConsensusState(newCcp.ConsensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(consensusState, unpackedConsensusState)
+}
+
 func (suite *TypesTestSuite) TestUpgradeProposalValidateBasic() {
 	var (
 		proposal govtypes.Content
@@ -88,6 +88,8 @@ type QueryClientStateResponse struct {
 	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 	// height at which the proof was retrieved
 	ProofHeight Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// current status of the client (Active, Expired, Frozen or Unknown)
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (m *QueryClientStateResponse) Reset()         { *m = QueryClientStateResponse{} }
@@ -144,6 +146,13 @@ func (m *QueryClientStateResponse) GetProofHeight() Height {
 	return Height{}
 }
 
+func (m *QueryClientStateResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 // QueryClientStatesRequest is the request type for the Query/ClientStates RPC
 // method
 type QueryClientStatesRequest struct {
@@ -198,6 +207,8 @@ type QueryClientStatesResponse struct {
 	ClientStates IdentifiedClientStates `protobuf:"bytes,1,rep,name=client_states,json=clientStates,proto3,castrepeated=IdentifiedClientStates" json:"client_states"`
 	// pagination response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// current status of each client, in the same order as client_states
+	Statuses []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
 }
 
 func (m *QueryClientStatesResponse) Reset()         { *m = QueryClientStatesResponse{} }
@@ -247,6 +258,13 @@ func (m *QueryClientStatesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryClientStatesResponse) GetStatuses() []string {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 // QueryConsensusStateRequest is the request type for the Query/ConsensusState
 // RPC method. Besides the consensus state, it includes a proof and the height
 // from which the proof was retrieved.
@@ -705,6 +723,119 @@ func (m *QueryClientStatusResponse) GetStatus() string {
 	return ""
 }
 
+// QueryFrozenClientsRequest is the request type for the Query/FrozenClients RPC
+// method
+type QueryFrozenClientsRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenClientsRequest) Reset()         { *m = QueryFrozenClientsRequest{} }
+func (m *QueryFrozenClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenClientsRequest) ProtoMessage()    {}
+func (*QueryFrozenClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+}
+func (m *QueryFrozenClientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenClientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenClientsRequest.Merge(m, src)
+}
+func (m *QueryFrozenClientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenClientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenClientsRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenClientsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenClientsResponse is the response type for the Query/FrozenClients
+// RPC method.
+type QueryFrozenClientsResponse struct {
+	// list of frozen ClientStates of the chain.
+	ClientStates IdentifiedClientStates `protobuf:"bytes,1,rep,name=client_states,json=clientStates,proto3,castrepeated=IdentifiedClientStates" json:"client_states"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// record of the misbehaviour submission which froze each client, in the same
+	// order as client_states. The record is empty if none was stored.
+	MisbehaviourRecords []MisbehaviourRecord `protobuf:"bytes,3,rep,name=misbehaviour_records,json=misbehaviourRecords,proto3" json:"misbehaviour_records"`
+}
+
+func (m *QueryFrozenClientsResponse) Reset()         { *m = QueryFrozenClientsResponse{} }
+func (m *QueryFrozenClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenClientsResponse) ProtoMessage()    {}
+func (*QueryFrozenClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+}
+func (m *QueryFrozenClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenClientsResponse.Merge(m, src)
+}
+func (m *QueryFrozenClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenClientsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenClientsResponse) GetClientStates() IdentifiedClientStates {
+	if m != nil {
+		return m.ClientStates
+	}
+	return nil
+}
+
+func (m *QueryFrozenClientsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryFrozenClientsResponse) GetMisbehaviourRecords() []MisbehaviourRecord {
+	if m != nil {
+		return m.MisbehaviourRecords
+	}
+	return nil
+}
+
 // QueryClientParamsRequest is the request type for the Query/ClientParams RPC
 // method.
 type QueryClientParamsRequest struct {
@@ -714,7 +845,7 @@ func (m *QueryClientParamsRequest) Reset()         { *m = QueryClientParamsReque
 func (m *QueryClientParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsRequest) ProtoMessage()    {}
 func (*QueryClientParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
 }
 func (m *QueryClientParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +885,7 @@ func (m *QueryClientParamsResponse) Reset()         { *m = QueryClientParamsResp
 func (m *QueryClientParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsResponse) ProtoMessage()    {}
 func (*QueryClientParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
 }
 func (m *QueryClientParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +930,7 @@ func (m *QueryUpgradedClientStateRequest) Reset()         { *m = QueryUpgradedCl
 func (m *QueryUpgradedClientStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedClientStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
 }
 func (m *QueryUpgradedClientStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,7 +970,7 @@ func (m *QueryUpgradedClientStateResponse) Reset()         { *m = QueryUpgradedC
 func (m *QueryUpgradedClientStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedClientStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
 }
 func (m *QueryUpgradedClientStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1015,7 @@ func (m *QueryUpgradedConsensusStateRequest) Reset()         { *m = QueryUpgrade
 func (m *QueryUpgradedConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{18}
 }
 func (m *QueryUpgradedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1055,7 @@ func (m *QueryUpgradedConsensusStateResponse) Reset()         { *m = QueryUpgrad
 func (m *QueryUpgradedConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{19}
 }
 func (m *QueryUpgradedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,6 +1104,8 @@ func init() {
 	proto.RegisterType((*QueryConsensusStateHeightsResponse)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsResponse")
 	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
 	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
+	proto.RegisterType((*QueryFrozenClientsRequest)(nil), "ibc.core.client.v1.QueryFrozenClientsRequest")
+	proto.RegisterType((*QueryFrozenClientsResponse)(nil), "ibc.core.client.v1.QueryFrozenClientsResponse")
 	proto.RegisterType((*QueryClientParamsRequest)(nil), "ibc.core.client.v1.QueryClientParamsRequest")
 	proto.RegisterType((*QueryClientParamsResponse)(nil), "ibc.core.client.v1.QueryClientParamsResponse")
 	proto.RegisterType((*QueryUpgradedClientStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedClientStateRequest")
@@ -984,73 +1117,81 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
 
 var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 1056 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xa4, 0x69, 0xd4, 0x3e, 0xbb, 0x09, 0x9a, 0xe6, 0xc3, 0xdd, 0x16, 0xc7, 0xd9, 0x20,
-	0x9a, 0x96, 0x64, 0x27, 0x71, 0xda, 0x24, 0x42, 0x42, 0x82, 0x54, 0x2a, 0xed, 0xa5, 0x94, 0x45,
-	0x08, 0x84, 0x84, 0xa2, 0xdd, 0xf5, 0x64, 0xb3, 0x92, 0xbd, 0xe3, 0x7a, 0x76, 0x2d, 0x45, 0x55,
-	0x2e, 0x3d, 0x21, 0x4e, 0x48, 0x48, 0x5c, 0x91, 0x38, 0x72, 0xa8, 0x38, 0x20, 0x71, 0xe5, 0x04,
-	0x39, 0x70, 0xa8, 0x04, 0x07, 0x4e, 0x14, 0x25, 0xfc, 0x21, 0xc8, 0x33, 0xb3, 0xf6, 0xae, 0x3d,
-	0xae, 0xd7, 0xa8, 0xf4, 0xb6, 0xfb, 0x3e, 0x7f, 0xef, 0xf7, 0x9e, 0xdf, 0x5b, 0x43, 0x39, 0x70,
-	0x3d, 0xe2, 0xb1, 0x16, 0x25, 0x5e, 0x3d, 0xa0, 0x61, 0x44, 0xda, 0x9b, 0xe4, 0x51, 0x4c, 0x5b,
-	0x47, 0x56, 0xb3, 0xc5, 0x22, 0x86, 0x71, 0xe0, 0x7a, 0x56, 0x47, 0x6f, 0x49, 0xbd, 0xd5, 0xde,
-	0x34, 0x6e, 0x7a, 0x8c, 0x37, 0x18, 0x27, 0xae, 0xc3, 0xa9, 0x34, 0x26, 0xed, 0x4d, 0x97, 0x46,
-	0xce, 0x26, 0x69, 0x3a, 0x7e, 0x10, 0x3a, 0x51, 0xc0, 0x42, 0xe9, 0x6f, 0x2c, 0x69, 0xe2, 0xab,
-	0x48, 0xd2, 0xe0, 0x8a, 0xcf, 0x98, 0x5f, 0xa7, 0x44, 0xbc, 0xb9, 0xf1, 0x01, 0x71, 0x42, 0x95,
-	0xdb, 0xb8, 0xa6, 0x54, 0x4e, 0x33, 0x20, 0x4e, 0x18, 0xb2, 0x48, 0x04, 0xe6, 0x4a, 0x3b, 0xe7,
-	0x33, 0x9f, 0x89, 0x47, 0xd2, 0x79, 0x92, 0x52, 0x73, 0x1b, 0x16, 0x3f, 0xec, 0x20, 0xba, 0x23,
-	0x72, 0x7c, 0x14, 0x39, 0x11, 0xb5, 0xe9, 0xa3, 0x98, 0xf2, 0x08, 0x5f, 0x85, 0x8b, 0x32, 0xf3,
-	0x7e, 0x50, 0x2b, 0xa1, 0x0a, 0x5a, 0xbd, 0x68, 0x5f, 0x90, 0x82, 0xfb, 0x35, 0xf3, 0x29, 0x82,
-	0xd2, 0xa0, 0x23, 0x6f, 0xb2, 0x90, 0x53, 0xbc, 0x03, 0x45, 0xe5, 0xc9, 0x3b, 0x72, 0xe1, 0x5c,
-	0xa8, 0xce, 0x59, 0x12, 0x9f, 0x95, 0x40, 0xb7, 0xde, 0x0b, 0x8f, 0xec, 0x82, 0xd7, 0x0b, 0x80,
-	0xe7, 0xe0, 0x7c, 0xb3, 0xc5, 0xd8, 0x41, 0x69, 0xb2, 0x82, 0x56, 0x8b, 0xb6, 0x7c, 0xc1, 0x77,
-	0xa0, 0x28, 0x1e, 0xf6, 0x0f, 0x69, 0xe0, 0x1f, 0x46, 0xa5, 0x73, 0x22, 0x9c, 0x61, 0x0d, 0x52,
-	0x6d, 0xdd, 0x13, 0x16, 0x7b, 0x53, 0x27, 0x7f, 0x2d, 0x4d, 0xd8, 0x05, 0xe1, 0x25, 0x45, 0xa6,
-	0x3b, 0x88, 0x97, 0x27, 0x95, 0xde, 0x05, 0xe8, 0x35, 0x42, 0xa1, 0x7d, 0xd3, 0x92, 0x5d, 0xb3,
-	0x3a, 0x5d, 0xb3, 0x64, 0x8b, 0x55, 0xd7, 0xac, 0x87, 0x8e, 0x9f, 0xb0, 0x64, 0xa7, 0x3c, 0xcd,
-	0x3f, 0x10, 0x5c, 0xd1, 0x24, 0x51, 0xac, 0x84, 0x70, 0x29, 0xcd, 0x0a, 0x2f, 0xa1, 0xca, 0xb9,
-	0xd5, 0x42, 0xf5, 0x86, 0xae, 0x8e, 0xfb, 0x35, 0x1a, 0x46, 0xc1, 0x41, 0x40, 0x6b, 0xa9, 0x50,
-	0x7b, 0xe5, 0x4e, 0x59, 0xdf, 0x3f, 0x5f, 0x5a, 0xd0, 0xaa, 0xb9, 0x5d, 0x4c, 0x71, 0xc9, 0xf1,
-	0xfb, 0x99, 0xaa, 0x26, 0x45, 0x55, 0xd7, 0x47, 0x56, 0x25, 0xc1, 0x66, 0xca, 0xfa, 0x01, 0x81,
-	0x21, 0xcb, 0xea, 0xa8, 0x42, 0x1e, 0xf3, 0xdc, 0x73, 0x82, 0xaf, 0xc3, 0x6c, 0x8b, 0xb6, 0x03,
-	0x1e, 0xb0, 0x70, 0x3f, 0x8c, 0x1b, 0x2e, 0x6d, 0x09, 0x24, 0x53, 0xf6, 0x4c, 0x22, 0x7e, 0x20,
-	0xa4, 0x19, 0xc3, 0x54, 0x9f, 0x53, 0x86, 0xb2, 0x91, 0x78, 0x05, 0x2e, 0xd5, 0x3b, 0xf5, 0x45,
-	0x89, 0xd9, 0x54, 0x05, 0xad, 0x5e, 0xb0, 0x8b, 0x52, 0xa8, 0xba, 0xfd, 0x13, 0x82, 0xab, 0x5a,
-	0xc8, 0xaa, 0x17, 0xef, 0xc0, 0xac, 0x97, 0x68, 0x72, 0x0c, 0xe9, 0x8c, 0x97, 0x09, 0xf3, 0x7f,
-	0xce, 0xe9, 0x13, 0x3d, 0x72, 0x9e, 0x8b, 0xed, 0xbb, 0x9a, 0x96, 0xff, 0x97, 0x41, 0xfe, 0x05,
-	0xc1, 0x35, 0x3d, 0x08, 0xc5, 0xdf, 0xe7, 0xf0, 0x5a, 0x1f, 0x7f, 0xc9, 0x38, 0xaf, 0xe9, 0xca,
-	0xcd, 0x86, 0xf9, 0x24, 0x88, 0x0e, 0x33, 0x04, 0xcc, 0x66, 0xe9, 0x7d, 0x89, 0xa3, 0xfb, 0x05,
-	0x82, 0x65, 0x4d, 0x21, 0x32, 0xfb, 0xab, 0xe5, 0xf4, 0x57, 0x04, 0xe6, 0x8b, 0xa0, 0x28, 0x66,
-	0x3f, 0x85, 0xc5, 0x3e, 0x66, 0xd5, 0x38, 0x25, 0x04, 0x8f, 0x9e, 0xa7, 0x79, 0x4f, 0x97, 0xe1,
-	0xe5, 0x91, 0xba, 0x33, 0xb0, 0x4a, 0xe3, 0x5c, 0x54, 0x9a, 0x5b, 0x03, 0xeb, 0x31, 0xee, 0x15,
-	0xbe, 0x00, 0xd3, 0x5c, 0x48, 0x94, 0x9b, 0x7a, 0x33, 0x8d, 0x4c, 0xb6, 0x87, 0x4e, 0xcb, 0x69,
-	0x24, 0xd9, 0xcc, 0x0f, 0x32, 0x01, 0x13, 0x9d, 0x0a, 0x58, 0x85, 0xe9, 0xa6, 0x90, 0xa8, 0x9f,
-	0xb6, 0x96, 0x38, 0xe5, 0xa3, 0x2c, 0xcd, 0x65, 0x58, 0x12, 0x01, 0x3f, 0x6e, 0xfa, 0x2d, 0xa7,
-	0x96, 0x59, 0xaf, 0x49, 0xce, 0x3a, 0x54, 0x86, 0x9b, 0xa8, 0xd4, 0xf7, 0x60, 0x3e, 0x56, 0xea,
-	0xfd, 0xdc, 0x97, 0xf0, 0x72, 0x3c, 0x18, 0xd1, 0x7c, 0x43, 0x0d, 0x4d, 0x37, 0x9b, 0x6e, 0x05,
-	0x9b, 0x31, 0xac, 0xbc, 0xd0, 0x4a, 0xc1, 0x7a, 0x00, 0xa5, 0x1e, 0xac, 0x31, 0xd6, 0xdf, 0x42,
-	0xac, 0x8d, 0x5b, 0xfd, 0xad, 0x08, 0xe7, 0x45, 0x5e, 0xfc, 0x2d, 0x82, 0x42, 0x0a, 0x36, 0x7e,
-	0x4b, 0xc7, 0xf5, 0x90, 0x0f, 0x0d, 0x63, 0x2d, 0x9f, 0xb1, 0x2c, 0xc2, 0xbc, 0xfd, 0xe4, 0xf7,
-	0x7f, 0xbe, 0x9e, 0x24, 0x78, 0x9d, 0x0c, 0xfd, 0x54, 0x52, 0x1b, 0x89, 0x3c, 0xee, 0x8e, 0xe2,
-	0x31, 0xfe, 0x06, 0x41, 0x31, 0x7d, 0x2c, 0x71, 0xae, 0xac, 0xc9, 0xa4, 0x19, 0xeb, 0x39, 0xad,
-	0x15, 0xc8, 0x1b, 0x02, 0xe4, 0x0a, 0x5e, 0x1e, 0x09, 0x12, 0x3f, 0x47, 0x30, 0x93, 0xe5, 0x15,
-	0x5b, 0xc3, 0x93, 0xe9, 0xda, 0x6f, 0x90, 0xdc, 0xf6, 0x0a, 0x5e, 0x5d, 0xc0, 0x3b, 0xc0, 0x35,
-	0x2d, 0xbc, 0xbe, 0xc5, 0x9e, 0xa6, 0x91, 0x24, 0xc7, 0x98, 0x3c, 0xee, 0x3b, 0xeb, 0xc7, 0x44,
-	0xae, 0xa9, 0x94, 0x42, 0x0a, 0x8e, 0xf1, 0x53, 0x04, 0xb3, 0x7d, 0x87, 0x04, 0xe7, 0x85, 0xdc,
-	0x6d, 0xc0, 0x46, 0x7e, 0x07, 0x55, 0xe4, 0xae, 0x28, 0xb2, 0x8a, 0x37, 0xc6, 0x2d, 0x12, 0x9f,
-	0x20, 0x98, 0xd7, 0x6e, 0x69, 0x7c, 0x3b, 0x27, 0x8a, 0xec, 0x81, 0x31, 0xb6, 0xc7, 0x75, 0x53,
-	0x25, 0xbc, 0x2b, 0x4a, 0x78, 0x1b, 0xef, 0x8e, 0xdd, 0x27, 0x75, 0x33, 0xf0, 0x77, 0x99, 0xb1,
-	0x8f, 0xf3, 0x8d, 0x7d, 0x3c, 0xd6, 0xd8, 0xf7, 0x76, 0x78, 0xee, 0xdf, 0x66, 0x9c, 0xe5, 0xfb,
-	0xcb, 0x2e, 0x48, 0xb9, 0x8e, 0x47, 0x82, 0xcc, 0x5c, 0x81, 0x91, 0x20, 0xb3, 0x77, 0xc1, 0x7c,
-	0x5d, 0x80, 0x5c, 0xc4, 0xf3, 0x12, 0x64, 0x17, 0x9f, 0x3c, 0x01, 0xf8, 0x47, 0x04, 0x97, 0x35,
-	0xbb, 0x1d, 0x6f, 0x0d, 0xcd, 0x32, 0xfc, 0x58, 0x18, 0xb7, 0xc6, 0x73, 0x52, 0x08, 0xab, 0x02,
-	0xe1, 0x1a, 0xbe, 0xa9, 0xa3, 0x51, 0x7b, 0x58, 0x38, 0xfe, 0x19, 0xc1, 0x82, 0x7e, 0xfd, 0xe3,
-	0xed, 0xd1, 0x20, 0xb4, 0x6b, 0x65, 0x67, 0x6c, 0xbf, 0x3c, 0x63, 0x30, 0xec, 0x02, 0xf1, 0x3d,
-	0xfb, 0xe4, 0xb4, 0x8c, 0x9e, 0x9d, 0x96, 0xd1, 0xdf, 0xa7, 0x65, 0xf4, 0xd5, 0x59, 0x79, 0xe2,
-	0xd9, 0x59, 0x79, 0xe2, 0xcf, 0xb3, 0xf2, 0xc4, 0x67, 0xbb, 0x7e, 0x10, 0x1d, 0xc6, 0xae, 0xe5,
-	0xb1, 0x06, 0x51, 0x7f, 0xa6, 0x03, 0xd7, 0x5b, 0xf7, 0x19, 0x69, 0xdf, 0x22, 0x0d, 0x56, 0x8b,
-	0xeb, 0x94, 0xcb, 0x3c, 0x1b, 0xd5, 0x75, 0x95, 0x2a, 0x3a, 0x6a, 0x52, 0xee, 0x4e, 0x8b, 0x43,
-	0xb6, 0xf5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xea, 0xa4, 0x42, 0xb8, 0x0f, 0x00, 0x00,
+	// 1170 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x4f, 0x24, 0xc5,
+	0x17, 0xa7, 0x80, 0x25, 0xec, 0x63, 0x80, 0x6f, 0x8a, 0x5f, 0x43, 0xef, 0x7e, 0x07, 0x68, 0x36,
+	0x2e, 0x8b, 0xd0, 0x05, 0xc3, 0x2e, 0x10, 0x13, 0x13, 0x65, 0x13, 0xdc, 0x3d, 0xb8, 0xae, 0x63,
+	0x8c, 0xc6, 0xc4, 0x4c, 0xba, 0x7b, 0x8a, 0xa1, 0x93, 0x99, 0xae, 0xd9, 0xae, 0xee, 0x49, 0x70,
+	0xc3, 0x65, 0x4f, 0xc6, 0x93, 0x89, 0x89, 0x07, 0x2f, 0x26, 0x1e, 0x3d, 0x18, 0x0f, 0x46, 0xaf,
+	0x9e, 0x56, 0x8e, 0x9b, 0x78, 0xf1, 0xe4, 0x1a, 0xf0, 0xe2, 0xcd, 0x3f, 0xc1, 0x74, 0x55, 0x35,
+	0x74, 0x33, 0x35, 0x4b, 0x8f, 0x41, 0x13, 0x6f, 0xd3, 0xf5, 0x7e, 0x7d, 0xde, 0xe7, 0x7d, 0xba,
+	0x5e, 0x67, 0xa0, 0xe4, 0x39, 0x2e, 0x71, 0x59, 0x40, 0x89, 0xdb, 0xf0, 0xa8, 0x1f, 0x92, 0xf6,
+	0x3a, 0x79, 0x14, 0xd1, 0xe0, 0xc0, 0x6a, 0x05, 0x2c, 0x64, 0x18, 0x7b, 0x8e, 0x6b, 0xc5, 0x76,
+	0x4b, 0xda, 0xad, 0xf6, 0xba, 0xb1, 0xec, 0x32, 0xde, 0x64, 0x9c, 0x38, 0x36, 0xa7, 0xd2, 0x99,
+	0xb4, 0xd7, 0x1d, 0x1a, 0xda, 0xeb, 0xa4, 0x65, 0xd7, 0x3d, 0xdf, 0x0e, 0x3d, 0xe6, 0xcb, 0x78,
+	0x63, 0x4e, 0x93, 0x5f, 0x65, 0x92, 0x0e, 0xb3, 0x75, 0xc6, 0xea, 0x0d, 0x4a, 0xc4, 0x93, 0x13,
+	0xed, 0x11, 0xdb, 0x57, 0xb5, 0x8d, 0xeb, 0xca, 0x64, 0xb7, 0x3c, 0x62, 0xfb, 0x3e, 0x0b, 0x45,
+	0x62, 0xae, 0xac, 0x93, 0x75, 0x56, 0x67, 0xe2, 0x27, 0x89, 0x7f, 0xc9, 0x53, 0x73, 0x13, 0x66,
+	0xde, 0x8e, 0x11, 0xdd, 0x15, 0x35, 0xde, 0x09, 0xed, 0x90, 0x56, 0xe8, 0xa3, 0x88, 0xf2, 0x10,
+	0x5f, 0x83, 0xab, 0xb2, 0x72, 0xd5, 0xab, 0x15, 0xd1, 0x3c, 0x5a, 0xba, 0x5a, 0x19, 0x96, 0x07,
+	0xf7, 0x6b, 0xe6, 0x53, 0x04, 0xc5, 0xce, 0x40, 0xde, 0x62, 0x3e, 0xa7, 0x78, 0x0b, 0x0a, 0x2a,
+	0x92, 0xc7, 0xe7, 0x22, 0x78, 0xa4, 0x3c, 0x69, 0x49, 0x7c, 0x56, 0x02, 0xdd, 0x7a, 0xdd, 0x3f,
+	0xa8, 0x8c, 0xb8, 0x67, 0x09, 0xf0, 0x24, 0x5c, 0x69, 0x05, 0x8c, 0xed, 0x15, 0xfb, 0xe7, 0xd1,
+	0x52, 0xa1, 0x22, 0x1f, 0xf0, 0x5d, 0x28, 0x88, 0x1f, 0xd5, 0x7d, 0xea, 0xd5, 0xf7, 0xc3, 0xe2,
+	0x80, 0x48, 0x67, 0x58, 0x9d, 0x54, 0x5b, 0xf7, 0x84, 0xc7, 0xce, 0xe0, 0xd1, 0xaf, 0x73, 0x7d,
+	0x95, 0x11, 0x11, 0x25, 0x8f, 0xf0, 0x34, 0x0c, 0xc5, 0x60, 0x22, 0x5e, 0x1c, 0x14, 0xad, 0xa8,
+	0x27, 0xd3, 0xe9, 0xec, 0x83, 0x27, 0x0c, 0xec, 0x02, 0x9c, 0x0d, 0x48, 0x75, 0xf1, 0x92, 0x25,
+	0xa7, 0x69, 0xc5, 0xd3, 0xb4, 0xe4, 0xe8, 0xd5, 0x34, 0xad, 0x87, 0x76, 0x3d, 0x61, 0xaf, 0x92,
+	0x8a, 0x34, 0xff, 0x44, 0x30, 0xab, 0x29, 0xa2, 0xd8, 0xf2, 0x61, 0x34, 0xcd, 0x16, 0x2f, 0xa2,
+	0xf9, 0x81, 0xa5, 0x91, 0xf2, 0x2d, 0x5d, 0x7f, 0xf7, 0x6b, 0xd4, 0x0f, 0xbd, 0x3d, 0x8f, 0xd6,
+	0x52, 0xa9, 0x76, 0x4a, 0x71, 0xbb, 0x5f, 0x3f, 0x9f, 0x9b, 0xd6, 0x9a, 0x79, 0xa5, 0x90, 0xe2,
+	0x98, 0xe3, 0x37, 0x32, 0x5d, 0xf5, 0x8b, 0xae, 0x6e, 0x5e, 0xd8, 0x95, 0x04, 0x9b, 0x6e, 0x0b,
+	0x1b, 0x30, 0x2c, 0x49, 0xa4, 0xbc, 0x38, 0x30, 0x3f, 0x10, 0xeb, 0x23, 0x79, 0x36, 0xbf, 0x45,
+	0x60, 0xc8, 0x96, 0xe3, 0x30, 0x9f, 0x47, 0x3c, 0xb7, 0xb6, 0xf0, 0x4d, 0x18, 0x0f, 0x68, 0xdb,
+	0xe3, 0x1e, 0xf3, 0xab, 0x7e, 0xd4, 0x74, 0x68, 0x20, 0x50, 0x0e, 0x56, 0xc6, 0x92, 0xe3, 0x07,
+	0xe2, 0x34, 0xe3, 0x98, 0xd2, 0x46, 0xca, 0x51, 0x0d, 0x7f, 0x11, 0x46, 0x1b, 0x71, 0xef, 0x61,
+	0xe2, 0x16, 0x6b, 0x60, 0xb8, 0x52, 0x90, 0x87, 0xd2, 0xc9, 0xfc, 0x01, 0xc1, 0x35, 0x2d, 0x64,
+	0x35, 0xa7, 0x57, 0x61, 0xdc, 0x4d, 0x2c, 0x39, 0x84, 0x3d, 0xe6, 0x66, 0xd2, 0xfc, 0x83, 0xda,
+	0x36, 0x9f, 0xe8, 0x91, 0xf3, 0x5c, 0x6c, 0xef, 0x6a, 0xe4, 0xf0, 0x77, 0x44, 0xfe, 0x14, 0xc1,
+	0x75, 0x3d, 0x08, 0xc5, 0xdf, 0x87, 0xf0, 0xbf, 0x73, 0xfc, 0x25, 0x52, 0x5f, 0xd1, 0xb5, 0x9b,
+	0x4d, 0xf3, 0x9e, 0x17, 0xee, 0x67, 0x08, 0x18, 0xcf, 0xd2, 0x7b, 0x79, 0xb2, 0x36, 0x3f, 0x46,
+	0xb0, 0xa0, 0x69, 0x44, 0x56, 0xff, 0x77, 0x39, 0xfd, 0x09, 0x81, 0xf9, 0x22, 0x28, 0x8a, 0xd9,
+	0xf7, 0x61, 0xe6, 0x1c, 0xb3, 0x4a, 0x4e, 0x09, 0xc1, 0x17, 0xeb, 0x69, 0xca, 0xd5, 0x55, 0xb8,
+	0x3c, 0x52, 0xb7, 0x3a, 0xae, 0xd9, 0x28, 0x17, 0x95, 0xe6, 0x06, 0xcc, 0x6a, 0x02, 0x55, 0xe3,
+	0x67, 0x97, 0x3a, 0xca, 0x5c, 0xea, 0xae, 0x0a, 0xda, 0x0d, 0xd8, 0x47, 0xd4, 0x97, 0xa1, 0x97,
+	0x7e, 0xab, 0x7f, 0xdf, 0x0f, 0x86, 0xae, 0xca, 0x7f, 0xfd, 0x5a, 0xaf, 0xc2, 0x64, 0xd3, 0xe3,
+	0x0e, 0xdd, 0xb7, 0xdb, 0x1e, 0x8b, 0x82, 0x6a, 0x40, 0x5d, 0x16, 0xd4, 0xe4, 0x15, 0x1f, 0x33,
+	0xa5, 0xc1, 0xff, 0x66, 0xca, 0xbf, 0x22, 0xdc, 0x95, 0xac, 0x26, 0x9a, 0x1d, 0x16, 0x6e, 0x1a,
+	0x19, 0x2d, 0x3c, 0xb4, 0x03, 0xbb, 0x99, 0x0c, 0xc7, 0x7c, 0x0b, 0x66, 0x35, 0x36, 0x45, 0x69,
+	0x19, 0x86, 0x5a, 0xe2, 0x44, 0x4d, 0x4d, 0x2b, 0x6b, 0x15, 0xa3, 0x3c, 0xcd, 0x05, 0x98, 0x13,
+	0x09, 0xdf, 0x6d, 0xd5, 0x03, 0xbb, 0x96, 0x61, 0x30, 0xa9, 0xd9, 0x80, 0xf9, 0xee, 0x2e, 0xaa,
+	0xf4, 0x3d, 0x98, 0x8a, 0x94, 0xb9, 0x9a, 0xfb, 0xdb, 0x66, 0x22, 0xea, 0xcc, 0x68, 0xde, 0x00,
+	0x33, 0x5b, 0x4d, 0xb7, 0x20, 0xcd, 0x08, 0x16, 0x5f, 0xe8, 0xa5, 0x60, 0x3d, 0x80, 0xe2, 0x19,
+	0xac, 0x1e, 0x96, 0xd3, 0x74, 0xa4, 0xcd, 0x5b, 0xfe, 0x63, 0x14, 0xae, 0x88, 0xba, 0xf8, 0x4b,
+	0x04, 0x23, 0x29, 0xd8, 0xf8, 0x65, 0x1d, 0xd7, 0x5d, 0x3e, 0x1d, 0x8d, 0x95, 0x7c, 0xce, 0xb2,
+	0x09, 0xf3, 0xce, 0x93, 0x9f, 0x7f, 0xff, 0xac, 0x9f, 0xe0, 0x55, 0xd2, 0xf5, 0xe3, 0x57, 0xbd,
+	0x43, 0xe4, 0xf1, 0xe9, 0x45, 0x71, 0x88, 0x3f, 0x47, 0x50, 0x48, 0xbf, 0x0f, 0x38, 0x57, 0xd5,
+	0x44, 0x69, 0xc6, 0x6a, 0x4e, 0x6f, 0x05, 0xf2, 0x96, 0x00, 0xb9, 0x88, 0x17, 0x2e, 0x04, 0x89,
+	0x9f, 0x23, 0x18, 0xcb, 0xf2, 0x8a, 0xad, 0xee, 0xc5, 0x74, 0xe3, 0x37, 0x48, 0x6e, 0x7f, 0x05,
+	0xaf, 0x21, 0xe0, 0xed, 0xe1, 0x9a, 0x16, 0xde, 0xb9, 0xb5, 0x9b, 0xa6, 0x91, 0x24, 0x9f, 0x4a,
+	0xe4, 0xf1, 0xb9, 0x8f, 0xae, 0x43, 0x22, 0x97, 0x48, 0xca, 0x20, 0x0f, 0x0e, 0xf1, 0x37, 0x08,
+	0xc6, 0xcf, 0xad, 0x79, 0x9c, 0x17, 0xf2, 0xe9, 0x00, 0xd6, 0xf2, 0x07, 0xa8, 0x26, 0xb7, 0x45,
+	0x93, 0x65, 0xbc, 0xd6, 0x6b, 0x93, 0xf8, 0x08, 0xc1, 0x94, 0x76, 0x87, 0xe2, 0x3b, 0x39, 0x51,
+	0x64, 0xd7, 0xbf, 0xb1, 0xd9, 0x6b, 0x98, 0x6a, 0xe1, 0x35, 0xd1, 0xc2, 0x2b, 0x78, 0xbb, 0xe7,
+	0x39, 0xa9, 0x8d, 0x8e, 0xbf, 0xca, 0xc8, 0x3e, 0xca, 0x27, 0xfb, 0xa8, 0x27, 0xd9, 0x47, 0xbc,
+	0xe7, 0x77, 0x33, 0xca, 0xf2, 0xfd, 0x05, 0x82, 0xd1, 0xcc, 0x5a, 0xc4, 0xdd, 0xeb, 0xea, 0x96,
+	0xb4, 0x61, 0xe5, 0x75, 0x57, 0x38, 0x97, 0x05, 0xce, 0x1b, 0xd8, 0xd4, 0xe1, 0xdc, 0x13, 0x21,
+	0xea, 0xde, 0xe6, 0xf8, 0x93, 0x53, 0x06, 0xe5, 0xae, 0xb8, 0x90, 0xc1, 0xcc, 0x8a, 0x32, 0x56,
+	0x73, 0x7a, 0x2b, 0x64, 0xff, 0x17, 0xc8, 0x66, 0xf0, 0x94, 0x44, 0x76, 0x0a, 0x4a, 0xee, 0x27,
+	0xfc, 0x1d, 0x82, 0x09, 0xcd, 0xe2, 0xc1, 0x1b, 0x5d, 0xab, 0x74, 0xdf, 0x64, 0xc6, 0xed, 0xde,
+	0x82, 0x14, 0xc2, 0xb2, 0x40, 0xb8, 0x82, 0x97, 0x75, 0xdc, 0x69, 0xb7, 0x1e, 0xc7, 0x3f, 0x22,
+	0x98, 0xd6, 0xef, 0x26, 0xbc, 0x79, 0x31, 0x08, 0xed, 0x9d, 0xb7, 0xd5, 0x73, 0x5c, 0x1e, 0x8d,
+	0x76, 0x5b, 0x8f, 0x7c, 0xa7, 0x72, 0x74, 0x5c, 0x42, 0xcf, 0x8e, 0x4b, 0xe8, 0xb7, 0xe3, 0x12,
+	0xfa, 0xf4, 0xa4, 0xd4, 0xf7, 0xec, 0xa4, 0xd4, 0xf7, 0xcb, 0x49, 0xa9, 0xef, 0x83, 0xed, 0xba,
+	0x17, 0xee, 0x47, 0x8e, 0xe5, 0xb2, 0x26, 0x51, 0xff, 0xdd, 0x78, 0x8e, 0xbb, 0x5a, 0x67, 0xa4,
+	0x7d, 0x9b, 0x34, 0x59, 0x2d, 0x6a, 0x50, 0x2e, 0xeb, 0xac, 0x95, 0x57, 0x55, 0xa9, 0xf0, 0xa0,
+	0x45, 0xb9, 0x33, 0x24, 0xb6, 0xec, 0xc6, 0x5f, 0x03, 0x00, 0x89, 0x39, 0xf3, 0xa0, 0x27, 0x12,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1079,6 +1220,9 @@ type QueryClient interface {
 	ConsensusStateHeights(ctx context.Context, in *QueryConsensusStateHeightsRequest, opts ...grpc.CallOption) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(ctx context.Context, in *QueryClientStatusRequest, opts ...grpc.CallOption) (*QueryClientStatusResponse, error)
+	// FrozenClients queries all IBC light clients which have been frozen due to
+	// misbehaviour.
+	FrozenClients(ctx context.Context, in *QueryFrozenClientsRequest, opts ...grpc.CallOption) (*QueryFrozenClientsResponse, error)
 	// ClientParams queries all parameters of the ibc client.
 	ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1149,6 +1293,15 @@ func (c *queryClient) ClientStatus(ctx context.Context, in *QueryClientStatusReq
 	return out, nil
 }
 
+func (c *queryClient) FrozenClients(ctx context.Context, in *QueryFrozenClientsRequest, opts ...grpc.CallOption) (*QueryFrozenClientsResponse, error) {
+	out := new(QueryFrozenClientsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/FrozenClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error) {
 	out := new(QueryClientParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientParams", in, out, opts...)
@@ -1192,6 +1345,9 @@ type QueryServer interface {
 	ConsensusStateHeights(context.Context, *QueryConsensusStateHeightsRequest) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(context.Context, *QueryClientStatusRequest) (*QueryClientStatusResponse, error)
+	// FrozenClients queries all IBC light clients which have been frozen due to
+	// misbehaviour.
+	FrozenClients(context.Context, *QueryFrozenClientsRequest) (*QueryFrozenClientsResponse, error)
 	// ClientParams queries all parameters of the ibc client.
 	ClientParams(context.Context, *QueryClientParamsRequest) (*QueryClientParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1222,6 +1378,9 @@ func (*UnimplementedQueryServer) ConsensusStateHeights(ctx context.Context, req
 func (*UnimplementedQueryServer) ClientStatus(ctx context.Context, req *QueryClientStatusRequest) (*QueryClientStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientStatus not implemented")
 }
+func (*UnimplementedQueryServer) FrozenClients(ctx context.Context, req *QueryFrozenClientsRequest) (*QueryFrozenClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenClients not implemented")
+}
 func (*UnimplementedQueryServer) ClientParams(ctx context.Context, req *QueryClientParamsRequest) (*QueryClientParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientParams not implemented")
 }
@@ -1344,6 +1503,24 @@ func _Query_ClientStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FrozenClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/FrozenClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenClients(ctx, req.(*QueryFrozenClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ClientParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClientParamsRequest)
 	if err := dec(in); err != nil {
@@ -1426,6 +1603,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClientStatus",
 			Handler:    _Query_ClientStatus_Handler,
 		},
+		{
+			MethodName: "FrozenClients",
+			Handler:    _Query_FrozenClients_Handler,
+		},
 		{
 			MethodName: "ClientParams",
 			Handler:    _Query_ClientParams_Handler,
@@ -1493,6 +1674,13 @@ func (m *QueryClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1580,6 +1768,15 @@ func (m *QueryClientStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Statuses[iNdEx])
+			copy(dAtA[i:], m.Statuses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Statuses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1953,6 +2150,104 @@ func (m *QueryClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFrozenClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MisbehaviourRecords) > 0 {
+		for iNdEx := len(m.MisbehaviourRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MisbehaviourRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientStates) > 0 {
+		for iNdEx := len(m.ClientStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryClientParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2167,6 +2462,10 @@ func (m *QueryClientStateResponse) Size() (n int) {
 	}
 	l = m.ProofHeight.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2199,6 +2498,12 @@ func (m *QueryClientStatesResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Statuses) > 0 {
+		for _, s := range m.Statuses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2341,6 +2646,44 @@ func (m *QueryClientStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFrozenClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientStates) > 0 {
+		for _, e := range m.ClientStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MisbehaviourRecords) > 0 {
+		for _, e := range m.MisbehaviourRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryClientParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2627,7 +2970,39 @@ func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
@@ -2833,6 +3208,38 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3787,6 +4194,246 @@ func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFrozenClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenClientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenClientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStates = append(m.ClientStates, IdentifiedClientState{})
+			if err := m.ClientStates[len(m.ClientStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisbehaviourRecords = append(m.MisbehaviourRecords, MisbehaviourRecord{})
+			if err := m.MisbehaviourRecords[len(m.MisbehaviourRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,14 +13,18 @@ import (
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
+const (
+	flagClientID = "client"
+)
+
 // GetCmdQueryConnections defines the command to query all the connection ends
 // that this chain mantains.
 func GetCmdQueryConnections() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "connections",
 		Short:   "Query all connections",
-		Long:    "Query all connections ends from a chain",
-		Example: fmt.Sprintf("%s query %s %s connections", version.AppName, host.ModuleName, types.SubModuleName),
+		Long:    "Query all connections ends from a chain, optionally filtered by client identifier",
+		Example: fmt.Sprintf("%s query %s %s connections --client 07-tendermint-0", version.AppName, host.ModuleName, types.SubModuleName),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -34,8 +38,14 @@ func GetCmdQueryConnections() *cobra.Command {
 				return err
 			}
 
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryConnectionsRequest{
 				Pagination: pageReq,
+				ClientId:   clientID,
 			}
 
 			res, err := queryClient.Connections(cmd.Context(), req)
@@ -47,6 +57,7 @@ func GetCmdQueryConnections() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagClientID, "", "filter connections by client identifier")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "connection ends")
 
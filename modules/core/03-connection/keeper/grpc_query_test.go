@@ -170,6 +170,91 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConnectionsFiltered() {
+	// connections alternate between two clients
+	clientIDs := []string{"07-tendermint-0", "07-tendermint-1", "07-tendermint-0", "07-tendermint-1", "07-tendermint-0"}
+
+	testCases := []struct {
+		msg      string
+		clientID string
+		expected []string // connection identifiers in iteration order
+		expPass  bool
+	}{
+		{"no filter", "", []string{"connection-0", "connection-1", "connection-2", "connection-3", "connection-4"}, true},
+		{"filter by client", "07-tendermint-0", []string{"connection-0", "connection-2", "connection-4"}, true},
+		{"filter by other client", "07-tendermint-1", []string{"connection-1", "connection-3"}, true},
+		{"no matching connections", "07-tendermint-9", nil, true},
+		{"invalid client identifier", "(client)", nil, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			for i, clientID := range clientIDs {
+				counterparty := types.NewCounterparty(clientID, "", suite.chainB.GetPrefix())
+				connection := types.NewConnectionEnd(types.INIT, clientID, counterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), types.FormatConnectionIdentifier(uint64(i)), connection)
+			}
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			// the first page is requested with an offset and counts the total number of matching connections
+			req := &types.QueryConnectionsRequest{
+				ClientId:   tc.clientID,
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			}
+
+			res, err := suite.chainA.QueryServer.Connections(ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(len(tc.expected)), res.Pagination.Total)
+
+			// follow the next keys until all pages are returned, a page may be empty if only
+			// non matching connections remain after the last key
+			var result []string
+			for {
+				suite.Require().LessOrEqual(len(res.Connections), 2)
+				for _, connection := range res.Connections {
+					result = append(result, connection.Id)
+				}
+
+				if res.Pagination.NextKey == nil {
+					break
+				}
+
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+				res, err = suite.chainA.QueryServer.Connections(ctx, req)
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(tc.expected, result)
+
+			// offset pagination skips the filtered connections before the offset
+			req.Pagination = &query.PageRequest{Offset: 1, Limit: 2}
+			res, err = suite.chainA.QueryServer.Connections(ctx, req)
+			suite.Require().NoError(err)
+
+			var offsetResult, expOffsetResult []string
+			for _, connection := range res.Connections {
+				offsetResult = append(offsetResult, connection.Id)
+			}
+
+			for i := 1; i < len(tc.expected) && i < 3; i++ {
+				expOffsetResult = append(expOffsetResult, tc.expected[i])
+			}
+
+			suite.Require().Equal(expOffsetResult, offsetResult)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryClientConnections() {
 	var (
 		req      *types.QueryClientConnectionsRequest
@@ -85,7 +85,12 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
 // given height.
+// The localhost client does not store consensus states, the current block time is returned instead.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (uint64, error) {
+	if connection.GetClientID() == exported.Localhost {
+		return uint64(ctx.BlockTime().UnixNano()), nil
+	}
+
 	consensusState, found := k.clientKeeper.GetClientConsensusState(
 		ctx, connection.GetClientID(), height,
 	)
@@ -188,6 +193,36 @@ func (k Keeper) GetAllConnections(ctx sdk.Context) (connections []types.Identifi
 	return connections
 }
 
+// CreateSentinelLocalhostConnection creates and sets the sentinel localhost connection end in the
+// IBC store. The connection is opened on the localhost client with itself as its counterparty,
+// so channels may be opened on it without a connection handshake.
+func (k Keeper) CreateSentinelLocalhostConnection(ctx sdk.Context) error {
+	if _, found := k.clientKeeper.GetClientState(ctx, exported.Localhost); !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, exported.Localhost)
+	}
+
+	if _, found := k.GetConnection(ctx, exported.LocalhostConnectionID); found {
+		return sdkerrors.Wrap(types.ErrConnectionExists, exported.LocalhostConnectionID)
+	}
+
+	counterparty := types.NewCounterparty(exported.Localhost, exported.LocalhostConnectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
+	connection := types.NewConnectionEnd(types.OPEN, exported.Localhost, counterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
+	k.SetConnection(ctx, exported.LocalhostConnectionID, connection)
+
+	return k.addConnectionToClient(ctx, exported.Localhost, exported.LocalhostConnectionID)
+}
+
+// getVerificationStore returns the store against which proofs are verified for the given client.
+// The localhost client verifies directly against the IBC store of the running chain, all other
+// clients are provided their client prefixed store.
+func (k Keeper) getVerificationStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	if clientID == exported.Localhost {
+		return ctx.KVStore(k.storeKey)
+	}
+
+	return k.clientKeeper.ClientStore(ctx, clientID)
+}
+
 // addConnectionToClient is used to add a connection identifier to the set of
 // connections associated with a client.
 func (k Keeper) addConnectionToClient(ctx sdk.Context, clientID, connectionID string) error {
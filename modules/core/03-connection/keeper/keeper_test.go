@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -44,6 +46,33 @@ func (suite *KeeperTestSuite) TestSetAndGetConnection() {
 	suite.Require().True(existed)
 }
 
+func (suite *KeeperTestSuite) TestCreateSentinelLocalhostConnection() {
+	ctx := suite.chainA.GetContext()
+
+	// the localhost client must exist
+	err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, clienttypes.NewParams(exported.Tendermint, exported.Localhost))
+	suite.Require().NoError(suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateLocalhostClient(ctx))
+
+	err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+	suite.Require().NoError(err)
+
+	connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.OPEN, connection.State)
+	suite.Require().Equal(exported.Localhost, connection.ClientId)
+	suite.Require().Equal(exported.LocalhostConnectionID, connection.Counterparty.ConnectionId)
+
+	paths, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetClientConnectionPaths(ctx, exported.Localhost)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{exported.LocalhostConnectionID}, paths)
+
+	err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+	suite.Require().ErrorIs(err, types.ErrConnectionExists)
+}
+
 func (suite *KeeperTestSuite) TestSetAndGetClientConnectionPaths() {
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
 	suite.coordinator.SetupClients(path)
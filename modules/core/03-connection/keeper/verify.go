@@ -20,7 +20,7 @@ func (k Keeper) VerifyClientState(
 	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	targetClient, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -51,7 +51,7 @@ func (k Keeper) VerifyClientConsensusState(
 	consensusState exported.ConsensusState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -83,7 +83,7 @@ func (k Keeper) VerifyConnectionState(
 	connectionEnd exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -116,7 +116,7 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -151,7 +151,7 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -191,7 +191,7 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -231,7 +231,7 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -258,6 +258,46 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketCommitmentAbsence verifies a proof of the absence of an
+// outgoing packet commitment at the specified port, specified channel, and
+// specified sequence.
+func (k Keeper) VerifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.getVerificationStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketCommitmentAbsence(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		sequence,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet commitment absence verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k Keeper) VerifyNextSequenceRecv(
@@ -270,7 +310,7 @@ func (k Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.getVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
 
 // NewConnectionPaths creates a ConnectionPaths instance.
@@ -45,13 +46,16 @@ func (gs GenesisState) Validate() error {
 	var maxSequence uint64 = 0
 
 	for i, conn := range gs.Connections {
-		sequence, err := ParseConnectionSequence(conn.Id)
-		if err != nil {
-			return err
-		}
+		// the sentinel localhost connection identifier is not generated from the connection sequence
+		if conn.Id != exported.LocalhostConnectionID {
+			sequence, err := ParseConnectionSequence(conn.Id)
+			if err != nil {
+				return err
+			}
 
-		if sequence > maxSequence {
-			maxSequence = sequence
+			if sequence > maxSequence {
+				maxSequence = sequence
+			}
 		}
 
 		if err := conn.ValidateBasic(); err != nil {
@@ -45,6 +45,9 @@ func (msg MsgConnectionOpenInit) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return sdkerrors.Wrap(err, "invalid client ID")
 	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "connection handshakes are disallowed for the localhost client, use the sentinel connection %s", exported.LocalhostConnectionID)
+	}
 	if msg.Counterparty.ConnectionId != "" {
 		return sdkerrors.Wrap(ErrInvalidCounterparty, "counterparty connection identifier must be empty")
 	}
@@ -106,6 +109,9 @@ func (msg MsgConnectionOpenTry) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return sdkerrors.Wrap(err, "invalid client ID")
 	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "connection handshakes are disallowed for the localhost client, use the sentinel connection %s", exported.LocalhostConnectionID)
+	}
 	// counterparty validate basic allows empty counterparty connection identifiers
 	if err := host.ConnectionIdentifierValidator(msg.Counterparty.ConnectionId); err != nil {
 		return sdkerrors.Wrap(err, "invalid counterparty connection ID")
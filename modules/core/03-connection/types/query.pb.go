@@ -149,6 +149,8 @@ func (m *QueryConnectionResponse) GetProofHeight() types.Height {
 // method
 type QueryConnectionsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional client identifier to filter connections by
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
 func (m *QueryConnectionsRequest) Reset()         { *m = QueryConnectionsRequest{} }
@@ -191,6 +193,13 @@ func (m *QueryConnectionsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryConnectionsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
 // QueryConnectionsResponse is the response type for the Query/Connections RPC
 // method.
 type QueryConnectionsResponse struct {
@@ -636,62 +645,62 @@ func init() {
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
 	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x38, 0xb9, 0xd3, 0x65, 0x1c, 0xee, 0x60, 0xe4, 0xbb, 0x33, 0x0b, 0x38, 0x61, 0x8f,
-	0x90, 0x1c, 0x70, 0x33, 0xe7, 0x84, 0x3b, 0x1d, 0x21, 0x46, 0xe0, 0x28, 0x90, 0x34, 0x51, 0x58,
-	0x24, 0x0a, 0x9a, 0x68, 0x77, 0x3d, 0x59, 0xaf, 0x64, 0xef, 0x38, 0x9e, 0xb5, 0x91, 0x15, 0x59,
-	0x48, 0xfc, 0x01, 0x90, 0x68, 0x68, 0x68, 0x29, 0xf8, 0x03, 0x14, 0x74, 0x54, 0x29, 0x23, 0xd1,
-	0xa4, 0x8a, 0x90, 0x43, 0x4b, 0xc3, 0x2f, 0x40, 0x3b, 0x33, 0xce, 0xce, 0xda, 0xeb, 0xc4, 0xb1,
-	0x2e, 0xdd, 0xe6, 0xcd, 0x7b, 0xf3, 0xbe, 0xef, 0x7b, 0x6f, 0x3e, 0x07, 0x9a, 0xbe, 0xe3, 0x12,
-	0x97, 0xb5, 0x28, 0x71, 0x59, 0x10, 0x50, 0x37, 0xf4, 0x59, 0x40, 0x3a, 0x25, 0x72, 0xd8, 0xa6,
-	0xad, 0x2e, 0x6e, 0xb6, 0x58, 0xc8, 0xd0, 0x03, 0xdf, 0x71, 0x71, 0x94, 0x83, 0xe3, 0x1c, 0xdc,
-	0x29, 0x19, 0x79, 0x8f, 0x79, 0x4c, 0xa4, 0x90, 0xe8, 0x4b, 0x66, 0x1b, 0xef, 0xb9, 0x8c, 0x37,
-	0x18, 0x27, 0x8e, 0xcd, 0xa9, 0xbc, 0x86, 0x74, 0x4a, 0x0e, 0x0d, 0xed, 0x12, 0x69, 0xda, 0x9e,
-	0x1f, 0xd8, 0xa2, 0x5c, 0xe6, 0x2e, 0xc4, 0xdd, 0xeb, 0x3e, 0x0d, 0xc2, 0xa8, 0xb3, 0xfc, 0x52,
-	0x09, 0xcb, 0x63, 0xe0, 0x69, 0x40, 0x64, 0xe2, 0x9b, 0x1e, 0x63, 0x5e, 0x9d, 0x12, 0xbb, 0xe9,
-	0x13, 0x3b, 0x08, 0x58, 0x28, 0xda, 0x70, 0x75, 0xfa, 0xba, 0x3a, 0x15, 0x7f, 0x39, 0xed, 0x03,
-	0x62, 0x07, 0x8a, 0x9c, 0x59, 0x86, 0x0f, 0xbe, 0x8c, 0x40, 0x6e, 0x5e, 0xdc, 0x68, 0xd1, 0xc3,
-	0x36, 0xe5, 0x21, 0x7a, 0x04, 0x5f, 0x89, 0xdb, 0xec, 0xfb, 0xd5, 0x02, 0x58, 0x04, 0x2b, 0x73,
-	0xd6, 0x7c, 0x1c, 0xdc, 0xa9, 0x9a, 0x7f, 0x00, 0xf8, 0x70, 0xa4, 0x9e, 0x37, 0x59, 0xc0, 0x29,
-	0xda, 0x82, 0x30, 0xce, 0x15, 0xd5, 0xb9, 0xd5, 0x25, 0x9c, 0x2e, 0x26, 0x8e, 0xeb, 0xb7, 0x82,
-	0xaa, 0xa5, 0x15, 0xa2, 0x3c, 0xbc, 0xd5, 0x6c, 0x31, 0x76, 0x50, 0xc8, 0x2e, 0x82, 0x95, 0x79,
-	0x4b, 0xfe, 0x81, 0x36, 0xe1, 0xbc, 0xf8, 0xd8, 0xaf, 0x51, 0xdf, 0xab, 0x85, 0x85, 0x19, 0x71,
-	0xbd, 0xa1, 0x5d, 0x2f, 0x75, 0xec, 0x94, 0xf0, 0xb6, 0xc8, 0xa8, 0xcc, 0x1e, 0x9f, 0x2d, 0x64,
-	0xac, 0x9c, 0xa8, 0x92, 0x21, 0xd3, 0x1e, 0x01, 0xcf, 0x07, 0xec, 0x3f, 0x87, 0x30, 0x1e, 0x97,
-	0x02, 0xff, 0x2e, 0x96, 0xb3, 0xc5, 0xd1, 0x6c, 0xb1, 0x5c, 0x11, 0x35, 0x5b, 0xbc, 0x67, 0x7b,
-	0x54, 0xd5, 0x5a, 0x5a, 0xa5, 0xf9, 0x2f, 0x80, 0x85, 0xd1, 0x1e, 0x4a, 0xa1, 0x5d, 0x98, 0x8b,
-	0x89, 0xf2, 0x02, 0x58, 0x9c, 0x59, 0xc9, 0xad, 0x7e, 0x30, 0x4e, 0xa2, 0x9d, 0x2a, 0x0d, 0x42,
-	0xff, 0xc0, 0xa7, 0x55, 0x4d, 0x6c, 0xfd, 0x02, 0xf4, 0x45, 0x02, 0x74, 0x56, 0x80, 0x5e, 0xbe,
-	0x12, 0xb4, 0x04, 0xa3, 0xa3, 0x46, 0x2f, 0xe0, 0xed, 0x6b, 0xea, 0xaa, 0xf2, 0xcd, 0x0d, 0xf8,
-	0x96, 0xa4, 0x2b, 0xd2, 0x52, 0x84, 0x7d, 0x03, 0xce, 0xc9, 0x2b, 0xe2, 0x95, 0xba, 0x23, 0x03,
-	0x3b, 0x55, 0xf3, 0x57, 0x00, 0x8b, 0xe3, 0xca, 0x95, 0x66, 0x8f, 0xe1, 0xab, 0xda, 0x5a, 0x36,
-	0xed, 0xb0, 0x26, 0x85, 0x9b, 0xb3, 0xee, 0xc5, 0xf1, 0xbd, 0x28, 0x7c, 0x93, 0x9b, 0xe3, 0xc0,
-	0xb7, 0x87, 0xa6, 0x2a, 0x11, 0x7f, 0x15, 0xda, 0xe1, 0x60, 0x0f, 0x50, 0x39, 0xf5, 0x05, 0x55,
-	0x0a, 0xff, 0x9d, 0x2d, 0xe4, 0xbb, 0x76, 0xa3, 0xbe, 0x6e, 0x26, 0x8e, 0xcd, 0xa1, 0xb7, 0xd5,
-	0x07, 0xd0, 0xbc, 0xac, 0x89, 0x12, 0xc4, 0x86, 0x0f, 0xfd, 0x8b, 0xcd, 0xd8, 0x57, 0xda, 0xf2,
-	0x28, 0x45, 0xad, 0xed, 0xe3, 0x34, 0x6a, 0xda, 0x32, 0x69, 0x77, 0xde, 0xf7, 0xd3, 0xc2, 0x37,
-	0x29, 0xe4, 0xef, 0x00, 0xbe, 0x33, 0x4c, 0x32, 0xa2, 0x15, 0xf0, 0x36, 0x7f, 0x89, 0x62, 0xa2,
-	0x65, 0x78, 0xaf, 0x45, 0x3b, 0x3e, 0x8f, 0x4e, 0x83, 0x76, 0xc3, 0xa1, 0x2d, 0x41, 0x66, 0xd6,
-	0xba, 0x3b, 0x08, 0xef, 0x8a, 0x68, 0x22, 0x51, 0x23, 0xa6, 0x25, 0x2a, 0xe4, 0x67, 0x00, 0x2e,
-	0x5d, 0x81, 0x5c, 0x4d, 0xa8, 0x0c, 0xa3, 0xd5, 0x94, 0x27, 0x89, 0xc9, 0xe4, 0xb1, 0x34, 0x66,
-	0x3c, 0x30, 0x66, 0xfc, 0x59, 0xd0, 0xb5, 0xee, 0xba, 0x89, 0x6b, 0x92, 0x2f, 0x26, 0x9b, 0x7c,
-	0x31, 0xf1, 0x68, 0x66, 0x2e, 0x1b, 0xcd, 0xec, 0x14, 0xa3, 0x59, 0xfd, 0xe1, 0x0e, 0xbc, 0x25,
-	0x08, 0xa2, 0xdf, 0x00, 0x84, 0x31, 0x4b, 0x84, 0xc7, 0x39, 0x54, 0xfa, 0x2f, 0x89, 0x41, 0x26,
-	0xce, 0x97, 0x82, 0x99, 0x1f, 0x7f, 0xff, 0xd7, 0x3f, 0x3f, 0x65, 0x9f, 0xa1, 0x35, 0x72, 0xe5,
-	0xef, 0x1f, 0x27, 0x47, 0x89, 0xb9, 0xf7, 0xd0, 0x2f, 0x00, 0xe6, 0x34, 0xe3, 0x40, 0x93, 0x76,
-	0x1f, 0x38, 0x94, 0xf1, 0x74, 0xf2, 0x02, 0x85, 0xf7, 0x7d, 0x81, 0x77, 0x09, 0x3d, 0x9a, 0x00,
-	0x2f, 0xfa, 0x13, 0xc0, 0xd7, 0x46, 0xec, 0x0d, 0x3d, 0xbb, 0xbc, 0xe9, 0x18, 0x37, 0x35, 0x9e,
-	0x5f, 0xb7, 0x4c, 0x21, 0xfe, 0x44, 0x20, 0x7e, 0x81, 0x9e, 0x8f, 0x45, 0x2c, 0x37, 0x2e, 0x29,
-	0xf4, 0x60, 0x0b, 0x7b, 0xe8, 0x14, 0xc0, 0xfb, 0xa9, 0xb6, 0x84, 0x3e, 0x9a, 0x50, 0xbd, 0x51,
-	0xbf, 0x34, 0xd6, 0xa7, 0x29, 0x55, 0x84, 0xb6, 0x05, 0xa1, 0x0a, 0xfa, 0x74, 0x8a, 0x95, 0x21,
-	0xba, 0x69, 0xa2, 0x9f, 0xb3, 0xb0, 0x30, 0xee, 0x49, 0xa3, 0x8d, 0x49, 0x21, 0xa6, 0x79, 0x98,
-	0x51, 0x9e, 0xb2, 0x5a, 0x71, 0xfc, 0x4e, 0x70, 0xec, 0xa2, 0x6f, 0xa7, 0xe2, 0x98, 0x74, 0x20,
-	0x32, 0x70, 0x33, 0x72, 0x34, 0xe4, 0x8b, 0x3d, 0x22, 0x4d, 0x43, 0x3b, 0x90, 0x81, 0x5e, 0xe5,
-	0xeb, 0xe3, 0x7e, 0x11, 0x9c, 0xf4, 0x8b, 0xe0, 0xef, 0x7e, 0x11, 0xfc, 0x78, 0x5e, 0xcc, 0x9c,
-	0x9c, 0x17, 0x33, 0xa7, 0xe7, 0xc5, 0xcc, 0x37, 0x1b, 0x9e, 0x1f, 0xd6, 0xda, 0x0e, 0x76, 0x59,
-	0x83, 0xa8, 0x7f, 0x80, 0x7d, 0xc7, 0x7d, 0xe2, 0x31, 0xd2, 0xf9, 0x90, 0x34, 0x58, 0xb5, 0x5d,
-	0xa7, 0x5c, 0x22, 0x7e, 0xba, 0xf6, 0x44, 0x03, 0x1d, 0x76, 0x9b, 0x94, 0x3b, 0xb7, 0x85, 0xff,
-	0xad, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x76, 0x45, 0xa5, 0xf9, 0x8e, 0x0b, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xa4, 0xdd, 0xd5, 0x76, 0x52, 0xb6, 0x30, 0xca, 0xee, 0x06, 0x03, 0x69, 0xf1, 0x52,
+	0xda, 0x05, 0x76, 0x66, 0xd3, 0xb2, 0xab, 0x52, 0x1a, 0x04, 0xa9, 0x0a, 0xed, 0xa5, 0x2a, 0x46,
+	0xe2, 0xc0, 0xa5, 0xb2, 0x9d, 0xa9, 0x63, 0x29, 0xf1, 0xa4, 0x19, 0x27, 0x28, 0xaa, 0x02, 0x12,
+	0x7f, 0x00, 0x24, 0x2e, 0x5c, 0xb8, 0x72, 0xe0, 0x0f, 0x70, 0xe0, 0xc6, 0xa9, 0xc7, 0x4a, 0x5c,
+	0x7a, 0xaa, 0x50, 0xca, 0x95, 0x0b, 0xbf, 0x00, 0x79, 0x66, 0x5c, 0xdb, 0x89, 0x93, 0xa6, 0x11,
+	0xbd, 0xd9, 0x6f, 0xde, 0x7b, 0xf3, 0x7d, 0xdf, 0x7b, 0xfe, 0x12, 0xa8, 0xbb, 0x96, 0x4d, 0x6c,
+	0xd6, 0xa2, 0xc4, 0x66, 0x9e, 0x47, 0x6d, 0xdf, 0x65, 0x1e, 0xe9, 0x94, 0xc8, 0x71, 0x9b, 0xb6,
+	0xba, 0xb8, 0xd9, 0x62, 0x3e, 0x43, 0x0f, 0x5d, 0xcb, 0xc6, 0x41, 0x0e, 0x8e, 0x72, 0x70, 0xa7,
+	0xa4, 0xe5, 0x1d, 0xe6, 0x30, 0x91, 0x42, 0x82, 0x27, 0x99, 0xad, 0xbd, 0x63, 0x33, 0xde, 0x60,
+	0x9c, 0x58, 0x26, 0xa7, 0xb2, 0x0d, 0xe9, 0x94, 0x2c, 0xea, 0x9b, 0x25, 0xd2, 0x34, 0x1d, 0xd7,
+	0x33, 0x45, 0xb9, 0xcc, 0x5d, 0x8c, 0x6e, 0xaf, 0xbb, 0xd4, 0xf3, 0x83, 0x9b, 0xe5, 0x93, 0x4a,
+	0x58, 0x19, 0x01, 0x2f, 0x7a, 0x53, 0x89, 0xaf, 0x3b, 0x8c, 0x39, 0x75, 0x4a, 0xcc, 0xa6, 0x4b,
+	0x4c, 0xcf, 0x63, 0xbe, 0xb8, 0x86, 0xab, 0xd3, 0x57, 0xd5, 0xa9, 0x78, 0xb3, 0xda, 0x47, 0xc4,
+	0xf4, 0x14, 0x39, 0xbd, 0x0c, 0x1f, 0x7e, 0x1e, 0x80, 0xdc, 0xbe, 0xea, 0x68, 0xd0, 0xe3, 0x36,
+	0xe5, 0x3e, 0x7a, 0x0c, 0x5f, 0x8a, 0xae, 0x39, 0x74, 0xab, 0x05, 0xb0, 0x04, 0x56, 0xe7, 0x8c,
+	0xf9, 0x28, 0xb8, 0x57, 0xd5, 0x7f, 0x07, 0xf0, 0xd1, 0x50, 0x3d, 0x6f, 0x32, 0x8f, 0x53, 0xb4,
+	0x03, 0x61, 0x94, 0x2b, 0xaa, 0x73, 0x6b, 0xcb, 0x38, 0x5d, 0x4c, 0x1c, 0xd5, 0xef, 0x78, 0x55,
+	0x23, 0x56, 0x88, 0xf2, 0xf0, 0x4e, 0xb3, 0xc5, 0xd8, 0x51, 0x21, 0xbb, 0x04, 0x56, 0xe7, 0x0d,
+	0xf9, 0x82, 0xb6, 0xe1, 0xbc, 0x78, 0x38, 0xac, 0x51, 0xd7, 0xa9, 0xf9, 0x85, 0x19, 0xd1, 0x5e,
+	0x8b, 0xb5, 0x97, 0x3a, 0x76, 0x4a, 0x78, 0x57, 0x64, 0x54, 0x66, 0x4f, 0x2f, 0x16, 0x33, 0x46,
+	0x4e, 0x54, 0xc9, 0x90, 0xfe, 0xcd, 0x10, 0x78, 0x1e, 0xb2, 0xff, 0x14, 0xc2, 0x68, 0x5c, 0x0a,
+	0xfc, 0xdb, 0x58, 0xce, 0x16, 0x07, 0xb3, 0xc5, 0x72, 0x45, 0xd4, 0x6c, 0xf1, 0x81, 0xe9, 0x50,
+	0x55, 0x6b, 0xc4, 0x2a, 0xd1, 0x6b, 0x70, 0x4e, 0x22, 0x09, 0x14, 0xcc, 0x0a, 0x05, 0xef, 0xc9,
+	0xc0, 0x5e, 0x55, 0xff, 0x07, 0xc0, 0xc2, 0x30, 0x00, 0x25, 0xdf, 0x3e, 0xcc, 0x45, 0x2a, 0xf0,
+	0x02, 0x58, 0x9a, 0x59, 0xcd, 0xad, 0xbd, 0x37, 0x4a, 0xbf, 0xbd, 0x2a, 0xf5, 0x7c, 0xf7, 0xc8,
+	0xa5, 0xd5, 0xd8, 0x24, 0xe2, 0x0d, 0xd0, 0x67, 0x09, 0x46, 0x59, 0xc1, 0x68, 0xe5, 0x5a, 0x46,
+	0x12, 0x4c, 0x82, 0xd2, 0x06, 0xbc, 0x7b, 0x43, 0xd1, 0x55, 0xbe, 0xbe, 0x05, 0xdf, 0x90, 0x74,
+	0x45, 0x5a, 0x8a, 0xea, 0x09, 0xb5, 0xc0, 0x80, 0x5a, 0xbf, 0x00, 0x58, 0x1c, 0x55, 0xae, 0x34,
+	0x7b, 0x02, 0x5f, 0x8e, 0xed, 0x6c, 0xd3, 0xf4, 0x6b, 0x52, 0xb8, 0x39, 0x63, 0x21, 0x8a, 0x1f,
+	0x04, 0xe1, 0xdb, 0x5c, 0x2b, 0x0b, 0xbe, 0x39, 0x30, 0x55, 0x89, 0xf8, 0x0b, 0xdf, 0xf4, 0xc3,
+	0x25, 0x41, 0xe5, 0xd4, 0xcf, 0xab, 0x52, 0xf8, 0xf7, 0x62, 0x31, 0xdf, 0x35, 0x1b, 0xf5, 0x4d,
+	0x3d, 0x71, 0xac, 0x0f, 0x7c, 0x78, 0x7d, 0x00, 0xf5, 0x71, 0x97, 0x28, 0x41, 0x4c, 0xf8, 0xc8,
+	0xbd, 0xda, 0x8c, 0x43, 0xa5, 0x2d, 0x0f, 0x52, 0xd4, 0x4e, 0x3f, 0x49, 0xa3, 0x16, 0x5b, 0xa6,
+	0x58, 0xcf, 0x07, 0x6e, 0x5a, 0xf8, 0x36, 0x85, 0xfc, 0x0d, 0xc0, 0xb7, 0x06, 0x49, 0x06, 0xb4,
+	0x3c, 0xde, 0xe6, 0xff, 0xa3, 0x98, 0x68, 0x05, 0x2e, 0xb4, 0x68, 0xc7, 0xe5, 0xc1, 0xa9, 0xd7,
+	0x6e, 0x58, 0xb4, 0x25, 0xc8, 0xcc, 0x1a, 0xf7, 0xc3, 0xf0, 0xbe, 0x88, 0x26, 0x12, 0x63, 0xc4,
+	0x62, 0x89, 0x0a, 0xf9, 0x05, 0x80, 0xcb, 0xd7, 0x20, 0x57, 0x13, 0x2a, 0xc3, 0x05, 0x3b, 0x3c,
+	0x49, 0x4c, 0x26, 0x8f, 0xa5, 0x6b, 0xe3, 0xd0, 0xb5, 0xf1, 0x27, 0x5e, 0xd7, 0xb8, 0x6f, 0x27,
+	0xda, 0x8c, 0xf5, 0x97, 0x68, 0x34, 0x33, 0xe3, 0x46, 0x33, 0x3b, 0xc5, 0x68, 0xd6, 0xbe, 0xbf,
+	0x07, 0xef, 0x08, 0x82, 0xe8, 0x57, 0x00, 0x61, 0xc4, 0x12, 0xe1, 0x51, 0x0e, 0x95, 0xfe, 0x33,
+	0xa3, 0x91, 0x89, 0xf3, 0xa5, 0x60, 0xfa, 0x87, 0xdf, 0xfd, 0xf9, 0xf7, 0x8f, 0xd9, 0xe7, 0x68,
+	0x9d, 0x5c, 0xfb, 0xe3, 0xc8, 0xc9, 0x49, 0x62, 0xee, 0x3d, 0xf4, 0x33, 0x80, 0xb9, 0xa8, 0x27,
+	0x47, 0x93, 0xde, 0x1e, 0x3a, 0x94, 0xf6, 0x6c, 0xf2, 0x02, 0x85, 0xf7, 0x5d, 0x81, 0x77, 0x19,
+	0x3d, 0x9e, 0x00, 0x2f, 0xfa, 0x03, 0xc0, 0x57, 0x86, 0xec, 0x0d, 0x3d, 0x1f, 0x7f, 0xe9, 0x08,
+	0x37, 0xd5, 0x5e, 0xdc, 0xb4, 0x4c, 0x21, 0xfe, 0x48, 0x20, 0xde, 0x40, 0x2f, 0x46, 0x22, 0x96,
+	0x1b, 0x97, 0x14, 0x3a, 0xdc, 0xc2, 0x1e, 0x3a, 0x07, 0xf0, 0x41, 0xaa, 0x2d, 0xa1, 0x0f, 0x26,
+	0x54, 0x6f, 0xd8, 0x2f, 0xb5, 0xcd, 0x69, 0x4a, 0x15, 0xa1, 0x5d, 0x41, 0xa8, 0x82, 0x3e, 0x9e,
+	0x62, 0x65, 0x48, 0xdc, 0x34, 0xd1, 0x4f, 0x59, 0x58, 0x18, 0xf5, 0x49, 0xa3, 0xad, 0x49, 0x21,
+	0xa6, 0x79, 0x98, 0x56, 0x9e, 0xb2, 0x5a, 0x71, 0xfc, 0x56, 0x70, 0xec, 0xa2, 0xaf, 0xa7, 0xe2,
+	0x98, 0x74, 0x20, 0x12, 0xba, 0x19, 0x39, 0x19, 0xf0, 0xc5, 0x1e, 0x91, 0xa6, 0x11, 0x3b, 0x90,
+	0x81, 0x5e, 0xe5, 0xcb, 0xd3, 0x7e, 0x11, 0x9c, 0xf5, 0x8b, 0xe0, 0xaf, 0x7e, 0x11, 0xfc, 0x70,
+	0x59, 0xcc, 0x9c, 0x5d, 0x16, 0x33, 0xe7, 0x97, 0xc5, 0xcc, 0x57, 0x5b, 0x8e, 0xeb, 0xd7, 0xda,
+	0x16, 0xb6, 0x59, 0x83, 0xa8, 0x7f, 0xc7, 0xae, 0x65, 0x3f, 0x75, 0x18, 0xe9, 0xbc, 0x4f, 0x1a,
+	0xac, 0xda, 0xae, 0x53, 0x2e, 0x11, 0x3f, 0x5b, 0x7f, 0x1a, 0x03, 0xed, 0x77, 0x9b, 0x94, 0x5b,
+	0x77, 0x85, 0xff, 0xad, 0xff, 0x37, 0x00, 0x15, 0xc9, 0xf4, 0x7f, 0xab, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1036,6 +1045,13 @@ func (m *QueryConnectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1423,6 +1439,10 @@ func (m *QueryConnectionsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1861,6 +1881,38 @@ func (m *QueryConnectionsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -28,8 +28,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPacketAcknowledgement(),
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
+		GetCmdQueryUnreceivedPacketsRange(),
+		GetCmdQueryUnreceivedAcksRange(),
 		GetCmdQueryNextSequenceReceive(),
-		// TODO: next sequence Send ?
+		GetCmdQueryNextSequenceSend(),
+		GetCmdQueryNextSequenceAck(),
 	)
 
 	return queryCmd
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,7 +16,10 @@ import (
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences    = "sequences"
+	flagConnectionID = "connection"
+	flagState        = "state"
+	flagPortIDPrefix = "port-prefix"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -24,8 +28,8 @@ func GetCmdQueryChannels() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "channels",
 		Short:   "Query all channels",
-		Long:    "Query all channels from a chain",
-		Example: fmt.Sprintf("%s query %s %s channels", version.AppName, host.ModuleName, types.SubModuleName),
+		Long:    "Query all channels from a chain, optionally filtered by connection identifier, channel state and port identifier prefix",
+		Example: fmt.Sprintf("%s query %s %s channels --connection connection-0 --state OPEN --port-prefix icacontroller-", version.AppName, host.ModuleName, types.SubModuleName),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -39,8 +43,31 @@ func GetCmdQueryChannels() *cobra.Command {
 				return err
 			}
 
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			stateStr, err := cmd.Flags().GetString(flagState)
+			if err != nil {
+				return err
+			}
+
+			state, err := parseChannelState(stateStr)
+			if err != nil {
+				return err
+			}
+
+			portIDPrefix, err := cmd.Flags().GetString(flagPortIDPrefix)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryChannelsRequest{
-				Pagination: pageReq,
+				Pagination:   pageReq,
+				ConnectionId: connectionID,
+				State:        state,
+				PortIdPrefix: portIDPrefix,
 			}
 
 			res, err := queryClient.Channels(cmd.Context(), req)
@@ -52,12 +79,35 @@ func GetCmdQueryChannels() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagConnectionID, "", "filter channels by connection identifier")
+	cmd.Flags().String(flagState, "", "filter channels by state (INIT, TRYOPEN, OPEN or CLOSED)")
+	cmd.Flags().String(flagPortIDPrefix, "", "filter channels by port identifier prefix")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "channels")
 
 	return cmd
 }
 
+// parseChannelState parses the channel state from either its full name (STATE_OPEN) or its
+// short name (OPEN), case insensitive. An empty string returns the unspecified state.
+func parseChannelState(state string) (types.State, error) {
+	if state == "" {
+		return types.UNINITIALIZED, nil
+	}
+
+	name := strings.ToUpper(state)
+	if !strings.HasPrefix(name, "STATE_") {
+		name = "STATE_" + name
+	}
+
+	value, ok := types.State_value[name]
+	if !ok || types.State(value) == types.UNINITIALIZED {
+		return types.UNINITIALIZED, fmt.Errorf("invalid channel state %s, expected one of INIT, TRYOPEN, OPEN or CLOSED", state)
+	}
+
+	return types.State(value), nil
+}
+
 // GetCmdQueryChannel defines the command to query a channel end
 func GetCmdQueryChannel() *cobra.Command {
 	cmd := &cobra.Command{
@@ -421,6 +471,126 @@ The return value represents:
 	return cmd
 }
 
+// GetCmdQueryUnreceivedPacketsRange defines the command to query the unreceived
+// packets within a range of sequences on the receiving chain
+func GetCmdQueryUnreceivedPacketsRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unreceived-packets-range [port-id] [channel-id] [from-sequence] [to-sequence]",
+		Short: "Query the unreceived packets associated with a channel within a range of sequences",
+		Long: `Determine which packet sequences within the inclusive range [from-sequence, to-sequence] are unreceived.
+
+The return value represents:
+- Unreceived packet commitments: no receipt exists on the receiving (executing) chain for the packet sequence.
+
+The --limit flag bounds the number of sequences examined per page, so a page may hold fewer results than the limit.
+`,
+		Example: fmt.Sprintf("%s query %s %s unreceived-packets-range [port-id] [channel-id] 1 10000 --limit=100", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromSeq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			toSeq, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnreceivedPacketsRangeRequest{
+				PortId:       args[0],
+				ChannelId:    args[1],
+				FromSequence: fromSeq,
+				ToSequence:   toSeq,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.UnreceivedPacketsRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unreceived packets within the range")
+
+	return cmd
+}
+
+// GetCmdQueryUnreceivedAcksRange defines the command to query the unreceived acks
+// within a range of sequences on the original sending chain
+func GetCmdQueryUnreceivedAcksRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unreceived-acks-range [port-id] [channel-id] [from-sequence] [to-sequence]",
+		Short: "Query the unreceived acks associated with a channel within a range of sequences",
+		Long: `Determine which acknowledgement sequences within the inclusive range [from-sequence, to-sequence] have not been received on the executing chain.
+
+The return value represents:
+- Unreceived packet acknowledgement: packet commitment exists on original sending (executing) chain for the packet sequence.
+
+The --limit flag bounds the number of sequences examined per page, so a page may hold fewer results than the limit.
+`,
+		Example: fmt.Sprintf("%s query %s %s unreceived-acks-range [port-id] [channel-id] 1 10000 --limit=100", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromSeq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			toSeq, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnreceivedAcksRangeRequest{
+				PortId:       args[0],
+				ChannelId:    args[1],
+				FromSequence: fromSeq,
+				ToSequence:   toSeq,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.UnreceivedAcksRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unreceived acks within the range")
+
+	return cmd
+}
+
 // GetCmdQueryNextSequenceReceive defines the command to query a next receive sequence for a given channel
 func GetCmdQueryNextSequenceReceive() *cobra.Command {
 	cmd := &cobra.Command{
@@ -455,3 +625,73 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryNextSequenceSend defines the command to query a next send sequence for a given channel
+func GetCmdQueryNextSequenceSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-sequence-send [port-id] [channel-id]",
+		Short: "Query a next send sequence",
+		Long:  "Query the next send sequence for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s next-sequence-send [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			sequenceRes, err := utils.QueryNextSequenceSend(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(sequenceRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(sequenceRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNextSequenceAck defines the command to query a next acknowledgement sequence for a given channel
+func GetCmdQueryNextSequenceAck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-sequence-ack [port-id] [channel-id]",
+		Short: "Query a next acknowledgement sequence",
+		Long:  "Query the next acknowledgement sequence for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s next-sequence-ack [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			sequenceRes, err := utils.QueryNextSequenceAck(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(sequenceRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(sequenceRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
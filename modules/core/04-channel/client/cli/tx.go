@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// NewCmdSubmitPruneAcknowledgementsProposal implements a command handler for submitting a prune
+// acknowledgements proposal transaction.
+func NewCmdSubmitPruneAcknowledgementsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-acknowledgements [port-id] [channel-id] [pruning-sequence-end] [limit]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a prune IBC packet acknowledgements proposal",
+		Long: "Submit a prune IBC packet acknowledgements proposal along with an initial deposit.\n" +
+			"The pruning sequence end may not exceed the pruning sequence end proven by the channel owner, packet\n" +
+			"acknowledgements and receipts with a lower sequence are pruned, at most limit sequences when the proposal is executed.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal prune-acknowledgements transfer channel-0 100000 1000", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			pruningSequenceEnd, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewPruneAcknowledgementsProposal(title, description, args[0], args[1], pruningSequenceEnd, limit)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
+)
+
+// requireEvent asserts that an event of the given type containing all of the expected attributes was emitted.
+func (suite *KeeperTestSuite) requireEvent(events sdk.Events, eventType string, expAttributes map[string]string) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		for key, value := range expAttributes {
+			suite.Require().Equal(value, attributes[key], "event %s attribute %s", eventType, key)
+		}
+
+		return
+	}
+
+	suite.Require().Failf("event not found", "no %s event emitted", eventType)
+}
+
+// channelEventAttributes returns the attributes expected on every channel lifecycle event of the given endpoint.
+func channelEventAttributes(endpoint *ibctesting.Endpoint) map[string]string {
+	return map[string]string{
+		types.AttributeKeyPortID:             endpoint.ChannelConfig.PortID,
+		types.AttributeKeyChannelID:          endpoint.ChannelID,
+		types.AttributeCounterpartyPortID:    endpoint.Counterparty.ChannelConfig.PortID,
+		types.AttributeCounterpartyChannelID: endpoint.Counterparty.ChannelID,
+		types.AttributeKeyConnectionID:       endpoint.ConnectionID,
+		types.AttributeVersion:               endpoint.ChannelConfig.Version,
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelLifecycleEvents() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg       string
+		eventType string
+		emit      func(ctx sdk.Context) *ibctesting.Endpoint
+	}{
+		{
+			"channel open ack",
+			types.EventTypeChannelOpenAck,
+			func(ctx sdk.Context) *ibctesting.Endpoint {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.WriteOpenAckChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.ChannelConfig.Version, path.EndpointB.ChannelID)
+				return path.EndpointA
+			},
+		},
+		{
+			"channel open confirm",
+			types.EventTypeChannelOpenConfirm,
+			func(ctx sdk.Context) *ibctesting.Endpoint {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.WriteOpenConfirmChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				return path.EndpointA
+			},
+		},
+		{
+			"channel close init",
+			types.EventTypeChannelCloseInit,
+			func(ctx sdk.Context) *ibctesting.Endpoint {
+				channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanCloseInit(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap)
+				suite.Require().NoError(err)
+				return path.EndpointA
+			},
+		},
+		{
+			"channel close confirm",
+			types.EventTypeChannelCloseConfirm,
+			func(ctx sdk.Context) *ibctesting.Endpoint {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				proof, proofHeight := suite.chainA.QueryProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				// a new context is required as updating the client commits a block on chainB
+				ctx = suite.chainB.GetContext()
+				channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanCloseConfirm(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channelCap, proof, proofHeight)
+				suite.Require().NoError(err)
+
+				suite.requireEvent(ctx.EventManager().Events(), types.EventTypeChannelCloseConfirm, channelEventAttributes(path.EndpointB))
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			ctx := suite.chainA.GetContext()
+			if endpoint := tc.emit(ctx); endpoint != nil {
+				suite.requireEvent(ctx.EventManager().Events(), tc.eventType, channelEventAttributes(endpoint))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWriteAcknowledgementDuplicateEvent() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	ctx := suite.chainB.GetContext()
+	err := channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ibcmock.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	// writing the same acknowledgement again is a no-op signalled through an event
+	ctx = suite.chainB.GetContext()
+	err = channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ibcmock.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	suite.requireEvent(ctx.EventManager().Events(), types.EventTypeWriteAckDuplicate, map[string]string{
+		types.AttributeKeySequence:   "1",
+		types.AttributeKeySrcPort:    packet.GetSourcePort(),
+		types.AttributeKeySrcChannel: packet.GetSourceChannel(),
+		types.AttributeKeyDstPort:    packet.GetDestPort(),
+		types.AttributeKeyDstChannel: packet.GetDestChannel(),
+		types.AttributeKeyAckHex:     hex.EncodeToString(ibcmock.MockAcknowledgement.Acknowledgement()),
+		types.AttributeKeyConnection: path.EndpointB.ConnectionID,
+	})
+
+	for _, event := range ctx.EventManager().Events() {
+		suite.Require().NotEqual(types.EventTypeWriteAck, event.Type)
+	}
+
+	ackCommitment, found := channelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(types.CommitAcknowledgement(ibcmock.MockAcknowledgement.Acknowledgement()), ackCommitment)
+
+	// writing a different acknowledgement fails
+	err = channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ibcmock.MockFailAcknowledgement)
+	suite.Require().ErrorIs(err, types.ErrAcknowledgementExists)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -49,25 +50,57 @@ func (q Keeper) Channels(c context.Context, req *types.QueryChannelsRequest) (*t
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if _, ok := types.State_name[int32(req.State)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel state %d", req.State)
+	}
+
+	if strings.Contains(req.PortIdPrefix, "/") {
+		return nil, status.Error(codes.InvalidArgument, "port identifier prefix cannot contain '/'")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// the port identifier prefix is applied by iterating over the channel ends stored under
+	// the prefix, so that the pagination keys are relative to the filtered store
+	keyPrefix := host.KeyChannelEndPrefix
+	if req.PortIdPrefix != "" {
+		keyPrefix = fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, req.PortIdPrefix)
+	}
+
 	channels := []*types.IdentifiedChannel{}
-	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelEndPrefix))
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(keyPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		var result types.Channel
 		if err := q.cdc.Unmarshal(value, &result); err != nil {
-			return err
+			return false, err
 		}
 
-		portID, channelID, err := host.ParseChannelPath(string(key))
+		if req.ConnectionId != "" && (len(result.ConnectionHops) == 0 || result.ConnectionHops[0] != req.ConnectionId) {
+			return false, nil
+		}
+
+		if req.State != types.UNINITIALIZED && result.State != req.State {
+			return false, nil
+		}
+
+		portID, channelID, err := host.ParseChannelPath(strings.TrimPrefix(keyPrefix, host.KeyChannelEndPrefix) + string(key))
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
-		channels = append(channels, &identifiedChannel)
-		return nil
+		if accumulate {
+			identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
+			channels = append(channels, &identifiedChannel)
+		}
+
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -505,6 +538,121 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *types.QueryUnreceivedAcks
 	}, nil
 }
 
+// UnreceivedPacketsRange implements the Query/UnreceivedPacketsRange gRPC method. It returns
+// the sequences within the inclusive range [FromSequence, ToSequence] for which no packet has
+// been received on the executing (receiving) chain. Unlike UnreceivedPackets, the caller does not
+// need to enumerate every sequence. The result is paginated, the pagination key being the big
+// endian encoded sequence to resume from. Each page examines at most limit sequences using point
+// lookups, such that a page may contain fewer than limit sequences.
+func (q Keeper) UnreceivedPacketsRange(c context.Context, req *types.QueryUnreceivedPacketsRangeRequest) (*types.QueryUnreceivedPacketsRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	startSeq, endSeq, err := sequenceRangePagination(req.FromSequence, req.ToSequence, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	unreceivedSequences := []uint64{}
+
+	switch channel.Ordering {
+	case types.UNORDERED:
+		// the receipts of sequences below the pruning sequence start have been pruned after the packets were received
+		pruningSequenceStart := q.GetPruningSequenceStart(ctx, req.PortId, req.ChannelId)
+
+		for seq := startSeq; seq >= startSeq && seq <= endSeq; seq++ {
+			if seq < pruningSequenceStart {
+				continue
+			}
+
+			if _, found := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, seq); !found {
+				unreceivedSequences = append(unreceivedSequences, seq)
+			}
+		}
+	case types.ORDERED:
+		nextSequenceRecv, found := q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				sdkerrors.Wrapf(
+					types.ErrSequenceReceiveNotFound,
+					"destination port: %s, destination channel: %s", req.PortId, req.ChannelId,
+				).Error(),
+			)
+		}
+
+		// any sequence lower than the next sequence to be received has been received
+		for seq := startSeq; seq >= startSeq && seq <= endSeq; seq++ {
+			if seq >= nextSequenceRecv {
+				unreceivedSequences = append(unreceivedSequences, seq)
+			}
+		}
+	default:
+		return nil, status.Error(
+			codes.InvalidArgument,
+			sdkerrors.Wrapf(types.ErrInvalidChannelOrdering, "channel order %s is not supported", channel.Ordering.String()).Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryUnreceivedPacketsRangeResponse{
+		Sequences:  unreceivedSequences,
+		Pagination: sequenceRangePageResponse(endSeq, req.ToSequence),
+		Height:     selfHeight,
+	}, nil
+}
+
+// UnreceivedAcksRange implements the Query/UnreceivedAcksRange gRPC method. It returns the
+// sequences within the inclusive range [FromSequence, ToSequence] for which a packet commitment
+// still exists on the executing (original sending) chain, meaning the acknowledgement has not
+// been received. The result is paginated, the pagination key being the big endian encoded
+// sequence to resume from. Each page examines at most limit sequences using point lookups,
+// such that a page may contain fewer than limit sequences.
+func (q Keeper) UnreceivedAcksRange(c context.Context, req *types.QueryUnreceivedAcksRangeRequest) (*types.QueryUnreceivedAcksRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	startSeq, endSeq, err := sequenceRangePagination(req.FromSequence, req.ToSequence, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	unreceivedSequences := []uint64{}
+	for seq := startSeq; seq >= startSeq && seq <= endSeq; seq++ {
+		if q.HasPacketCommitment(ctx, req.PortId, req.ChannelId, seq) {
+			unreceivedSequences = append(unreceivedSequences, seq)
+		}
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryUnreceivedAcksRangeResponse{
+		Sequences:  unreceivedSequences,
+		Pagination: sequenceRangePageResponse(endSeq, req.ToSequence),
+		Height:     selfHeight,
+	}, nil
+}
+
 // NextSequenceReceive implements the Query/NextSequenceReceive gRPC method
 func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSequenceReceiveRequest) (*types.QueryNextSequenceReceiveResponse, error) {
 	if req == nil {
@@ -528,6 +676,52 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, selfHeight), nil
 }
 
+// NextSequenceSend implements the Query/NextSequenceSend gRPC method
+func (q Keeper) NextSequenceSend(c context.Context, req *types.QueryNextSequenceSendRequest) (*types.QueryNextSequenceSendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sequence, found := q.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrSequenceSendNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryNextSequenceSendResponse(sequence, nil, selfHeight), nil
+}
+
+// NextSequenceAck implements the Query/NextSequenceAck gRPC method
+func (q Keeper) NextSequenceAck(c context.Context, req *types.QueryNextSequenceAckRequest) (*types.QueryNextSequenceAckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sequence, found := q.GetNextSequenceAck(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrSequenceAckNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryNextSequenceAckResponse(sequence, nil, selfHeight), nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -539,3 +733,62 @@ func validategRPCRequest(portID, channelID string) error {
 
 	return nil
 }
+
+// sequenceRangePagination validates the inclusive sequence range [fromSeq, toSeq] and the pagination
+// request of a range query. The inclusive range of sequences to be examined by the requested page is
+// returned, starting at the sequence encoded by the pagination key and containing at most limit
+// sequences. Only key based pagination in ascending order is supported, counting the total is not
+// supported as it would require examining every sequence of the range.
+func sequenceRangePagination(fromSeq, toSeq uint64, pageReq *query.PageRequest) (uint64, uint64, error) {
+	if fromSeq == 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "from sequence cannot be 0")
+	}
+
+	if toSeq < fromSeq {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "to sequence %d cannot be less than from sequence %d", toSeq, fromSeq)
+	}
+
+	startSeq, limit := fromSeq, uint64(query.DefaultLimit)
+	if pageReq != nil {
+		if pageReq.Offset != 0 || pageReq.Reverse {
+			return 0, 0, status.Error(codes.InvalidArgument, "only key based pagination in ascending order is supported")
+		}
+
+		if pageReq.CountTotal {
+			return 0, 0, status.Error(codes.InvalidArgument, "count total is not supported for sequence range queries")
+		}
+
+		if len(pageReq.Key) != 0 {
+			if len(pageReq.Key) != 8 {
+				return 0, 0, status.Errorf(codes.InvalidArgument, "pagination key must be a big endian encoded sequence, got %d bytes", len(pageReq.Key))
+			}
+
+			startSeq = sdk.BigEndianToUint64(pageReq.Key)
+			if startSeq < fromSeq || startSeq > toSeq {
+				return 0, 0, status.Errorf(codes.InvalidArgument, "pagination key sequence %d is outside of range [%d, %d]", startSeq, fromSeq, toSeq)
+			}
+		}
+
+		if pageReq.Limit != 0 {
+			limit = pageReq.Limit
+		}
+	}
+
+	endSeq := toSeq
+	if limit <= toSeq-startSeq {
+		endSeq = startSeq + limit - 1
+	}
+
+	return startSeq, endSeq, nil
+}
+
+// sequenceRangePageResponse returns the page response of a range query whose page examined the
+// sequences up to endSeq. The next key is the sequence following endSeq, unless it lies beyond toSeq.
+func sequenceRangePageResponse(endSeq, toSeq uint64) *query.PageResponse {
+	pageRes := &query.PageResponse{}
+	if endSeq < toSeq {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(endSeq + 1)
+	}
+
+	return pageRes
+}
@@ -9,6 +9,8 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
@@ -200,6 +202,156 @@ func (suite *KeeperTestSuite) TestQueryChannels() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryChannelsFiltered() {
+	type channelInfo struct {
+		portID, channelID, connectionID string
+		state                           types.State
+	}
+
+	// channels are iterated in store key order, ports sort before the channel identifiers
+	channels := []channelInfo{
+		{"icacontroller-a", "channel-4", "connection-0", types.OPEN},
+		{"icacontroller-b", "channel-5", "connection-0", types.CLOSED},
+		{"icahost", "channel-6", "connection-1", types.OPEN},
+		{ibctesting.TransferPort, "channel-0", "connection-0", types.OPEN},
+		{ibctesting.TransferPort, "channel-1", "connection-1", types.OPEN},
+		{ibctesting.TransferPort, "channel-2", "connection-0", types.INIT},
+		{ibctesting.TransferPort, "channel-3", "connection-0", types.OPEN},
+	}
+
+	testCases := []struct {
+		msg      string
+		req      types.QueryChannelsRequest
+		expected []string // port/channel pairs in iteration order
+		expPass  bool
+	}{
+		{
+			"no filters",
+			types.QueryChannelsRequest{},
+			[]string{"icacontroller-a/channel-4", "icacontroller-b/channel-5", "icahost/channel-6", "transfer/channel-0", "transfer/channel-1", "transfer/channel-2", "transfer/channel-3"},
+			true,
+		},
+		{
+			"filter by connection",
+			types.QueryChannelsRequest{ConnectionId: "connection-1"},
+			[]string{"icahost/channel-6", "transfer/channel-1"},
+			true,
+		},
+		{
+			"filter by state",
+			types.QueryChannelsRequest{State: types.OPEN},
+			[]string{"icacontroller-a/channel-4", "icahost/channel-6", "transfer/channel-0", "transfer/channel-1", "transfer/channel-3"},
+			true,
+		},
+		{
+			"filter by connection and state",
+			types.QueryChannelsRequest{ConnectionId: "connection-0", State: types.OPEN},
+			[]string{"icacontroller-a/channel-4", "transfer/channel-0", "transfer/channel-3"},
+			true,
+		},
+		{
+			"filter by port prefix",
+			types.QueryChannelsRequest{PortIdPrefix: "icacontroller-"},
+			[]string{"icacontroller-a/channel-4", "icacontroller-b/channel-5"},
+			true,
+		},
+		{
+			"filter by port prefix, connection and state",
+			types.QueryChannelsRequest{PortIdPrefix: "ica", ConnectionId: "connection-0", State: types.OPEN},
+			[]string{"icacontroller-a/channel-4"},
+			true,
+		},
+		{
+			"no matching channels",
+			types.QueryChannelsRequest{PortIdPrefix: "mock"},
+			nil,
+			true,
+		},
+		{
+			"invalid connection identifier",
+			types.QueryChannelsRequest{ConnectionId: "(connection)"},
+			nil,
+			false,
+		},
+		{
+			"invalid state",
+			types.QueryChannelsRequest{State: types.State(10)},
+			nil,
+			false,
+		},
+		{
+			"invalid port prefix",
+			types.QueryChannelsRequest{PortIdPrefix: "transfer/channels"},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			for _, ch := range channels {
+				channel := types.NewChannel(ch.state, types.UNORDERED, types.NewCounterparty(ch.portID, ch.channelID), []string{ch.connectionID}, ibctesting.DefaultChannelVersion)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), ch.portID, ch.channelID, channel)
+			}
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			// the first page is requested with an offset and counts the total number of matching channels
+			req := tc.req
+			req.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+
+			res, err := suite.chainA.QueryServer.Channels(ctx, &req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(len(tc.expected)), res.Pagination.Total)
+
+			// follow the next keys until all pages are returned, a page may be empty if only
+			// non matching channels remain after the last key
+			var result []string
+			for {
+				suite.Require().LessOrEqual(len(res.Channels), 2)
+				for _, channel := range res.Channels {
+					result = append(result, fmt.Sprintf("%s/%s", channel.PortId, channel.ChannelId))
+				}
+
+				if res.Pagination.NextKey == nil {
+					break
+				}
+
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+				res, err = suite.chainA.QueryServer.Channels(ctx, &req)
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(tc.expected, result)
+
+			// offset pagination skips the filtered channels before the offset
+			req.Pagination = &query.PageRequest{Offset: 1, Limit: 2}
+			res, err = suite.chainA.QueryServer.Channels(ctx, &req)
+			suite.Require().NoError(err)
+
+			var offsetResult, expOffsetResult []string
+			for _, channel := range res.Channels {
+				offsetResult = append(offsetResult, fmt.Sprintf("%s/%s", channel.PortId, channel.ChannelId))
+			}
+
+			for i := 1; i < len(tc.expected) && i < 3; i++ {
+				expOffsetResult = append(expOffsetResult, tc.expected[i])
+			}
+
+			suite.Require().Equal(expOffsetResult, offsetResult)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConnectionChannels() {
 	var (
 		req         *types.QueryConnectionChannelsRequest
@@ -1462,10 +1614,11 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
+func (suite *KeeperTestSuite) TestQueryUnreceivedPacketsRange() {
 	var (
-		req    *types.QueryNextSequenceReceiveRequest
-		expSeq uint64
+		req        *types.QueryUnreceivedPacketsRangeRequest
+		expSeq     []uint64
+		expNextKey []byte
 	)
 
 	testCases := []struct {
@@ -1483,9 +1636,11 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		{
 			"invalid port ID",
 			func() {
-				req = &types.QueryNextSequenceReceiveRequest{
-					PortId:    "",
-					ChannelId: "test-channel-id",
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
 				}
 			},
 			false,
@@ -1493,9 +1648,87 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		{
 			"invalid channel ID",
 			func() {
-				req = &types.QueryNextSequenceReceiveRequest{
-					PortId:    "test-port-id",
-					ChannelId: "",
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "",
+					FromSequence: 1,
+					ToSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"from sequence is 0",
+			func() {
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 0,
+					ToSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"to sequence less than from sequence",
+			func() {
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 10,
+					ToSequence:   9,
+				}
+			},
+			false,
+		},
+		{
+			"offset pagination not supported",
+			func() {
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Offset: 1},
+				}
+			},
+			false,
+		},
+		{
+			"invalid pagination key",
+			func() {
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Key: []byte("key")},
+				}
+			},
+			false,
+		},
+		{
+			"pagination key outside of range",
+			func() {
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Key: sdk.Uint64ToBigEndian(11)},
+				}
+			},
+			false,
+		},
+		{
+			"count total not supported",
+			func() {
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{CountTotal: true},
 				}
 			},
 			false,
@@ -1503,24 +1736,128 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		{
 			"channel not found",
 			func() {
-				req = &types.QueryNextSequenceReceiveRequest{
-					PortId:    "test-port-id",
-					ChannelId: "test-channel-id",
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       "invalid-port-id",
+					ChannelId:    "invalid-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
 				}
 			},
 			false,
 		},
 		{
-			"success",
+			"basic success empty packet receipts",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				suite.coordinator.Setup(path)
-				expSeq = 1
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expSeq)
 
-				req = &types.QueryNextSequenceReceiveRequest{
-					PortId:    path.EndpointA.ChannelConfig.PortID,
-					ChannelId: path.EndpointA.ChannelID,
+				expSeq = []uint64{1, 2, 3}
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 1,
+					ToSequence:   3,
+				}
+			},
+			true,
+		},
+		{
+			"success unordered channel, paginated from key",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				for _, seq := range []uint64{2, 4, 5, 9} {
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+				}
+
+				// the page examines sequences 3 to 6
+				expSeq = []uint64{3, 6}
+				expNextKey = sdk.Uint64ToBigEndian(7)
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Key: sdk.Uint64ToBigEndian(3), Limit: 4},
+				}
+			},
+			true,
+		},
+		{
+			"success unordered channel, page without unreceived packets",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				for _, seq := range []uint64{4, 5} {
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+				}
+
+				expSeq = []uint64{}
+				expNextKey = sdk.Uint64ToBigEndian(6)
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Key: sdk.Uint64ToBigEndian(4), Limit: 2},
+				}
+			},
+			true,
+		},
+		{
+			"success unordered channel, pruned sequences are received",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 4)
+
+				expSeq = []uint64{4, 5}
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 1,
+					ToSequence:   5,
+				}
+			},
+			true,
+		},
+		{
+			"success ordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+
+				expSeq = []uint64{5, 6, 7, 8}
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 3,
+					ToSequence:   8,
+				}
+			},
+			true,
+		},
+		{
+			"success ordered channel, all received",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 20)
+
+				expSeq = []uint64{}
+				req = &types.QueryUnreceivedPacketsRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 3,
+					ToSequence:   8,
 				}
 			},
 			true,
@@ -1530,19 +1867,597 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expNextKey = nil
 
 			tc.malleate()
 			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 
-			res, err := suite.chainA.QueryServer.NextSequenceReceive(ctx, req)
+			res, err := suite.chainA.QueryServer.UnreceivedPacketsRange(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expSeq, res.NextSequenceReceive)
+				suite.Require().Equal(expSeq, res.Sequences)
+				suite.Require().Equal(expNextKey, res.Pagination.NextKey)
 			} else {
 				suite.Require().Error(err)
 			}
 		})
 	}
 }
+
+// TestQueryUnreceivedPacketsRangeSparse pages through a 10k sequence range with sparse packet
+// receipts and verifies every sequence without a receipt is returned exactly once, in order.
+func (suite *KeeperTestSuite) TestQueryUnreceivedPacketsRangeSparse() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	const (
+		maxSeq = uint64(10000)
+		limit  = uint64(1000)
+	)
+
+	ctx := suite.chainA.GetContext()
+	expSeq := []uint64{}
+	for seq := uint64(1); seq <= maxSeq; seq++ {
+		if seq%7 == 0 || seq == maxSeq {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+			continue
+		}
+
+		expSeq = append(expSeq, seq)
+	}
+
+	// receipts outside of the range must not be considered
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, maxSeq+1)
+
+	var (
+		sequences []uint64
+		nextKey   []byte
+		pages     int
+	)
+
+	for {
+		res, err := suite.chainA.QueryServer.UnreceivedPacketsRange(sdk.WrapSDKContext(ctx), &types.QueryUnreceivedPacketsRangeRequest{
+			PortId:       path.EndpointA.ChannelConfig.PortID,
+			ChannelId:    path.EndpointA.ChannelID,
+			FromSequence: 1,
+			ToSequence:   maxSeq,
+			Pagination:   &query.PageRequest{Key: nextKey, Limit: limit},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(uint64(len(res.Sequences)), limit)
+
+		sequences = append(sequences, res.Sequences...)
+		nextKey = res.Pagination.NextKey
+		pages++
+
+		if nextKey == nil {
+			break
+		}
+	}
+
+	suite.Require().Equal(expSeq, sequences)
+	// every page examines limit sequences
+	suite.Require().Equal(int(maxSeq/limit), pages)
+}
+
+func (suite *KeeperTestSuite) TestQueryUnreceivedAcksRange() {
+	var (
+		req        *types.QueryUnreceivedAcksRangeRequest
+		expSeq     []uint64
+		expNextKey []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       "",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "",
+					FromSequence: 1,
+					ToSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"from sequence is 0",
+			func() {
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 0,
+					ToSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"to sequence less than from sequence",
+			func() {
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 10,
+					ToSequence:   9,
+				}
+			},
+			false,
+		},
+		{
+			"reverse pagination not supported",
+			func() {
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Reverse: true},
+				}
+			},
+			false,
+		},
+		{
+			"count total not supported",
+			func() {
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       "test-port-id",
+					ChannelId:    "test-channel-id",
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{CountTotal: true},
+				}
+			},
+			false,
+		},
+		{
+			"basic success unreceived packet acknowledgements, nothing to relay",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expSeq = []uint64{}
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 1,
+					ToSequence:   10,
+				}
+			},
+			true,
+		},
+		{
+			"success, paginated from key",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				for _, seq := range []uint64{2, 4, 5, 9, 11} {
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq, []byte("commitment"))
+				}
+
+				// the page examines sequences 3 to 6
+				expSeq = []uint64{4, 5}
+				expNextKey = sdk.Uint64ToBigEndian(7)
+				req = &types.QueryUnreceivedAcksRangeRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ChannelId:    path.EndpointA.ChannelID,
+					FromSequence: 1,
+					ToSequence:   10,
+					Pagination:   &query.PageRequest{Key: sdk.Uint64ToBigEndian(3), Limit: 4},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expNextKey = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.UnreceivedAcksRange(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.Sequences)
+				suite.Require().Equal(expNextKey, res.Pagination.NextKey)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestQueryUnreceivedAcksRangeSparse pages through a 10k sequence range with sparse packet
+// commitments and verifies every committed sequence is returned exactly once, in order.
+func (suite *KeeperTestSuite) TestQueryUnreceivedAcksRangeSparse() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	const (
+		maxSeq = uint64(10000)
+		limit  = uint64(100)
+	)
+
+	ctx := suite.chainA.GetContext()
+	expSeq := []uint64{}
+	for seq := uint64(1); seq <= maxSeq; seq++ {
+		if seq%7 == 0 || seq == maxSeq {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq, []byte("commitment"))
+			expSeq = append(expSeq, seq)
+		}
+	}
+
+	// commitments outside of the range must not be considered
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, maxSeq+1, []byte("commitment"))
+
+	var (
+		sequences []uint64
+		nextKey   []byte
+		pages     int
+	)
+
+	for {
+		res, err := suite.chainA.QueryServer.UnreceivedAcksRange(sdk.WrapSDKContext(ctx), &types.QueryUnreceivedAcksRangeRequest{
+			PortId:       path.EndpointA.ChannelConfig.PortID,
+			ChannelId:    path.EndpointA.ChannelID,
+			FromSequence: 1,
+			ToSequence:   maxSeq,
+			Pagination:   &query.PageRequest{Key: nextKey, Limit: limit},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(uint64(len(res.Sequences)), limit)
+
+		sequences = append(sequences, res.Sequences...)
+		nextKey = res.Pagination.NextKey
+		pages++
+
+		if nextKey == nil {
+			break
+		}
+	}
+
+	suite.Require().Equal(expSeq, sequences)
+	// every page examines limit sequences
+	suite.Require().Equal(int(maxSeq/limit), pages)
+}
+
+func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
+	var (
+		req    *types.QueryNextSequenceReceiveRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expSeq = 1
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expSeq)
+
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.NextSequenceReceive(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.NextSequenceReceive)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNextSequenceSend() {
+	var (
+		req    *types.QueryNextSequenceSendRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expSeq = 1
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expSeq)
+
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.NextSequenceSend(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.NextSequenceSend)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNextSequenceAck() {
+	var (
+		req    *types.QueryNextSequenceAckRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryNextSequenceAckRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryNextSequenceAckRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryNextSequenceAckRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expSeq = 1
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expSeq)
+
+				req = &types.QueryNextSequenceAckRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.NextSequenceAck(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.NextSequenceAck)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNextSequenceProofs() {
+	testCases := []struct {
+		msg    string
+		key    func(portID, channelID string) []byte
+		setSeq func(ctx sdk.Context, portID, channelID string, sequence uint64)
+	}{
+		{
+			"next sequence send",
+			host.NextSequenceSendKey,
+			func(ctx sdk.Context, portID, channelID string, sequence uint64) {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(ctx, portID, channelID, sequence)
+			},
+		},
+		{
+			"next sequence ack",
+			host.NextSequenceAckKey,
+			func(ctx sdk.Context, portID, channelID string, sequence uint64) {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(ctx, portID, channelID, sequence)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			expSeq := uint64(10)
+			portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+			tc.setSeq(suite.chainA.GetContext(), portID, channelID, expSeq)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			proof, proofHeight := suite.chainA.QueryProof(tc.key(portID, channelID))
+
+			consensusState := path.EndpointB.GetConsensusState(proofHeight)
+
+			var merkleProof commitmenttypes.MerkleProof
+			suite.Require().NoError(suite.chainA.Codec.Unmarshal(proof, &merkleProof))
+
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(string(tc.key(portID, channelID))))
+			suite.Require().NoError(err)
+
+			err = merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), merklePath, sdk.Uint64ToBigEndian(expSeq))
+			suite.Require().NoError(err)
+
+			// a proof for the sequence must not verify against a different value
+			err = merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), merklePath, sdk.Uint64ToBigEndian(expSeq+1))
+			suite.Require().Error(err)
+		})
+	}
+}
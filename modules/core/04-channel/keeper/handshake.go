@@ -11,7 +11,6 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
-	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
 
@@ -50,13 +49,13 @@ func (k Keeper) ChanOpenInit(
 		)
 	}
 
-	if !k.portKeeper.Authenticate(ctx, portCap, portID) {
+	if !k.authenticatePortCapability(ctx, portCap, portID) {
 		return "", nil, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "caller does not own port capability for port ID %s", portID)
 	}
 
 	channelID := k.GenerateChannelIdentifier(ctx)
 
-	capKey, err := k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	capKey, err := k.newChannelCapability(ctx, portID, channelID)
 	if err != nil {
 		return "", nil, sdkerrors.Wrapf(err, "could not create channel capability for port ID %s and channel ID %s", portID, channelID)
 	}
@@ -113,7 +112,7 @@ func (k Keeper) ChanOpenTry(
 	// generate a new channel
 	channelID := k.GenerateChannelIdentifier(ctx)
 
-	if !k.portKeeper.Authenticate(ctx, portCap, portID) {
+	if !k.authenticatePortCapability(ctx, portCap, portID) {
 		return "", nil, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "caller does not own port capability for port ID %s", portID)
 	}
 
@@ -168,7 +167,7 @@ func (k Keeper) ChanOpenTry(
 		err    error
 	)
 
-	capKey, err = k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	capKey, err = k.newChannelCapability(ctx, portID, channelID)
 	if err != nil {
 		return "", nil, sdkerrors.Wrapf(err, "could not create channel capability for port ID %s and channel ID %s", portID, channelID)
 	}
@@ -226,7 +225,7 @@ func (k Keeper) ChanOpenAck(
 		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel state should be INIT (got %s)", channel.State.String())
 	}
 
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+	if !k.authenticateChannelCapability(ctx, chanCap, portID, channelID) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
 	}
 
@@ -312,7 +311,7 @@ func (k Keeper) ChanOpenConfirm(
 		)
 	}
 
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+	if !k.authenticateChannelCapability(ctx, chanCap, portID, channelID) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
 	}
 
@@ -383,7 +382,7 @@ func (k Keeper) ChanCloseInit(
 	channelID string,
 	chanCap *capabilitytypes.Capability,
 ) error {
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+	if !k.authenticateChannelCapability(ctx, chanCap, portID, channelID) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
 	}
 
@@ -432,7 +431,7 @@ func (k Keeper) ChanCloseConfirm(
 	proofInit []byte,
 	proofHeight exported.Height,
 ) error {
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+	if !k.authenticateChannelCapability(ctx, chanCap, portID, channelID) {
 		return sdkerrors.Wrap(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)")
 	}
 
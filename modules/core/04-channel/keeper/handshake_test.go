@@ -7,6 +7,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
@@ -25,9 +26,10 @@ type testCase = struct {
 // can succeed.
 func (suite *KeeperTestSuite) TestChanOpenInit() {
 	var (
-		path     *ibctesting.Path
-		features []string
-		portCap  *capabilitytypes.Capability
+		path          *ibctesting.Path
+		features      []string
+		portCap       *capabilitytypes.Capability
+		channelKeeper keeper.Keeper
 	)
 
 	testCases := []testCase{
@@ -85,6 +87,32 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{"success: statically owned port without port capability", func() {
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			path.EndpointA.ChannelConfig.PortID = ibctesting.MockStaticPort
+			portCap = nil
+			channelKeeper = suite.chainA.GetChannelKeeper(ibctesting.MockStaticPort)
+		}, true},
+		{"statically owned port used by another module", func() {
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			path.EndpointA.ChannelConfig.PortID = ibctesting.MockStaticPort
+			portCap = nil
+			channelKeeper = suite.newChannelKeeper().ScopeToModule(ibctesting.MockPort)
+		}, false},
+		{"statically owned port used by unscoped keeper", func() {
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			path.EndpointA.ChannelConfig.PortID = ibctesting.MockStaticPort
+			portCap = nil
+		}, false},
+		{"port capability is nil", func() {
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = nil
+		}, false},
 	}
 
 	for _, tc := range testCases {
@@ -96,12 +124,14 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 				path = ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.EndpointA.ChannelConfig.Order = order
 				path.EndpointB.ChannelConfig.Order = order
+				channelKeeper = suite.chainA.App.GetIBCKeeper().ChannelKeeper
 
 				tc.malleate()
 
 				counterparty := types.NewCounterparty(ibctesting.MockPort, ibctesting.FirstChannelID)
+				_, isStatic := suite.chainA.App.GetIBCKeeper().PortKeeper.StaticPortOwner(path.EndpointA.ChannelConfig.PortID)
 
-				channelID, cap, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanOpenInit(
+				channelID, cap, err := channelKeeper.ChanOpenInit(
 					suite.chainA.GetContext(), path.EndpointA.ChannelConfig.Order, []string{path.EndpointA.ConnectionID},
 					path.EndpointA.ChannelConfig.PortID, portCap, counterparty, path.EndpointA.ChannelConfig.Version,
 				)
@@ -118,15 +148,22 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 				// asserting the channel handshake initiation succeeded
 				if tc.expPass && orderSupported {
 					suite.Require().NoError(err)
-					suite.Require().NotNil(cap)
 					suite.Require().Equal(types.FormatChannelIdentifier(0), channelID)
 
 					chanCap, ok := suite.chainA.App.GetScopedIBCKeeper().GetCapability(
 						suite.chainA.GetContext(),
 						host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, channelID),
 					)
-					suite.Require().True(ok, "could not retrieve channel capability after successful ChanOpenInit")
-					suite.Require().Equal(chanCap.String(), cap.String(), "channel capability is not correct")
+
+					// channels on statically owned ports are not assigned capabilities
+					if isStatic {
+						suite.Require().Nil(cap)
+						suite.Require().False(ok, "channel capability created for channel on statically owned port")
+					} else {
+						suite.Require().NotNil(cap)
+						suite.Require().True(ok, "could not retrieve channel capability after successful ChanOpenInit")
+						suite.Require().Equal(chanCap.String(), cap.String(), "channel capability is not correct")
+					}
 				} else {
 					suite.Require().Error(err)
 					suite.Require().Nil(cap)
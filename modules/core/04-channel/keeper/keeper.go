@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -33,6 +34,17 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     capabilitykeeper.ScopedKeeper
+
+	// module is the name of the module the keeper is scoped to, see ScopeToModule
+	module string
+	// scopes is shared by all copies of the keeper
+	scopes *moduleScopes
+}
+
+// moduleScopes records the names of the modules a keeper has been scoped to
+type moduleScopes struct {
+	modules map[string]struct{}
+	sealed  bool
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -48,7 +60,40 @@ func NewKeeper(
 		connectionKeeper: connectionKeeper,
 		portKeeper:       portKeeper,
 		scopedKeeper:     scopedKeeper,
+		scopes:           &moduleScopes{modules: make(map[string]struct{})},
+	}
+}
+
+// ScopeToModule returns a copy of the keeper scoped to the provided module name. Channels on ports statically owned
+// by a module registered on the IBC router are not authenticated using capabilities, they are instead only
+// authenticated for a keeper scoped to the owning module, or to core IBC which routes to the owning module. The
+// scoped keeper must be created when the app is wired and only be handed to the owning module. Like scoped capability
+// keepers, a keeper may only be scoped once to each module name and not after the keeper is sealed, which happens when
+// the IBC router is set. It will panic if the module name is empty, already scoped to or the keeper is sealed.
+func (k Keeper) ScopeToModule(module string) Keeper {
+	if k.scopes.sealed {
+		panic("cannot scope to module via a sealed channel keeper")
+	}
+	if strings.TrimSpace(module) == "" {
+		panic("cannot scope to an empty module name")
+	}
+	if _, ok := k.scopes.modules[module]; ok {
+		panic(fmt.Sprintf("cannot create multiple scoped channel keepers for the same module name: %s", module))
+	}
+
+	k.scopes.modules[module] = struct{}{}
+
+	k.module = module
+	return k
+}
+
+// Seal prevents the keeper from being scoped to any further modules. It will panic if the keeper is already sealed.
+func (k Keeper) Seal() {
+	if k.scopes.sealed {
+		panic("channel keeper already sealed")
 	}
+
+	k.scopes.sealed = true
 }
 
 // Logger returns a module-specific logger.
@@ -168,6 +213,45 @@ func (k Keeper) SetNextSequenceAck(ctx sdk.Context, portID, channelID string, se
 	store.Set(host.NextSequenceAckKey(portID, channelID), bz)
 }
 
+// GetPruningSequenceStart gets the next sequence to be pruned of a channel. If no packet
+// acknowledgements or receipts have been pruned yet, the first sequence is returned.
+func (k Keeper) GetPruningSequenceStart(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PruningSequenceStartKey(portID, channelID))
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPruningSequenceStart sets the next sequence to be pruned of a channel
+func (k Keeper) SetPruningSequenceStart(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.PruningSequenceStartKey(portID, channelID), bz)
+}
+
+// GetPruningSequenceEnd gets the sequence below which packet acknowledgements and receipts
+// of a channel may be pruned
+func (k Keeper) GetPruningSequenceEnd(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PruningSequenceEndKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPruningSequenceEnd sets the sequence below which packet acknowledgements and receipts
+// of a channel may be pruned
+func (k Keeper) SetPruningSequenceEnd(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.PruningSequenceEndKey(portID, channelID), bz)
+}
+
 // GetPacketReceipt gets a packet receipt from the store
 func (k Keeper) GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -185,6 +269,11 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -231,6 +320,11 @@ func (k Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
+func (k Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+}
+
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
 // For each sequence, cb will be called. If the cb returns true, the iterator
 // will close and stop.
@@ -440,8 +534,22 @@ func (k Keeper) GetChannelConnection(ctx sdk.Context, portID, channelID string)
 	return connectionID, connection, nil
 }
 
-// LookupModuleByChannel will return the IBCModule along with the capability associated with a given channel defined by its portID and channelID
+// LookupModuleByChannel will return the IBCModule along with the capability associated with a given channel defined by its portID and channelID.
+// A nil capability is returned for channels on ports statically owned by a module registered on the IBC router.
 func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error) {
+	module, ok, err := k.portKeeper.LookupStaticPortOwner(ctx, portID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if ok {
+		if _, found := k.GetChannel(ctx, portID, channelID); !found {
+			return "", nil, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		return module, nil, nil
+	}
+
 	modules, cap, err := k.scopedKeeper.LookupModules(ctx, host.ChannelCapabilityPath(portID, channelID))
 	if err != nil {
 		return "", nil, err
@@ -450,6 +558,64 @@ func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string)
 	return porttypes.GetModuleOwner(modules), cap, nil
 }
 
+// isScopedTo returns true if the keeper is scoped to the provided module owning a statically owned port, or to core
+// IBC which routes the callbacks of statically owned ports to the owning module.
+func (k Keeper) isScopedTo(owner string) bool {
+	return k.module == owner || k.module == host.ModuleName
+}
+
+// authenticatePortCapability authenticates the port capability against the given port ID. Ports statically owned by a
+// module registered on the IBC router are not authenticated using capabilities, they are only authenticated if the
+// keeper is scoped to the owning module.
+func (k Keeper) authenticatePortCapability(ctx sdk.Context, portCap *capabilitytypes.Capability, portID string) bool {
+	owner, ok, err := k.portKeeper.LookupStaticPortOwner(ctx, portID)
+	if err != nil {
+		return false
+	}
+
+	if ok {
+		return k.isScopedTo(owner)
+	}
+
+	return k.portKeeper.Authenticate(ctx, portCap, portID)
+}
+
+// authenticateChannelCapability authenticates the channel capability against the given port ID and channel ID. Channels on
+// ports statically owned by a module registered on the IBC router are not authenticated using capabilities, they are
+// only authenticated if the keeper is scoped to the owning module.
+func (k Keeper) authenticateChannelCapability(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID, channelID string) bool {
+	owner, ok, err := k.portKeeper.LookupStaticPortOwner(ctx, portID)
+	if err != nil {
+		return false
+	}
+
+	if ok {
+		return k.isScopedTo(owner)
+	}
+
+	return k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// newChannelCapability creates the capability for the given port ID and channel ID. Channels on ports statically owned by
+// a module registered on the IBC router are not assigned a capability, in which case a nil capability is returned if the
+// keeper is scoped to the owning module.
+func (k Keeper) newChannelCapability(ctx sdk.Context, portID, channelID string) (*capabilitytypes.Capability, error) {
+	owner, ok, err := k.portKeeper.LookupStaticPortOwner(ctx, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		if !k.isScopedTo(owner) {
+			return nil, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "port %s is statically owned by module %s, not by module %s", portID, owner, k.module)
+		}
+
+		return nil, nil
+	}
+
+	return k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+}
+
 // common functionality for IteratePacketCommitment and IteratePacketAcknowledgement
 func (k Keeper) iterateHashes(_ sdk.Context, iterator db.Iterator, cb func(portID, channelID string, sequence uint64, hash []byte) bool) {
 	defer iterator.Close()
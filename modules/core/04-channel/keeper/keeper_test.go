@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
 )
@@ -36,6 +38,34 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.coordinator.CommitNBlocks(suite.chainB, 2)
 }
 
+// newChannelKeeper returns a new channel keeper of chainA sharing the stores and keepers of the IBC keeper. The channel
+// keeper of the IBC keeper is sealed once the app is wired, a new channel keeper allows tests to scope it to any module.
+func (suite *KeeperTestSuite) newChannelKeeper() keeper.Keeper {
+	app := suite.chainA.GetSimApp()
+	ibcKeeper := app.GetIBCKeeper()
+
+	return keeper.NewKeeper(app.AppCodec(), app.GetKey(host.StoreKey), ibcKeeper.ClientKeeper, ibcKeeper.ConnectionKeeper, &ibcKeeper.PortKeeper, app.GetScopedIBCKeeper())
+}
+
+func (suite *KeeperTestSuite) TestScopeToModule() {
+	// the channel keeper of the IBC keeper is sealed when the router is set
+	suite.Require().Panics(func() {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.ScopeToModule(ibctesting.MockPort)
+	})
+
+	channelKeeper := suite.newChannelKeeper()
+	suite.Require().NotPanics(func() { channelKeeper.ScopeToModule(ibctesting.MockPort) })
+
+	// a keeper may only be scoped once to each module, including through copies of the keeper
+	suite.Require().Panics(func() { channelKeeper.ScopeToModule(ibctesting.MockPort) })
+	suite.Require().Panics(func() { channelKeeper.ScopeToModule(ibctesting.TransferPort).ScopeToModule(ibctesting.MockPort) })
+	suite.Require().Panics(func() { channelKeeper.ScopeToModule(" ") })
+
+	channelKeeper.Seal()
+	suite.Require().Panics(func() { channelKeeper.ScopeToModule(ibctesting.MockFeePort) })
+	suite.Require().Panics(func() { channelKeeper.Seal() })
+}
+
 // TestSetChannel create clients and connections on both chains. It tests for the non-existence
 // and existence of a channel in INIT on chainA.
 func (suite *KeeperTestSuite) TestSetChannel() {
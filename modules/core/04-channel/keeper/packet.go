@@ -40,7 +40,7 @@ func (k Keeper) SendPacket(
 		)
 	}
 
-	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
+	if !k.authenticateChannelCapability(ctx, channelCap, packet.GetSourcePort(), packet.GetSourceChannel()) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
 
@@ -84,10 +84,9 @@ func (k Keeper) SendPacket(
 		)
 	}
 
-	clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
-	if err != nil {
-		return err
-	}
+	// NOTE: the client type is taken from the client state as the localhost client
+	// identifier is not of the format {client-type}-{N}
+	clientType := clientState.ClientType()
 
 	// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
 	// A future change should move this function to be a ClientState callback.
@@ -163,7 +162,7 @@ func (k Keeper) RecvPacket(
 
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
 	capName := host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel())
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+	if !k.authenticateChannelCapability(ctx, chanCap, packet.GetDestPort(), packet.GetDestChannel()) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelCapability,
 			"channel capability failed authentication for capability name %s", capName,
@@ -231,9 +230,10 @@ func (k Keeper) RecvPacket(
 
 	switch channel.Ordering {
 	case types.UNORDERED:
-		// check if the packet receipt has been received already for unordered channels
+		// check if the packet receipt has been received already for unordered channels. Packets with a
+		// sequence below the pruning sequence start have been received before their receipts were pruned.
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-		if found {
+		if found || packet.GetSequence() < k.GetPruningSequenceStart(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
 			EmitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
@@ -329,7 +329,7 @@ func (k Keeper) WriteAcknowledgement(
 
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
 	capName := host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel())
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+	if !k.authenticateChannelCapability(ctx, chanCap, packet.GetDestPort(), packet.GetDestChannel()) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelCapability,
 			"channel capability failed authentication for capability name %s", capName,
@@ -338,9 +338,23 @@ func (k Keeper) WriteAcknowledgement(
 
 	// NOTE: IBC app modules might have written the acknowledgement synchronously on
 	// the OnRecvPacket callback so we need to check if the acknowledgement is already
-	// set on the store and return an error if so.
-	if k.HasPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
-		return types.ErrAcknowledgementExists
+	// set on the store. Writing the same acknowledgement again is a no-op which is only
+	// signalled through an event, while writing a different one returns an error.
+	if ackCommitment, found := k.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()); found {
+		if acknowledgement == nil || !bytes.Equal(ackCommitment, types.CommitAcknowledgement(acknowledgement.Acknowledgement())) {
+			return types.ErrAcknowledgementExists
+		}
+
+		k.Logger(ctx).Info(
+			"acknowledgement already written",
+			"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+			"dst_port", packet.GetDestPort(),
+			"dst_channel", packet.GetDestChannel(),
+		)
+
+		EmitWriteAcknowledgementDuplicateEvent(ctx, packet, channel, acknowledgement.Acknowledgement())
+
+		return nil
 	}
 
 	if acknowledgement == nil {
@@ -404,7 +418,7 @@ func (k Keeper) AcknowledgePacket(
 
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
 	capName := host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+	if !k.authenticateChannelCapability(ctx, chanCap, packet.GetSourcePort(), packet.GetSourceChannel()) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelCapability,
 			"channel capability failed authentication for capability name %s", capName,
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// HandlePruneAcknowledgementsProposal prunes up to the proposal limit of packet acknowledgements
+// and receipts of the channel below the pruning sequence end of the proposal. The proposed pruning
+// sequence end may not exceed the pruning sequence end proven using ConfirmPruningSequenceEnd.
+// Remaining entries may be pruned by subsequent calls to PruneAcknowledgements.
+func (k Keeper) HandlePruneAcknowledgementsProposal(ctx sdk.Context, p *types.PruneAcknowledgementsProposal) error {
+	if _, found := k.GetChannel(ctx, p.PortId, p.ChannelId); !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	end, found := k.GetPruningSequenceEnd(ctx, p.PortId, p.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPruningSequenceEndNotFound, "port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	if p.PruningSequenceEnd > end {
+		return sdkerrors.Wrapf(types.ErrInvalidPruningSequence, "proposed pruning sequence end %d exceeds the proven pruning sequence end %d", p.PruningSequenceEnd, end)
+	}
+
+	_, _, err := k.pruneAcknowledgements(ctx, p.PortId, p.ChannelId, p.PruningSequenceEnd, p.Limit)
+	return err
+}
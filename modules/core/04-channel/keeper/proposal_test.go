@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandlePruneAcknowledgementsProposal() {
+	var (
+		path     *ibctesting.Path
+		proposal *types.PruneAcknowledgementsProposal
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"channel not found",
+			func() {
+				proposal.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"pruning sequence end not proven",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 7)
+			},
+			false,
+		},
+		{
+			"pruning sequence end not found",
+			func() {
+				store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(host.StoreKey))
+				store.Delete(host.PruningSequenceEndKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.setupPrunableChannel(path)
+
+			proofs, proofHeight := suite.queryPruningProofs(path, 1, 9)
+			chanCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ConfirmPruningSequenceEnd(suite.chainB.GetContext(), chanCap, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 9, proofs, proofHeight)
+			suite.Require().NoError(err)
+
+			proposal = types.NewPruneAcknowledgementsProposal(ibctesting.Title, ibctesting.Description, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 8, 5).(*types.PruneAcknowledgementsProposal)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			err = channelKeeper.HandlePruneAcknowledgementsProposal(ctx, proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				end, found := channelKeeper.GetPruningSequenceEnd(ctx, proposal.PortId, proposal.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(9), end)
+				suite.Require().Equal(uint64(6), channelKeeper.GetPruningSequenceStart(ctx, proposal.PortId, proposal.ChannelId))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// PruneAcknowledgements deletes the packet acknowledgements and packet receipts of a channel
+// whose sequence lies below the pruning sequence end, below which the counterparty has been
+// proven to have processed the acknowledgement or timeout of every packet. At most limit
+// sequences are processed per call and the pruning sequence start is advanced past the last
+// processed sequence, so repeated calls resume where the previous one stopped. Packets with a
+// sequence below the pruning sequence start are treated as received by RecvPacket. Packet
+// commitments are never deleted. The number of pruned entries and the number of sequences left
+// to be processed are returned.
+func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string, limit uint64) (uint64, uint64, error) {
+	if _, found := k.GetChannel(ctx, portID, channelID); !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	end, found := k.GetPruningSequenceEnd(ctx, portID, channelID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrPruningSequenceEndNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return k.pruneAcknowledgements(ctx, portID, channelID, end, limit)
+}
+
+// pruneAcknowledgements deletes at most limit sequences of packet acknowledgements and receipts
+// of a channel between the pruning sequence start and the provided end.
+func (k Keeper) pruneAcknowledgements(ctx sdk.Context, portID, channelID string, end, limit uint64) (uint64, uint64, error) {
+	if limit == 0 {
+		return 0, 0, sdkerrors.Wrap(types.ErrInvalidPruningLimit, "limit must be greater than 0")
+	}
+
+	start := k.GetPruningSequenceStart(ctx, portID, channelID)
+
+	var (
+		totalPruned uint64
+		sequence    = start
+	)
+
+	for ; sequence < end && sequence-start < limit; sequence++ {
+		if k.HasPacketAcknowledgement(ctx, portID, channelID, sequence) {
+			k.deletePacketAcknowledgement(ctx, portID, channelID, sequence)
+			totalPruned++
+		}
+
+		if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); found {
+			k.deletePacketReceipt(ctx, portID, channelID, sequence)
+			totalPruned++
+		}
+	}
+
+	k.SetPruningSequenceStart(ctx, portID, channelID, sequence)
+
+	k.Logger(ctx).Info("packet acknowledgements pruned", "port-id", portID, "channel-id", channelID, "total-pruned", totalPruned, "pruning-sequence-start", sequence)
+
+	EmitPruneAcknowledgementsEvent(ctx, portID, channelID, totalPruned, sequence, end)
+
+	var totalRemaining uint64
+	if end > sequence {
+		totalRemaining = end - sequence
+	}
+
+	return totalPruned, totalRemaining, nil
+}
+
+// ConfirmPruningSequenceEnd allows the module owning the channel capability to advance the pruning
+// sequence end of the channel, below which packet acknowledgements and receipts may be pruned. A
+// proof of the absence of the counterparty packet commitment at the provided proof height must be
+// provided for every sequence from the current pruning sequence end, or the pruning sequence start
+// if no end has been confirmed yet, up to the new pruning sequence end, in order of sequence. The
+// absence of the commitment proves that the counterparty has processed the acknowledgement or the
+// timeout of the packet, such that its acknowledgement and receipt are no longer needed. The new
+// pruning sequence end is also bounded by the packets received on the channel, such that the
+// counterparty has sent every packet below it.
+func (k Keeper) ConfirmPruningSequenceEnd(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	portID, channelID string,
+	sequence uint64,
+	proofs [][]byte,
+	proofHeight exported.Height,
+) error {
+	if !k.authenticateChannelCapability(ctx, chanCap, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	from, found := k.GetPruningSequenceEnd(ctx, portID, channelID)
+	if !found {
+		from = k.GetPruningSequenceStart(ctx, portID, channelID)
+	}
+
+	// the pruning sequence end may never decrease, as the pruning sequence start may already have been advanced
+	if sequence < from {
+		return sdkerrors.Wrapf(types.ErrInvalidPruningSequence, "pruning sequence end cannot decrease from %d to %d", from, sequence)
+	}
+
+	if uint64(len(proofs)) != sequence-from {
+		return sdkerrors.Wrapf(types.ErrInvalidPruningSequence, "expected %d packet commitment absence proofs for sequences [%d, %d), got %d", sequence-from, from, sequence, len(proofs))
+	}
+
+	if sequence > from {
+		if err := k.validatePruningSequenceReceived(ctx, channel, portID, channelID, sequence); err != nil {
+			return err
+		}
+
+		connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+		}
+
+		for i, proof := range proofs {
+			if err := k.connectionKeeper.VerifyPacketCommitmentAbsence(
+				ctx, connectionEnd, proofHeight, proof,
+				channel.Counterparty.PortId, channel.Counterparty.ChannelId, from+uint64(i),
+			); err != nil {
+				return sdkerrors.Wrapf(err, "couldn't verify absence of counterparty packet commitment for sequence %d", from+uint64(i))
+			}
+		}
+	}
+
+	k.SetPruningSequenceEnd(ctx, portID, channelID, sequence)
+
+	return nil
+}
+
+// validatePruningSequenceReceived ensures every packet below the provided pruning sequence end has been
+// sent by the counterparty. On ORDERED channels the packets below the next sequence receive have been
+// received. Packets on UNORDERED channels are sent in order of sequence, so the receipt of the packet
+// preceding the pruning sequence end proves every lower sequence has been sent.
+func (k Keeper) validatePruningSequenceReceived(ctx sdk.Context, channel types.Channel, portID, channelID string, sequence uint64) error {
+	switch channel.Ordering {
+	case types.ORDERED:
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrSequenceReceiveNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		if sequence > nextSequenceRecv {
+			return sdkerrors.Wrapf(types.ErrInvalidPruningSequence, "pruning sequence end %d exceeds the next sequence receive %d", sequence, nextSequenceRecv)
+		}
+
+	default:
+		if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence-1); !found {
+			return sdkerrors.Wrapf(types.ErrInvalidPruningSequence, "packet with sequence %d preceding the pruning sequence end has not been received", sequence-1)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const numPrunableSequences = uint64(10)
+
+// setupPrunableChannel relays a packet from chainA to chainB for every sequence up to
+// numPrunableSequences, storing a packet acknowledgement and receipt on chainB and deleting the
+// packet commitment on chainA. The commitments of packets sent by chainB which have not been
+// acknowledged yet are stored as well. The client on chainB is updated to the latest height of chainA.
+func (suite *KeeperTestSuite) setupPrunableChannel(path *ibctesting.Path) {
+	suite.coordinator.Setup(path)
+
+	for seq := uint64(1); seq <= numPrunableSequences; seq++ {
+		packet := types.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+		err := path.EndpointA.SendPacket(packet)
+		suite.Require().NoError(err)
+
+		err = path.RelayPacket(packet)
+		suite.Require().NoError(err)
+
+		packet = types.NewPacket(ibctesting.MockPacketData, seq, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+		err = path.EndpointB.SendPacket(packet)
+		suite.Require().NoError(err)
+	}
+
+	err := path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+}
+
+// queryPruningProofs queries the proofs of absence of the packet commitments on chainA for
+// every sequence in [from, to).
+func (suite *KeeperTestSuite) queryPruningProofs(path *ibctesting.Path, from, to uint64) ([][]byte, clienttypes.Height) {
+	var (
+		proofs      [][]byte
+		proofHeight clienttypes.Height
+	)
+
+	for seq := from; seq < to; seq++ {
+		var proof []byte
+		proof, proofHeight = path.EndpointA.QueryProof(host.PacketCommitmentKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq))
+		proofs = append(proofs, proof)
+	}
+
+	return proofs, proofHeight
+}
+
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path        *ibctesting.Path
+		limit       uint64
+		expPruned   uint64
+		expRemains  uint64
+		expStart    uint64
+		expPrunedTo uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError *sdkerrors.Error
+	}{
+		{
+			"success: limit below pruning sequence end",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 8)
+				limit = 3
+				expPruned, expRemains, expStart, expPrunedTo = 6, 4, 4, 3
+			},
+			nil,
+		},
+		{
+			"success: limit above pruning sequence end",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 8)
+				limit = 100
+				expPruned, expRemains, expStart, expPrunedTo = 14, 0, 8, 7
+			},
+			nil,
+		},
+		{
+			"success: resumes from pruning sequence start",
+			func() {
+				channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+				channelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 8)
+
+				pruned, remaining, err := channelKeeper.PruneAcknowledgements(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(4), pruned)
+				suite.Require().Equal(uint64(5), remaining)
+
+				limit = 2
+				expPruned, expRemains, expStart, expPrunedTo = 4, 3, 5, 4
+			},
+			nil,
+		},
+		{
+			"success: nothing left to prune",
+			func() {
+				channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+				channelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+				limit = 10
+				expPruned, expRemains, expStart, expPrunedTo = 0, 0, 1, 0
+			},
+			nil,
+		},
+		{
+			"limit is 0",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 8)
+				limit = 0
+			},
+			types.ErrInvalidPruningLimit,
+		},
+		{
+			"pruning sequence end not found",
+			func() {
+				limit = 10
+			},
+			types.ErrPruningSequenceEndNotFound,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointB.ChannelID = ibctesting.InvalidID
+				limit = 10
+			},
+			types.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.setupPrunableChannel(path)
+
+			portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			pruned, remaining, err := channelKeeper.PruneAcknowledgements(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, limit)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPruned, pruned)
+				suite.Require().Equal(expRemains, remaining)
+				suite.Require().Equal(expStart, channelKeeper.GetPruningSequenceStart(ctx, portID, channelID))
+
+				for seq := uint64(1); seq <= numPrunableSequences; seq++ {
+					_, receiptFound := channelKeeper.GetPacketReceipt(ctx, portID, channelID, seq)
+					ackFound := channelKeeper.HasPacketAcknowledgement(ctx, portID, channelID, seq)
+
+					suite.Require().Equal(seq > expPrunedTo, receiptFound, "sequence %d", seq)
+					suite.Require().Equal(seq > expPrunedTo, ackFound, "sequence %d", seq)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+
+			// commitments of unacknowledged packets must never be pruned
+			for seq := uint64(1); seq <= numPrunableSequences; seq++ {
+				suite.Require().True(channelKeeper.HasPacketCommitment(ctx, portID, channelID, seq), "sequence %d", seq)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConfirmPruningSequenceEnd() {
+	var (
+		path        *ibctesting.Path
+		chanCap     *capabilitytypes.Capability
+		end         uint64
+		proofs      [][]byte
+		proofHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError *sdkerrors.Error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: advances from the current pruning sequence end",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 3)
+				proofs, proofHeight = suite.queryPruningProofs(path, 3, end)
+			},
+			nil,
+		},
+		{
+			"success: pruning sequence end unchanged",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, end)
+				proofs = nil
+			},
+			nil,
+		},
+		{
+			"pruning sequence end decreases",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, end+1)
+			},
+			types.ErrInvalidPruningSequence,
+		},
+		{
+			"pruning sequence end is 0",
+			func() {
+				end = 0
+			},
+			types.ErrInvalidPruningSequence,
+		},
+		{
+			"proof missing for a sequence",
+			func() {
+				proofs = proofs[1:]
+			},
+			types.ErrInvalidPruningSequence,
+		},
+		{
+			"packet preceding the pruning sequence end has not been received",
+			func() {
+				end = numPrunableSequences + 2
+				proofs, proofHeight = suite.queryPruningProofs(path, 1, end)
+			},
+			types.ErrInvalidPruningSequence,
+		},
+		{
+			"counterparty packet commitment still exists",
+			func() {
+				// the packet is received on chainB, but the acknowledgement is not relayed to chainA
+				sequence := numPrunableSequences + 1
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+				err := path.EndpointA.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				end = sequence + 1
+				proofs, proofHeight = suite.queryPruningProofs(path, 1, end)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"invalid proof",
+			func() {
+				proofs[0] = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"caller does not own channel capability",
+			func() {
+				chanCap = capabilitytypes.NewCapability(100)
+			},
+			types.ErrChannelCapabilityNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.setupPrunableChannel(path)
+
+			chanCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			end = 5
+			proofs, proofHeight = suite.queryPruningProofs(path, 1, end)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.ConfirmPruningSequenceEnd(ctx, chanCap, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, end, proofs, proofHeight)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				storedEnd, found := channelKeeper.GetPruningSequenceEnd(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(end, storedEnd)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestRecvPrunedPacket tests that relaying a packet again after its receipt has been pruned is a no-op.
+func (suite *KeeperTestSuite) TestRecvPrunedPacket() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+
+	// the proof of the packet commitment is queried before the packet is acknowledged on chainA
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, portID, channelID, clienttypes.NewHeight(0, 100), 0)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proofs, pruningProofHeight := suite.queryPruningProofs(path, 1, 2)
+	chanCap := suite.chainB.GetChannelCapability(portID, channelID)
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	err = channelKeeper.ConfirmPruningSequenceEnd(suite.chainB.GetContext(), chanCap, portID, channelID, 2, proofs, pruningProofHeight)
+	suite.Require().NoError(err)
+
+	_, _, err = channelKeeper.PruneAcknowledgements(suite.chainB.GetContext(), portID, channelID, 10)
+	suite.Require().NoError(err)
+
+	_, found := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), portID, channelID, packet.GetSequence())
+	suite.Require().False(found)
+
+	err = channelKeeper.RecvPacket(suite.chainB.GetContext(), chanCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrNoOpMsg)
+
+	_, found = channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), portID, channelID, packet.GetSequence())
+	suite.Require().False(found)
+}
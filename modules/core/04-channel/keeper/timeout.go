@@ -144,7 +144,7 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	capName := host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+	if !k.authenticateChannelCapability(ctx, chanCap, packet.GetSourcePort(), packet.GetSourceChannel()) {
 		return sdkerrors.Wrapf(
 			types.ErrChannelCapabilityNotFound,
 			"caller does not own capability for channel with capability name %s", capName,
@@ -195,7 +195,7 @@ func (k Keeper) TimeoutOnClose(
 	}
 
 	capName := host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+	if !k.authenticateChannelCapability(ctx, chanCap, packet.GetSourcePort(), packet.GetSourceChannel()) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelCapability,
 			"channel capability failed authentication with capability name %s", capName,
@@ -41,6 +41,23 @@ func NewErrorAcknowledgement(err error) Acknowledgement {
 	}
 }
 
+// NewErrorAcknowledgementWithCodespace returns a new instance of Acknowledgement using an Acknowledgement_Error
+// type in the Response field. The error string contains the ABCI code and codespace of the error, the remainder of
+// the error is redacted and should instead be emitted in events by the application.
+// NOTE: Acknowledgements are written into state and thus, changing the ABCI code or codespace of an error returned
+// by an application is state machine breaking.
+func NewErrorAcknowledgementWithCodespace(err error) Acknowledgement {
+	// the ABCI code and codespace are registered constants and are therefore deterministic,
+	// errors which are not registered resolve to the internal ABCI code and codespace
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-determinstic log value
+
+	return Acknowledgement{
+		Response: &Acknowledgement_Error{
+			Error: fmt.Sprintf("ABCI code: %d: codespace: %s: %s", code, codespace, ackErrorString),
+		},
+	}
+}
+
 // ValidateBasic performs a basic validation of the acknowledgement
 func (ack Acknowledgement) ValidateBasic() error {
 	switch resp := ack.Response.(type) {
@@ -143,3 +143,26 @@ func (suite *TypesTestSuite) TestAcknowledgementError() {
 	suite.Require().Equal(ack, ackSameABCICode)
 	suite.Require().NotEqual(ack, ackDifferentABCICode)
 }
+
+// TestAcknowledgementErrorWithCodespace will verify that only a constant string,
+// the ABCI error code and the codespace are used in constructing the acknowledgement error string
+func (suite *TypesTestSuite) TestAcknowledgementErrorWithCodespace() {
+	// same ABCI error code and codespace used
+	err := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 1")
+	errSameABCICode := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 2")
+
+	// same ABCI error code used in a different codespace
+	errDifferentCodespace := types.ErrSequenceReceiveNotFound
+
+	ack := types.NewErrorAcknowledgementWithCodespace(err)
+	ackSameABCICode := types.NewErrorAcknowledgementWithCodespace(errSameABCICode)
+	ackDifferentCodespace := types.NewErrorAcknowledgementWithCodespace(errDifferentCodespace)
+
+	suite.Require().Equal(ack, ackSameABCICode)
+	suite.Require().NotEqual(ack, ackDifferentCodespace)
+	suite.Require().Equal("ABCI code: 11: codespace: sdk: error handling packet: see events for details", ack.GetError())
+
+	// errors which are not registered resolve to the internal ABCI code and codespace
+	ack = types.NewErrorAcknowledgementWithCodespace(fmt.Errorf("unregistered error"))
+	suite.Require().Equal("ABCI code: 1: codespace: undefined: error handling packet: see events for details", ack.GetError())
+}
@@ -8,6 +8,7 @@ import (
 	types "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -486,6 +487,58 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// PruneAcknowledgementsProposal is a governance proposal. If it passes, up to
+// limit sequences of stale packet acknowledgements and receipts with a sequence
+// below the provided pruning sequence end are pruned. The pruning sequence end
+// may not exceed the pruning sequence end proven by the channel owner.
+type PruneAcknowledgementsProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the port identifier of the channel to be pruned
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the channel identifier of the channel to be pruned
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the sequence below which packet acknowledgements and receipts may be pruned
+	PruningSequenceEnd uint64 `protobuf:"varint,5,opt,name=pruning_sequence_end,json=pruningSequenceEnd,proto3" json:"pruning_sequence_end,omitempty" yaml:"pruning_sequence_end"`
+	// the maximum number of sequences to be processed when the proposal is executed
+	Limit uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *PruneAcknowledgementsProposal) Reset()         { *m = PruneAcknowledgementsProposal{} }
+func (m *PruneAcknowledgementsProposal) String() string { return proto.CompactTextString(m) }
+func (*PruneAcknowledgementsProposal) ProtoMessage()    {}
+func (*PruneAcknowledgementsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{7}
+}
+func (m *PruneAcknowledgementsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruneAcknowledgementsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneAcknowledgementsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruneAcknowledgementsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneAcknowledgementsProposal.Merge(m, src)
+}
+func (m *PruneAcknowledgementsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneAcknowledgementsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneAcknowledgementsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneAcknowledgementsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -496,70 +549,80 @@ func init() {
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*PruneAcknowledgementsProposal)(nil), "ibc.core.channel.v1.PruneAcknowledgementsProposal")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x8e, 0x1a, 0x47,
-	0x10, 0x66, 0x60, 0xf8, 0x2b, 0x16, 0x96, 0x6d, 0x67, 0xf1, 0x64, 0x62, 0x33, 0x78, 0x94, 0xc3,
-	0xca, 0x91, 0xc1, 0xeb, 0xac, 0x12, 0xc5, 0xa7, 0x2c, 0x3f, 0xd6, 0x8e, 0x62, 0xc1, 0x6a, 0x60,
-	0x0f, 0xf1, 0x85, 0xc0, 0x4c, 0x07, 0x46, 0x86, 0x69, 0x32, 0xd3, 0xb0, 0xda, 0x37, 0xb0, 0xb8,
-	0x24, 0x2f, 0x80, 0x14, 0x29, 0x4a, 0x5e, 0x21, 0xaf, 0xe0, 0xa3, 0x8f, 0x39, 0xa1, 0x68, 0xf7,
-	0x90, 0x3b, 0x2f, 0x90, 0x68, 0xba, 0x7b, 0xf8, 0x59, 0x5b, 0x7b, 0x4c, 0x2e, 0x39, 0xd1, 0x55,
-	0xdf, 0x57, 0x55, 0xdf, 0x54, 0x15, 0xad, 0x86, 0x47, 0x4e, 0xdf, 0xaa, 0x58, 0xc4, 0xc3, 0x15,
-	0x6b, 0xd8, 0x73, 0x5d, 0x3c, 0xaa, 0xcc, 0x8e, 0xc3, 0x63, 0x79, 0xe2, 0x11, 0x4a, 0xd0, 0x3d,
-	0xa7, 0x6f, 0x95, 0x03, 0x4a, 0x39, 0xf4, 0xcf, 0x8e, 0xd5, 0x8f, 0x06, 0x64, 0x40, 0x18, 0x5e,
-	0x09, 0x4e, 0x9c, 0xaa, 0x6a, 0x9b, 0x6c, 0x23, 0x07, 0xbb, 0x94, 0x25, 0x63, 0x27, 0x4e, 0xd0,
-	0x7f, 0x8d, 0x42, 0xb2, 0xc6, 0xb3, 0xa0, 0xa7, 0x10, 0xf7, 0x69, 0x8f, 0x62, 0x45, 0x2a, 0x49,
-	0x47, 0xb9, 0x67, 0x6a, 0xf9, 0x03, 0x75, 0xca, 0xed, 0x80, 0x61, 0x72, 0x22, 0xfa, 0x02, 0x52,
-	0xc4, 0xb3, 0xb1, 0xe7, 0xb8, 0x03, 0x25, 0x7a, 0x47, 0x50, 0x2b, 0x20, 0x99, 0x6b, 0x2e, 0xfa,
-	0x06, 0xf6, 0x2c, 0x32, 0x75, 0x29, 0xf6, 0x26, 0x3d, 0x8f, 0x5e, 0x29, 0xb1, 0x92, 0x74, 0x94,
-	0x79, 0xf6, 0xe8, 0x83, 0xb1, 0xb5, 0x2d, 0x62, 0x55, 0x7e, 0xbb, 0xd4, 0x22, 0xe6, 0x4e, 0x30,
-	0xaa, 0xc1, 0xbe, 0x45, 0x5c, 0x17, 0x5b, 0xd4, 0x21, 0x6e, 0x77, 0x48, 0x26, 0xbe, 0x22, 0x97,
-	0x62, 0x47, 0xe9, 0xaa, 0xba, 0x5a, 0x6a, 0x85, 0xab, 0xde, 0x78, 0xf4, 0x5c, 0xbf, 0x45, 0xd0,
-	0xcd, 0xdc, 0xc6, 0x73, 0x46, 0x26, 0x3e, 0x52, 0x20, 0x39, 0xc3, 0x9e, 0xef, 0x10, 0x57, 0x89,
-	0x97, 0xa4, 0xa3, 0xb4, 0x19, 0x9a, 0xcf, 0xe5, 0x37, 0x3f, 0x6b, 0x11, 0xfd, 0xaf, 0x28, 0x1c,
-	0x18, 0x36, 0x76, 0xa9, 0xf3, 0xbd, 0x83, 0xed, 0xff, 0x3b, 0x76, 0x47, 0xc7, 0xd0, 0x7d, 0x48,
-	0x4e, 0x88, 0x47, 0xbb, 0x8e, 0xad, 0x24, 0x18, 0x92, 0x08, 0x4c, 0xc3, 0x46, 0x0f, 0x01, 0x84,
-	0xcc, 0x00, 0x4b, 0x32, 0x2c, 0x2d, 0x3c, 0x86, 0x2d, 0x3a, 0x7d, 0x09, 0x7b, 0xdb, 0x1f, 0x80,
-	0x3e, 0xdb, 0x64, 0x0b, 0xba, 0x9c, 0xae, 0xa2, 0xd5, 0x52, 0xcb, 0x71, 0x91, 0x02, 0xd0, 0xd7,
-	0x15, 0x4e, 0x76, 0x2a, 0x44, 0x19, 0xff, 0x70, 0xb5, 0xd4, 0x0e, 0xc4, 0x47, 0xad, 0x31, 0xfd,
-	0xfd, 0xc2, 0x7f, 0xc7, 0x20, 0x71, 0xde, 0xb3, 0x5e, 0x63, 0x8a, 0x54, 0x48, 0xf9, 0xf8, 0x87,
-	0x29, 0x76, 0x2d, 0x3e, 0x5a, 0xd9, 0x5c, 0xdb, 0xe8, 0x4b, 0xc8, 0xf8, 0x64, 0xea, 0x59, 0xb8,
-	0x1b, 0xd4, 0x14, 0x35, 0x0a, 0xab, 0xa5, 0x86, 0x78, 0x8d, 0x2d, 0x50, 0x37, 0x81, 0x5b, 0xe7,
-	0xc4, 0xa3, 0xe8, 0x6b, 0xc8, 0x09, 0x4c, 0x54, 0x66, 0x43, 0x4c, 0x57, 0x3f, 0x5e, 0x2d, 0xb5,
-	0xc3, 0x9d, 0x58, 0x81, 0xeb, 0x66, 0x96, 0x3b, 0xc2, 0x75, 0x7b, 0x01, 0x79, 0x1b, 0xfb, 0xd4,
-	0x71, 0x7b, 0x6c, 0x2e, 0xac, 0xbe, 0xcc, 0x72, 0x7c, 0xb2, 0x5a, 0x6a, 0xf7, 0x79, 0x8e, 0xdb,
-	0x0c, 0xdd, 0xdc, 0xdf, 0x72, 0x31, 0x25, 0x2d, 0xb8, 0xb7, 0xcd, 0x0a, 0xe5, 0xb0, 0x31, 0x56,
-	0x8b, 0xab, 0xa5, 0xa6, 0xbe, 0x9f, 0x6a, 0xad, 0x09, 0x6d, 0x79, 0x43, 0x61, 0x08, 0x64, 0xbb,
-	0x47, 0x7b, 0x6c, 0xdc, 0x7b, 0x26, 0x3b, 0xa3, 0xef, 0x20, 0x47, 0x9d, 0x31, 0x26, 0x53, 0xda,
-	0x1d, 0x62, 0x67, 0x30, 0xa4, 0x6c, 0xe0, 0x99, 0x9d, 0x7d, 0xe7, 0x37, 0xd1, 0xec, 0xb8, 0x7c,
-	0xc6, 0x18, 0xd5, 0x87, 0xc1, 0xb2, 0x6e, 0xda, 0xb1, 0x1b, 0xaf, 0x9b, 0x59, 0xe1, 0xe0, 0x6c,
-	0x64, 0xc0, 0x41, 0xc8, 0x08, 0x7e, 0x7d, 0xda, 0x1b, 0x4f, 0x94, 0x54, 0x30, 0xae, 0xea, 0x83,
-	0xd5, 0x52, 0x53, 0x76, 0x93, 0xac, 0x29, 0xba, 0x99, 0x17, 0xbe, 0x4e, 0xe8, 0x12, 0x1b, 0xf0,
-	0x9b, 0x04, 0x19, 0xbe, 0x01, 0xec, 0x3f, 0xfb, 0x2f, 0xac, 0xde, 0xce, 0xa6, 0xc5, 0x6e, 0x6d,
-	0x5a, 0xd8, 0x55, 0x79, 0xd3, 0x55, 0x21, 0xf4, 0x47, 0x09, 0x52, 0x5c, 0xa8, 0x61, 0xff, 0xc7,
-	0x2a, 0x85, 0xa2, 0x16, 0xec, 0x9f, 0x5a, 0xaf, 0x5d, 0x72, 0x39, 0xc2, 0xf6, 0x00, 0x8f, 0xb1,
-	0x4b, 0x91, 0x02, 0x09, 0x0f, 0xfb, 0xd3, 0x11, 0x55, 0x0e, 0x83, 0x0f, 0x38, 0x8b, 0x98, 0xc2,
-	0x46, 0x05, 0x88, 0x63, 0xcf, 0x23, 0x9e, 0x52, 0x08, 0xea, 0x9f, 0x45, 0x4c, 0x6e, 0x56, 0x01,
-	0x52, 0x1e, 0xf6, 0x27, 0xc4, 0xf5, 0xf1, 0xe3, 0xdf, 0x25, 0x88, 0xb7, 0xc5, 0x95, 0xa9, 0xb5,
-	0x3b, 0xa7, 0x9d, 0x46, 0xf7, 0xa2, 0x69, 0x34, 0x8d, 0x8e, 0x71, 0xfa, 0xd2, 0x78, 0xd5, 0xa8,
-	0x77, 0x2f, 0x9a, 0xed, 0xf3, 0x46, 0xcd, 0x78, 0x61, 0x34, 0xea, 0xf9, 0x88, 0x7a, 0x30, 0x5f,
-	0x94, 0xb2, 0x3b, 0x04, 0xa4, 0x00, 0xf0, 0xb8, 0xc0, 0x99, 0x97, 0xd4, 0xd4, 0x7c, 0x51, 0x92,
-	0x83, 0x33, 0x2a, 0x42, 0x96, 0x23, 0x1d, 0xf3, 0xdb, 0xd6, 0x79, 0xa3, 0x99, 0x8f, 0xaa, 0x99,
-	0xf9, 0xa2, 0x94, 0x14, 0xe6, 0x26, 0x92, 0x81, 0x31, 0x1e, 0xc9, 0x90, 0x07, 0xb0, 0xc7, 0x91,
-	0xda, 0xcb, 0x56, 0xbb, 0x51, 0xcf, 0xcb, 0x2a, 0xcc, 0x17, 0xa5, 0x04, 0xb7, 0x54, 0xf9, 0xcd,
-	0x2f, 0xc5, 0xc8, 0xe3, 0x4b, 0x88, 0xb3, 0xdb, 0x1b, 0x7d, 0x0a, 0x85, 0x96, 0x59, 0x6f, 0x98,
-	0xdd, 0x66, 0xab, 0xd9, 0xb8, 0xa5, 0x97, 0xa5, 0x0c, 0xfc, 0x48, 0x87, 0x7d, 0xce, 0xba, 0x68,
-	0xb2, 0xdf, 0x46, 0x3d, 0x2f, 0xa9, 0xd9, 0xf9, 0xa2, 0x94, 0x5e, 0x3b, 0x02, 0xc1, 0x9c, 0x13,
-	0x32, 0x84, 0x60, 0x61, 0xf2, 0xc2, 0xd5, 0xf6, 0xdb, 0xeb, 0xa2, 0xf4, 0xee, 0xba, 0x28, 0xfd,
-	0x79, 0x5d, 0x94, 0x7e, 0xba, 0x29, 0x46, 0xde, 0xdd, 0x14, 0x23, 0x7f, 0xdc, 0x14, 0x23, 0xaf,
-	0xbe, 0x1a, 0x38, 0x74, 0x38, 0xed, 0x97, 0x2d, 0x32, 0xae, 0x58, 0xc4, 0x1f, 0x13, 0xbf, 0xe2,
-	0xf4, 0xad, 0x27, 0x03, 0x52, 0x99, 0x9d, 0x54, 0xc6, 0xc4, 0x9e, 0x8e, 0xb0, 0xcf, 0x9f, 0x09,
-	0x4f, 0x4f, 0x9e, 0x84, 0xef, 0x0e, 0x7a, 0x35, 0xc1, 0x7e, 0x3f, 0xc1, 0xde, 0x09, 0x9f, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x59, 0xc1, 0x4c, 0x94, 0x98, 0x08, 0x00, 0x00,
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x16, 0x25, 0x4a, 0x96, 0x46, 0xbe, 0xc8, 0x13, 0xdb, 0x61, 0x98, 0x58, 0x54, 0x88, 0x7f,
+	0x61, 0xe4, 0x87, 0xa5, 0x38, 0x35, 0x5a, 0xd4, 0xab, 0x5a, 0xb6, 0x02, 0x13, 0x0d, 0x24, 0x95,
+	0xb2, 0x17, 0xcd, 0x46, 0xa5, 0xc8, 0xa9, 0x4c, 0x44, 0x9a, 0x61, 0xc9, 0x91, 0x0c, 0xbf, 0x41,
+	0xe0, 0x4d, 0xfb, 0x02, 0x06, 0x0a, 0x14, 0xed, 0x13, 0x14, 0xe8, 0x23, 0x34, 0xcb, 0xa0, 0xab,
+	0xae, 0x84, 0xc2, 0x5e, 0x74, 0xaf, 0x17, 0x68, 0x31, 0x17, 0xea, 0x16, 0x23, 0x68, 0x37, 0xed,
+	0xa6, 0x2b, 0xcd, 0xf9, 0xbe, 0xef, 0xcc, 0x39, 0x73, 0xce, 0xa1, 0x66, 0xc0, 0x63, 0xbf, 0xe3,
+	0x56, 0x5c, 0x12, 0xa2, 0x8a, 0x7b, 0xee, 0x60, 0x8c, 0x7a, 0x95, 0xe1, 0x5e, 0xbc, 0x2c, 0x07,
+	0x21, 0xa1, 0x04, 0xde, 0xf3, 0x3b, 0x6e, 0x99, 0x49, 0xca, 0x31, 0x3e, 0xdc, 0xd3, 0x37, 0xba,
+	0xa4, 0x4b, 0x38, 0x5f, 0x61, 0x2b, 0x21, 0xd5, 0x1f, 0xb8, 0x24, 0xea, 0x93, 0xa8, 0x2d, 0x08,
+	0x61, 0x48, 0xca, 0x98, 0x06, 0xea, 0xf9, 0x08, 0x53, 0x1e, 0x87, 0xaf, 0x84, 0xc0, 0xfc, 0x3e,
+	0x09, 0x96, 0x8e, 0x44, 0x00, 0xf8, 0x14, 0xa4, 0x23, 0xea, 0x50, 0xa4, 0x29, 0x25, 0x65, 0x67,
+	0xf5, 0x99, 0x5e, 0xbe, 0x23, 0x85, 0x72, 0x8b, 0x29, 0x6c, 0x21, 0x84, 0x1f, 0x82, 0x2c, 0x09,
+	0x3d, 0x14, 0xfa, 0xb8, 0xab, 0x25, 0xdf, 0xe3, 0xd4, 0x60, 0x22, 0x7b, 0xa2, 0x85, 0x9f, 0x82,
+	0x65, 0x97, 0x0c, 0x30, 0x45, 0x61, 0xe0, 0x84, 0xf4, 0x52, 0x4b, 0x95, 0x94, 0x9d, 0xfc, 0xb3,
+	0xc7, 0x77, 0xfa, 0x1e, 0xcd, 0x08, 0xab, 0xea, 0x9b, 0x91, 0x91, 0xb0, 0xe7, 0x9c, 0xe1, 0x11,
+	0x58, 0x73, 0x09, 0xc6, 0xc8, 0xa5, 0x3e, 0xc1, 0xed, 0x73, 0x12, 0x44, 0x9a, 0x5a, 0x4a, 0xed,
+	0xe4, 0xaa, 0xfa, 0x78, 0x64, 0x6c, 0x5d, 0x3a, 0xfd, 0xde, 0x81, 0xb9, 0x20, 0x30, 0xed, 0xd5,
+	0x29, 0x72, 0x42, 0x82, 0x08, 0x6a, 0x60, 0x69, 0x88, 0xc2, 0xc8, 0x27, 0x58, 0x4b, 0x97, 0x94,
+	0x9d, 0x9c, 0x1d, 0x9b, 0x07, 0xea, 0xeb, 0x6f, 0x8d, 0x84, 0xf9, 0x7b, 0x12, 0xac, 0x5b, 0x1e,
+	0xc2, 0xd4, 0xff, 0xd2, 0x47, 0xde, 0x7f, 0x15, 0x7b, 0x4f, 0xc5, 0xe0, 0x7d, 0xb0, 0x14, 0x90,
+	0x90, 0xb6, 0x7d, 0x4f, 0xcb, 0x70, 0x26, 0xc3, 0x4c, 0xcb, 0x83, 0xdb, 0x00, 0xc8, 0x34, 0x19,
+	0xb7, 0xc4, 0xb9, 0x9c, 0x44, 0x2c, 0x4f, 0x56, 0xfa, 0x02, 0x2c, 0xcf, 0x1e, 0x00, 0xfe, 0x7f,
+	0xba, 0x1b, 0xab, 0x72, 0xae, 0x0a, 0xc7, 0x23, 0x63, 0x55, 0x24, 0x29, 0x09, 0x73, 0x12, 0x61,
+	0x7f, 0x2e, 0x42, 0x92, 0xeb, 0x37, 0xc7, 0x23, 0x63, 0x5d, 0x1e, 0x6a, 0xc2, 0x99, 0xef, 0x06,
+	0xfe, 0x23, 0x05, 0x32, 0x4d, 0xc7, 0x7d, 0x85, 0x28, 0xd4, 0x41, 0x36, 0x42, 0x5f, 0x0d, 0x10,
+	0x76, 0x45, 0x6b, 0x55, 0x7b, 0x62, 0xc3, 0x8f, 0x40, 0x3e, 0x22, 0x83, 0xd0, 0x45, 0x6d, 0x16,
+	0x53, 0xc6, 0xd8, 0x1a, 0x8f, 0x0c, 0x28, 0x62, 0xcc, 0x90, 0xa6, 0x0d, 0x84, 0xd5, 0x24, 0x21,
+	0x85, 0x9f, 0x80, 0x55, 0xc9, 0xc9, 0xc8, 0xbc, 0x89, 0xb9, 0xea, 0x83, 0xf1, 0xc8, 0xd8, 0x9c,
+	0xf3, 0x95, 0xbc, 0x69, 0xaf, 0x08, 0x20, 0x1e, 0xb7, 0xe7, 0xa0, 0xe0, 0xa1, 0x88, 0xfa, 0xd8,
+	0xe1, 0x7d, 0xe1, 0xf1, 0x55, 0xbe, 0xc7, 0xc3, 0xf1, 0xc8, 0xb8, 0x2f, 0xf6, 0x58, 0x54, 0x98,
+	0xf6, 0xda, 0x0c, 0xc4, 0x33, 0x69, 0x80, 0x7b, 0xb3, 0xaa, 0x38, 0x1d, 0xde, 0xc6, 0x6a, 0x71,
+	0x3c, 0x32, 0xf4, 0x77, 0xb7, 0x9a, 0xe4, 0x04, 0x67, 0xd0, 0x38, 0x31, 0x08, 0x54, 0xcf, 0xa1,
+	0x0e, 0x6f, 0xf7, 0xb2, 0xcd, 0xd7, 0xf0, 0x0b, 0xb0, 0x4a, 0xfd, 0x3e, 0x22, 0x03, 0xda, 0x3e,
+	0x47, 0x7e, 0xf7, 0x9c, 0xf2, 0x86, 0xe7, 0xe7, 0xe6, 0x5d, 0xfc, 0x13, 0x0d, 0xf7, 0xca, 0x27,
+	0x5c, 0x51, 0xdd, 0x66, 0xc3, 0x3a, 0x2d, 0xc7, 0xbc, 0xbf, 0x69, 0xaf, 0x48, 0x40, 0xa8, 0xa1,
+	0x05, 0xd6, 0x63, 0x05, 0xfb, 0x8d, 0xa8, 0xd3, 0x0f, 0xb4, 0x2c, 0x6b, 0x57, 0xf5, 0xd1, 0x78,
+	0x64, 0x68, 0xf3, 0x9b, 0x4c, 0x24, 0xa6, 0x5d, 0x90, 0xd8, 0x69, 0x0c, 0xc9, 0x09, 0xf8, 0x41,
+	0x01, 0x79, 0x31, 0x01, 0xfc, 0x9b, 0xfd, 0x07, 0x46, 0x6f, 0x6e, 0xd2, 0x52, 0x0b, 0x93, 0x16,
+	0x57, 0x55, 0x9d, 0x56, 0x55, 0x26, 0xfa, 0xb5, 0x02, 0xb2, 0x22, 0x51, 0xcb, 0xfb, 0x97, 0xb3,
+	0x94, 0x19, 0x35, 0xc0, 0xda, 0xa1, 0xfb, 0x0a, 0x93, 0x8b, 0x1e, 0xf2, 0xba, 0xa8, 0x8f, 0x30,
+	0x85, 0x1a, 0xc8, 0x84, 0x28, 0x1a, 0xf4, 0xa8, 0xb6, 0xc9, 0x0e, 0x70, 0x92, 0xb0, 0xa5, 0x0d,
+	0xb7, 0x40, 0x1a, 0x85, 0x21, 0x09, 0xb5, 0x2d, 0x16, 0xff, 0x24, 0x61, 0x0b, 0xb3, 0x0a, 0x40,
+	0x36, 0x44, 0x51, 0x40, 0x70, 0x84, 0xcc, 0x9f, 0x93, 0x60, 0xbb, 0x19, 0x0e, 0x30, 0x5a, 0xd8,
+	0x36, 0x6a, 0x86, 0x24, 0x20, 0x91, 0xd3, 0x83, 0x1b, 0x20, 0x4d, 0x7d, 0xda, 0x13, 0x5f, 0x68,
+	0xce, 0x16, 0x06, 0x2c, 0x81, 0xbc, 0x87, 0x22, 0x37, 0xf4, 0x03, 0x36, 0xa0, 0xe2, 0x84, 0xf6,
+	0x2c, 0x34, 0x5b, 0xaf, 0xd4, 0xdf, 0xac, 0x97, 0xfa, 0x17, 0xeb, 0xf5, 0x19, 0xd8, 0x08, 0xc2,
+	0x01, 0xf6, 0x71, 0xb7, 0x1d, 0xd7, 0xa9, 0x8d, 0xb0, 0xc7, 0xbf, 0x30, 0xb5, 0x6a, 0x8c, 0x47,
+	0xc6, 0x43, 0x19, 0xef, 0x0e, 0x95, 0x69, 0x43, 0x09, 0xb7, 0x24, 0x5a, 0xc3, 0x1e, 0x3b, 0x6d,
+	0xcf, 0xef, 0xfb, 0x94, 0x7f, 0x63, 0xaa, 0x2d, 0x8c, 0x03, 0x93, 0x15, 0xff, 0x97, 0x1f, 0x77,
+	0x75, 0x79, 0xeb, 0x77, 0xc9, 0xb0, 0x3c, 0xdc, 0xeb, 0x20, 0xea, 0xb0, 0x6b, 0x00, 0x53, 0x84,
+	0xe9, 0x93, 0x9f, 0x14, 0x90, 0x6e, 0xc9, 0xcb, 0xc7, 0x68, 0x9d, 0x1e, 0x9e, 0xd6, 0xda, 0x67,
+	0x75, 0xab, 0x6e, 0x9d, 0x5a, 0x87, 0x2f, 0xac, 0x97, 0xb5, 0xe3, 0xf6, 0x59, 0xbd, 0xd5, 0xac,
+	0x1d, 0x59, 0xcf, 0xad, 0xda, 0x71, 0x21, 0xa1, 0xaf, 0x5f, 0x5d, 0x97, 0x56, 0xe6, 0x04, 0x50,
+	0x03, 0x40, 0xf8, 0x31, 0xb0, 0xa0, 0xe8, 0xd9, 0xab, 0xeb, 0x92, 0xca, 0xd6, 0xb0, 0x08, 0x56,
+	0x04, 0x73, 0x6a, 0x7f, 0xde, 0x68, 0xd6, 0xea, 0x85, 0xa4, 0x9e, 0xbf, 0xba, 0x2e, 0x2d, 0x49,
+	0x73, 0xea, 0xc9, 0xc9, 0x94, 0xf0, 0xe4, 0xcc, 0x23, 0xb0, 0x2c, 0x98, 0xa3, 0x17, 0x8d, 0x56,
+	0xed, 0xb8, 0xa0, 0xea, 0xe0, 0xea, 0xba, 0x94, 0x11, 0x96, 0xae, 0xbe, 0xfe, 0xae, 0x98, 0x78,
+	0x72, 0x01, 0xd2, 0xfc, 0x1e, 0x84, 0xff, 0x03, 0x5b, 0x0d, 0xfb, 0xb8, 0x66, 0xb7, 0xeb, 0x8d,
+	0x7a, 0x6d, 0x21, 0x5f, 0xbe, 0x25, 0xc3, 0xa1, 0x09, 0xd6, 0x84, 0xea, 0xac, 0xce, 0x7f, 0x6b,
+	0xc7, 0x05, 0x45, 0x5f, 0xb9, 0xba, 0x2e, 0xe5, 0x26, 0x00, 0x4b, 0x58, 0x68, 0x62, 0x85, 0x4c,
+	0x58, 0x9a, 0x22, 0x70, 0xb5, 0xf5, 0xe6, 0xa6, 0xa8, 0xbc, 0xbd, 0x29, 0x2a, 0xbf, 0xdd, 0x14,
+	0x95, 0x6f, 0x6e, 0x8b, 0x89, 0xb7, 0xb7, 0xc5, 0xc4, 0xaf, 0xb7, 0xc5, 0xc4, 0xcb, 0x8f, 0xbb,
+	0x3e, 0x3d, 0x1f, 0x74, 0xca, 0x2e, 0xe9, 0xcb, 0x97, 0x56, 0xc5, 0xef, 0xb8, 0xbb, 0x5d, 0x52,
+	0x19, 0xee, 0x57, 0xfa, 0xc4, 0x1b, 0xf4, 0x50, 0x24, 0x1e, 0x5c, 0x4f, 0xf7, 0x77, 0xe3, 0xc7,
+	0x1d, 0xbd, 0x0c, 0x50, 0xd4, 0xc9, 0xf0, 0x17, 0xd7, 0x07, 0x7f, 0x0e, 0x00, 0xdf, 0x46, 0x57,
+	0x03, 0xfd, 0x09, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -966,6 +1029,67 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
+func (m *PruneAcknowledgementsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneAcknowledgementsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneAcknowledgementsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PruningSequenceEnd != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.PruningSequenceEnd))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChannel(v)
 	base := offset
@@ -1171,6 +1295,36 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *PruneAcknowledgementsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.PruningSequenceEnd != 0 {
+		n += 1 + sovChannel(uint64(m.PruningSequenceEnd))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannel(uint64(m.Limit))
+	}
+	return n
+}
 
 func sovChannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2424,6 +2578,222 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PruneAcknowledgementsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneAcknowledgementsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneAcknowledgementsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceEnd", wireType)
+			}
+			m.PruningSequenceEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningSequenceEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
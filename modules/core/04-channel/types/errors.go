@@ -39,4 +39,9 @@ var (
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
 	ErrPacketNotSent         = sdkerrors.Register(SubModuleName, 25, "packet has not been sent")
+
+	ErrPruningSequenceEndNotFound = sdkerrors.Register(SubModuleName, 26, "pruning sequence end not found")
+	ErrInvalidPruningSequence     = sdkerrors.Register(SubModuleName, 27, "invalid pruning sequence")
+	ErrInvalidPruningLimit        = sdkerrors.Register(SubModuleName, 28, "invalid pruning limit")
+	ErrInvalidPacketBatch         = sdkerrors.Register(SubModuleName, 29, "invalid packet batch")
 )
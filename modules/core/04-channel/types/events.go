@@ -18,6 +18,7 @@ const (
 	EventTypeSendPacket           = "send_packet"
 	EventTypeRecvPacket           = "recv_packet"
 	EventTypeWriteAck             = "write_acknowledgement"
+	EventTypeWriteAckDuplicate    = "write_acknowledgement_duplicate"
 	EventTypeAcknowledgePacket    = "acknowledge_packet"
 	EventTypeTimeoutPacket        = "timeout_packet"
 	EventTypeTimeoutPacketOnClose = "timeout_on_close_packet"
@@ -38,6 +39,10 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+
+	AttributeKeyPruningSequenceStart = "pruning_sequence_start"
+	AttributeKeyPruningSequenceEnd   = "pruning_sequence_end"
+	AttributeKeyTotalPruned          = "total_pruned"
 )
 
 // IBC channel events vars
@@ -50,5 +55,7 @@ var (
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 	EventTypeChannelClosed       = "channel_close"
 
+	EventTypePruneAcknowledgements = "prune_acknowledgements"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
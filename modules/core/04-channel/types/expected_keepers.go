@@ -61,6 +61,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketCommitmentAbsence(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -75,4 +84,5 @@ type ConnectionKeeper interface {
 // PortKeeper expected account IBC port keeper
 type PortKeeper interface {
 	Authenticate(ctx sdk.Context, key *capabilitytypes.Capability, portID string) bool
+	LookupStaticPortOwner(ctx sdk.Context, portID string) (string, bool, error)
 }
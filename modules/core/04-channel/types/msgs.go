@@ -490,3 +490,114 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgRecvPacketBatch{}
+
+// NewMsgRecvPacketBatch constructs a new MsgRecvPacketBatch
+// nolint:interfacer
+func NewMsgRecvPacketBatch(
+	packets []Packet, proofCommitments [][]byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPacketBatch {
+	return &MsgRecvPacketBatch{
+		Packets:          packets,
+		ProofCommitments: proofCommitments,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPacketBatch) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketBatch, "batch must contain at least one packet")
+	}
+	if len(msg.ProofCommitments) != len(msg.Packets) {
+		return sdkerrors.Wrapf(ErrInvalidPacketBatch, "number of proofs (%d) does not match number of packets (%d)", len(msg.ProofCommitments), len(msg.Packets))
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if len(msg.ProofCommitments[i]) == 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof for packet at index %d", i)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPacketBatch) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgAcknowledgementBatch{}
+
+// NewMsgAcknowledgementBatch constructs a new MsgAcknowledgementBatch
+// nolint:interfacer
+func NewMsgAcknowledgementBatch(
+	packets []Packet,
+	acks, proofsAcked [][]byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgementBatch {
+	return &MsgAcknowledgementBatch{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofsAcked:      proofsAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgementBatch) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketBatch, "batch must contain at least one packet")
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return sdkerrors.Wrapf(ErrInvalidPacketBatch, "number of acknowledgements (%d) does not match number of packets (%d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+	if len(msg.ProofsAcked) != len(msg.Packets) {
+		return sdkerrors.Wrapf(ErrInvalidPacketBatch, "number of proofs (%d) does not match number of packets (%d)", len(msg.ProofsAcked), len(msg.Packets))
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if len(msg.ProofsAcked[i]) == 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof for packet at index %d", i)
+		}
+		if len(msg.Acknowledgements[i]) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "ack bytes cannot be empty for packet at index %d", i)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAcknowledgementBatch) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
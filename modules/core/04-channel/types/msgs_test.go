@@ -437,3 +437,72 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgRecvPacketBatchValidateBasic() {
+	packets := []types.Packet{packet, packet}
+	proofs := [][]byte{suite.proof, suite.proof}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRecvPacketBatch
+		expPass bool
+	}{
+		{"success", types.NewMsgRecvPacketBatch(packets, proofs, height, addr), true},
+		{"empty batch", types.NewMsgRecvPacketBatch(nil, nil, height, addr), false},
+		{"mismatched number of proofs", types.NewMsgRecvPacketBatch(packets, proofs[:1], height, addr), false},
+		{"proof height is zero", types.NewMsgRecvPacketBatch(packets, proofs, clienttypes.ZeroHeight(), addr), false},
+		{"batch contains empty proof", types.NewMsgRecvPacketBatch(packets, [][]byte{suite.proof, emptyProof}, height, addr), false},
+		{"missing signer address", types.NewMsgRecvPacketBatch(packets, proofs, height, emptyAddr), false},
+		{"batch contains invalid packet", types.NewMsgRecvPacketBatch([]types.Packet{packet, invalidPacket}, proofs, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementBatchValidateBasic() {
+	packets := []types.Packet{packet, packet}
+	acks := [][]byte{packet.GetData(), packet.GetData()}
+	proofs := [][]byte{suite.proof, suite.proof}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAcknowledgementBatch
+		expPass bool
+	}{
+		{"success", types.NewMsgAcknowledgementBatch(packets, acks, proofs, height, addr), true},
+		{"empty batch", types.NewMsgAcknowledgementBatch(nil, nil, nil, height, addr), false},
+		{"mismatched number of acks", types.NewMsgAcknowledgementBatch(packets, acks[:1], proofs, height, addr), false},
+		{"mismatched number of proofs", types.NewMsgAcknowledgementBatch(packets, acks, proofs[:1], height, addr), false},
+		{"proof height is zero", types.NewMsgAcknowledgementBatch(packets, acks, proofs, clienttypes.ZeroHeight(), addr), false},
+		{"batch contains empty ack", types.NewMsgAcknowledgementBatch(packets, [][]byte{packet.GetData(), nil}, proofs, height, addr), false},
+		{"batch contains empty proof", types.NewMsgAcknowledgementBatch(packets, acks, [][]byte{suite.proof, emptyProof}, height, addr), false},
+		{"missing signer address", types.NewMsgAcknowledgementBatch(packets, acks, proofs, height, emptyAddr), false},
+		{"batch contains invalid packet", types.NewMsgAcknowledgementBatch([]types.Packet{packet, invalidPacket}, acks, proofs, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
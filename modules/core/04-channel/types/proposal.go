@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+const (
+	// ProposalTypePruneAcknowledgements defines the type for a PruneAcknowledgementsProposal
+	ProposalTypePruneAcknowledgements = "PruneAcknowledgements"
+)
+
+var _ govtypes.Content = &PruneAcknowledgementsProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypePruneAcknowledgements)
+}
+
+// NewPruneAcknowledgementsProposal creates a new prune acknowledgements proposal.
+func NewPruneAcknowledgementsProposal(title, description, portID, channelID string, pruningSequenceEnd, limit uint64) govtypes.Content {
+	return &PruneAcknowledgementsProposal{
+		Title:              title,
+		Description:        description,
+		PortId:             portID,
+		ChannelId:          channelID,
+		PruningSequenceEnd: pruningSequenceEnd,
+		Limit:              limit,
+	}
+}
+
+// GetTitle returns the title of a prune acknowledgements proposal.
+func (p *PruneAcknowledgementsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a prune acknowledgements proposal.
+func (p *PruneAcknowledgementsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a prune acknowledgements proposal.
+func (p *PruneAcknowledgementsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a prune acknowledgements proposal.
+func (p *PruneAcknowledgementsProposal) ProposalType() string {
+	return ProposalTypePruneAcknowledgements
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PruneAcknowledgementsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(p.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if p.PruningSequenceEnd == 0 {
+		return sdkerrors.Wrap(ErrInvalidPruningSequence, "pruning sequence end cannot be 0")
+	}
+
+	if p.Limit == 0 {
+		return sdkerrors.Wrap(ErrInvalidPruningLimit, "limit must be greater than 0")
+	}
+
+	return nil
+}
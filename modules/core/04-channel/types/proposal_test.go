@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *TypesTestSuite) TestPruneAcknowledgementsProposalValidateBasic() {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewPruneAcknowledgementsProposal(ibctesting.Title, ibctesting.Description, portid, chanid, 100, 10),
+			true,
+		},
+		{
+			"fails validate abstract - empty title",
+			types.NewPruneAcknowledgementsProposal("", ibctesting.Description, portid, chanid, 100, 10),
+			false,
+		},
+		{
+			"invalid port ID",
+			types.NewPruneAcknowledgementsProposal(ibctesting.Title, ibctesting.Description, invalidPort, chanid, 100, 10),
+			false,
+		},
+		{
+			"invalid channel ID",
+			types.NewPruneAcknowledgementsProposal(ibctesting.Title, ibctesting.Description, portid, invalidChannel, 100, 10),
+			false,
+		},
+		{
+			"pruning sequence end is 0",
+			types.NewPruneAcknowledgementsProposal(ibctesting.Title, ibctesting.Description, portid, chanid, 0, 10),
+			false,
+		},
+		{
+			"limit is 0",
+			types.NewPruneAcknowledgementsProposal(ibctesting.Title, ibctesting.Description, portid, chanid, 100, 0),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(types.RouterKey, tc.proposal.ProposalRoute())
+			suite.Require().Equal(types.ProposalTypePruneAcknowledgements, tc.proposal.ProposalType())
+
+			err := tc.proposal.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -93,3 +93,25 @@ func NewQueryNextSequenceReceiveResponse(
 		ProofHeight:         height,
 	}
 }
+
+// NewQueryNextSequenceSendResponse creates a new QueryNextSequenceSendResponse instance
+func NewQueryNextSequenceSendResponse(
+	sequence uint64, proof []byte, height clienttypes.Height,
+) *QueryNextSequenceSendResponse {
+	return &QueryNextSequenceSendResponse{
+		NextSequenceSend: sequence,
+		Proof:            proof,
+		ProofHeight:      height,
+	}
+}
+
+// NewQueryNextSequenceAckResponse creates a new QueryNextSequenceAckResponse instance
+func NewQueryNextSequenceAckResponse(
+	sequence uint64, proof []byte, height clienttypes.Height,
+) *QueryNextSequenceAckResponse {
+	return &QueryNextSequenceAckResponse{
+		NextSequenceAck: sequence,
+		Proof:           proof,
+		ProofHeight:     height,
+	}
+}
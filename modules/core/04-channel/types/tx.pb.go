@@ -890,6 +890,172 @@ func (m *MsgAcknowledgementResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcknowledgementResponse proto.InternalMessageInfo
 
+// MsgRecvPacketBatch receives a batch of incoming IBC packets. Every packet commitment
+// is proven against the same proof height, and the packets are processed in order.
+type MsgRecvPacketBatch struct {
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// proof of the packet commitment for each packet, in the same order as packets
+	ProofCommitments [][]byte     `protobuf:"bytes,2,rep,name=proof_commitments,json=proofCommitments,proto3" json:"proof_commitments,omitempty" yaml:"proof_commitments"`
+	ProofHeight      types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	Signer           string       `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRecvPacketBatch) Reset()         { *m = MsgRecvPacketBatch{} }
+func (m *MsgRecvPacketBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketBatch) ProtoMessage()    {}
+func (*MsgRecvPacketBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{20}
+}
+func (m *MsgRecvPacketBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketBatch.Merge(m, src)
+}
+func (m *MsgRecvPacketBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketBatch proto.InternalMessageInfo
+
+// MsgRecvPacketBatchResponse defines the Msg/RecvPacketBatch response type.
+type MsgRecvPacketBatchResponse struct {
+	// result for each packet, in the same order as the request packets
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v1.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgRecvPacketBatchResponse) Reset()         { *m = MsgRecvPacketBatchResponse{} }
+func (m *MsgRecvPacketBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketBatchResponse) ProtoMessage()    {}
+func (*MsgRecvPacketBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{21}
+}
+func (m *MsgRecvPacketBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketBatchResponse.Merge(m, src)
+}
+func (m *MsgRecvPacketBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketBatchResponse proto.InternalMessageInfo
+
+// MsgAcknowledgementBatch receives a batch of incoming IBC acknowledgements. Every
+// acknowledgement is proven against the same proof height, and the packets are processed in order.
+type MsgAcknowledgementBatch struct {
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// acknowledgement for each packet, in the same order as packets
+	Acknowledgements [][]byte `protobuf:"bytes,2,rep,name=acknowledgements,proto3" json:"acknowledgements,omitempty"`
+	// proof of the acknowledgement for each packet, in the same order as packets
+	ProofsAcked [][]byte     `protobuf:"bytes,3,rep,name=proofs_acked,json=proofsAcked,proto3" json:"proofs_acked,omitempty" yaml:"proofs_acked"`
+	ProofHeight types.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	Signer      string       `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgAcknowledgementBatch) Reset()         { *m = MsgAcknowledgementBatch{} }
+func (m *MsgAcknowledgementBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgementBatch) ProtoMessage()    {}
+func (*MsgAcknowledgementBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{22}
+}
+func (m *MsgAcknowledgementBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgementBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgementBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgementBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgementBatch.Merge(m, src)
+}
+func (m *MsgAcknowledgementBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgementBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgementBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgementBatch proto.InternalMessageInfo
+
+// MsgAcknowledgementBatchResponse defines the Msg/AcknowledgementBatch response type.
+type MsgAcknowledgementBatchResponse struct {
+	// result for each packet, in the same order as the request packets
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v1.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgAcknowledgementBatchResponse) Reset()         { *m = MsgAcknowledgementBatchResponse{} }
+func (m *MsgAcknowledgementBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgementBatchResponse) ProtoMessage()    {}
+func (*MsgAcknowledgementBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{23}
+}
+func (m *MsgAcknowledgementBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgementBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgementBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgementBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgementBatchResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgementBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgementBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgementBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgementBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
@@ -912,93 +1078,107 @@ func init() {
 	proto.RegisterType((*MsgTimeoutOnCloseResponse)(nil), "ibc.core.channel.v1.MsgTimeoutOnCloseResponse")
 	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v1.MsgAcknowledgement")
 	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v1.MsgAcknowledgementResponse")
+	proto.RegisterType((*MsgRecvPacketBatch)(nil), "ibc.core.channel.v1.MsgRecvPacketBatch")
+	proto.RegisterType((*MsgRecvPacketBatchResponse)(nil), "ibc.core.channel.v1.MsgRecvPacketBatchResponse")
+	proto.RegisterType((*MsgAcknowledgementBatch)(nil), "ibc.core.channel.v1.MsgAcknowledgementBatch")
+	proto.RegisterType((*MsgAcknowledgementBatchResponse)(nil), "ibc.core.channel.v1.MsgAcknowledgementBatchResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdf, 0x6f, 0xda, 0xd6,
-	0x17, 0xc7, 0x40, 0x21, 0x39, 0xf4, 0x9b, 0x10, 0x93, 0xa4, 0xc4, 0x24, 0x98, 0xaf, 0x1f, 0x9a,
-	0x28, 0x53, 0xa0, 0x49, 0x53, 0x4d, 0x8d, 0x26, 0x4d, 0x81, 0x51, 0x2d, 0xda, 0x92, 0x20, 0x43,
-	0x26, 0x2d, 0x9b, 0x86, 0xc0, 0xdc, 0x12, 0x0b, 0xb0, 0x99, 0x6d, 0x68, 0xf9, 0x0f, 0xaa, 0x3c,
-	0xf5, 0xb9, 0x52, 0xa4, 0x4e, 0x7b, 0x9a, 0xf6, 0xd0, 0xfd, 0x19, 0x7d, 0xec, 0xdb, 0xaa, 0x3d,
-	0xa0, 0x29, 0x79, 0xd9, 0x33, 0x7f, 0xc1, 0xe4, 0xeb, 0x6b, 0x63, 0xc0, 0x56, 0x9c, 0x36, 0x49,
-	0xf7, 0xe6, 0x7b, 0xcf, 0xe7, 0x9e, 0x73, 0xee, 0xe7, 0x7c, 0xee, 0x2f, 0xc3, 0xb2, 0x58, 0x15,
-	0x32, 0x82, 0xac, 0xa0, 0x8c, 0x70, 0x52, 0x91, 0x24, 0xd4, 0xcc, 0x74, 0x37, 0x33, 0xda, 0xf3,
-	0x74, 0x5b, 0x91, 0x35, 0x99, 0x8e, 0x89, 0x55, 0x21, 0xad, 0x5b, 0xd3, 0xc4, 0x9a, 0xee, 0x6e,
-	0x32, 0xf3, 0x75, 0xb9, 0x2e, 0x63, 0x7b, 0x46, 0xff, 0x32, 0xa0, 0x0c, 0x3b, 0x74, 0xd4, 0x14,
-	0x91, 0xa4, 0xe9, 0x7e, 0x8c, 0x2f, 0x02, 0xf8, 0xbf, 0x53, 0x24, 0xd3, 0x2d, 0x86, 0x70, 0xbf,
-	0x50, 0x40, 0xef, 0xab, 0xf5, 0x9c, 0xd1, 0x79, 0xd8, 0x46, 0xd2, 0x9e, 0x24, 0x6a, 0xf4, 0x67,
-	0x10, 0x6e, 0xcb, 0x8a, 0x56, 0x16, 0x6b, 0x71, 0x2a, 0x45, 0xad, 0x4d, 0x67, 0xe9, 0x41, 0x9f,
-	0x9d, 0xe9, 0x55, 0x5a, 0xcd, 0x1d, 0x8e, 0x18, 0x38, 0x3e, 0xa4, 0x7f, 0xed, 0xd5, 0xe8, 0x2f,
-	0x20, 0x4c, 0x9c, 0xc6, 0xfd, 0x29, 0x6a, 0x2d, 0xb2, 0xb5, 0x9c, 0x76, 0x98, 0x44, 0x9a, 0xc4,
-	0xc8, 0x06, 0xdf, 0xf6, 0x59, 0x1f, 0x6f, 0x0e, 0xa1, 0x17, 0x21, 0xa4, 0x8a, 0x75, 0x09, 0x29,
-	0xf1, 0x80, 0x1e, 0x89, 0x27, 0xad, 0x9d, 0xa9, 0x17, 0xaf, 0x59, 0xdf, 0x3f, 0xaf, 0x59, 0x1f,
-	0xd7, 0x04, 0x66, 0x32, 0x45, 0x1e, 0xa9, 0x6d, 0x59, 0x52, 0x11, 0xbd, 0x0d, 0x40, 0x5c, 0x0d,
-	0xb3, 0x5d, 0x18, 0xf4, 0xd9, 0x39, 0x23, 0xdb, 0xa1, 0x8d, 0xe3, 0xa7, 0x49, 0x63, 0xaf, 0x46,
-	0xc7, 0x21, 0xdc, 0x45, 0x8a, 0x2a, 0xca, 0x12, 0xce, 0x79, 0x9a, 0x37, 0x9b, 0xdc, 0xfb, 0x00,
-	0xcc, 0x8d, 0x86, 0x2b, 0x29, 0xbd, 0xab, 0x11, 0x52, 0x80, 0x58, 0x5b, 0x41, 0x5d, 0x51, 0xee,
-	0xa8, 0x65, 0x5b, 0x6e, 0x38, 0x50, 0x36, 0x35, 0xe8, 0xb3, 0x0c, 0x19, 0x38, 0x09, 0xe2, 0xe2,
-	0x14, 0x3f, 0x67, 0xf6, 0xe7, 0xac, 0x74, 0x6d, 0x14, 0x07, 0xae, 0x4e, 0x31, 0x0f, 0xf3, 0x82,
-	0xdc, 0x91, 0x34, 0xa4, 0xb4, 0x2b, 0x8a, 0xd6, 0x2b, 0x9b, 0x33, 0x0f, 0xe2, 0x84, 0xd8, 0x41,
-	0x9f, 0x4d, 0x10, 0xb2, 0x1c, 0x50, 0x1c, 0x1f, 0xb3, 0x77, 0x7f, 0x67, 0xf4, 0xea, 0xb4, 0xb7,
-	0x15, 0x59, 0x7e, 0x5a, 0x16, 0x25, 0x51, 0x8b, 0xdf, 0x49, 0x51, 0x6b, 0x77, 0xed, 0xb4, 0x0f,
-	0x6d, 0x1c, 0x3f, 0x8d, 0x1b, 0x58, 0x57, 0xc7, 0x70, 0xd7, 0xb0, 0x9c, 0x20, 0xb1, 0x7e, 0xa2,
-	0xc5, 0x43, 0x78, 0x32, 0x8c, 0x6d, 0x32, 0x86, 0x7e, 0xbb, 0x9b, 0xe9, 0xaf, 0x31, 0x22, 0x9b,
-	0xd0, 0xa7, 0x32, 0xe8, 0xb3, 0x31, 0xbb, 0x5f, 0x63, 0x34, 0xc7, 0x47, 0x70, 0xd3, 0x40, 0xda,
-	0x84, 0x14, 0x76, 0x11, 0xd2, 0x23, 0x58, 0x9a, 0xa8, 0xac, 0xa5, 0x23, 0x9b, 0x22, 0xa8, 0x51,
-	0x45, 0xfc, 0x39, 0xa1, 0x88, 0x5d, 0xa1, 0x71, 0x35, 0x45, 0x8c, 0x8a, 0xd4, 0xef, 0x51, 0xa4,
-	0xc7, 0x70, 0x6f, 0xa4, 0x22, 0x36, 0x17, 0x78, 0xad, 0x64, 0xb9, 0x41, 0x9f, 0x4d, 0x3a, 0x94,
-	0xce, 0xee, 0x6f, 0xc1, 0x6e, 0x19, 0x2a, 0xea, 0x26, 0x34, 0xb1, 0x09, 0x46, 0xa9, 0xcb, 0x9a,
-	0xd2, 0x23, 0x92, 0x98, 0x1f, 0xf4, 0xd9, 0xa8, 0xbd, 0x74, 0x9a, 0xd2, 0xe3, 0xf8, 0x29, 0xfc,
-	0xad, 0xaf, 0xab, 0x4f, 0x2b, 0x88, 0xc4, 0xb8, 0x20, 0x76, 0x85, 0x86, 0x29, 0x08, 0xee, 0x77,
-	0x3f, 0x2c, 0x8c, 0x5a, 0x73, 0xb2, 0xf4, 0x54, 0x54, 0x5a, 0xb7, 0x51, 0x7a, 0x8b, 0xca, 0x8a,
-	0xd0, 0xc0, 0xc5, 0x76, 0xa0, 0xb2, 0x22, 0x34, 0x4c, 0x2a, 0x75, 0x41, 0x8e, 0x53, 0x19, 0xbc,
-	0x11, 0x2a, 0xef, 0xb8, 0x50, 0xc9, 0xc2, 0x8a, 0x23, 0x59, 0x16, 0x9d, 0xaf, 0x28, 0x88, 0x0d,
-	0x11, 0xb9, 0xa6, 0xac, 0xa2, 0xab, 0x1f, 0x35, 0x1f, 0x46, 0xe6, 0xe5, 0x47, 0xcc, 0x0a, 0x24,
-	0x1c, 0x72, 0xb3, 0x72, 0x7f, 0xe3, 0x87, 0xc5, 0x31, 0xfb, 0x2d, 0x6a, 0x61, 0x74, 0xab, 0x0d,
-	0x7c, 0xe0, 0x56, 0x7b, 0xbb, 0x72, 0x48, 0x41, 0xd2, 0x99, 0x30, 0x8b, 0xd3, 0x97, 0x7e, 0xf8,
-	0xdf, 0xbe, 0x5a, 0xe7, 0x91, 0xd0, 0x2d, 0x54, 0x84, 0x06, 0xd2, 0xe8, 0xc7, 0x10, 0x6a, 0xe3,
-	0x2f, 0xcc, 0x64, 0x64, 0x2b, 0xe1, 0x78, 0xc6, 0x19, 0x60, 0x72, 0xc4, 0x91, 0x01, 0xf4, 0x13,
-	0x88, 0x1a, 0xe9, 0x0a, 0x72, 0xab, 0x25, 0x6a, 0x2d, 0x24, 0x69, 0x98, 0xde, 0xbb, 0xd9, 0xc4,
-	0xa0, 0xcf, 0xde, 0xb3, 0x4f, 0x68, 0x88, 0xe0, 0xf8, 0x59, 0xdc, 0x95, 0xb3, 0x7a, 0x26, 0x48,
-	0x0b, 0xdc, 0x08, 0x69, 0x41, 0x17, 0xd2, 0x7e, 0xc2, 0x1b, 0xce, 0x90, 0x11, 0xeb, 0x6c, 0xfa,
-	0x12, 0x42, 0x0a, 0x52, 0x3b, 0x4d, 0x83, 0x99, 0x99, 0xad, 0x55, 0x47, 0x66, 0x4c, 0x38, 0x8f,
-	0xa1, 0xa5, 0x5e, 0x1b, 0xf1, 0x64, 0xd8, 0x4e, 0x50, 0x8f, 0xc1, 0xfd, 0xe5, 0x07, 0xd8, 0x57,
-	0xeb, 0x25, 0xb1, 0x85, 0xe4, 0xce, 0xf5, 0xf0, 0xdd, 0x91, 0x14, 0x24, 0x20, 0xb1, 0x8b, 0x6a,
-	0x6e, 0x7c, 0x0f, 0x11, 0x26, 0xdf, 0x47, 0x56, 0xcf, 0x8d, 0xf2, 0xfd, 0x0d, 0xd0, 0x12, 0x7a,
-	0xae, 0x95, 0x55, 0xf4, 0x73, 0x07, 0x49, 0x02, 0x2a, 0x2b, 0x48, 0xe8, 0x62, 0xee, 0x83, 0xd9,
-	0x95, 0x41, 0x9f, 0x5d, 0x32, 0x3c, 0x4c, 0x62, 0x38, 0x3e, 0xaa, 0x77, 0x16, 0x49, 0x9f, 0x5e,
-	0x0f, 0x0f, 0x8a, 0xff, 0x01, 0x5f, 0xa4, 0x09, 0xb7, 0xd7, 0x5d, 0xb9, 0x57, 0xc6, 0x15, 0x84,
-	0x78, 0x3f, 0x94, 0xf0, 0x8a, 0xfa, 0x2f, 0x14, 0xf0, 0x73, 0x88, 0x90, 0x65, 0xa5, 0x67, 0x44,
-	0x36, 0xa7, 0xc5, 0x41, 0x9f, 0xa5, 0x47, 0xd6, 0x9c, 0x6e, 0xe4, 0x78, 0x63, 0x1b, 0x33, 0x72,
-	0xbf, 0xc9, 0xed, 0xc9, 0xb9, 0xf2, 0x77, 0x3e, 0xb6, 0xf2, 0x21, 0x97, 0xca, 0x57, 0xf1, 0x2d,
-	0x62, 0xb4, 0x36, 0xd7, 0x2d, 0x80, 0x3f, 0xfc, 0x58, 0x5e, 0xbb, 0x42, 0x43, 0x92, 0x9f, 0x35,
-	0x51, 0xad, 0x8e, 0xf0, 0x7e, 0xf5, 0x11, 0x0a, 0x58, 0x83, 0xd9, 0xca, 0xa8, 0x37, 0x43, 0x00,
-	0xfc, 0x78, 0xf7, 0xb0, 0xc6, 0xfa, 0xc0, 0x9a, 0x5b, 0x8d, 0xb1, 0xd1, 0xac, 0xf1, 0xae, 0xde,
-	0xf8, 0xc4, 0x47, 0x90, 0x80, 0x9f, 0x8d, 0x63, 0x8c, 0x5d, 0x73, 0x5d, 0xd6, 0x7f, 0xa3, 0x80,
-	0x9e, 0x04, 0xd1, 0x8f, 0x20, 0xc5, 0xe7, 0x8b, 0x85, 0xc3, 0x83, 0x62, 0xbe, 0xcc, 0xe7, 0x8b,
-	0x47, 0xdf, 0x96, 0xca, 0xa5, 0xef, 0x0b, 0xf9, 0xf2, 0xd1, 0x41, 0xb1, 0x90, 0xcf, 0xed, 0x3d,
-	0xd9, 0xcb, 0x7f, 0x15, 0xf5, 0x31, 0xb3, 0xa7, 0x67, 0xa9, 0x88, 0xad, 0x8b, 0x5e, 0x85, 0x25,
-	0xc7, 0x61, 0x07, 0x87, 0x87, 0x85, 0x28, 0xc5, 0x4c, 0x9d, 0x9e, 0xa5, 0x82, 0xfa, 0x37, 0xbd,
-	0x01, 0xcb, 0x8e, 0xc0, 0xe2, 0x51, 0x2e, 0x97, 0x2f, 0x16, 0xa3, 0x7e, 0x26, 0x72, 0x7a, 0x96,
-	0x0a, 0x93, 0x26, 0x13, 0x7c, 0xf1, 0x6b, 0xd2, 0xb7, 0xf5, 0x66, 0x0a, 0x02, 0xfb, 0x6a, 0x9d,
-	0x6e, 0xc0, 0xec, 0xf8, 0x7b, 0xdf, 0x79, 0xf6, 0x93, 0xaf, 0x6e, 0x26, 0xe3, 0x11, 0x68, 0xf1,
-	0x7c, 0x02, 0x33, 0x63, 0x4f, 0xe9, 0xfb, 0x1e, 0x5c, 0x94, 0x94, 0x1e, 0x93, 0xf6, 0x86, 0x73,
-	0x89, 0xa4, 0xdf, 0x88, 0xbd, 0x44, 0xda, 0x15, 0x1a, 0x9e, 0x22, 0xd9, 0x5e, 0x06, 0xb4, 0x06,
-	0xb4, 0xc3, 0xab, 0x60, 0xdd, 0x83, 0x17, 0x82, 0x65, 0xb6, 0xbc, 0x63, 0xad, 0xa8, 0x12, 0x44,
-	0x27, 0x2e, 0xcf, 0x6b, 0x97, 0xf8, 0xb1, 0x90, 0xcc, 0x03, 0xaf, 0x48, 0x2b, 0xde, 0x33, 0x88,
-	0x39, 0x5e, 0x78, 0xbd, 0x38, 0x32, 0xe7, 0xf9, 0xf0, 0x0a, 0x60, 0x2b, 0xf0, 0x8f, 0x00, 0xb6,
-	0x5b, 0x21, 0xe7, 0xe6, 0x62, 0x88, 0x61, 0xd6, 0x2f, 0xc7, 0x58, 0xde, 0x8b, 0x10, 0x36, 0x2f,
-	0x40, 0xac, 0xdb, 0x30, 0x02, 0x60, 0x56, 0x2f, 0x01, 0xd8, 0xb5, 0x37, 0x76, 0x36, 0xdf, 0xbf,
-	0x64, 0x28, 0xc1, 0xb9, 0x6b, 0xcf, 0xe5, 0x3c, 0x69, 0xc0, 0xec, 0xf8, 0x21, 0xe0, 0x9a, 0xe5,
-	0x18, 0xd0, 0x7d, 0xf1, 0xba, 0x6c, 0x92, 0xd9, 0xe2, 0xdb, 0xf3, 0x24, 0xf5, 0xee, 0x3c, 0x49,
-	0xfd, 0x7d, 0x9e, 0xa4, 0x5e, 0x5e, 0x24, 0x7d, 0xef, 0x2e, 0x92, 0xbe, 0xf7, 0x17, 0x49, 0xdf,
-	0xf1, 0xe3, 0xba, 0xa8, 0x9d, 0x74, 0xaa, 0x69, 0x41, 0x6e, 0x65, 0x04, 0x59, 0x6d, 0xc9, 0x6a,
-	0x46, 0xac, 0x0a, 0x1b, 0x75, 0x39, 0xd3, 0xdd, 0xce, 0xb4, 0xe4, 0x5a, 0xa7, 0x89, 0x54, 0xe3,
-	0xd7, 0xe3, 0x83, 0xed, 0x0d, 0xf3, 0xef, 0xa3, 0xd6, 0x6b, 0x23, 0xb5, 0x1a, 0xc2, 0x7f, 0x1e,
-	0x1f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x58, 0xd3, 0x8a, 0x27, 0x08, 0x15, 0x00, 0x00,
+	// 1445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x15, 0x25, 0x45, 0x8a, 0xaf, 0xfd, 0xc5, 0x32, 0x6d, 0xc7, 0x32, 0xed, 0x88, 0xfa, 0xb8,
+	0x88, 0x0d, 0xb7, 0x91, 0x62, 0xc7, 0x41, 0x11, 0xb7, 0x40, 0x61, 0xa9, 0x0a, 0x6a, 0xb4, 0x8e,
+	0x0d, 0xca, 0x2e, 0xd0, 0xb4, 0xa8, 0x20, 0x53, 0x13, 0x99, 0x95, 0x44, 0xaa, 0x24, 0xa5, 0x44,
+	0x7d, 0x82, 0x20, 0xab, 0x2c, 0x8b, 0x00, 0x01, 0x52, 0x74, 0x55, 0x74, 0xd1, 0x3e, 0x40, 0x1f,
+	0x20, 0xcb, 0xec, 0x1a, 0x74, 0x21, 0x14, 0xc9, 0xa6, 0x6b, 0x3d, 0x41, 0xc1, 0x99, 0xe1, 0x8f,
+	0x48, 0x0a, 0xa6, 0x12, 0x3b, 0xce, 0x8e, 0x9c, 0x39, 0x73, 0xef, 0x9d, 0x73, 0xce, 0xcc, 0x70,
+	0x24, 0x58, 0x96, 0x8f, 0xa4, 0xbc, 0xa4, 0x6a, 0x28, 0x2f, 0x1d, 0x57, 0x15, 0x05, 0x35, 0xf3,
+	0xdd, 0xf5, 0xbc, 0xf1, 0x20, 0xd7, 0xd6, 0x54, 0x43, 0x65, 0x67, 0xe5, 0x23, 0x29, 0x67, 0xf6,
+	0xe6, 0x68, 0x6f, 0xae, 0xbb, 0xce, 0xcd, 0xd5, 0xd5, 0xba, 0x8a, 0xfb, 0xf3, 0xe6, 0x13, 0x81,
+	0x72, 0xbc, 0x13, 0xa8, 0x29, 0x23, 0xc5, 0x30, 0xe3, 0x90, 0x27, 0x0a, 0xf8, 0x7f, 0x50, 0x26,
+	0x2b, 0x2c, 0x86, 0x08, 0x3f, 0x33, 0xc0, 0xee, 0xea, 0xf5, 0x22, 0x69, 0xdc, 0x6b, 0x23, 0x65,
+	0x47, 0x91, 0x0d, 0xf6, 0x03, 0x48, 0xb6, 0x55, 0xcd, 0xa8, 0xc8, 0xb5, 0x34, 0x93, 0x65, 0x56,
+	0x27, 0x0a, 0xec, 0xa0, 0xcf, 0x5f, 0xea, 0x55, 0x5b, 0xcd, 0x2d, 0x81, 0x76, 0x08, 0x62, 0xc2,
+	0x7c, 0xda, 0xa9, 0xb1, 0x9f, 0x40, 0x92, 0x06, 0x4d, 0x47, 0xb3, 0xcc, 0xea, 0xe4, 0xc6, 0x72,
+	0x2e, 0x60, 0x12, 0x39, 0x9a, 0xa3, 0x10, 0x7f, 0xde, 0xe7, 0x23, 0xa2, 0x35, 0x84, 0xbd, 0x0c,
+	0x09, 0x5d, 0xae, 0x2b, 0x48, 0x4b, 0xc7, 0xcc, 0x4c, 0x22, 0x7d, 0xdb, 0xba, 0xf8, 0xf0, 0x19,
+	0x1f, 0xf9, 0xf7, 0x19, 0x1f, 0x11, 0x9a, 0xc0, 0xf9, 0x4b, 0x14, 0x91, 0xde, 0x56, 0x15, 0x1d,
+	0xb1, 0x9b, 0x00, 0x34, 0x94, 0x53, 0xed, 0xfc, 0xa0, 0xcf, 0xcf, 0x90, 0x6a, 0x9d, 0x3e, 0x41,
+	0x9c, 0xa0, 0x2f, 0x3b, 0x35, 0x36, 0x0d, 0xc9, 0x2e, 0xd2, 0x74, 0x59, 0x55, 0x70, 0xcd, 0x13,
+	0xa2, 0xf5, 0x2a, 0xbc, 0x8c, 0xc1, 0xcc, 0x70, 0xba, 0x03, 0xad, 0x37, 0x1e, 0x21, 0xfb, 0x30,
+	0xdb, 0xd6, 0x50, 0x57, 0x56, 0x3b, 0x7a, 0xc5, 0x55, 0x1b, 0x4e, 0x54, 0xc8, 0x0e, 0xfa, 0x3c,
+	0x47, 0x07, 0xfa, 0x41, 0x42, 0x9a, 0x11, 0x67, 0xac, 0xf6, 0xa2, 0x5d, 0xae, 0x8b, 0xe2, 0xd8,
+	0xf8, 0x14, 0x8b, 0x30, 0x27, 0xa9, 0x1d, 0xc5, 0x40, 0x5a, 0xbb, 0xaa, 0x19, 0xbd, 0x8a, 0x35,
+	0xf3, 0x38, 0x2e, 0x88, 0x1f, 0xf4, 0xf9, 0x25, 0x4a, 0x56, 0x00, 0x4a, 0x10, 0x67, 0xdd, 0xcd,
+	0x5f, 0x91, 0x56, 0x93, 0xf6, 0xb6, 0xa6, 0xaa, 0xf7, 0x2a, 0xb2, 0x22, 0x1b, 0xe9, 0x0b, 0x59,
+	0x66, 0x75, 0xca, 0x4d, 0xbb, 0xd3, 0x27, 0x88, 0x13, 0xf8, 0x05, 0xfb, 0xea, 0x2e, 0x4c, 0x91,
+	0x9e, 0x63, 0x24, 0xd7, 0x8f, 0x8d, 0x74, 0x02, 0x4f, 0x86, 0x73, 0x4d, 0x86, 0xf8, 0xb7, 0xbb,
+	0x9e, 0xfb, 0x1c, 0x23, 0x0a, 0x4b, 0xe6, 0x54, 0x06, 0x7d, 0x7e, 0xd6, 0x1d, 0x97, 0x8c, 0x16,
+	0xc4, 0x49, 0xfc, 0x4a, 0x90, 0x2e, 0x23, 0x25, 0x47, 0x18, 0xe9, 0x26, 0x2c, 0xfa, 0x94, 0xb5,
+	0x7d, 0xe4, 0x72, 0x04, 0x33, 0xec, 0x88, 0xbf, 0x7c, 0x8e, 0xd8, 0x96, 0x1a, 0xe3, 0x39, 0x62,
+	0xd8, 0xa4, 0xd1, 0x90, 0x26, 0xbd, 0x0b, 0x0b, 0x43, 0x8a, 0xb8, 0x42, 0xe0, 0xb5, 0x52, 0x10,
+	0x06, 0x7d, 0x3e, 0x13, 0x20, 0x9d, 0x3b, 0xde, 0xbc, 0xbb, 0xc7, 0x71, 0xd4, 0x59, 0x78, 0x62,
+	0x1d, 0x88, 0xd4, 0x15, 0x43, 0xeb, 0x51, 0x4b, 0xcc, 0x0d, 0xfa, 0x7c, 0xca, 0x2d, 0x9d, 0xa1,
+	0xf5, 0x04, 0xf1, 0x22, 0x7e, 0x36, 0xd7, 0xd5, 0xf9, 0x1a, 0x62, 0xc9, 0x6b, 0x88, 0x6d, 0xa9,
+	0x61, 0x19, 0x42, 0xf8, 0x2d, 0x0a, 0xf3, 0xc3, 0xbd, 0x45, 0x55, 0xb9, 0x27, 0x6b, 0xad, 0x77,
+	0x21, 0xbd, 0x4d, 0x65, 0x55, 0x6a, 0xa4, 0x63, 0xc1, 0x54, 0x56, 0xa5, 0x86, 0x45, 0xa5, 0x69,
+	0x48, 0x2f, 0x95, 0xf1, 0x33, 0xa1, 0xf2, 0xc2, 0x08, 0x2a, 0x79, 0xb8, 0x12, 0x48, 0x96, 0x4d,
+	0xe7, 0x13, 0x06, 0x66, 0x1d, 0x44, 0xb1, 0xa9, 0xea, 0x68, 0xfc, 0xa3, 0xe6, 0xcd, 0xc8, 0x3c,
+	0xf9, 0x88, 0xb9, 0x02, 0x4b, 0x01, 0xb5, 0xd9, 0xb5, 0xff, 0x1e, 0x85, 0xcb, 0x9e, 0xfe, 0x77,
+	0xe8, 0x85, 0xe1, 0xad, 0x36, 0xf6, 0x86, 0x5b, 0xed, 0xbb, 0xb5, 0x43, 0x16, 0x32, 0xc1, 0x84,
+	0xd9, 0x9c, 0x3e, 0x8e, 0xc2, 0xff, 0x76, 0xf5, 0xba, 0x88, 0xa4, 0xee, 0x7e, 0x55, 0x6a, 0x20,
+	0x83, 0xbd, 0x05, 0x89, 0x36, 0x7e, 0xc2, 0x4c, 0x4e, 0x6e, 0x2c, 0x05, 0x9e, 0x71, 0x04, 0x4c,
+	0x8f, 0x38, 0x3a, 0x80, 0xbd, 0x0d, 0x29, 0x52, 0xae, 0xa4, 0xb6, 0x5a, 0xb2, 0xd1, 0x42, 0x8a,
+	0x81, 0xe9, 0x9d, 0x2a, 0x2c, 0x0d, 0xfa, 0xfc, 0x82, 0x7b, 0x42, 0x0e, 0x42, 0x10, 0xa7, 0x71,
+	0x53, 0xd1, 0x6e, 0xf1, 0x91, 0x16, 0x3b, 0x13, 0xd2, 0xe2, 0x23, 0x48, 0xfb, 0x0e, 0xe6, 0x87,
+	0x18, 0xb1, 0xcf, 0xa6, 0x4f, 0x21, 0xa1, 0x21, 0xbd, 0xd3, 0x24, 0xcc, 0x5c, 0xda, 0x58, 0x09,
+	0x64, 0xc6, 0x82, 0x8b, 0x18, 0x7a, 0xd0, 0x6b, 0x23, 0x91, 0x0e, 0xdb, 0x8a, 0x9b, 0x39, 0x84,
+	0xbf, 0xa3, 0x00, 0xbb, 0x7a, 0xfd, 0x40, 0x6e, 0x21, 0xb5, 0x73, 0x3a, 0x7c, 0x77, 0x14, 0x0d,
+	0x49, 0x48, 0xee, 0xa2, 0xda, 0x28, 0xbe, 0x1d, 0x84, 0xc5, 0xf7, 0xa1, 0xdd, 0x72, 0xa6, 0x7c,
+	0x7f, 0x01, 0xac, 0x82, 0x1e, 0x18, 0x15, 0x1d, 0xfd, 0xd0, 0x41, 0x8a, 0x84, 0x2a, 0x1a, 0x92,
+	0xba, 0x98, 0xfb, 0x78, 0xe1, 0xca, 0xa0, 0xcf, 0x2f, 0x92, 0x08, 0x7e, 0x8c, 0x20, 0xa6, 0xcc,
+	0xc6, 0x32, 0x6d, 0x33, 0xf5, 0x08, 0xe1, 0xf8, 0x6f, 0x80, 0x75, 0xb8, 0x3d, 0x6d, 0xe5, 0x9e,
+	0x90, 0x4f, 0x10, 0x1a, 0x7d, 0x4f, 0xc1, 0x2b, 0xea, 0x7d, 0x10, 0xf0, 0x23, 0x20, 0x9c, 0x57,
+	0x24, 0xb3, 0x22, 0xba, 0x39, 0x5d, 0x1e, 0xf4, 0x79, 0x76, 0x68, 0xcd, 0x99, 0x9d, 0x82, 0x48,
+	0xb6, 0x31, 0x52, 0xfb, 0x59, 0x6e, 0x4f, 0xc1, 0xca, 0x5f, 0x78, 0x5b, 0xe5, 0x13, 0x23, 0x94,
+	0x3f, 0x82, 0x45, 0x9f, 0x36, 0xa7, 0x6d, 0x80, 0x3f, 0xa2, 0xd8, 0x5e, 0xdb, 0x52, 0x43, 0x51,
+	0xef, 0x37, 0x51, 0xad, 0x8e, 0xf0, 0x7e, 0xf5, 0x16, 0x0e, 0x58, 0x85, 0xe9, 0xea, 0x70, 0x34,
+	0x62, 0x00, 0xd1, 0xdb, 0xec, 0x68, 0x6c, 0x0e, 0xac, 0x8d, 0xd2, 0x18, 0x77, 0x5a, 0x1a, 0x6f,
+	0x9b, 0x2f, 0xe7, 0x7c, 0x04, 0x49, 0xc0, 0xf9, 0x19, 0x3b, 0xf5, 0x85, 0x49, 0x74, 0x71, 0xf6,
+	0xec, 0x42, 0xd5, 0x90, 0x8e, 0xd9, 0x8f, 0x21, 0x49, 0x68, 0xd6, 0xd3, 0x4c, 0x36, 0x16, 0x4e,
+	0x18, 0x6b, 0x04, 0xbb, 0x03, 0x33, 0xde, 0xa3, 0x4a, 0x4f, 0x47, 0xb3, 0xb1, 0xd5, 0xa9, 0xc2,
+	0xf2, 0xa0, 0xcf, 0xa7, 0x83, 0x4f, 0x33, 0x5d, 0x10, 0x53, 0x9e, 0xe3, 0x4c, 0x3f, 0xe7, 0xf3,
+	0x0c, 0x01, 0xe7, 0xe7, 0xc6, 0x56, 0x60, 0x1b, 0x92, 0x84, 0x4a, 0xc2, 0xd1, 0x18, 0x12, 0x58,
+	0xe3, 0xa8, 0x06, 0x7f, 0x46, 0x61, 0xc1, 0xaf, 0xf4, 0x29, 0x08, 0xb1, 0x06, 0x29, 0xcf, 0x5a,
+	0xa0, 0x3a, 0x88, 0xbe, 0x76, 0x76, 0x8b, 0x32, 0xad, 0xdb, 0xab, 0xc4, 0xd4, 0x6b, 0xc1, 0xc3,
+	0xa4, 0x6e, 0x2d, 0x13, 0xc2, 0xa4, 0xfe, 0x3e, 0xac, 0x93, 0xef, 0x81, 0x1f, 0xc1, 0xde, 0xa9,
+	0x4b, 0xb5, 0xf6, 0x2b, 0x03, 0xac, 0x1f, 0xc5, 0xde, 0x84, 0xac, 0x58, 0x2a, 0xef, 0xef, 0xdd,
+	0x29, 0x97, 0x2a, 0x62, 0xa9, 0x7c, 0xf8, 0xe5, 0x41, 0xe5, 0xe0, 0xeb, 0xfd, 0x52, 0xe5, 0xf0,
+	0x4e, 0x79, 0xbf, 0x54, 0xdc, 0xb9, 0xbd, 0x53, 0xfa, 0x2c, 0x15, 0xe1, 0xa6, 0x1f, 0x3d, 0xcd,
+	0x4e, 0xba, 0x9a, 0xd8, 0x15, 0x58, 0x0c, 0x1c, 0x76, 0x67, 0x6f, 0x6f, 0x3f, 0xc5, 0x70, 0x17,
+	0x1f, 0x3d, 0xcd, 0xc6, 0xcd, 0x67, 0xf6, 0x1a, 0x2c, 0x07, 0x02, 0xcb, 0x87, 0xc5, 0x62, 0xa9,
+	0x5c, 0x4e, 0x45, 0xb9, 0xc9, 0x47, 0x4f, 0xb3, 0x49, 0xfa, 0xca, 0xc5, 0x1f, 0xfe, 0x92, 0x89,
+	0x6c, 0xfc, 0x04, 0x10, 0xdb, 0xd5, 0xeb, 0x6c, 0x03, 0xa6, 0xbd, 0x3f, 0x8f, 0x05, 0x4f, 0xdf,
+	0xff, 0x23, 0x15, 0x97, 0x0f, 0x09, 0xb4, 0x99, 0x3e, 0x86, 0x4b, 0x9e, 0x5f, 0x9e, 0xae, 0x86,
+	0x08, 0x71, 0xa0, 0xf5, 0xb8, 0x5c, 0x38, 0xdc, 0x88, 0x4c, 0xe6, 0x05, 0x32, 0x4c, 0xa6, 0x6d,
+	0xa9, 0x11, 0x2a, 0x93, 0xeb, 0x22, 0xcd, 0x1a, 0xc0, 0x06, 0x5c, 0xa2, 0xd7, 0x42, 0x44, 0xa1,
+	0x58, 0x6e, 0x23, 0x3c, 0xd6, 0xce, 0xaa, 0x40, 0xca, 0x77, 0xd7, 0x5c, 0x3d, 0x21, 0x8e, 0x8d,
+	0xe4, 0xae, 0x87, 0x45, 0xda, 0xf9, 0xee, 0xc3, 0x6c, 0xe0, 0xfd, 0x30, 0x4c, 0x20, 0x6b, 0x9e,
+	0x37, 0xc6, 0x00, 0xdb, 0x89, 0xbf, 0x05, 0x70, 0x5d, 0xa2, 0x84, 0x51, 0x21, 0x1c, 0x0c, 0xb7,
+	0x76, 0x32, 0xc6, 0x8e, 0x5e, 0x86, 0xa4, 0x75, 0x5f, 0xe0, 0x47, 0x0d, 0xa3, 0x00, 0x6e, 0xe5,
+	0x04, 0x80, 0xdb, 0x7b, 0x9e, 0x4f, 0xd9, 0xab, 0x27, 0x0c, 0xa5, 0x38, 0x2e, 0x17, 0x0e, 0x67,
+	0x67, 0x6a, 0xc0, 0xb4, 0xf7, 0x9b, 0x69, 0x64, 0x95, 0x1e, 0x20, 0x97, 0x0f, 0x09, 0x74, 0x27,
+	0xf3, 0x7e, 0x08, 0xac, 0x9c, 0x4c, 0x35, 0x06, 0x72, 0xf9, 0x90, 0x40, 0x3b, 0xd9, 0x8f, 0x30,
+	0x17, 0x78, 0xe2, 0x7d, 0x18, 0xb2, 0x6a, 0x92, 0x76, 0x73, 0x1c, 0xb4, 0x95, 0xbb, 0x50, 0x7e,
+	0xfe, 0x2a, 0xc3, 0xbc, 0x78, 0x95, 0x61, 0xfe, 0x79, 0x95, 0x61, 0x1e, 0xbf, 0xce, 0x44, 0x5e,
+	0xbc, 0xce, 0x44, 0x5e, 0xbe, 0xce, 0x44, 0xee, 0xde, 0xaa, 0xcb, 0xc6, 0x71, 0xe7, 0x28, 0x27,
+	0xa9, 0xad, 0xbc, 0xa4, 0xea, 0x2d, 0x55, 0xcf, 0xcb, 0x47, 0xd2, 0xb5, 0xba, 0x9a, 0xef, 0x6e,
+	0xe6, 0x5b, 0x6a, 0xad, 0xd3, 0x44, 0x3a, 0xf9, 0x4b, 0xe2, 0xfa, 0xe6, 0x35, 0xeb, 0x5f, 0x09,
+	0xa3, 0xd7, 0x46, 0xfa, 0x51, 0x02, 0xff, 0x23, 0x71, 0xe3, 0xbf, 0x01, 0x00, 0x2a, 0xd9, 0x7f,
+	0xa5, 0x20, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1034,6 +1214,10 @@ type MsgClient interface {
 	TimeoutOnClose(ctx context.Context, in *MsgTimeoutOnClose, opts ...grpc.CallOption) (*MsgTimeoutOnCloseResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(ctx context.Context, in *MsgAcknowledgement, opts ...grpc.CallOption) (*MsgAcknowledgementResponse, error)
+	// RecvPacketBatch defines a rpc handler method for MsgRecvPacketBatch.
+	RecvPacketBatch(ctx context.Context, in *MsgRecvPacketBatch, opts ...grpc.CallOption) (*MsgRecvPacketBatchResponse, error)
+	// AcknowledgementBatch defines a rpc handler method for MsgAcknowledgementBatch.
+	AcknowledgementBatch(ctx context.Context, in *MsgAcknowledgementBatch, opts ...grpc.CallOption) (*MsgAcknowledgementBatchResponse, error)
 }
 
 type msgClient struct {
@@ -1134,6 +1318,24 @@ func (c *msgClient) Acknowledgement(ctx context.Context, in *MsgAcknowledgement,
 	return out, nil
 }
 
+func (c *msgClient) RecvPacketBatch(ctx context.Context, in *MsgRecvPacketBatch, opts ...grpc.CallOption) (*MsgRecvPacketBatchResponse, error) {
+	out := new(MsgRecvPacketBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/RecvPacketBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgementBatch(ctx context.Context, in *MsgAcknowledgementBatch, opts ...grpc.CallOption) (*MsgAcknowledgementBatchResponse, error) {
+	out := new(MsgAcknowledgementBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/AcknowledgementBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
@@ -1157,6 +1359,10 @@ type MsgServer interface {
 	TimeoutOnClose(context.Context, *MsgTimeoutOnClose) (*MsgTimeoutOnCloseResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// RecvPacketBatch defines a rpc handler method for MsgRecvPacketBatch.
+	RecvPacketBatch(context.Context, *MsgRecvPacketBatch) (*MsgRecvPacketBatchResponse, error)
+	// AcknowledgementBatch defines a rpc handler method for MsgAcknowledgementBatch.
+	AcknowledgementBatch(context.Context, *MsgAcknowledgementBatch) (*MsgAcknowledgementBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1193,6 +1399,12 @@ func (*UnimplementedMsgServer) TimeoutOnClose(ctx context.Context, req *MsgTimeo
 func (*UnimplementedMsgServer) Acknowledgement(ctx context.Context, req *MsgAcknowledgement) (*MsgAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgement not implemented")
 }
+func (*UnimplementedMsgServer) RecvPacketBatch(ctx context.Context, req *MsgRecvPacketBatch) (*MsgRecvPacketBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecvPacketBatch not implemented")
+}
+func (*UnimplementedMsgServer) AcknowledgementBatch(ctx context.Context, req *MsgAcknowledgementBatch) (*MsgAcknowledgementBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgementBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1378,6 +1590,42 @@ func _Msg_Acknowledgement_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecvPacketBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecvPacketBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecvPacketBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/RecvPacketBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecvPacketBatch(ctx, req.(*MsgRecvPacketBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgementBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgementBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgementBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/AcknowledgementBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgementBatch(ctx, req.(*MsgAcknowledgementBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1422,6 +1670,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Acknowledgement",
 			Handler:    _Msg_Acknowledgement_Handler,
 		},
+		{
+			MethodName: "RecvPacketBatch",
+			Handler:    _Msg_RecvPacketBatch_Handler,
+		},
+		{
+			MethodName: "AcknowledgementBatch",
+			Handler:    _Msg_AcknowledgementBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -2316,102 +2572,319 @@ func (m *MsgAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgChannelOpenInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRecvPacketBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChannelOpenInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRecvPacketBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRecvPacketBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PreviousChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CounterpartyVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofCommitments) > 0 {
+		for iNdEx := len(m.ProofCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofCommitments[iNdEx])
+			copy(dAtA[i:], m.ProofCommitments[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofCommitments[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRecvPacketBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecvPacketBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecvPacketBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		dAtA17 := make([]byte, len(m.Results)*10)
+		var j16 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTx(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcknowledgementBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgementBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgementBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofsAcked) > 0 {
+		for iNdEx := len(m.ProofsAcked) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsAcked[iNdEx])
+			copy(dAtA[i:], m.ProofsAcked[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsAcked[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Acknowledgements[iNdEx])
+			copy(dAtA[i:], m.Acknowledgements[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Acknowledgements[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgementBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgementBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgementBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		dAtA20 := make([]byte, len(m.Results)*10)
+		var j19 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgChannelOpenInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelOpenInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelOpenTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CounterpartyVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelOpenTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelOpenAck) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2701,6 +3174,98 @@ func (m *MsgAcknowledgementResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRecvPacketBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProofCommitments) > 0 {
+		for _, b := range m.ProofCommitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for _, b := range m.Acknowledgements {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProofsAcked) > 0 {
+		for _, b := range m.ProofsAcked {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5487,6 +6052,638 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRecvPacketBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitments = append(m.ProofCommitments, make([]byte, postIndex-iNdEx))
+			copy(m.ProofCommitments[len(m.ProofCommitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacketBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgementBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgementBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgementBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, make([]byte, postIndex-iNdEx))
+			copy(m.Acknowledgements[len(m.Acknowledgements)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsAcked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsAcked = append(m.ProofsAcked, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsAcked[len(m.ProofsAcked)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgementBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgementBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgementBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
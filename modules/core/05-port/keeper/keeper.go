@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -31,12 +32,44 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
 }
 
-// IsBound checks a given port ID is already bounded.
+// IsBound checks a given port ID is already bounded. Ports statically owned by a module
+// registered on the router are always bound.
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	if _, ok := k.StaticPortOwner(portID); ok {
+		return true
+	}
+
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
 	return ok
 }
 
+// StaticPortOwner returns the name of the module which statically owns the given port ID and true if
+// the port is statically owned by a module registered on the router, see Router.AddStaticRoute.
+func (k Keeper) StaticPortOwner(portID string) (string, bool) {
+	if k.Router == nil {
+		return "", false
+	}
+
+	return k.Router.StaticPortOwner(portID)
+}
+
+// LookupStaticPortOwner returns the static owner of the given port ID, see StaticPortOwner. An error is returned if
+// the port is also bound using a capability, as the module owning the capability would otherwise be shadowed by the
+// static owner. This may only happen if the capability was created before the static route was registered, as
+// statically owned ports cannot be bound.
+func (k Keeper) LookupStaticPortOwner(ctx sdk.Context, portID string) (string, bool, error) {
+	module, ok := k.StaticPortOwner(portID)
+	if !ok {
+		return "", false, nil
+	}
+
+	if _, found := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID)); found {
+		return "", false, sdkerrors.Wrapf(types.ErrInvalidPort, "port %s is bound using a capability and statically owned by module %s", portID, module)
+	}
+
+	return module, true, nil
+}
+
 // BindPort binds to a port and returns the associated capability.
 // Ports must be bound statically when the chain starts in `app.go`.
 // The capability must then be passed to a module which will need to pass
@@ -71,8 +104,18 @@ func (k Keeper) Authenticate(ctx sdk.Context, key *capabilitytypes.Capability, p
 	return k.scopedKeeper.AuthenticateCapability(ctx, key, host.PortPath(portID))
 }
 
-// LookupModuleByPort will return the IBCModule along with the capability associated with a given portID
+// LookupModuleByPort will return the IBCModule along with the capability associated with a given portID.
+// A nil capability is returned for ports statically owned by a module registered on the router.
 func (k Keeper) LookupModuleByPort(ctx sdk.Context, portID string) (string, *capabilitytypes.Capability, error) {
+	module, ok, err := k.LookupStaticPortOwner(ctx, portID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if ok {
+		return module, nil, nil
+	}
+
 	modules, cap, err := k.scopedKeeper.LookupModules(ctx, host.PortPath(portID))
 	if err != nil {
 		return "", nil, err
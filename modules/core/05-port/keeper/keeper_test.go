@@ -4,11 +4,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/05-port/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/testing/simapp"
 )
 
@@ -20,8 +23,9 @@ var (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx    sdk.Context
-	keeper *keeper.Keeper
+	ctx          sdk.Context
+	keeper       *keeper.Keeper
+	scopedKeeper capabilitykeeper.ScopedKeeper
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -30,6 +34,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	suite.ctx = app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
 	suite.keeper = &app.IBCKeeper.PortKeeper
+	suite.scopedKeeper = app.ScopedIBCKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -74,3 +79,43 @@ func (suite *KeeperTestSuite) TestAuthenticate() {
 	auth = suite.keeper.Authenticate(suite.ctx, capKey2, validPort)
 	require.False(suite.T(), auth, "invalid authentication for different capKey failed")
 }
+
+func (suite *KeeperTestSuite) TestStaticPorts() {
+	staticPort := simapp.MockStaticPort + "-1"
+
+	module, ok := suite.keeper.StaticPortOwner(staticPort)
+	require.True(suite.T(), ok, "port with static port prefix is not statically owned")
+	require.Equal(suite.T(), simapp.MockStaticPort, module)
+
+	_, ok = suite.keeper.StaticPortOwner(validPort)
+	require.False(suite.T(), ok, "port without static port prefix is statically owned")
+
+	// statically owned ports are bound without capabilities
+	require.True(suite.T(), suite.keeper.IsBound(suite.ctx, staticPort), "statically owned port is not bound")
+	require.Panics(suite.T(), func() { suite.keeper.BindPort(suite.ctx, staticPort) }, "did not panic on binding a statically owned port")
+
+	module, capKey, err := suite.keeper.LookupModuleByPort(suite.ctx, staticPort)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), simapp.MockStaticPort, module)
+	require.Nil(suite.T(), capKey)
+
+	// ports without static owner are looked up by capability
+	_, _, err = suite.keeper.LookupModuleByPort(suite.ctx, validPort)
+	require.Error(suite.T(), err)
+
+	module, ok, err = suite.keeper.LookupStaticPortOwner(suite.ctx, staticPort)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), ok)
+	require.Equal(suite.T(), simapp.MockStaticPort, module)
+
+	// statically owned ports bound using a capability, e.g. before the static route was registered, are rejected
+	_, err = suite.scopedKeeper.NewCapability(suite.ctx, host.PortPath(staticPort))
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), suite.keeper.IsBound(suite.ctx, staticPort), "statically owned port is not bound")
+
+	_, _, err = suite.keeper.LookupStaticPortOwner(suite.ctx, staticPort)
+	require.ErrorIs(suite.T(), err, types.ErrInvalidPort)
+
+	_, _, err = suite.keeper.LookupModuleByPort(suite.ctx, staticPort)
+	require.ErrorIs(suite.T(), err, types.ErrInvalidPort)
+}
@@ -2,20 +2,26 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 // The router is a map from module name to the IBCModule
 // which contains all the module-defined callbacks required by ICS-26
 type Router struct {
-	routes map[string]IBCModule
-	sealed bool
+	routes      map[string]IBCModule
+	staticPorts map[string]string // port prefix -> module name
+	sealed      bool
 }
 
 func NewRouter() *Router {
 	return &Router{
-		routes: make(map[string]IBCModule),
+		routes:      make(map[string]IBCModule),
+		staticPorts: make(map[string]string),
 	}
 }
 
@@ -34,7 +40,8 @@ func (rtr Router) Sealed() bool {
 }
 
 // AddRoute adds IBCModule for a given module name. It returns the Router
-// so AddRoute calls can be linked. It will panic if the Router is sealed.
+// so AddRoute calls can be linked. It will panic if the Router is sealed
+// or the module name starts with the port prefix of a static route.
 func (rtr *Router) AddRoute(module string, cbs IBCModule) *Router {
 	if rtr.sealed {
 		panic(fmt.Sprintf("router sealed; cannot register %s route callbacks", module))
@@ -45,11 +52,57 @@ func (rtr *Router) AddRoute(module string, cbs IBCModule) *Router {
 	if rtr.HasRoute(module) {
 		panic(fmt.Sprintf("route %s has already been registered", module))
 	}
+	// the port bound by the module, conventionally named after the module, would be shadowed by the static owner
+	if owner, ok := rtr.StaticPortOwner(module); ok {
+		panic(fmt.Sprintf("route %s is shadowed by the static route of module %s", module, owner))
+	}
 
 	rtr.routes[module] = cbs
 	return rtr
 }
 
+// AddStaticRoute adds IBCModule for a given module name and declares the module the static owner of all ports
+// whose identifier starts with the provided port prefix. Channels on statically owned ports are not authenticated
+// using capabilities, core IBC instead routes the channel and packet callbacks for these ports to the owning module.
+// Static routes are opt-in per port and coexist with capability-based routes. It returns the Router so AddRoute
+// calls can be linked. It will panic if the Router is sealed, the port prefix is invalid, the port prefix overlaps
+// with the port prefix of an existing static route or the name of an existing route starts with the port prefix.
+// Ports bound using capabilities are checked against the static routes by the port keeper.
+func (rtr *Router) AddStaticRoute(module, portPrefix string, cbs IBCModule) *Router {
+	if rtr.sealed {
+		panic(fmt.Sprintf("router sealed; cannot register %s static route callbacks", module))
+	}
+	if err := host.PortIdentifierValidator(portPrefix); err != nil {
+		panic(fmt.Sprintf("invalid static port prefix %s: %s", portPrefix, err))
+	}
+	for prefix, owner := range rtr.staticPorts {
+		if strings.HasPrefix(portPrefix, prefix) || strings.HasPrefix(prefix, portPrefix) {
+			panic(fmt.Sprintf("static port prefix %s overlaps with static port prefix %s owned by module %s", portPrefix, prefix, owner))
+		}
+	}
+	for route := range rtr.routes {
+		if strings.HasPrefix(route, portPrefix) {
+			panic(fmt.Sprintf("static port prefix %s shadows the port of route %s", portPrefix, route))
+		}
+	}
+
+	rtr.AddRoute(module, cbs)
+	rtr.staticPorts[portPrefix] = module
+	return rtr
+}
+
+// StaticPortOwner returns the name of the module which statically owns the provided port and true if the port
+// identifier starts with the port prefix of a static route. Otherwise an empty string and false are returned.
+func (rtr *Router) StaticPortOwner(portID string) (string, bool) {
+	for prefix, module := range rtr.staticPorts {
+		if strings.HasPrefix(portID, prefix) {
+			return module, true
+		}
+	}
+
+	return "", false
+}
+
 // HasRoute returns true if the Router has a module registered or false otherwise.
 func (rtr *Router) HasRoute(module string) bool {
 	_, ok := rtr.routes[module]
@@ -63,3 +116,14 @@ func (rtr *Router) GetRoute(module string) (IBCModule, bool) {
 	}
 	return rtr.routes[module], true
 }
+
+// Modules returns the names of all modules which have been registered on the Router in lexicographical order.
+func (rtr *Router) Modules() []string {
+	modules := make([]string, 0, len(rtr.routes))
+	for module := range rtr.routes {
+		modules = append(modules, module)
+	}
+
+	sort.Strings(modules)
+	return modules
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/testing/mock"
+)
+
+func TestRouterStaticRoutes(t *testing.T) {
+	app := mock.NewIBCMiddleware("app", nil, nil, nil)
+
+	router := types.NewRouter()
+	router.AddRoute("capability", app).
+		AddStaticRoute("static", "staticport", app)
+
+	require.Equal(t, []string{"capability", "static"}, router.Modules())
+	require.True(t, router.HasRoute("static"))
+
+	module, ok := router.StaticPortOwner("staticport")
+	require.True(t, ok)
+	require.Equal(t, "static", module)
+
+	module, ok = router.StaticPortOwner("staticport-1")
+	require.True(t, ok)
+	require.Equal(t, "static", module)
+
+	_, ok = router.StaticPortOwner("capability")
+	require.False(t, ok)
+
+	// static port prefixes must be valid port identifiers and must not overlap
+	require.Panics(t, func() { router.AddStaticRoute("invalid", "(invalid)", app) })
+	require.Panics(t, func() { router.AddStaticRoute("longer", "staticport-1", app) })
+	require.Panics(t, func() { router.AddStaticRoute("shorter", "static", app) })
+
+	// static port prefixes must not shadow the ports of existing routes
+	require.Panics(t, func() { router.AddStaticRoute("capabilityowner", "capa", app) })
+	require.Panics(t, func() { router.AddRoute("staticport-transfer", app) })
+
+	// modules may only be registered once
+	require.Panics(t, func() { router.AddStaticRoute("capability", "otherport", app) })
+
+	router.Seal()
+	require.Panics(t, func() { router.AddStaticRoute("sealed", "sealedport", app) })
+}
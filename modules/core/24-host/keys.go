@@ -41,6 +41,8 @@ const (
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
 	KeyPacketReceiptPrefix     = "receipts"
+	KeyPruningSequenceStart    = "pruningSequenceStart"
+	KeyPruningSequenceEnd      = "pruningSequenceEnd"
 )
 
 // FullClientPath returns the full path of a specific client path in the format:
@@ -175,6 +177,30 @@ func NextSequenceAckKey(portID, channelID string) []byte {
 	return []byte(NextSequenceAckPath(portID, channelID))
 }
 
+// PruningSequenceStartPath defines the path under which the next sequence to be pruned of a
+// particular channel is stored
+func PruningSequenceStartPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSequenceStart, channelPath(portID, channelID))
+}
+
+// PruningSequenceStartKey returns the store key for the next sequence to be pruned of a
+// particular channel binded to a specific port.
+func PruningSequenceStartKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceStartPath(portID, channelID))
+}
+
+// PruningSequenceEndPath defines the path under which the sequence below which packet
+// acknowledgements and receipts of a particular channel may be pruned is stored
+func PruningSequenceEndPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSequenceEnd, channelPath(portID, channelID))
+}
+
+// PruningSequenceEndKey returns the store key for the sequence below which packet
+// acknowledgements and receipts of a particular channel binded to a specific port may be pruned.
+func PruningSequenceEndKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceEndPath(portID, channelID))
+}
+
 // PacketCommitmentPath defines the commitments to packet data fields store path
 func PacketCommitmentPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/%d", PacketCommitmentPrefixPath(portID, channelID), sequence)
@@ -218,6 +244,11 @@ func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketReceiptPath(portID, channelID, sequence))
 }
 
+// PacketReceiptPrefixPath defines the prefix for packet receipts store path.
+func PacketReceiptPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketReceiptPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
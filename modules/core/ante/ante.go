@@ -3,8 +3,10 @@ package ante
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clientkeeper "github.com/cosmos/ibc-go/v4/modules/core/02-client/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	"github.com/cosmos/ibc-go/v4/modules/core/keeper"
 )
 
@@ -24,7 +26,8 @@ func NewAnteDecorator(k *keeper.Keeper) AnteDecorator {
 func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// do not run redundancy check on DeliverTx or simulate
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
-		// keep track of total packet messages and number of redundancies across `RecvPacket`, `AcknowledgePacket`, and `TimeoutPacket/OnClose`
+		// keep track of total packet messages and number of redundancies across `RecvPacket`, `AcknowledgePacket`, and `TimeoutPacket/OnClose`.
+		// Each packet within a batch message is counted individually.
 		redundancies := 0
 		packetMsgs := 0
 		for _, m := range tx.GetMsgs() {
@@ -69,6 +72,30 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				}
 				packetMsgs += 1
 
+			case *channeltypes.MsgRecvPacketBatch:
+				response, err := ad.k.RecvPacketBatch(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies += 1
+					}
+					packetMsgs += 1
+				}
+
+			case *channeltypes.MsgAcknowledgementBatch:
+				response, err := ad.k.AcknowledgementBatch(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies += 1
+					}
+					packetMsgs += 1
+				}
+
 			case *clienttypes.MsgUpdateClient:
 				_, err := ad.k.UpdateClient(sdk.WrapSDKContext(ctx), msg)
 				if err != nil {
@@ -88,5 +115,32 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, channeltypes.ErrRedundantTx
 		}
 	}
+
+	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+		ad.emitExpiredClientUpdateEvents(ctx, tx)
+	}
+
 	return next(ctx, tx, simulate)
 }
+
+// emitExpiredClientUpdateEvents emits an update expired client event for every MsgUpdateClient within
+// the tx which targets an expired client. Execution of such a message fails and the events it emits are
+// discarded, however events emitted by the ante handler are retained in the result of a failed tx which
+// allows monitoring services to observe attempts to update an expired client.
+func (ad AnteDecorator) emitExpiredClientUpdateEvents(ctx sdk.Context, tx sdk.Tx) {
+	for _, m := range tx.GetMsgs() {
+		msg, ok := m.(*clienttypes.MsgUpdateClient)
+		if !ok {
+			continue
+		}
+
+		clientState, found := ad.k.ClientKeeper.GetClientState(ctx, msg.ClientId)
+		if !found {
+			continue
+		}
+
+		if status := ad.k.ClientKeeper.GetClientStatus(ctx, clientState, msg.ClientId); status == exported.Expired {
+			clientkeeper.EmitUpdateExpiredClientEvent(ctx, msg.ClientId, clientState)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/ante"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -68,6 +69,30 @@ func (suite *AnteTestSuite) createRecvPacketMessage(sequenceNumber uint64, isRed
 	return channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketBatchMessage creates a RecvPacketBatch message for packets sent from chain A to chain B.
+// A packet is created for each entry of isRedundant, and all packet commitments are proven at the same height.
+func (suite *AnteTestSuite) createRecvPacketBatchMessage(isRedundant ...bool) sdk.Msg {
+	var packets []channeltypes.Packet
+	for i, redundant := range isRedundant {
+		msg := suite.createRecvPacketMessage(uint64(i+1), redundant).(*channeltypes.MsgRecvPacket)
+		packets = append(packets, msg.Packet)
+	}
+
+	var (
+		proofs      [][]byte
+		proofHeight clienttypes.Height
+	)
+	for _, packet := range packets {
+		packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+		var proof []byte
+		proof, proofHeight = suite.chainA.QueryProof(packetKey)
+		proofs = append(proofs, proof)
+	}
+
+	return channeltypes.NewMsgRecvPacketBatch(packets, proofs, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createAcknowledgementMessage creates an Acknowledgement message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createAcknowledgementMessage(sequenceNumber uint64, isRedundant bool) sdk.Msg {
 	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequenceNumber,
@@ -448,6 +473,21 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			false,
 		},
+		{
+			"success on RecvPacketBatch message with new and redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				// only the first packet in the batch has already been received
+				return []sdk.Msg{suite.createRecvPacketBatchMessage(true, false, false)}
+			},
+			true,
+		},
+		{
+			"no success on RecvPacketBatch message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketBatchMessage(true, true, true)}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -485,3 +525,57 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestAnteDecoratorExpiredClientUpdate() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expEvent bool
+	}{
+		{
+			"active client does not emit update expired client event",
+			func() {},
+			false,
+		},
+		{
+			"expired client emits update expired client event",
+			func() {
+				clientState := suite.path.EndpointB.GetClientState().(*ibctmtypes.ClientState)
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			// reset suite
+			suite.SetupTest()
+
+			tc.malleate()
+
+			decorator := ante.NewAnteDecorator(suite.chainB.App.GetIBCKeeper())
+
+			txBuilder := suite.chainB.TxConfig.NewTxBuilder()
+			err := txBuilder.SetMsgs(suite.createUpdateClientMessage())
+			suite.Require().NoError(err)
+			tx := txBuilder.GetTx()
+
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+			deliverCtx := suite.chainB.GetContext().WithIsCheckTx(false)
+			_, err = decorator.AnteHandle(deliverCtx, tx, false, next)
+			suite.Require().NoError(err)
+
+			var found bool
+			for _, event := range deliverCtx.EventManager().Events() {
+				if event.Type == clienttypes.EventTypeUpdateExpiredClient {
+					found = true
+				}
+			}
+			suite.Require().Equal(tc.expEvent, found)
+		})
+	}
+}
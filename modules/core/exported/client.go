@@ -163,6 +163,19 @@ type ClientState interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketCommitmentAbsence(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		store sdk.KVStore,
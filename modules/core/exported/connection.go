@@ -1,5 +1,10 @@
 package exported
 
+// LocalhostConnectionID is the sentinel connection identifier of the connection opened on the
+// localhost client. It allows modules on the same chain to open channels with each other
+// without a connection handshake.
+const LocalhostConnectionID string = "connection-localhost"
+
 // ConnectionI describes the required methods for a connection.
 type ConnectionI interface {
 	GetClientID() string
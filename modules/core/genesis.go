@@ -6,6 +6,7 @@ import (
 	client "github.com/cosmos/ibc-go/v4/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v4/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v4/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	"github.com/cosmos/ibc-go/v4/modules/core/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/core/types"
 )
@@ -15,6 +16,15 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, createLocalhost bool, gs *types.GenesisState) {
 	client.InitGenesis(ctx, k.ClientKeeper, gs.ClientGenesis)
 	connection.InitGenesis(ctx, k.ConnectionKeeper, gs.ConnectionGenesis)
+
+	// the sentinel localhost connection is opened together with the localhost client
+	_, found := k.ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID)
+	if gs.ClientGenesis.CreateLocalhost && !found {
+		if err := k.ConnectionKeeper.CreateSentinelLocalhostConnection(ctx); err != nil {
+			panic(err)
+		}
+	}
+
 	channel.InitGenesis(ctx, k.ChannelKeeper, gs.ChannelGenesis)
 }
 
@@ -38,6 +38,11 @@ func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStat
 	return q.ClientKeeper.ClientStatus(c, req)
 }
 
+// FrozenClients implements the IBC QueryServer interface
+func (q Keeper) FrozenClients(c context.Context, req *clienttypes.QueryFrozenClientsRequest) (*clienttypes.QueryFrozenClientsResponse, error) {
+	return q.ClientKeeper.FrozenClients(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (q Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return q.ClientKeeper.ClientParams(c, req)
@@ -133,7 +138,27 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnrecei
 	return q.ChannelKeeper.UnreceivedAcks(c, req)
 }
 
+// UnreceivedPacketsRange implements the IBC QueryServer interface
+func (q Keeper) UnreceivedPacketsRange(c context.Context, req *channeltypes.QueryUnreceivedPacketsRangeRequest) (*channeltypes.QueryUnreceivedPacketsRangeResponse, error) {
+	return q.ChannelKeeper.UnreceivedPacketsRange(c, req)
+}
+
+// UnreceivedAcksRange implements the IBC QueryServer interface
+func (q Keeper) UnreceivedAcksRange(c context.Context, req *channeltypes.QueryUnreceivedAcksRangeRequest) (*channeltypes.QueryUnreceivedAcksRangeResponse, error) {
+	return q.ChannelKeeper.UnreceivedAcksRange(c, req)
+}
+
 // NextSequenceReceive implements the IBC QueryServer interface
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// NextSequenceSend implements the IBC QueryServer interface
+func (q Keeper) NextSequenceSend(c context.Context, req *channeltypes.QueryNextSequenceSendRequest) (*channeltypes.QueryNextSequenceSendResponse, error) {
+	return q.ChannelKeeper.NextSequenceSend(c, req)
+}
+
+// NextSequenceAck implements the IBC QueryServer interface
+func (q Keeper) NextSequenceAck(c context.Context, req *channeltypes.QueryNextSequenceAckRequest) (*channeltypes.QueryNextSequenceAckResponse, error) {
+	return q.ChannelKeeper.NextSequenceAck(c, req)
+}
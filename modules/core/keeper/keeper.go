@@ -16,6 +16,7 @@ import (
 	channelkeeper "github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
 	portkeeper "github.com/cosmos/ibc-go/v4/modules/core/05-port/keeper"
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/types"
 )
 
@@ -33,6 +34,9 @@ type Keeper struct {
 	ChannelKeeper    channelkeeper.Keeper
 	PortKeeper       portkeeper.Keeper
 	Router           *porttypes.Router
+
+	// channelKeeper is scoped to core IBC, it is used by the message server to act on behalf of the module routed to
+	channelKeeper channelkeeper.Keeper
 }
 
 // NewKeeper creates a new ibc Keeper
@@ -65,15 +69,19 @@ func NewKeeper(
 	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper)
 	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
 	portKeeper := portkeeper.NewKeeper(scopedKeeper)
-	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
 
-	return &Keeper{
+	k := &Keeper{
 		cdc:              cdc,
 		ClientKeeper:     clientKeeper,
 		ConnectionKeeper: connectionKeeper,
-		ChannelKeeper:    channelKeeper,
 		PortKeeper:       portKeeper,
 	}
+
+	// the channel keeper references the port keeper of the IBC keeper, such that it observes the router set in SetRouter
+	k.ChannelKeeper = channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, &k.PortKeeper, scopedKeeper)
+	k.channelKeeper = k.ChannelKeeper.ScopeToModule(host.ModuleName)
+
+	return k
 }
 
 // Codec returns the IBC module codec.
@@ -81,8 +89,10 @@ func (k Keeper) Codec() codec.BinaryCodec {
 	return k.cdc
 }
 
-// SetRouter sets the Router in IBC Keeper and seals it. The method panics if
-// there is an existing router that's already sealed.
+// SetRouter sets the Router in IBC Keeper and seals it. The channel keeper is
+// sealed as well, such that channel keepers scoped to the static owners of ports
+// must be created before the router is set. The method panics if there is an
+// existing router that's already sealed.
 func (k *Keeper) SetRouter(rtr *porttypes.Router) {
 	if k.Router != nil && k.Router.Sealed() {
 		panic("cannot reset a sealed router")
@@ -91,4 +101,5 @@ func (k *Keeper) SetRouter(rtr *porttypes.Router) {
 	k.PortKeeper.Router = rtr
 	k.Router = rtr
 	k.Router.Seal()
+	k.ChannelKeeper.Seal()
 }
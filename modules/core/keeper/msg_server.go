@@ -13,6 +13,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v4/modules/core/types"
 )
 
@@ -26,6 +27,10 @@ var (
 func (k Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateClient) (*clienttypes.MsgCreateClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.ClientKeeper.GetPermissionedClientCreation(ctx) {
+		return nil, sdkerrors.Wrap(clienttypes.ErrClientCreationNotPermitted, "clients must be created with a create client proposal")
+	}
+
 	clientState, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -56,6 +61,11 @@ func (k Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateCl
 		return nil, err
 	}
 
+	// a frozen client cannot be updated, so a client frozen after the update was frozen by this header
+	if clientState, found := k.ClientKeeper.GetClientState(ctx, msg.ClientId); found && k.ClientKeeper.GetClientStatus(ctx, clientState, msg.ClientId) == exported.Frozen {
+		k.setMisbehaviourSubmitter(ctx, msg.ClientId, msg.Signer)
+	}
+
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
@@ -93,9 +103,23 @@ func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSu
 		return nil, sdkerrors.Wrap(err, "failed to process misbehaviour for IBC client")
 	}
 
+	k.setMisbehaviourSubmitter(ctx, misbehaviour.GetClientID(), msg.Signer)
+
 	return &clienttypes.MsgSubmitMisbehaviourResponse{}, nil
 }
 
+// setMisbehaviourSubmitter sets the submitter of the misbehaviour record stored by the
+// client keeper when freezing a client, as only the message handler knows the signer.
+func (k Keeper) setMisbehaviourSubmitter(ctx sdk.Context, clientID, submitter string) {
+	record, found := k.ClientKeeper.GetMisbehaviourRecord(ctx, clientID)
+	if !found {
+		return
+	}
+
+	record.Submitter = submitter
+	k.ClientKeeper.SetMisbehaviourRecord(ctx, clientID, record)
+}
+
 // ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
 func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -178,7 +202,7 @@ func (k Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChan
 	}
 
 	// Perform 04-channel verification
-	channelID, cap, err := k.ChannelKeeper.ChanOpenInit(
+	channelID, cap, err := k.channelKeeper.ChanOpenInit(
 		ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId,
 		portCap, msg.Channel.Counterparty, msg.Channel.Version,
 	)
@@ -220,7 +244,7 @@ func (k Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChann
 	}
 
 	// Perform 04-channel verification
-	channelID, cap, err := k.ChannelKeeper.ChanOpenTry(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId,
+	channelID, cap, err := k.channelKeeper.ChanOpenTry(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId,
 		portCap, msg.Channel.Counterparty, msg.CounterpartyVersion, msg.ProofInit, msg.ProofHeight,
 	)
 	if err != nil {
@@ -260,7 +284,7 @@ func (k Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChann
 	}
 
 	// Perform 04-channel verification
-	if err = k.ChannelKeeper.ChanOpenAck(
+	if err = k.channelKeeper.ChanOpenAck(
 		ctx, msg.PortId, msg.ChannelId, cap, msg.CounterpartyVersion, msg.CounterpartyChannelId, msg.ProofTry, msg.ProofHeight,
 	); err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake open ack failed")
@@ -296,7 +320,7 @@ func (k Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.MsgC
 	}
 
 	// Perform 04-channel verification
-	if err = k.ChannelKeeper.ChanOpenConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofAck, msg.ProofHeight); err != nil {
+	if err = k.channelKeeper.ChanOpenConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofAck, msg.ProofHeight); err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake open confirm failed")
 	}
 
@@ -330,7 +354,7 @@ func (k Keeper) ChannelCloseInit(goCtx context.Context, msg *channeltypes.MsgCha
 		return nil, sdkerrors.Wrap(err, "channel close init callback failed")
 	}
 
-	err = k.ChannelKeeper.ChanCloseInit(ctx, msg.PortId, msg.ChannelId, cap)
+	err = k.channelKeeper.ChanCloseInit(ctx, msg.PortId, msg.ChannelId, cap)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake close init failed")
 	}
@@ -358,7 +382,7 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 		return nil, sdkerrors.Wrap(err, "channel close confirm callback failed")
 	}
 
-	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofInit, msg.ProofHeight)
+	err = k.channelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofInit, msg.ProofHeight)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake close confirm failed")
 	}
@@ -375,16 +399,51 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
 	}
 
+	result, err := k.recvPacket(ctx, relayer, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgRecvPacketResponse{Result: result}, nil
+}
+
+// RecvPacketBatch defines a rpc handler method for MsgRecvPacketBatch.
+// The packets are processed in order. Packets which have already been received
+// are skipped with a NOOP result rather than failing the entire batch.
+func (k Keeper) RecvPacketBatch(goCtx context.Context, msg *channeltypes.MsgRecvPacketBatch) (*channeltypes.MsgRecvPacketBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		results[i], err = k.recvPacket(ctx, relayer, packet, msg.ProofCommitments[i], msg.ProofHeight)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "packet at index %d with sequence %d", i, packet.GetSequence())
+		}
+	}
+
+	return &channeltypes.MsgRecvPacketBatchResponse{Results: results}, nil
+}
+
+// recvPacket performs the verification and application callback for a single received packet.
+func (k Keeper) recvPacket(
+	ctx sdk.Context, relayer sdk.AccAddress, packet channeltypes.Packet,
+	proofCommitment []byte, proofHeight clienttypes.Height,
+) (channeltypes.ResponseResultType, error) {
 	// Lookup module by channel capability
-	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.Router.GetRoute(module)
 	if !ok {
-		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	// Perform TAO verification
@@ -392,7 +451,7 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	// If the packet was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.RecvPacket(cacheCtx, cap, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+	err = k.channelKeeper.RecvPacket(cacheCtx, cap, packet, proofCommitment, proofHeight)
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -401,29 +460,32 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
-		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+		return channeltypes.NOOP, nil
 	default:
-		return nil, sdkerrors.Wrap(err, "receive packet verification failed")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "receive packet verification failed")
 	}
 
 	// Perform application logic callback
 	//
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
 	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+	ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
 	if ack == nil || ack.Success() {
 		// write application state changes for asynchronous and successful acknowledgements
 		writeFn()
 		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	} else {
+		// the state changes of unsuccessful acknowledgements are discarded, their events are emitted as error events
+		ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, cap, msg.Packet, ack); err != nil {
-			return nil, err
+		if err := k.channelKeeper.WriteAcknowledgement(ctx, cap, packet, ack); err != nil {
+			return channeltypes.UNSPECIFIED, err
 		}
 	}
 
@@ -432,15 +494,15 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
 			1,
 			[]metrics.Label{
-				telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-				telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-				telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-				telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			},
 		)
 	}()
 
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return channeltypes.SUCCESS, nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
@@ -490,7 +552,7 @@ func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*c
 	}
 
 	// Delete packet commitment
-	if err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
+	if err = k.channelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
 		return nil, err
 	}
 
@@ -537,7 +599,7 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	// If the timeout was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.TimeoutOnClose(cacheCtx, cap, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
+	err = k.channelKeeper.TimeoutOnClose(cacheCtx, cap, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -561,7 +623,7 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	}
 
 	// Delete packet commitment
-	if err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
+	if err = k.channelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
 		return nil, err
 	}
 
@@ -591,16 +653,51 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
 	}
 
+	result, err := k.acknowledgePacket(ctx, relayer, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgAcknowledgementResponse{Result: result}, nil
+}
+
+// AcknowledgementBatch defines a rpc handler method for MsgAcknowledgementBatch.
+// The acknowledgements are processed in order. Acknowledgements which have already
+// been processed are skipped with a NOOP result rather than failing the entire batch.
+func (k Keeper) AcknowledgementBatch(goCtx context.Context, msg *channeltypes.MsgAcknowledgementBatch) (*channeltypes.MsgAcknowledgementBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		results[i], err = k.acknowledgePacket(ctx, relayer, packet, msg.Acknowledgements[i], msg.ProofsAcked[i], msg.ProofHeight)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "packet at index %d with sequence %d", i, packet.GetSequence())
+		}
+	}
+
+	return &channeltypes.MsgAcknowledgementBatchResponse{Results: results}, nil
+}
+
+// acknowledgePacket performs the verification and application callback for a single packet acknowledgement.
+func (k Keeper) acknowledgePacket(
+	ctx sdk.Context, relayer sdk.AccAddress, packet channeltypes.Packet,
+	acknowledgement, proofAcked []byte, proofHeight clienttypes.Height,
+) (channeltypes.ResponseResultType, error) {
 	// Lookup module by channel capability
-	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, packet.SourcePort, packet.SourceChannel)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.Router.GetRoute(module)
 	if !ok {
-		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	// Perform TAO verification
@@ -608,7 +705,7 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	// If the acknowledgement was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.AcknowledgePacket(cacheCtx, cap, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+	err = k.channelKeeper.AcknowledgePacket(cacheCtx, cap, packet, acknowledgement, proofAcked, proofHeight)
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -617,15 +714,15 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
-		return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.NOOP}, nil
+		return channeltypes.NOOP, nil
 	default:
-		return nil, sdkerrors.Wrap(err, "acknowledge packet verification failed")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "acknowledge packet verification failed")
 	}
 
 	// Perform application logic callback
-	err = cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer)
+	err = cbs.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "acknowledge packet callback failed")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "acknowledge packet callback failed")
 	}
 
 	defer func() {
@@ -633,13 +730,34 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
 			1,
 			[]metrics.Label{
-				telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-				telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-				telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-				telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			},
 		)
 	}()
 
-	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+	return channeltypes.SUCCESS, nil
+}
+
+// convertToErrorEvents returns a copy of the provided events with the ErrorAttributeKeyPrefix prepended to each event
+// type and attribute key, such that the events of application callbacks returning an unsuccessful acknowledgement are
+// observable without being mistaken for the events of a successful execution.
+func convertToErrorEvents(events sdk.Events) sdk.Events {
+	if events == nil {
+		return nil
+	}
+
+	errorEvents := make(sdk.Events, len(events))
+	for i, event := range events {
+		attributes := make([]sdk.Attribute, len(event.Attributes))
+		for j, attribute := range event.Attributes {
+			attributes[j] = sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+string(attribute.Key), string(attribute.Value))
+		}
+
+		errorEvents[i] = sdk.NewEvent(coretypes.ErrorAttributeKeyPrefix+event.Type, attributes...)
+	}
+
+	return errorEvents
 }
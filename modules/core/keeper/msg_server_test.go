@@ -1,15 +1,20 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channelkeeper "github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	"github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	coretypes "github.com/cosmos/ibc-go/v4/modules/core/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
@@ -192,6 +197,65 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests that the events emitted by the application callback are included in the events of the
+// transaction receiving the packet. The events of a callback returning an unsuccessful acknowledgement
+// are emitted as error events, as the state changes of the callback are discarded.
+func (suite *KeeperTestSuite) TestHandleRecvPacketCallbackEvents() {
+	const (
+		eventType    = "mock_recv_packet"
+		attributeKey = "mock_key"
+	)
+
+	testCases := []struct {
+		name       string
+		ack        exported.Acknowledgement
+		expEvent   sdk.Event
+		unexpEvent sdk.Event
+	}{
+		{
+			"successful acknowledgement",
+			ibcmock.MockAcknowledgement,
+			sdk.NewEvent(eventType, sdk.NewAttribute(attributeKey, "value")),
+			sdk.NewEvent(coretypes.ErrorAttributeKeyPrefix+eventType, sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+attributeKey, "value")),
+		},
+		{
+			"unsuccessful acknowledgement",
+			ibcmock.MockFailAcknowledgement,
+			sdk.NewEvent(coretypes.ErrorAttributeKeyPrefix+eventType, sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+attributeKey, "value")),
+			sdk.NewEvent(eventType, sdk.NewAttribute(attributeKey, "value")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.PortID = ibctesting.MockStaticPort
+			path.EndpointB.ChannelConfig.PortID = ibctesting.MockStaticPort
+			suite.coordinator.Setup(path)
+
+			suite.chainB.GetSimApp().StaticMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+				ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, sdk.NewAttribute(attributeKey, "value")))
+				return tc.ack
+			}
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			res, ack, err := path.RelayPacketWithResult(packet)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.ack.Acknowledgement(), ack)
+
+			suite.Require().Contains(res.GetEvents(), tc.expEvent)
+			suite.Require().NotContains(res.GetEvents(), tc.unexpEvent)
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -338,6 +402,254 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets which mixes fresh packets with
+// packets that have already been received.
+func (suite *KeeperTestSuite) TestHandleRecvPacketBatch() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	sendPackets := func(n uint64) {
+		packets = nil
+		for seq := uint64(1); seq <= n; seq++ {
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+			packets = append(packets, packet)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED all fresh packets", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: UNORDERED fresh and redundant packets", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED fresh and redundant packets", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[0]))
+			expResults = []channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: all packets redundant", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[0]))
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+			expResults = []channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.NOOP}
+		}, true},
+		{"failure: packet in batch was never sent", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+		}, false},
+		{"failure: ORDERED packets out of order", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+
+			packets[0], packets[1] = packets[1], packets[0]
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// prove every packet commitment against the same client update
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			var (
+				proofs      [][]byte
+				proofHeight clienttypes.Height
+			)
+			for _, packet := range packets {
+				packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+				var proof []byte
+				proof, proofHeight = path.EndpointA.QueryProof(packetKey)
+				proofs = append(proofs, proof)
+			}
+
+			msg := channeltypes.NewMsgRecvPacketBatch(packets, proofs, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			suite.Require().NoError(msg.ValidateBasic())
+
+			ctx := suite.chainB.GetContext()
+			res, err := keeper.Keeper.RecvPacketBatch(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// a receive event is emitted for every packet, including redundant ones,
+				// while acknowledgements are only written for freshly received packets
+				var expWriteAckEvents int
+				for _, result := range expResults {
+					if result == channeltypes.SUCCESS {
+						expWriteAckEvents++
+					}
+				}
+
+				var recvEvents, writeAckEvents int
+				for _, event := range ctx.EventManager().Events() {
+					switch event.Type {
+					case channeltypes.EventTypeRecvPacket:
+						recvEvents++
+					case channeltypes.EventTypeWriteAck:
+						writeAckEvents++
+					}
+				}
+				suite.Require().Equal(len(packets), recvEvents)
+				suite.Require().Equal(expWriteAckEvents, writeAckEvents)
+
+				for _, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found)
+				}
+
+				// replay of the entire batch should be treated as a no-op
+				res, err = keeper.Keeper.RecvPacketBatch(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(ctx), msg)
+				suite.Require().NoError(err)
+				for _, result := range res.Results {
+					suite.Require().Equal(channeltypes.NOOP, result)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// tests the IBC handler acknowledging a batch of packets which mixes fresh
+// acknowledgements with acknowledgements that have already been processed.
+func (suite *KeeperTestSuite) TestHandleAcknowledgementBatch() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	relayPackets := func(n uint64) {
+		packets = nil
+		for seq := uint64(1); seq <= n; seq++ {
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+			packets = append(packets, packet)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED fresh and redundant acknowledgements", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+
+			suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[1], ibctesting.MockAcknowledgement))
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED fresh and redundant acknowledgements", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+
+			suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[0], ibctesting.MockAcknowledgement))
+			expResults = []channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"failure: packet in batch was never received", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+			packets = append(packets, packet)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// prove every acknowledgement against the same client update
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			var (
+				acks        [][]byte
+				proofs      [][]byte
+				proofHeight clienttypes.Height
+			)
+			for _, packet := range packets {
+				packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+				var proof []byte
+				proof, proofHeight = path.EndpointB.QueryProof(packetKey)
+				proofs = append(proofs, proof)
+				acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+			}
+
+			msg := channeltypes.NewMsgAcknowledgementBatch(packets, acks, proofs, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+			suite.Require().NoError(msg.ValidateBasic())
+
+			ctx := suite.chainA.GetContext()
+			res, err := keeper.Keeper.AcknowledgementBatch(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// an acknowledge event is emitted for every packet, including redundant ones
+				var ackEvents int
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == channeltypes.EventTypeAcknowledgePacket {
+						ackEvents++
+					}
+				}
+				suite.Require().Equal(len(packets), ackEvents)
+
+				// verify packet commitments were deleted on source chain
+				for _, packet := range packets {
+					has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().False(has)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -624,6 +936,289 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 	}
 }
 
+// newChannelKeeper returns a new channel keeper of the provided chain sharing the stores and keepers of the IBC keeper,
+// allowing tests to scope a channel keeper to a module after the channel keeper of the IBC keeper has been sealed
+func newChannelKeeper(chain *ibctesting.TestChain) channelkeeper.Keeper {
+	app := chain.GetSimApp()
+	ibcKeeper := app.GetIBCKeeper()
+
+	return channelkeeper.NewKeeper(app.AppCodec(), app.GetKey(host.StoreKey), ibcKeeper.ClientKeeper, ibcKeeper.ConnectionKeeper, &ibcKeeper.PortKeeper, app.GetScopedIBCKeeper())
+}
+
+// tests the channel handshake, packet relaying, packet timeouts and channel closure through the IBC handler for
+// channels on capability-based ports, on ports statically owned by a module registered on the IBC router and between
+// the two. Channels on statically owned ports must not be assigned capabilities.
+func (suite *KeeperTestSuite) TestStaticPortChannelLifecycle() {
+	testCases := []struct {
+		name  string
+		portA string
+		portB string
+	}{
+		{"capability-based ports", ibctesting.MockPort, ibctesting.MockPort},
+		{"statically owned ports", ibctesting.MockStaticPort, ibctesting.MockStaticPort},
+		{"statically owned port to capability-based port", ibctesting.MockStaticPort, ibctesting.MockPort},
+		{"capability-based port to statically owned port", ibctesting.MockPort, ibctesting.MockStaticPort},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.PortID = tc.portA
+			path.EndpointB.ChannelConfig.PortID = tc.portB
+			suite.coordinator.Setup(path)
+
+			for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+				_, isStatic := endpoint.Chain.App.GetIBCKeeper().PortKeeper.StaticPortOwner(endpoint.ChannelConfig.PortID)
+
+				_, found := endpoint.Chain.App.GetScopedIBCKeeper().GetCapability(endpoint.Chain.GetContext(), host.ChannelCapabilityPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID))
+				suite.Require().Equal(!isStatic, found)
+
+				module, chanCap, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.LookupModuleByChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(isStatic, chanCap == nil)
+				if isStatic {
+					suite.Require().Equal(endpoint.ChannelConfig.PortID, module)
+				}
+
+				// packets sent on capability-based ports must be authenticated by the channel capability and packets
+				// sent on statically owned ports must be sent by the owning module
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, endpoint.ChannelConfig.PortID, endpoint.ChannelID, endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, timeoutHeight, 0)
+				err = endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), nil, packet)
+				suite.Require().ErrorIs(err, channeltypes.ErrChannelCapabilityNotFound)
+
+				if isStatic {
+					err = newChannelKeeper(endpoint.Chain).ScopeToModule(ibctesting.MockPort).SendPacket(endpoint.Chain.GetContext(), nil, packet)
+					suite.Require().ErrorIs(err, channeltypes.ErrChannelCapabilityNotFound)
+				}
+
+				// relay a packet in both directions
+				err = endpoint.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				_, ack, err := path.RelayPacketWithResult(packet)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ibcmock.MockAcknowledgement.Acknowledgement(), ack)
+			}
+
+			// time out a packet
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano()))
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+
+			// the channel may only be closed by the module owning the port
+			err = newChannelKeeper(suite.chainA).ScopeToModule(ibctesting.MockPort).ChanCloseInit(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nil)
+			suite.Require().ErrorIs(err, channeltypes.ErrChannelCapabilityNotFound)
+
+			// close the channel
+			err = path.EndpointA.ChanCloseInit()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanCloseConfirm()
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+			suite.Require().Equal(channeltypes.CLOSED, path.EndpointB.GetChannel().State)
+		})
+	}
+}
+
+// tests that MsgCreateClient respects the AllowedClients and PermissionedClientCreation params
+// and that clients may be created through governance when client creation is permissioned.
+func (suite *KeeperTestSuite) TestCreateClient() {
+	var (
+		path   *ibctesting.Path
+		params clienttypes.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"client type not in allowlist", func() {
+				params.AllowedClients = []string{exported.Solomachine}
+			}, clienttypes.ErrInvalidClientType,
+		},
+		{
+			"permissioned client creation", func() {
+				params.PermissionedClientCreation = true
+			}, clienttypes.ErrClientCreationNotPermitted,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			params = clienttypes.DefaultParams()
+
+			tc.malleate()
+
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			clientState := path.EndpointA.GetClientState()
+			consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+			msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, suite.chainA.SenderAccount.GetAddress().String())
+			suite.Require().NoError(err)
+
+			_, err = keeper.Keeper.CreateClient(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// tests the permissioned client creation flow where a client is created by a governance proposal
+// after creation through MsgCreateClient has been rejected.
+func (suite *KeeperTestSuite) TestCreateClientPermissioned() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	params := clienttypes.DefaultParams()
+	params.PermissionedClientCreation = true
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	clientState := path.EndpointA.GetClientState()
+	consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+	msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	_, err = keeper.Keeper.CreateClient(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientCreationNotPermitted)
+
+	content, err := clienttypes.NewCreateClientProposal(ibctesting.Title, ibctesting.Description, clientState, consensusState)
+	suite.Require().NoError(err)
+	suite.Require().NoError(content.ValidateBasic())
+
+	ctx := suite.chainA.GetContext()
+	expClientID := clienttypes.FormatClientIdentifier(exported.Tendermint, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetNextClientSequence(ctx))
+
+	proposalHandler := ibcclient.NewClientProposalHandler(suite.chainA.App.GetIBCKeeper().ClientKeeper)
+	err = proposalHandler(ctx, content)
+	suite.Require().NoError(err)
+
+	storedClientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, expClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(clientState, storedClientState)
+}
+
+// tests that the signer of the message which froze a client is recorded as the
+// submitter of the misbehaviour.
+func (suite *KeeperTestSuite) TestMisbehaviourSubmitter() {
+	var (
+		path                *ibctesting.Path
+		initialHeight       clienttypes.Height
+		msg                 sdk.Msg
+		expMisbehaviourType string
+	)
+
+	// createHeader creates a header for chainB at the given height trusting the latest client height
+	createHeader := func(height clienttypes.Height, timestamp time.Time) *ibctmtypes.Header {
+		trustedHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+		return suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(height.RevisionHeight), trustedHeight, timestamp,
+			suite.chainB.Vals, suite.chainB.NextVals, suite.chainB.Vals, suite.chainB.Signers)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"duplicate header misbehaviour", func() {
+				height := path.EndpointA.GetClientState().GetLatestHeight().Increment().(clienttypes.Height)
+				misbehaviour := ibctmtypes.NewMisbehaviour(path.EndpointA.ClientID,
+					createHeader(height, suite.chainB.CurrentHeader.Time.Add(time.Minute)),
+					createHeader(height, suite.chainB.CurrentHeader.Time),
+				)
+
+				var err error
+				msg, err = clienttypes.NewMsgSubmitMisbehaviour(path.EndpointA.ClientID, misbehaviour, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				expMisbehaviourType = clienttypes.MisbehaviourTypeDuplicateHeader
+			},
+		},
+		{
+			"time violation misbehaviour", func() {
+				height := path.EndpointA.GetClientState().GetLatestHeight().Increment().(clienttypes.Height)
+				misbehaviour := ibctmtypes.NewMisbehaviour(path.EndpointA.ClientID,
+					createHeader(height.Increment().(clienttypes.Height), suite.chainB.CurrentHeader.Time),
+					createHeader(height, suite.chainB.CurrentHeader.Time.Add(time.Minute)),
+				)
+
+				var err error
+				msg, err = clienttypes.NewMsgSubmitMisbehaviour(path.EndpointA.ClientID, misbehaviour, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				expMisbehaviourType = clienttypes.MisbehaviourTypeTimeViolation
+			},
+		},
+		{
+			"conflicting header on update", func() {
+				latestHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+				consensusState := path.EndpointA.GetConsensusState(latestHeight).(*ibctmtypes.ConsensusState)
+				header := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(latestHeight.RevisionHeight), initialHeight, consensusState.Timestamp.Add(time.Second),
+					suite.chainB.Vals, suite.chainB.NextVals, suite.chainB.Vals, suite.chainB.Signers)
+
+				var err error
+				msg, err = clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				expMisbehaviourType = clienttypes.MisbehaviourTypeDuplicateHeader
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			initialHeight = path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			tc.malleate()
+
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			clientState := path.EndpointA.GetClientState()
+			suite.Require().Equal(exported.Frozen, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), clientState, path.EndpointA.ClientID))
+
+			record, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetMisbehaviourRecord(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), record.Submitter)
+			suite.Require().Equal(expMisbehaviourType, record.MisbehaviourType)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path              *ibctesting.Path
@@ -139,6 +139,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := clientkeeper.NewMigrator(am.keeper.ClientKeeper)
 	cfg.RegisterMigration(host.ModuleName, 1, m.Migrate1to2)
+	cfg.RegisterMigration(host.ModuleName, 2, m.Migrate2to3)
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -160,7 +161,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -0,0 +1,5 @@
+package types
+
+// ErrorAttributeKeyPrefix is the prefix of the event types and attribute keys of the events emitted by an application
+// callback which returned an unsuccessful acknowledgement.
+const ErrorAttributeKeyPrefix = "ibccallbackerror-"
@@ -9,4 +9,7 @@ const (
 	LabelTimeoutType        = "timeout_type"
 	LabelDenom              = "denom"
 	LabelSource             = "source"
+	LabelMsgTypeURL         = "msg_type_url"
+	LabelCodespace          = "codespace"
+	LabelCode               = "code"
 )
@@ -374,6 +374,24 @@ func (cs *ClientState) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketCommitmentAbsence returns an error, as the solo machine proof
+// format does not define a data type for the absence of a packet commitment.
+func (cs *ClientState) VerifyPacketCommitmentAbsence(
+	_ sdk.Context,
+	_ sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	_,
+	_ string,
+	_ uint64,
+) error {
+	return sdkerrors.Wrap(clienttypes.ErrFailedPacketCommitmentVerification, "packet commitment absence proofs are not supported by solo machine clients")
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (cs *ClientState) VerifyNextSequenceRecv(
@@ -38,8 +38,8 @@ func (suite *SoloMachineTestSuite) TestStatus() {
 }
 
 func (suite *SoloMachineTestSuite) TestClientStateValidateBasic() {
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		testCases := []struct {
 			name        string
@@ -95,8 +95,8 @@ func (suite *SoloMachineTestSuite) TestClientStateValidateBasic() {
 }
 
 func (suite *SoloMachineTestSuite) TestInitialize() {
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 		malleatedConsensus := solomachine.ClientState().ConsensusState
 		malleatedConsensus.Timestamp = malleatedConsensus.Timestamp + 10
 
@@ -150,8 +150,8 @@ func (suite *SoloMachineTestSuite) TestVerifyClientState() {
 	clientState := suite.chainA.GetClientState(tmPath.EndpointA.ClientID)
 	path := suite.solomachine.GetClientStatePath(counterpartyClientIdentifier)
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		value, err := types.ClientStateSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, clientState)
 		suite.Require().NoError(err)
@@ -271,8 +271,8 @@ func (suite *SoloMachineTestSuite) TestVerifyClientConsensusState() {
 
 	path := suite.solomachine.GetConsensusStatePath(counterpartyClientIdentifier, consensusHeight)
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		value, err := types.ConsensusStateSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, consensusState)
 		suite.Require().NoError(err)
@@ -387,8 +387,8 @@ func (suite *SoloMachineTestSuite) TestVerifyConnectionState() {
 
 	path := suite.solomachine.GetConnectionStatePath(testConnectionID)
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		value, err := types.ConnectionStateSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, conn)
 		suite.Require().NoError(err)
@@ -465,8 +465,8 @@ func (suite *SoloMachineTestSuite) TestVerifyChannelState() {
 
 	path := suite.solomachine.GetChannelStatePath(testPortID, testChannelID)
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		value, err := types.ChannelStateSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, ch)
 		suite.Require().NoError(err)
@@ -540,8 +540,8 @@ func (suite *SoloMachineTestSuite) TestVerifyChannelState() {
 func (suite *SoloMachineTestSuite) TestVerifyPacketCommitment() {
 	commitmentBytes := []byte("COMMITMENT BYTES")
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
 
@@ -616,8 +616,8 @@ func (suite *SoloMachineTestSuite) TestVerifyPacketCommitment() {
 
 func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgement() {
 	ack := []byte("ACK")
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		path := solomachine.GetPacketAcknowledgementPath(testPortID, testChannelID)
 
@@ -691,8 +691,8 @@ func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgement() {
 }
 
 func (suite *SoloMachineTestSuite) TestVerifyPacketReceiptAbsence() {
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		// absence uses receipt path as well
 		path := solomachine.GetPacketReceiptPath(testPortID, testChannelID)
@@ -767,8 +767,8 @@ func (suite *SoloMachineTestSuite) TestVerifyPacketReceiptAbsence() {
 }
 
 func (suite *SoloMachineTestSuite) TestVerifyNextSeqRecv() {
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		nextSeqRecv := solomachine.Sequence + 1
 		path := solomachine.GetNextSequenceRecvPath(testPortID, testChannelID)
@@ -2,7 +2,9 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
@@ -42,6 +44,62 @@ func UnmarshalSignatureData(cdc codec.BinaryCodec, data []byte) (signing.Signatu
 	return sigData, nil
 }
 
+// UnmarshalAminoMultisignature attempts to unmarshal the amino encoded multisignature, as
+// produced by legacy amino signing tooling, into MultiSignatureData for the provided
+// multisig public key. Nested multisig public keys are expected to provide nested amino
+// encoded multisignatures. An error is returned if the set signatures do not match the
+// bit array or the bit array size does not match the number of public keys.
+func UnmarshalAminoMultisignature(pubKey multisig.PubKey, bz []byte) (*signing.MultiSignatureData, error) {
+	var aminoSig multisig.AminoMultisignature
+	if err := legacy.Cdc.Unmarshal(bz, &aminoSig); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to unmarshal proof into type %T", aminoSig)
+	}
+
+	if aminoSig.BitArray == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "amino multisignature bit array cannot be nil")
+	}
+
+	pubKeys := pubKey.GetPubKeys()
+	size := aminoSig.BitArray.Count()
+	if size != len(pubKeys) {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "amino multisignature bit array size %d does not match the number of public keys %d", size, len(pubKeys))
+	}
+
+	if numSet := aminoSig.BitArray.NumTrueBitsBefore(size); numSet != len(aminoSig.Sigs) {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "amino multisignature has %d signatures set in the bit array, but contains %d signatures", numSet, len(aminoSig.Sigs))
+	}
+
+	sigs := make([]signing.SignatureData, 0, len(aminoSig.Sigs))
+	for i := 0; i < size; i++ {
+		if !aminoSig.BitArray.GetIndex(i) {
+			continue
+		}
+
+		sig := aminoSig.Sigs[len(sigs)]
+
+		nestedPubKey, ok := pubKeys[i].(multisig.PubKey)
+		if !ok {
+			sigs = append(sigs, &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: sig,
+			})
+			continue
+		}
+
+		nestedSigData, err := UnmarshalAminoMultisignature(nestedPubKey, sig)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to unmarshal nested multisignature at index %d", i)
+		}
+
+		sigs = append(sigs, nestedSigData)
+	}
+
+	return &signing.MultiSignatureData{
+		BitArray:   aminoSig.BitArray,
+		Signatures: sigs,
+	}, nil
+}
+
 // UnmarshalDataByType attempts to unmarshal the data to the specified type. An error is
 // return if it fails.
 func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte) (Data, error) {
@@ -14,8 +14,8 @@ func (suite SoloMachineTestSuite) TestUnmarshalDataByType() {
 		err  error
 	)
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		cdc := suite.chainA.App.AppCodec()
 		cases := []struct {
@@ -15,8 +15,8 @@ func (suite *SoloMachineTestSuite) TestConsensusState() {
 }
 
 func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		testCases := []struct {
 			name           string
@@ -7,8 +7,8 @@ import (
 )
 
 func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		header := solomachine.CreateHeader()
 
@@ -16,15 +16,27 @@ import (
 
 // VerifySignature verifies if the the provided public key generated the signature
 // over the given data. Single and Multi signature public keys are supported.
-// The signature data type must correspond to the public key type. An error is
-// returned if signature verification fails or an invalid SignatureData type is
-// provided.
+// The signature data type must correspond to the public key type. Multi signature
+// public keys additionally accept an amino encoded multisignature provided as
+// SingleSignatureData. An error is returned if signature verification fails or an
+// invalid SignatureData type is provided.
 func VerifySignature(pubKey cryptotypes.PubKey, signBytes []byte, sigData signing.SignatureData) error {
 	switch pubKey := pubKey.(type) {
 	case multisig.PubKey:
-		data, ok := sigData.(*signing.MultiSignatureData)
-		if !ok {
-			return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type, expected %T, got %T", (*signing.MultiSignatureData)(nil), data)
+		var data *signing.MultiSignatureData
+		switch sigData := sigData.(type) {
+		case *signing.MultiSignatureData:
+			data = sigData
+		case *signing.SingleSignatureData:
+			// legacy amino signing tooling produces a single signature containing
+			// the amino encoded multisignature
+			var err error
+			data, err = UnmarshalAminoMultisignature(pubKey, sigData.Signature)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid amino multisignature: %s", err)
+			}
+		default:
+			return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type, expected %T, got %T", (*signing.MultiSignatureData)(nil), sigData)
 		}
 
 		// The function supplied fulfills the VerifyMultisignature interface. No special
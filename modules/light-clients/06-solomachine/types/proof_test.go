@@ -1,7 +1,17 @@
 package types_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine/types"
@@ -21,6 +31,25 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 	multiSigData, err := solomachinetypes.UnmarshalSignatureData(cdc, multiSignature)
 	suite.Require().NoError(err)
 
+	thresholdSignature := suite.solomachineThreshold.GenerateSignature(signBytes)
+	thresholdSigData, err := solomachinetypes.UnmarshalSignatureData(cdc, thresholdSignature)
+	suite.Require().NoError(err)
+
+	aminoSignature := suite.solomachineAmino.GenerateSignature(signBytes)
+	aminoSigData, err := solomachinetypes.UnmarshalSignatureData(cdc, aminoSignature)
+	suite.Require().NoError(err)
+
+	// sign with a single key of the 2-of-3 multisig
+	belowThreshold := *suite.solomachineThreshold
+	belowThreshold.Threshold = 1
+	belowThresholdSigData, err := solomachinetypes.UnmarshalSignatureData(cdc, belowThreshold.GenerateSignature(signBytes))
+	suite.Require().NoError(err)
+
+	aminoBelowThreshold := *suite.solomachineAmino
+	aminoBelowThreshold.Threshold = 1
+	aminoBelowThresholdSigData, err := solomachinetypes.UnmarshalSignatureData(cdc, aminoBelowThreshold.GenerateSignature(signBytes))
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name      string
 		publicKey cryptotypes.PubKey
@@ -51,6 +80,54 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 			multiSigData,
 			false,
 		},
+		{
+			"threshold multi signature with threshold multisig public key",
+			suite.solomachineThreshold.PublicKey,
+			thresholdSigData,
+			true,
+		},
+		{
+			"amino multi signature with threshold multisig public key",
+			suite.solomachineAmino.PublicKey,
+			aminoSigData,
+			true,
+		},
+		{
+			"threshold multi signature with different multisig public key",
+			suite.solomachineAmino.PublicKey,
+			thresholdSigData,
+			false,
+		},
+		{
+			"amino multi signature with different multisig public key",
+			suite.solomachineThreshold.PublicKey,
+			aminoSigData,
+			false,
+		},
+		{
+			"amino multi signature with regular public key",
+			suite.solomachine.PublicKey,
+			aminoSigData,
+			false,
+		},
+		{
+			"multi signature below threshold",
+			suite.solomachineThreshold.PublicKey,
+			belowThresholdSigData,
+			false,
+		},
+		{
+			"amino multi signature below threshold",
+			suite.solomachineAmino.PublicKey,
+			aminoBelowThresholdSigData,
+			false,
+		},
+		{
+			"amino multi signature with invalid encoding",
+			suite.solomachineAmino.PublicKey,
+			&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte("invalid")},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -68,10 +145,105 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 	}
 }
 
+// multisigVector is a single entry of testdata/multisig_vectors.json.
+type multisigVector struct {
+	Description       string   `json:"description"`
+	PrivateKeysHex    []string `json:"private_keys_hex"`
+	Threshold         int      `json:"threshold"`
+	Signers           []int    `json:"signers"`
+	PublicKeyHex      string   `json:"public_key_hex"`
+	Sequence          uint64   `json:"sequence"`
+	Timestamp         uint64   `json:"timestamp"`
+	Diversifier       string   `json:"diversifier"`
+	DataType          string   `json:"data_type"`
+	DataHex           string   `json:"data_hex"`
+	SignBytesHex      string   `json:"sign_bytes_hex"`
+	SignatureHex      string   `json:"signature_hex"`
+	AminoSignatureHex string   `json:"amino_signature_hex"`
+}
+
+// TestMultisigVectors checks the encoding and verification of multisig proofs against the
+// fixed vectors in testdata/multisig_vectors.json. The public key is the protobuf Any
+// stored in the consensus state and the signatures are the marshaled SignatureDescriptor_Data
+// used in solo machine proofs, headers and misbehaviour. The vectors may be used by external
+// solo machine implementations, so they must never be regenerated to match a changed implementation.
+func (suite *SoloMachineTestSuite) TestMultisigVectors() {
+	cdc := suite.chainA.App.AppCodec()
+
+	bz, err := os.ReadFile(filepath.Join("testdata", "multisig_vectors.json"))
+	suite.Require().NoError(err)
+
+	var vectors []multisigVector
+	suite.Require().NoError(json.Unmarshal(bz, &vectors))
+	suite.Require().NotEmpty(vectors)
+
+	mustDecodeHex := func(s string) []byte {
+		bz, err := hex.DecodeString(s)
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	for _, v := range vectors {
+		v := v
+
+		suite.Run(v.Description, func() {
+			privKeys := make([]cryptotypes.PrivKey, len(v.PrivateKeysHex))
+			pubKeys := make([]cryptotypes.PubKey, len(v.PrivateKeysHex))
+			for i, privKeyHex := range v.PrivateKeysHex {
+				privKeys[i] = &secp256k1.PrivKey{Key: mustDecodeHex(privKeyHex)}
+				pubKeys[i] = privKeys[i].PubKey()
+			}
+
+			publicKey, err := codectypes.NewAnyWithValue(kmultisig.NewLegacyAminoPubKey(v.Threshold, pubKeys))
+			suite.Require().NoError(err)
+
+			publicKeyBz, err := cdc.Marshal(publicKey)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v.PublicKeyHex, strings.ToUpper(hex.EncodeToString(publicKeyBz)))
+
+			dataType, ok := solomachinetypes.DataType_value[v.DataType]
+			suite.Require().True(ok)
+
+			signBytes, err := cdc.Marshal(&solomachinetypes.SignBytes{
+				Sequence:    v.Sequence,
+				Timestamp:   v.Timestamp,
+				Diversifier: v.Diversifier,
+				DataType:    solomachinetypes.DataType(dataType),
+				Data:        mustDecodeHex(v.DataHex),
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(v.SignBytesHex, strings.ToUpper(hex.EncodeToString(signBytes)))
+
+			// secp256k1 signatures are deterministic
+			multiSigData := multisig.NewMultisig(len(privKeys))
+			for _, i := range v.Signers {
+				sig, err := privKeys[i].Sign(signBytes)
+				suite.Require().NoError(err)
+
+				multisig.AddSignature(multiSigData, &signing.SingleSignatureData{Signature: sig}, i)
+			}
+
+			signature, err := cdc.Marshal(signing.SignatureDataToProto(multiSigData))
+			suite.Require().NoError(err)
+			suite.Require().Equal(v.SignatureHex, strings.ToUpper(hex.EncodeToString(signature)))
+
+			var pubKey cryptotypes.PubKey
+			suite.Require().NoError(cdc.UnpackAny(publicKey, &pubKey))
+
+			for _, sigHex := range []string{v.SignatureHex, v.AminoSignatureHex} {
+				sigData, err := solomachinetypes.UnmarshalSignatureData(cdc, mustDecodeHex(sigHex))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(solomachinetypes.VerifySignature(pubKey, signBytes, sigData))
+			}
+		})
+	}
+}
+
 func (suite *SoloMachineTestSuite) TestClientStateSignBytes() {
 	cdc := suite.chainA.App.AppCodec()
 
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 		// success
 		path := solomachine.GetClientStatePath(counterpartyClientIdentifier)
 		bz, err := types.ClientStateSignBytes(cdc, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, solomachine.ClientState())
@@ -88,7 +260,7 @@ func (suite *SoloMachineTestSuite) TestClientStateSignBytes() {
 func (suite *SoloMachineTestSuite) TestConsensusStateSignBytes() {
 	cdc := suite.chainA.App.AppCodec()
 
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 		// success
 		path := solomachine.GetConsensusStatePath(counterpartyClientIdentifier, consensusHeight)
 		bz, err := types.ConsensusStateSignBytes(cdc, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, solomachine.ConsensusState())
@@ -13,8 +13,8 @@ func (suite *SoloMachineTestSuite) TestCheckSubstituteAndUpdateState() {
 		substituteClientState exported.ClientState
 	)
 
-	// test singlesig and multisig public keys
-	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig, multisig and threshold multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineThreshold, suite.solomachineAmino} {
 
 		testCases := []struct {
 			name     string
@@ -21,9 +21,11 @@ import (
 type SoloMachineTestSuite struct {
 	suite.Suite
 
-	solomachine      *ibctesting.Solomachine // singlesig public key
-	solomachineMulti *ibctesting.Solomachine // multisig public key
-	coordinator      *ibctesting.Coordinator
+	solomachine          *ibctesting.Solomachine // singlesig public key
+	solomachineMulti     *ibctesting.Solomachine // multisig public key
+	solomachineThreshold *ibctesting.Solomachine // 2-of-3 multisig public key
+	solomachineAmino     *ibctesting.Solomachine // 2-of-3 multisig public key with amino encoded signatures
+	coordinator          *ibctesting.Coordinator
 
 	// testing chain used for convenience and readability
 	chainA *ibctesting.TestChain
@@ -39,6 +41,9 @@ func (suite *SoloMachineTestSuite) SetupTest() {
 
 	suite.solomachine = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinesingle", "testing", 1)
 	suite.solomachineMulti = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinemulti", "testing", 4)
+	suite.solomachineThreshold = ibctesting.NewThresholdSolomachine(suite.T(), suite.chainA.Codec, "solomachinethreshold", "testing", 2, 3)
+	suite.solomachineAmino = ibctesting.NewThresholdSolomachine(suite.T(), suite.chainA.Codec, "solomachineamino", "testing", 2, 3)
+	suite.solomachineAmino.AminoMultisig = true
 
 	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), exported.Solomachine)
 }
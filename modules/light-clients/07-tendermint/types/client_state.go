@@ -97,6 +97,23 @@ func (cs ClientState) IsExpired(latestTimestamp, now time.Time) bool {
 	return !expirationTime.After(now)
 }
 
+// GetConsensusStateRetention returns the duration since a consensus state timestamp after which
+// the consensus state may be pruned. The trusting period is used if no retention period is set.
+func (cs ClientState) GetConsensusStateRetention() time.Duration {
+	if cs.ConsensusStateRetention == nil {
+		return cs.TrustingPeriod
+	}
+
+	return *cs.ConsensusStateRetention
+}
+
+// IsPrunable returns whether or not a consensus state with the provided timestamp has passed
+// the consensus state retention period and may be pruned.
+func (cs ClientState) IsPrunable(timestamp, now time.Time) bool {
+	pruneTime := timestamp.Add(cs.GetConsensusStateRetention())
+	return !pruneTime.After(now)
+}
+
 // Validate performs a basic validation of the client state fields.
 func (cs ClientState) Validate() error {
 	if strings.TrimSpace(cs.ChainId) == "" {
@@ -124,6 +141,9 @@ func (cs ClientState) Validate() error {
 	if cs.MaxClockDrift == 0 {
 		return sdkerrors.Wrap(ErrInvalidMaxClockDrift, "max clock drift cannot be zero")
 	}
+	if cs.ConsensusStateRetention != nil && *cs.ConsensusStateRetention <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidRetentionPeriod, "consensus state retention must be positive if set, got: %s", *cs.ConsensusStateRetention)
+	}
 
 	// the latest height revision number must match the chain id revision number
 	if cs.LatestHeight.RevisionNumber != clienttypes.ParseChainID(cs.ChainId) {
@@ -469,6 +489,45 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketCommitmentAbsence verifies a proof of the absence of an
+// outgoing packet commitment at the specified port, specified channel, and
+// specified sequence.
+func (cs ClientState) VerifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	commitmentPath := commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmentPath)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (cs ClientState) VerifyNextSequenceRecv(
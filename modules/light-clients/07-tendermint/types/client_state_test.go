@@ -126,6 +126,36 @@ func (suite *TendermintTestSuite) TestValidate() {
 			clientState: types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, 0, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false),
 			expPass:     false,
 		},
+		{
+			name: "valid client with consensus state retention",
+			clientState: func() *types.ClientState {
+				retention := trustingPeriod * 2
+				cs := types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+				cs.ConsensusStateRetention = &retention
+				return cs
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid zero consensus state retention",
+			clientState: func() *types.ClientState {
+				retention := time.Duration(0)
+				cs := types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+				cs.ConsensusStateRetention = &retention
+				return cs
+			}(),
+			expPass: false,
+		},
+		{
+			name: "invalid negative consensus state retention",
+			clientState: func() *types.ClientState {
+				retention := -time.Hour
+				cs := types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+				cs.ConsensusStateRetention = &retention
+				return cs
+			}(),
+			expPass: false,
+		},
 		{
 			name:        "invalid revision number",
 			clientState: types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, clienttypes.NewHeight(1, 1), commitmenttypes.GetSDKSpecs(), upgradePath, false, false),
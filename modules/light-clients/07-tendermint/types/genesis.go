@@ -15,6 +15,9 @@ func (cs ClientState) ExportMetadata(store sdk.KVStore) []exported.GenesisMetada
 		gm = append(gm, clienttypes.NewGenesisMetadata(key, val))
 		return false
 	})
+	if bz := store.Get(KeyPrunedHeight); bz != nil {
+		gm = append(gm, clienttypes.NewGenesisMetadata(KeyPrunedHeight, bz))
+	}
 	if len(gm) == 0 {
 		return nil
 	}
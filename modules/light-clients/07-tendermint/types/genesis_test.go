@@ -86,4 +86,22 @@ func (suite *TendermintTestSuite) TestExportMetadata() {
 
 	suite.Require().Equal(types.IterationKey(updateHeight), gm[5].GetKey(), "metadata has unexpected key")
 	suite.Require().Equal(iteration, gm[5].GetValue(), "metadata has unexpected value")
+
+	// test exporting the pruned height once the expired consensus states have been pruned
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod / 2)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod / 2)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	clientStore = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	prunedHeight, found := types.GetPrunedHeight(clientStore)
+	suite.Require().True(found)
+	suite.Require().True(prunedHeight.GTE(updateHeight))
+
+	// the pruned height is exported last
+	gm = clientState.ExportMetadata(clientStore)
+	suite.Require().Equal(types.KeyPrunedHeight, gm[len(gm)-1].GetKey(), "metadata has unexpected key")
+	suite.Require().Equal([]byte(prunedHeight.String()), gm[len(gm)-1].GetValue(), "metadata has unexpected value")
 }
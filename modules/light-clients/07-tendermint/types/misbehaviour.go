@@ -47,6 +47,22 @@ func (misbehaviour Misbehaviour) GetTime() time.Time {
 	return t2
 }
 
+// GetHeight returns the height at which misbehaviour occurred. Header1 is
+// always at a height greater than or equal to Header2.
+func (misbehaviour Misbehaviour) GetHeight() exported.Height {
+	return misbehaviour.Header1.GetHeight()
+}
+
+// GetMisbehaviourType returns the type of the misbehaviour. Conflicting headers
+// at the same height are a duplicate header, otherwise the headers violate
+// monotonic time.
+func (misbehaviour Misbehaviour) GetMisbehaviourType() string {
+	if misbehaviour.Header1.GetHeight().EQ(misbehaviour.Header2.GetHeight()) {
+		return clienttypes.MisbehaviourTypeDuplicateHeader
+	}
+	return clienttypes.MisbehaviourTypeTimeViolation
+}
+
 // ValidateBasic implements Misbehaviour interface
 func (misbehaviour Misbehaviour) ValidateBasic() error {
 	if misbehaviour.Header1 == nil {
@@ -25,6 +25,12 @@ func (suite *TendermintTestSuite) TestMisbehaviour() {
 
 	suite.Require().Equal(exported.Tendermint, misbehaviour.ClientType())
 	suite.Require().Equal(clientID, misbehaviour.GetClientID())
+	suite.Require().Equal(height, misbehaviour.GetHeight())
+	suite.Require().Equal(clienttypes.MisbehaviourTypeDuplicateHeader, misbehaviour.GetMisbehaviourType())
+
+	misbehaviour.Header2 = suite.chainA.CreateTMClientHeader(chainID, int64(heightMinus1.RevisionHeight), heightMinus1, suite.now, suite.valSet, suite.valSet, suite.valSet, suite.signers)
+	suite.Require().Equal(height, misbehaviour.GetHeight())
+	suite.Require().Equal(clienttypes.MisbehaviourTypeTimeViolation, misbehaviour.GetMisbehaviourType())
 }
 
 func (suite *TendermintTestSuite) TestMisbehaviourValidateBasic() {
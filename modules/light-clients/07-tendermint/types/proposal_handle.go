@@ -20,7 +20,8 @@ import (
 //
 // The following must always be true:
 //	- The substitute client is the same type as the subject client
-//	- The subject and substitute client states match in all parameters (expect frozen height, latest height, and chain-id)
+//	- The subject and substitute client states match in all parameters (expect frozen height, latest height, trusting period and consensus state retention)
+//	- The subject and substitute chain-id match, ignoring the revision number if the chain-id is in revision format
 //
 // In case 1) before updating the client, the client will be unfrozen by resetting
 // the FrozenHeight to the zero Height.
@@ -71,8 +72,9 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	cs.LatestHeight = substituteClientState.LatestHeight
 	cs.ChainId = substituteClientState.ChainId
 
-	// set new trusting period based on the substitute client state
+	// set new trusting period and consensus state retention based on the substitute client state
 	cs.TrustingPeriod = substituteClientState.TrustingPeriod
+	cs.ConsensusStateRetention = substituteClientState.ConsensusStateRetention
 
 	// no validation is necessary since the substitute is verified to be Active
 	// in 02-client.
@@ -81,8 +83,15 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 }
 
 // IsMatchingClientState returns true if all the client state parameters match
-// except for frozen height, latest height, trusting period, chain-id.
+// except for frozen height, latest height, trusting period and consensus state
+// retention. The chain-id is required to match except for its revision number,
+// which allows a substitute to track the subject chain after it has been
+// restarted with a new revision.
 func IsMatchingClientState(subject, substitute ClientState) bool {
+	if chainIDWithoutRevision(subject.ChainId) != chainIDWithoutRevision(substitute.ChainId) {
+		return false
+	}
+
 	// zero out parameters which do not need to match
 	subject.LatestHeight = clienttypes.ZeroHeight()
 	subject.FrozenHeight = clienttypes.ZeroHeight()
@@ -90,6 +99,8 @@ func IsMatchingClientState(subject, substitute ClientState) bool {
 	substitute.LatestHeight = clienttypes.ZeroHeight()
 	substitute.FrozenHeight = clienttypes.ZeroHeight()
 	substitute.TrustingPeriod = time.Duration(0)
+	subject.ConsensusStateRetention = nil
+	substitute.ConsensusStateRetention = nil
 	subject.ChainId = ""
 	substitute.ChainId = ""
 	// sets both sets of flags to true as these flags have been DEPRECATED, see ADR-026 for more information
@@ -100,3 +111,15 @@ func IsMatchingClientState(subject, substitute ClientState) bool {
 
 	return reflect.DeepEqual(subject, substitute)
 }
+
+// chainIDWithoutRevision returns the chain-id with the revision number set to zero
+// if the chain-id is in revision format. Otherwise the chain-id is returned unchanged.
+func chainIDWithoutRevision(chainID string) string {
+	if !clienttypes.IsRevisionFormat(chainID) {
+		return chainID
+	}
+
+	// the error can be ignored as the chain-id is known to be in revision format
+	chainID, _ = clienttypes.SetRevisionNumber(chainID, 0)
+	return chainID
+}
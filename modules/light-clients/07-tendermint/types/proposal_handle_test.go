@@ -156,8 +156,9 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 			// get updated substitute
 			substituteClientState = suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*types.ClientState)
 
-			// test that subject gets updated chain-id
-			newChainID := "new-chain-id"
+			// test that subject gets updated chain-id when the substitute tracks a new revision of the subject chain
+			subjectClientState.ChainId = "testchain-1"
+			newChainID := "testchain-2"
 			substituteClientState.ChainId = newChainID
 
 			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
@@ -232,10 +233,28 @@ func (suite *TendermintTestSuite) TestIsMatchingClientState() {
 			}, true,
 		},
 		{
-			"matching, chain id is different", func() {
+			"matching, chain id differs only in revision number", func() {
+				subjectClientState.ChainId = "testchain-1"
+				substituteClientState.ChainId = "testchain-2"
+			}, true,
+		},
+		{
+			"not matching, chain id is different", func() {
 				subjectClientState.ChainId = "bitcoin"
 				substituteClientState.ChainId = "ethereum"
-			}, true,
+			}, false,
+		},
+		{
+			"not matching, chain id in revision format is different", func() {
+				subjectClientState.ChainId = "bitcoin-1"
+				substituteClientState.ChainId = "ethereum-1"
+			}, false,
+		},
+		{
+			"not matching, unbonding period is different", func() {
+				subjectClientState.UnbondingPeriod = time.Duration(time.Hour * 10)
+				substituteClientState.UnbondingPeriod = time.Duration(time.Hour * 20)
+			}, false,
 		},
 		{
 			"matching, trusting period is different", func() {
@@ -261,6 +280,8 @@ func (suite *TendermintTestSuite) TestIsMatchingClientState() {
 			substitutePath = ibctesting.NewPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupClients(subjectPath)
 			suite.coordinator.SetupClients(substitutePath)
+			subjectClientState = suite.chainA.GetClientState(subjectPath.EndpointA.ClientID).(*types.ClientState)
+			substituteClientState = suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*types.ClientState)
 
 			tc.malleate()
 
@@ -32,7 +32,14 @@ Iterators to iterate over the consensus states in ascending/descending order by
 A future version of IBC may choose to replace the ICS24 ConsensusState path with the more efficient format and make this indirection unnecessary.
 */
 
-const KeyIterateConsensusStatePrefix = "iterateConsensusStates"
+const (
+	KeyIterateConsensusStatePrefix = "iterateConsensusStates"
+
+	// MaxConsensusStatesPrunedPerUpdate is the maximum number of consensus states pruned by a single
+	// client update. It bounds the gas cost of UpdateClient while still allowing a client with a
+	// backlog of prunable consensus states to catch up over successive updates.
+	MaxConsensusStatesPrunedPerUpdate = 10
+)
 
 var (
 	// KeyProcessedTime is appended to consensus state key to store the processed time
@@ -41,6 +48,8 @@ var (
 	KeyProcessedHeight = []byte("/processedHeight")
 	// KeyIteration stores the key mapping to consensus state key for efficient iteration
 	KeyIteration = []byte("/iterationKey")
+	// KeyPrunedHeight stores the height of the highest consensus state pruned from the client store
+	KeyPrunedHeight = []byte("prunedConsensusHeight")
 )
 
 // SetConsensusState stores the consensus state at the given height.
@@ -317,6 +326,123 @@ func PruneAllExpiredConsensusStates(
 	return nil
 }
 
+// pruneConsensusStates deletes, in ascending height order, the consensus states which have passed
+// the consensus state retention period along with all associated metadata. At most
+// MaxConsensusStatesPrunedPerUpdate consensus states are pruned and the consensus state stored at the
+// latest height of the client is never pruned. The highest pruned height is recorded in the client store.
+func pruneConsensusStates(
+	ctx sdk.Context, clientStore sdk.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState,
+) error {
+	var (
+		heights    []exported.Height
+		pruneError error
+	)
+
+	pruneCb := func(height exported.Height) bool {
+		if len(heights) == MaxConsensusStatesPrunedPerUpdate || height.GTE(clientState.GetLatestHeight()) {
+			return true
+		}
+
+		consState, err := GetConsensusState(clientStore, cdc, height)
+		// this error should never occur
+		if err != nil {
+			pruneError = err
+			return true
+		}
+
+		// consensus state timestamps are monotonic, so no later consensus state can be pruned
+		if !clientState.IsPrunable(consState.Timestamp, ctx.BlockTime()) {
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	if err := IterateConsensusStateAscending(clientStore, pruneCb); err != nil {
+		return err
+	}
+	if pruneError != nil {
+		return pruneError
+	}
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	if len(heights) != 0 {
+		prunedHeight := heights[len(heights)-1]
+		if previous, found := GetPrunedHeight(clientStore); !found || prunedHeight.GT(previous) {
+			SetPrunedHeight(clientStore, prunedHeight)
+		}
+	}
+
+	return nil
+}
+
+// SetPrunedHeight stores the height of the highest consensus state pruned from the client store.
+func SetPrunedHeight(clientStore sdk.KVStore, height exported.Height) {
+	clientStore.Set(KeyPrunedHeight, []byte(height.String()))
+}
+
+// GetPrunedHeight returns the height of the highest consensus state pruned from the client store.
+// Consensus states at or below this height which are no longer stored have been pruned.
+func GetPrunedHeight(clientStore sdk.KVStore) (exported.Height, bool) {
+	bz := clientStore.Get(KeyPrunedHeight)
+	if bz == nil {
+		return nil, false
+	}
+	height, err := clienttypes.ParseHeight(string(bz))
+	if err != nil {
+		return nil, false
+	}
+	return height, true
+}
+
+// IsConsensusStatePruned returns true if the consensus state at the provided height has been pruned
+// from the client store.
+func IsConsensusStatePruned(clientStore sdk.KVStore, height exported.Height) bool {
+	if clientStore.Has(host.ConsensusStateKey(height)) {
+		return false
+	}
+
+	prunedHeight, found := GetPrunedHeight(clientStore)
+	return found && height.LTE(prunedHeight)
+}
+
+// BackfillConsensusMetadata sets the iteration key, processed height and processed time for every
+// consensus state in the client store which is missing them. Missing processed heights and times are
+// set to the current block height and time. Iteration keys are required for consensus state pruning.
+func BackfillConsensusMetadata(ctx sdk.Context, clientStore sdk.KVStore) {
+	var heights []exported.Height
+	iterator := sdk.KVStorePrefixIterator(clientStore, []byte(host.KeyConsensusStatePrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// consensus key is in the format "consensusStates/<height>"
+		if len(keySplit) != 2 {
+			continue
+		}
+
+		heights = append(heights, clienttypes.MustParseHeight(keySplit[1]))
+	}
+
+	for _, height := range heights {
+		if GetIterationKey(clientStore, height) == nil {
+			SetIterationKey(clientStore, height)
+		}
+		if _, found := GetProcessedHeight(clientStore, height); !found {
+			SetProcessedHeight(clientStore, height, clienttypes.GetSelfHeight(ctx))
+		}
+		if _, found := GetProcessedTime(clientStore, height); !found {
+			SetProcessedTime(clientStore, height, uint64(ctx.BlockTime().UnixNano()))
+		}
+	}
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
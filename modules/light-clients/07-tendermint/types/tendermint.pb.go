@@ -63,6 +63,10 @@ type ClientState struct {
 	AllowUpdateAfterExpiry bool `protobuf:"varint,10,opt,name=allow_update_after_expiry,json=allowUpdateAfterExpiry,proto3" json:"allow_update_after_expiry,omitempty" yaml:"allow_update_after_expiry"` // Deprecated: Do not use.
 	// allow_update_after_misbehaviour is deprecated
 	AllowUpdateAfterMisbehaviour bool `protobuf:"varint,11,opt,name=allow_update_after_misbehaviour,json=allowUpdateAfterMisbehaviour,proto3" json:"allow_update_after_misbehaviour,omitempty" yaml:"allow_update_after_misbehaviour"` // Deprecated: Do not use.
+	// duration since the consensus state timestamp after which a consensus state
+	// may be pruned. If unset, consensus states are pruned once they have passed
+	// the trusting period. The latest consensus state is never pruned.
+	ConsensusStateRetention *time.Duration `protobuf:"bytes,12,opt,name=consensus_state_retention,json=consensusStateRetention,proto3,stdduration" json:"consensus_state_retention,omitempty" yaml:"consensus_state_retention"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -322,75 +326,77 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 1082 bytes of a gzipped FileDescriptorProto
+	// 1111 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xe3, 0xc4,
-	0x17, 0x6f, 0xda, 0x7e, 0xb7, 0xc9, 0x24, 0xdd, 0xf6, 0x6b, 0x4a, 0x37, 0x2d, 0xdd, 0x38, 0x32,
-	0x52, 0xc9, 0x81, 0xda, 0x24, 0xbb, 0x12, 0x52, 0xc5, 0x05, 0x77, 0x17, 0xb5, 0x88, 0x95, 0x2a,
-	0x97, 0x1f, 0x12, 0x12, 0x32, 0x13, 0x7b, 0x92, 0x8c, 0xd6, 0xf6, 0x58, 0x9e, 0x49, 0x68, 0xf9,
-	0x0b, 0xe0, 0x80, 0xb4, 0x47, 0xc4, 0x89, 0x03, 0x7f, 0xcc, 0x1e, 0x7b, 0xe4, 0x64, 0x50, 0x7b,
-	0xe5, 0x94, 0x23, 0x27, 0x34, 0x3f, 0x6c, 0x4f, 0xb3, 0x5d, 0xaa, 0xe5, 0x12, 0xcd, 0x7b, 0xef,
-	0xf3, 0x3e, 0x9f, 0xcc, 0x9b, 0x37, 0x6f, 0x0c, 0x1c, 0x3c, 0x0c, 0x9c, 0x08, 0x8f, 0x27, 0x2c,
-	0x88, 0x30, 0x4a, 0x18, 0x75, 0x18, 0x4a, 0x42, 0x94, 0xc5, 0x38, 0x61, 0xce, 0xac, 0xaf, 0x59,
-	0x76, 0x9a, 0x11, 0x46, 0x8c, 0x0e, 0x1e, 0x06, 0xb6, 0x9e, 0x60, 0x6b, 0x90, 0x59, 0x7f, 0xb7,
-	0xab, 0xe5, 0xb3, 0x8b, 0x14, 0x51, 0x67, 0x06, 0x23, 0x1c, 0x42, 0x46, 0x32, 0xc9, 0xb0, 0xbb,
-	0xf7, 0x0a, 0x42, 0xfc, 0xaa, 0x68, 0x2b, 0xcd, 0x08, 0x19, 0x15, 0x56, 0x67, 0x4c, 0xc8, 0x38,
-	0x42, 0x8e, 0xb0, 0x86, 0xd3, 0x91, 0x13, 0x4e, 0x33, 0xc8, 0x30, 0x49, 0x54, 0xdc, 0x5c, 0x8c,
-	0x33, 0x1c, 0x23, 0xca, 0x60, 0x9c, 0x16, 0x00, 0xbe, 0xbf, 0x80, 0x64, 0xc8, 0x91, 0x7f, 0x97,
-	0xef, 0x49, 0xae, 0x14, 0xe0, 0xbd, 0x0a, 0x40, 0xe2, 0x18, 0xb3, 0xb8, 0x00, 0x95, 0x96, 0x02,
-	0x6e, 0x8d, 0xc9, 0x98, 0x88, 0xa5, 0xc3, 0x57, 0xd2, 0x6b, 0xfd, 0xb5, 0x06, 0x9a, 0x47, 0x82,
-	0xef, 0x8c, 0x41, 0x86, 0x8c, 0x1d, 0x50, 0x0f, 0x26, 0x10, 0x27, 0x3e, 0x0e, 0xdb, 0xb5, 0x6e,
-	0xad, 0xd7, 0xf0, 0xd6, 0x84, 0x7d, 0x12, 0x1a, 0x08, 0x34, 0x59, 0x36, 0xa5, 0xcc, 0x8f, 0xd0,
-	0x0c, 0x45, 0xed, 0xe5, 0x6e, 0xad, 0xd7, 0x1c, 0xf4, 0xec, 0x7f, 0xaf, 0xa7, 0xfd, 0x49, 0x06,
-	0x03, 0xbe, 0x61, 0x77, 0xf7, 0x65, 0x6e, 0x2e, 0xcd, 0x73, 0xd3, 0xb8, 0x80, 0x71, 0x74, 0x68,
-	0x69, 0x54, 0x96, 0x07, 0x84, 0xf5, 0x19, 0x37, 0x8c, 0x11, 0xd8, 0x10, 0x16, 0x4e, 0xc6, 0x7e,
-	0x8a, 0x32, 0x4c, 0xc2, 0xf6, 0x8a, 0x90, 0xda, 0xb1, 0x65, 0xb1, 0xec, 0xa2, 0x58, 0xf6, 0x13,
-	0x55, 0x4c, 0xd7, 0x52, 0xdc, 0xdb, 0x1a, 0x77, 0x95, 0x6f, 0xfd, 0xfc, 0x87, 0x59, 0xf3, 0xee,
-	0x17, 0xde, 0x53, 0xe1, 0x34, 0x30, 0xd8, 0x9c, 0x26, 0x43, 0x92, 0x84, 0x9a, 0xd0, 0xea, 0x5d,
-	0x42, 0xef, 0x2a, 0xa1, 0x07, 0x52, 0x68, 0x91, 0x40, 0x2a, 0x6d, 0x94, 0x6e, 0x25, 0x85, 0xc0,
-	0x46, 0x0c, 0xcf, 0xfd, 0x20, 0x22, 0xc1, 0x73, 0x3f, 0xcc, 0xf0, 0x88, 0xb5, 0xff, 0xf7, 0x86,
-	0x5b, 0x5a, 0xc8, 0x97, 0x42, 0xeb, 0x31, 0x3c, 0x3f, 0xe2, 0xce, 0x27, 0xdc, 0x67, 0x7c, 0x03,
-	0xd6, 0x47, 0x19, 0xf9, 0x1e, 0x25, 0xfe, 0x04, 0xf1, 0x03, 0x69, 0xdf, 0x13, 0x22, 0xbb, 0xe2,
-	0x88, 0x78, 0x8b, 0xd8, 0xaa, 0x73, 0x66, 0x7d, 0xfb, 0x58, 0x20, 0xdc, 0x3d, 0xa5, 0xb2, 0x25,
-	0x55, 0x6e, 0xa4, 0x5b, 0x5e, 0x4b, 0xda, 0x12, 0xcb, 0xe9, 0x23, 0xc8, 0x10, 0x65, 0x05, 0xfd,
-	0xda, 0x9b, 0xd2, 0xdf, 0x48, 0xb7, 0xbc, 0x96, 0xb4, 0x15, 0xfd, 0x09, 0x68, 0x8a, 0xab, 0xe3,
-	0xd3, 0x14, 0x05, 0xb4, 0x5d, 0xef, 0xae, 0xf4, 0x9a, 0x83, 0x4d, 0x1b, 0x07, 0x74, 0xf0, 0xc8,
-	0x3e, 0xe5, 0x91, 0xb3, 0x14, 0x05, 0xee, 0x76, 0xd5, 0x42, 0x1a, 0xdc, 0xf2, 0x40, 0x5a, 0x40,
-	0xa8, 0x71, 0x08, 0x5a, 0xd3, 0x74, 0x9c, 0xc1, 0x10, 0xf9, 0x29, 0x64, 0x93, 0x76, 0xa3, 0xbb,
-	0xd2, 0x6b, 0xb8, 0x0f, 0xe6, 0xb9, 0xf9, 0x96, 0x3a, 0x37, 0x2d, 0x6a, 0x79, 0x4d, 0x65, 0x9e,
-	0x42, 0x36, 0x31, 0x20, 0xd8, 0x81, 0x51, 0x44, 0xbe, 0xf3, 0xa7, 0x69, 0x08, 0x19, 0xf2, 0xe1,
-	0x88, 0xa1, 0xcc, 0x47, 0xe7, 0x29, 0xce, 0x2e, 0xda, 0xa0, 0x5b, 0xeb, 0xd5, 0xdd, 0xfd, 0x79,
-	0x6e, 0x76, 0x25, 0xd1, 0x6b, 0xa1, 0x56, 0xbb, 0xe6, 0x6d, 0x8b, 0xe8, 0x17, 0x22, 0xf8, 0x31,
-	0x8f, 0x3d, 0x15, 0x21, 0x83, 0x02, 0xf3, 0x96, 0xbc, 0x18, 0xd3, 0x21, 0x9a, 0xc0, 0x19, 0x26,
-	0xd3, 0xac, 0xdd, 0x14, 0x42, 0xef, 0xcf, 0x73, 0x73, 0xff, 0xb5, 0x42, 0x7a, 0x02, 0x97, 0xdb,
-	0x5b, 0x94, 0x7b, 0xa6, 0x01, 0x0e, 0x57, 0x7f, 0xf8, 0xd5, 0x5c, 0xb2, 0x7e, 0x5b, 0x06, 0xf7,
-	0x8f, 0x48, 0x42, 0x51, 0x42, 0xa7, 0x54, 0xde, 0x78, 0x17, 0x34, 0xca, 0xa1, 0x23, 0xae, 0x3c,
-	0x3f, 0xd2, 0xc5, 0xb6, 0xfc, 0xbc, 0x40, 0xb8, 0x75, 0x7e, 0xa4, 0x2f, 0x78, 0xf7, 0x55, 0x69,
-	0xc6, 0x47, 0x60, 0x35, 0x23, 0x84, 0xa9, 0x99, 0x60, 0x69, 0x1d, 0x51, 0x4d, 0xa1, 0x59, 0xdf,
-	0x7e, 0x86, 0xb2, 0xe7, 0x11, 0xf2, 0x08, 0x61, 0xee, 0x2a, 0xa7, 0xf1, 0x44, 0x96, 0xf1, 0x63,
-	0x0d, 0x6c, 0x25, 0xe8, 0x9c, 0xf9, 0xe5, 0xa4, 0xa5, 0xfe, 0x04, 0xd2, 0x89, 0xb8, 0xf7, 0x2d,
-	0xf7, 0xab, 0x79, 0x6e, 0xbe, 0x23, 0xab, 0x70, 0x1b, 0xca, 0xfa, 0x3b, 0x37, 0x1f, 0x8f, 0x31,
-	0x9b, 0x4c, 0x87, 0x5c, 0x4e, 0x9f, 0xff, 0xda, 0x32, 0xc2, 0x43, 0xea, 0x0c, 0x2f, 0x18, 0xa2,
-	0xf6, 0x31, 0x3a, 0x77, 0xf9, 0xc2, 0x33, 0x38, 0xdd, 0x97, 0x25, 0xdb, 0x31, 0xa4, 0x13, 0x55,
-	0xa6, 0x9f, 0x96, 0x41, 0x4b, 0xaf, 0x9e, 0xd1, 0x07, 0x0d, 0xd9, 0xdc, 0xe5, 0x5c, 0x74, 0xb7,
-	0xe6, 0xb9, 0xb9, 0x29, 0xff, 0x56, 0x19, 0xb2, 0xbc, 0xba, 0x5c, 0x9f, 0x84, 0x06, 0x04, 0xf5,
-	0x09, 0x82, 0x21, 0xca, 0xfc, 0xbe, 0xaa, 0xcb, 0xfe, 0x5d, 0xb3, 0xf2, 0x58, 0xe0, 0xdd, 0xce,
-	0x55, 0x6e, 0xae, 0xc9, 0x75, 0x7f, 0x9e, 0x9b, 0x1b, 0x52, 0xa4, 0x20, 0xb3, 0xbc, 0x35, 0xb9,
-	0xec, 0x6b, 0x12, 0x03, 0x35, 0x23, 0xff, 0x83, 0xc4, 0xe0, 0x15, 0x89, 0x41, 0x29, 0x31, 0x50,
-	0xf5, 0xf8, 0x65, 0x05, 0xdc, 0x93, 0x68, 0x03, 0x82, 0x75, 0x8a, 0xc7, 0x09, 0x0a, 0x7d, 0x09,
-	0x51, 0x2d, 0xd3, 0xd1, 0x75, 0xe4, 0x7b, 0x78, 0x26, 0x60, 0x4a, 0x70, 0xef, 0x32, 0x37, 0x6b,
-	0xd5, 0x24, 0xb8, 0x41, 0x61, 0x79, 0x2d, 0xaa, 0x61, 0xf9, 0xa0, 0x29, 0xcf, 0xd8, 0xa7, 0xa8,
-	0x68, 0xab, 0x5b, 0x24, 0xca, 0xc3, 0x3b, 0x43, 0xcc, 0x6d, 0x57, 0xf4, 0x37, 0xd2, 0x2d, 0xaf,
-	0x35, 0xd3, 0x70, 0xc6, 0xb7, 0x40, 0x3e, 0x05, 0x42, 0x5f, 0x0c, 0xb2, 0x95, 0x3b, 0x07, 0xd9,
-	0x43, 0x35, 0xc8, 0xde, 0xd6, 0x1e, 0x98, 0x32, 0xdf, 0xf2, 0xd6, 0x95, 0x43, 0x8d, 0xb2, 0x08,
-	0x18, 0x05, 0xa2, 0x6a, 0x56, 0xf5, 0xb8, 0xdc, 0xb5, 0x8b, 0x87, 0xf3, 0xdc, 0xdc, 0xb9, 0xa9,
-	0x52, 0x71, 0x58, 0xde, 0xff, 0x95, 0xb3, 0x6a, 0x5b, 0xeb, 0x53, 0x50, 0x2f, 0x1e, 0x59, 0x63,
-	0x0f, 0x34, 0x92, 0x69, 0x8c, 0x32, 0x1e, 0x11, 0x27, 0xb3, 0xea, 0x55, 0x0e, 0xa3, 0x0b, 0x9a,
-	0x21, 0x4a, 0x48, 0x8c, 0x13, 0x11, 0x5f, 0x16, 0x71, 0xdd, 0xe5, 0xfa, 0x2f, 0xaf, 0x3a, 0xb5,
-	0xcb, 0xab, 0x4e, 0xed, 0xcf, 0xab, 0x4e, 0xed, 0xc5, 0x75, 0x67, 0xe9, 0xf2, 0xba, 0xb3, 0xf4,
-	0xfb, 0x75, 0x67, 0xe9, 0xeb, 0xa7, 0xda, 0x15, 0x0b, 0x08, 0x8d, 0x09, 0xe5, 0x9f, 0x5e, 0x07,
-	0x63, 0xe2, 0xcc, 0x1e, 0x3b, 0x31, 0x09, 0xa7, 0x11, 0xa2, 0xf2, 0x43, 0xec, 0xa0, 0xf8, 0x12,
-	0xfb, 0xe0, 0xc3, 0x83, 0xc5, 0x4f, 0xa5, 0xe1, 0x3d, 0x31, 0x52, 0x1e, 0xfd, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x0b, 0x9f, 0x2c, 0x5c, 0xb8, 0x09, 0x00, 0x00,
+	0x17, 0xaf, 0x9b, 0x7c, 0xb7, 0xc9, 0x24, 0xdd, 0xee, 0xd7, 0x94, 0x5d, 0xb7, 0x74, 0xe3, 0xc8,
+	0x48, 0x4b, 0x0e, 0xac, 0x4d, 0xb2, 0x2b, 0x21, 0x55, 0x5c, 0x70, 0x77, 0x51, 0x8b, 0x58, 0xa9,
+	0x72, 0xf9, 0x21, 0x21, 0x21, 0x33, 0xb1, 0x27, 0xc9, 0x68, 0x6d, 0x8f, 0xe5, 0x99, 0x84, 0x96,
+	0x0b, 0x57, 0x38, 0x20, 0xed, 0x11, 0x71, 0xe2, 0xc0, 0x1f, 0xb3, 0xc7, 0x1e, 0x39, 0x19, 0xd4,
+	0x9e, 0xb9, 0xe4, 0xc8, 0x09, 0xcd, 0x0f, 0xc7, 0x4e, 0xb6, 0xa5, 0x2c, 0x97, 0x68, 0xde, 0x7b,
+	0x9f, 0xf7, 0xf9, 0x64, 0xe6, 0x3d, 0xbf, 0x19, 0xe0, 0xe0, 0x61, 0xe0, 0x44, 0x78, 0x3c, 0x61,
+	0x41, 0x84, 0x51, 0xc2, 0xa8, 0xc3, 0x50, 0x12, 0xa2, 0x2c, 0xc6, 0x09, 0x73, 0x66, 0xfd, 0x8a,
+	0x65, 0xa7, 0x19, 0x61, 0x44, 0xef, 0xe0, 0x61, 0x60, 0x57, 0x13, 0xec, 0x0a, 0x64, 0xd6, 0xdf,
+	0xed, 0x56, 0xf2, 0xd9, 0x59, 0x8a, 0xa8, 0x33, 0x83, 0x11, 0x0e, 0x21, 0x23, 0x99, 0x64, 0xd8,
+	0xdd, 0x7b, 0x05, 0x21, 0x7e, 0x55, 0xb4, 0x9d, 0x66, 0x84, 0x8c, 0x0a, 0xab, 0x33, 0x26, 0x64,
+	0x1c, 0x21, 0x47, 0x58, 0xc3, 0xe9, 0xc8, 0x09, 0xa7, 0x19, 0x64, 0x98, 0x24, 0x2a, 0x6e, 0xae,
+	0xc6, 0x19, 0x8e, 0x11, 0x65, 0x30, 0x4e, 0x0b, 0x00, 0xdf, 0x5f, 0x40, 0x32, 0xe4, 0xc8, 0xbf,
+	0xcb, 0xf7, 0x24, 0x57, 0x0a, 0xf0, 0x4e, 0x09, 0x20, 0x71, 0x8c, 0x59, 0x5c, 0x80, 0x16, 0x96,
+	0x02, 0x6e, 0x8f, 0xc9, 0x98, 0x88, 0xa5, 0xc3, 0x57, 0xd2, 0x6b, 0xfd, 0xd9, 0x00, 0xad, 0x03,
+	0xc1, 0x77, 0xc2, 0x20, 0x43, 0xfa, 0x0e, 0x68, 0x04, 0x13, 0x88, 0x13, 0x1f, 0x87, 0x86, 0xd6,
+	0xd5, 0x7a, 0x4d, 0x6f, 0x43, 0xd8, 0x47, 0xa1, 0x8e, 0x40, 0x8b, 0x65, 0x53, 0xca, 0xfc, 0x08,
+	0xcd, 0x50, 0x64, 0xac, 0x77, 0xb5, 0x5e, 0x6b, 0xd0, 0xb3, 0xff, 0xf9, 0x3c, 0xed, 0x8f, 0x32,
+	0x18, 0xf0, 0x0d, 0xbb, 0xbb, 0x2f, 0x73, 0x73, 0x6d, 0x9e, 0x9b, 0xfa, 0x19, 0x8c, 0xa3, 0x7d,
+	0xab, 0x42, 0x65, 0x79, 0x40, 0x58, 0x9f, 0x70, 0x43, 0x1f, 0x81, 0x2d, 0x61, 0xe1, 0x64, 0xec,
+	0xa7, 0x28, 0xc3, 0x24, 0x34, 0x6a, 0x42, 0x6a, 0xc7, 0x96, 0x87, 0x65, 0x17, 0x87, 0x65, 0x3f,
+	0x51, 0x87, 0xe9, 0x5a, 0x8a, 0xfb, 0x6e, 0x85, 0xbb, 0xcc, 0xb7, 0x7e, 0xfa, 0xdd, 0xd4, 0xbc,
+	0xdb, 0x85, 0xf7, 0x58, 0x38, 0x75, 0x0c, 0xee, 0x4c, 0x93, 0x21, 0x49, 0xc2, 0x8a, 0x50, 0xfd,
+	0x26, 0xa1, 0xb7, 0x95, 0xd0, 0x3d, 0x29, 0xb4, 0x4a, 0x20, 0x95, 0xb6, 0x16, 0x6e, 0x25, 0x85,
+	0xc0, 0x56, 0x0c, 0x4f, 0xfd, 0x20, 0x22, 0xc1, 0x73, 0x3f, 0xcc, 0xf0, 0x88, 0x19, 0xff, 0x7b,
+	0xcd, 0x2d, 0xad, 0xe4, 0x4b, 0xa1, 0xcd, 0x18, 0x9e, 0x1e, 0x70, 0xe7, 0x13, 0xee, 0xd3, 0xbf,
+	0x02, 0x9b, 0xa3, 0x8c, 0x7c, 0x8b, 0x12, 0x7f, 0x82, 0x78, 0x41, 0x8c, 0x5b, 0x42, 0x64, 0x57,
+	0x94, 0x88, 0xb7, 0x88, 0xad, 0x3a, 0x67, 0xd6, 0xb7, 0x0f, 0x05, 0xc2, 0xdd, 0x53, 0x2a, 0xdb,
+	0x52, 0x65, 0x29, 0xdd, 0xf2, 0xda, 0xd2, 0x96, 0x58, 0x4e, 0x1f, 0x41, 0x86, 0x28, 0x2b, 0xe8,
+	0x37, 0x5e, 0x97, 0x7e, 0x29, 0xdd, 0xf2, 0xda, 0xd2, 0x56, 0xf4, 0x47, 0xa0, 0x25, 0x3e, 0x1d,
+	0x9f, 0xa6, 0x28, 0xa0, 0x46, 0xa3, 0x5b, 0xeb, 0xb5, 0x06, 0x77, 0x6c, 0x1c, 0xd0, 0xc1, 0x23,
+	0xfb, 0x98, 0x47, 0x4e, 0x52, 0x14, 0xb8, 0x77, 0xcb, 0x16, 0xaa, 0xc0, 0x2d, 0x0f, 0xa4, 0x05,
+	0x84, 0xea, 0xfb, 0xa0, 0x3d, 0x4d, 0xc7, 0x19, 0x0c, 0x91, 0x9f, 0x42, 0x36, 0x31, 0x9a, 0xdd,
+	0x5a, 0xaf, 0xe9, 0xde, 0x9b, 0xe7, 0xe6, 0x1b, 0xaa, 0x6e, 0x95, 0xa8, 0xe5, 0xb5, 0x94, 0x79,
+	0x0c, 0xd9, 0x44, 0x87, 0x60, 0x07, 0x46, 0x11, 0xf9, 0xc6, 0x9f, 0xa6, 0x21, 0x64, 0xc8, 0x87,
+	0x23, 0x86, 0x32, 0x1f, 0x9d, 0xa6, 0x38, 0x3b, 0x33, 0x40, 0x57, 0xeb, 0x35, 0xdc, 0x07, 0xf3,
+	0xdc, 0xec, 0x4a, 0xa2, 0x6b, 0xa1, 0x96, 0xa1, 0x79, 0x77, 0x45, 0xf4, 0x33, 0x11, 0xfc, 0x90,
+	0xc7, 0x9e, 0x8a, 0x90, 0x4e, 0x81, 0x79, 0x45, 0x5e, 0x8c, 0xe9, 0x10, 0x4d, 0xe0, 0x0c, 0x93,
+	0x69, 0x66, 0xb4, 0x84, 0xd0, 0xbb, 0xf3, 0xdc, 0x7c, 0x70, 0xad, 0x50, 0x35, 0x81, 0xcb, 0xed,
+	0xad, 0xca, 0x3d, 0xab, 0x00, 0xf4, 0xef, 0xc0, 0x4e, 0x40, 0x12, 0x8a, 0x12, 0x3a, 0xa5, 0x3e,
+	0xe5, 0xdf, 0xba, 0x9f, 0x21, 0x86, 0x12, 0xde, 0x6c, 0x46, 0xfb, 0xa6, 0x6e, 0xec, 0x95, 0x5b,
+	0xbe, 0x96, 0x45, 0xf6, 0xe4, 0xbd, 0x45, 0x5c, 0x0c, 0x14, 0xaf, 0x88, 0xee, 0xd7, 0xbf, 0xff,
+	0xc5, 0x5c, 0xb3, 0x7e, 0x5d, 0x07, 0xb7, 0x0f, 0x96, 0x10, 0xba, 0x0b, 0x9a, 0x8b, 0xa9, 0x67,
+	0x68, 0xaa, 0xa7, 0x56, 0xff, 0xc9, 0xa7, 0x05, 0xc2, 0x6d, 0xf0, 0x9e, 0x7a, 0xc1, 0xa5, 0xca,
+	0x34, 0xfd, 0x03, 0x50, 0xcf, 0x08, 0x61, 0x6a, 0x28, 0x59, 0x95, 0x96, 0x2c, 0xc7, 0xe0, 0xac,
+	0x6f, 0x3f, 0x43, 0xd9, 0xf3, 0x08, 0x79, 0x84, 0x30, 0xb7, 0xce, 0x69, 0x3c, 0x91, 0xa5, 0xff,
+	0xa0, 0x81, 0xed, 0x04, 0x9d, 0x32, 0x7f, 0x31, 0xea, 0xa9, 0x3f, 0x81, 0x74, 0x22, 0x06, 0x4f,
+	0xdb, 0xfd, 0x62, 0x9e, 0x9b, 0x6f, 0xc9, 0xcd, 0x5f, 0x85, 0xb2, 0xfe, 0xca, 0xcd, 0xc7, 0x63,
+	0xcc, 0x26, 0xd3, 0x21, 0x97, 0xab, 0x5e, 0x40, 0x95, 0x65, 0x84, 0x87, 0xd4, 0x19, 0x9e, 0x31,
+	0x44, 0xed, 0x43, 0x74, 0xea, 0xf2, 0x85, 0xa7, 0x73, 0xba, 0xcf, 0x17, 0x6c, 0x87, 0x90, 0x4e,
+	0xd4, 0x31, 0xfd, 0xb8, 0x0e, 0xda, 0x4b, 0xe5, 0xeb, 0x83, 0xa6, 0xfc, 0xba, 0x16, 0x83, 0xd9,
+	0xdd, 0x9e, 0xe7, 0xe6, 0x1d, 0x55, 0x93, 0x22, 0x64, 0x79, 0x0d, 0xb9, 0x3e, 0x0a, 0x75, 0x08,
+	0x1a, 0x13, 0x04, 0x43, 0x94, 0xf9, 0x7d, 0x75, 0x2e, 0x0f, 0x6e, 0x1a, 0xd6, 0x87, 0x02, 0xef,
+	0x76, 0x2e, 0x72, 0x73, 0x43, 0xae, 0xfb, 0xf3, 0xdc, 0xdc, 0x92, 0x22, 0x05, 0x99, 0xe5, 0x6d,
+	0xc8, 0x65, 0xbf, 0x22, 0x31, 0x30, 0x6a, 0xff, 0x55, 0x62, 0xf0, 0x8a, 0xc4, 0x60, 0x21, 0x31,
+	0x50, 0xe7, 0xf1, 0x73, 0x0d, 0xdc, 0x92, 0x68, 0x1d, 0x82, 0x4d, 0x8a, 0xc7, 0x09, 0x0a, 0x7d,
+	0x09, 0x51, 0x2d, 0xd3, 0xa9, 0xea, 0xc8, 0x0b, 0xf9, 0x44, 0xc0, 0x94, 0xe0, 0xde, 0x79, 0x6e,
+	0x6a, 0xe5, 0x28, 0x5a, 0xa2, 0xb0, 0xbc, 0x36, 0xad, 0x60, 0xf9, 0xa4, 0x5b, 0xd4, 0xd8, 0xa7,
+	0xa8, 0x68, 0xab, 0x2b, 0x24, 0x16, 0xc5, 0x3b, 0x41, 0xcc, 0x35, 0x4a, 0xfa, 0xa5, 0x74, 0xcb,
+	0x6b, 0xcf, 0x2a, 0x38, 0xfd, 0x6b, 0x20, 0xef, 0x22, 0xa1, 0x2f, 0x26, 0x69, 0xed, 0xc6, 0x49,
+	0x7a, 0x5f, 0x4d, 0xd2, 0x37, 0x2b, 0x37, 0xdc, 0x22, 0xdf, 0xf2, 0x36, 0x95, 0x43, 0xcd, 0xd2,
+	0x08, 0xe8, 0x05, 0xa2, 0x6c, 0x56, 0xa3, 0xfe, 0xaf, 0x76, 0x71, 0x7f, 0x9e, 0x9b, 0x3b, 0xcb,
+	0x2a, 0x25, 0x87, 0xe5, 0xfd, 0x5f, 0x39, 0xcb, 0xb6, 0xb5, 0x3e, 0x06, 0x8d, 0xe2, 0x96, 0xd7,
+	0xf7, 0x40, 0x33, 0x99, 0xc6, 0x28, 0xe3, 0x11, 0x51, 0x99, 0xba, 0x57, 0x3a, 0xf4, 0x2e, 0x68,
+	0x85, 0x28, 0x21, 0x31, 0x4e, 0x44, 0x7c, 0x5d, 0xc4, 0xab, 0x2e, 0xd7, 0x7f, 0x79, 0xd1, 0xd1,
+	0xce, 0x2f, 0x3a, 0xda, 0x1f, 0x17, 0x1d, 0xed, 0xc5, 0x65, 0x67, 0xed, 0xfc, 0xb2, 0xb3, 0xf6,
+	0xdb, 0x65, 0x67, 0xed, 0xcb, 0xa7, 0x95, 0x4f, 0x2c, 0x20, 0x34, 0x26, 0x94, 0xbf, 0xfd, 0x1e,
+	0x8e, 0x89, 0x33, 0x7b, 0xec, 0xc4, 0x24, 0x9c, 0x46, 0x88, 0xca, 0x97, 0xe0, 0xc3, 0xe2, 0x29,
+	0xf8, 0xde, 0xfb, 0x0f, 0x57, 0xdf, 0x6a, 0xc3, 0x5b, 0x62, 0xa4, 0x3c, 0xfa, 0x7b, 0x00, 0x9d,
+	0xa1, 0xce, 0xee, 0x39, 0x0a, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -413,6 +419,16 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConsensusStateRetention != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ConsensusStateRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ConsensusStateRetention):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTendermint(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.AllowUpdateAfterMisbehaviour {
 		i--
 		if m.AllowUpdateAfterMisbehaviour {
@@ -476,29 +492,29 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxClockDrift):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTendermint(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x2a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxClockDrift):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintTendermint(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x22
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TrustingPeriod):])
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTendermint(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTendermint(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size, err := m.TrustLevel.MarshalToSizedBuffer(dAtA[:i])
@@ -557,12 +573,12 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTendermint(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTendermint(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -775,6 +791,10 @@ func (m *ClientState) Size() (n int) {
 	if m.AllowUpdateAfterMisbehaviour {
 		n += 2
 	}
+	if m.ConsensusStateRetention != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ConsensusStateRetention)
+		n += 1 + l + sovTendermint(uint64(l))
+	}
 	return n
 }
 
@@ -1225,6 +1245,42 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUpdateAfterMisbehaviour = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusStateRetention == nil {
+				m.ConsensusStateRetention = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ConsensusStateRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTendermint(dAtA[iNdEx:])
@@ -383,28 +383,29 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	err := types.IterateConsensusStateAscending(clientStore, getFirstHeightCb)
 	suite.Require().Nil(err)
 
-	// this height will be expired but not pruned
+	// this height will be expired and pruned within the same update as the first height
 	path.EndpointA.UpdateClient()
 	expiredHeight := path.EndpointA.GetClientState().GetLatestHeight()
 
+	// Increment the time by a week
+	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+
+	// create the consensus state that can be used as trusted height for next update
+	path.EndpointA.UpdateClient()
+	unexpiredHeight := path.EndpointA.GetClientState().GetLatestHeight()
+
 	// expected values that must still remain in store after pruning
-	expectedConsState, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, expiredHeight)
+	expectedConsState, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, unexpiredHeight)
 	suite.Require().True(ok)
 	ctx = path.EndpointA.Chain.GetContext()
 	clientStore = path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
-	expectedProcessTime, ok := types.GetProcessedTime(clientStore, expiredHeight)
+	expectedProcessTime, ok := types.GetProcessedTime(clientStore, unexpiredHeight)
 	suite.Require().True(ok)
-	expectedProcessHeight, ok := types.GetProcessedHeight(clientStore, expiredHeight)
+	expectedProcessHeight, ok := types.GetProcessedHeight(clientStore, unexpiredHeight)
 	suite.Require().True(ok)
-	expectedConsKey := types.GetIterationKey(clientStore, expiredHeight)
+	expectedConsKey := types.GetIterationKey(clientStore, unexpiredHeight)
 	suite.Require().NotNil(expectedConsKey)
 
-	// Increment the time by a week
-	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
-
-	// create the consensus state that can be used as trusted height for next update
-	path.EndpointA.UpdateClient()
-
 	// Increment the time by another week, then update the client.
 	// This will cause the first two consensus states to become expired.
 	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
@@ -413,38 +414,138 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	ctx = path.EndpointA.Chain.GetContext()
 	clientStore = path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
 
-	// check that the first expired consensus state got deleted along with all associated metadata
-	consState, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, pruneHeight)
-	suite.Require().Nil(consState, "expired consensus state not pruned")
-	suite.Require().False(ok)
-	// check processed time metadata is pruned
-	processTime, ok := types.GetProcessedTime(clientStore, pruneHeight)
-	suite.Require().Equal(uint64(0), processTime, "processed time metadata not pruned")
-	suite.Require().False(ok)
-	processHeight, ok := types.GetProcessedHeight(clientStore, pruneHeight)
-	suite.Require().Nil(processHeight, "processed height metadata not pruned")
-	suite.Require().False(ok)
-
-	// check iteration key metadata is pruned
-	consKey := types.GetIterationKey(clientStore, pruneHeight)
-	suite.Require().Nil(consKey, "iteration key not pruned")
-
-	// check that second expired consensus state doesn't get deleted
-	// this ensures that there is a cap on gas cost of UpdateClient
-	consState, ok = path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, expiredHeight)
+	// check that both expired consensus states got deleted along with all associated metadata
+	for _, height := range []exported.Height{pruneHeight, expiredHeight} {
+		consState, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, height)
+		suite.Require().Nil(consState, "expired consensus state not pruned")
+		suite.Require().False(ok)
+		// check processed time metadata is pruned
+		processTime, ok := types.GetProcessedTime(clientStore, height)
+		suite.Require().Equal(uint64(0), processTime, "processed time metadata not pruned")
+		suite.Require().False(ok)
+		processHeight, ok := types.GetProcessedHeight(clientStore, height)
+		suite.Require().Nil(processHeight, "processed height metadata not pruned")
+		suite.Require().False(ok)
+
+		// check iteration key metadata is pruned
+		consKey := types.GetIterationKey(clientStore, height)
+		suite.Require().Nil(consKey, "iteration key not pruned")
+
+		suite.Require().True(types.IsConsensusStatePruned(clientStore, height))
+	}
+
+	// check that the highest pruned height is recorded
+	prunedHeight, ok := types.GetPrunedHeight(clientStore)
+	suite.Require().True(ok)
+	suite.Require().Equal(expiredHeight, prunedHeight)
+
+	// check that the unexpired consensus state doesn't get deleted
+	consState, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, unexpiredHeight)
 	suite.Require().Equal(expectedConsState, consState, "consensus state incorrectly pruned")
 	suite.Require().True(ok)
+	suite.Require().False(types.IsConsensusStatePruned(clientStore, unexpiredHeight))
 	// check processed time metadata is not pruned
-	processTime, ok = types.GetProcessedTime(clientStore, expiredHeight)
+	processTime, ok := types.GetProcessedTime(clientStore, unexpiredHeight)
 	suite.Require().Equal(expectedProcessTime, processTime, "processed time metadata incorrectly pruned")
 	suite.Require().True(ok)
 
 	// check processed height metadata is not pruned
-	processHeight, ok = types.GetProcessedHeight(clientStore, expiredHeight)
+	processHeight, ok := types.GetProcessedHeight(clientStore, unexpiredHeight)
 	suite.Require().Equal(expectedProcessHeight, processHeight, "processed height metadata incorrectly pruned")
 	suite.Require().True(ok)
 
 	// check iteration key metadata is not pruned
-	consKey = types.GetIterationKey(clientStore, expiredHeight)
+	consKey := types.GetIterationKey(clientStore, unexpiredHeight)
 	suite.Require().Equal(expectedConsKey, consKey, "iteration key incorrectly pruned")
 }
+
+// TestPruneConsensusStateLongUpdateSequence tests that the number of consensus states pruned by a
+// single update is bounded and that a backlog of expired consensus states is pruned over successive updates.
+func (suite *TendermintTestSuite) TestPruneConsensusStateLongUpdateSequence() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	numUpdates := types.MaxConsensusStatesPrunedPerUpdate * 2
+	for i := 0; i < numUpdates; i++ {
+		suite.coordinator.IncrementTimeBy(time.Hour)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+	}
+
+	heights := suite.consensusStateHeights(path)
+	suite.Require().Len(heights, numUpdates+1)
+
+	// expire all consensus states stored within the first half of the update sequence
+	expiredCount := types.MaxConsensusStatesPrunedPerUpdate + 2
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod - time.Duration(numUpdates-expiredCount+1)*time.Hour)
+
+	// first update prunes the maximum number of consensus states allowed
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	remaining := suite.consensusStateHeights(path)
+	suite.Require().Len(remaining, numUpdates+2-types.MaxConsensusStatesPrunedPerUpdate)
+	suite.Require().Equal(heights[types.MaxConsensusStatesPrunedPerUpdate], remaining[0])
+
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	prunedHeight, ok := types.GetPrunedHeight(clientStore)
+	suite.Require().True(ok)
+	suite.Require().Equal(heights[types.MaxConsensusStatesPrunedPerUpdate-1], prunedHeight)
+
+	// second update prunes the remaining expired consensus states
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	remaining = suite.consensusStateHeights(path)
+	suite.Require().Len(remaining, numUpdates+3-expiredCount)
+	suite.Require().Equal(heights[expiredCount], remaining[0])
+
+	clientStore = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	prunedHeight, ok = types.GetPrunedHeight(clientStore)
+	suite.Require().True(ok)
+	suite.Require().Equal(heights[expiredCount-1], prunedHeight)
+
+	for i, height := range heights {
+		suite.Require().Equal(i < expiredCount, types.IsConsensusStatePruned(clientStore, height))
+	}
+
+	// the client remains active and further updates prune nothing
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().Len(suite.consensusStateHeights(path), numUpdates+4-expiredCount)
+}
+
+// TestPruneConsensusStateRetention tests that the client state retention override takes precedence
+// over the trusting period and that the latest consensus state is never pruned.
+func (suite *TendermintTestSuite) TestPruneConsensusStateRetention() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	retention := time.Hour
+	clientState := path.EndpointA.GetClientState().(*types.ClientState)
+	clientState.ConsensusStateRetention = &retention
+	path.EndpointA.SetClientState(clientState)
+
+	for i := 0; i < 5; i++ {
+		suite.coordinator.IncrementTimeBy(time.Minute)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+	}
+	suite.Require().Len(suite.consensusStateHeights(path), 6)
+
+	// all consensus states pass the retention period well within the trusting period
+	suite.coordinator.IncrementTimeBy(2 * retention)
+	latestHeight := path.EndpointA.GetClientState().GetLatestHeight()
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	// the previous latest consensus state is retained alongside the newly added consensus state
+	heights := suite.consensusStateHeights(path)
+	suite.Require().Equal([]exported.Height{latestHeight, path.EndpointA.GetClientState().GetLatestHeight()}, heights)
+}
+
+// consensusStateHeights returns the heights of all consensus states stored for the client of endpoint A in ascending order.
+func (suite *TendermintTestSuite) consensusStateHeights(path *ibctesting.Path) []exported.Height {
+	var heights []exported.Height
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	err := types.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		heights = append(heights, height)
+		return false
+	})
+	suite.Require().NoError(err)
+
+	return heights
+}
@@ -103,6 +103,7 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 		cs.MaxClockDrift, tmUpgradeClient.LatestHeight, tmUpgradeClient.ProofSpecs, tmUpgradeClient.UpgradePath,
 		cs.AllowUpdateAfterExpiry, cs.AllowUpdateAfterMisbehaviour,
 	)
+	newClientState.ConsensusStateRetention = cs.ConsensusStateRetention
 
 	if err := newClientState.Validate(); err != nil {
 		return nil, nil, sdkerrors.Wrap(err, "updated client state failed basic validation")
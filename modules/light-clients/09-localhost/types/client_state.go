@@ -114,13 +114,14 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
 }
 
-// VerifyClientState verifies that the localhost client state is stored locally
+// VerifyClientState verifies that the client state of the counterparty client is stored locally.
+// The localhost client verifies against the IBC store of the running chain.
 func (cs ClientState) VerifyClientState(
 	store sdk.KVStore, cdc codec.BinaryCodec,
-	_ exported.Height, _ exported.Prefix, _ string, _ []byte, clientState exported.ClientState,
+	_ exported.Height, _ exported.Prefix, counterpartyClientIdentifier string, _ []byte, clientState exported.ClientState,
 ) error {
-	path := host.KeyClientState
-	bz := store.Get([]byte(path))
+	path := host.FullClientStateKey(counterpartyClientIdentifier)
+	bz := store.Get(path)
 	if bz == nil {
 		return sdkerrors.Wrapf(clienttypes.ErrFailedClientStateVerification,
 			"not found for path: %s", path)
@@ -148,7 +149,8 @@ func (cs ClientState) VerifyClientConsensusState(
 }
 
 // VerifyConnectionState verifies a proof of the connection state of the
-// specified connection end stored locally.
+// specified connection end stored locally. The localhost client verifies against
+// the IBC store of the running chain, the proof is ignored.
 func (cs ClientState) VerifyConnectionState(
 	store sdk.KVStore,
 	cdc codec.BinaryCodec,
@@ -170,6 +172,11 @@ func (cs ClientState) VerifyConnectionState(
 		return err
 	}
 
+	// the connection keeper provides the expected connection end by value
+	if conn, ok := connectionEnd.(connectiontypes.ConnectionEnd); ok {
+		connectionEnd = &conn
+	}
+
 	if !reflect.DeepEqual(&prevConnection, connectionEnd) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedConnectionStateVerification,
@@ -204,6 +211,11 @@ func (cs ClientState) VerifyChannelState(
 		return err
 	}
 
+	// the channel keeper provides the expected channel end by value
+	if ch, ok := channel.(channeltypes.Channel); ok {
+		channel = &ch
+	}
+
 	if !reflect.DeepEqual(&prevChannel, channel) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedChannelStateVerification,
@@ -270,10 +282,11 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketAckVerification, "not found for path %s", path)
 	}
 
-	if !bytes.Equal(data, acknowledgement) {
+	ackCommitment := channeltypes.CommitAcknowledgement(acknowledgement)
+	if !bytes.Equal(data, ackCommitment) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedPacketAckVerification,
-			"ak bytes ≠ previous ack: \n%X\n≠\n%X", acknowledgement, data,
+			"ak bytes ≠ previous ack: \n%X\n≠\n%X", ackCommitment, data,
 		)
 	}
 
@@ -303,6 +316,37 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 		return sdkerrors.Wrap(clienttypes.ErrFailedPacketReceiptVerification, "expected no packet receipt")
 	}
 
+	// the receipts of sequences below the pruning sequence start have been pruned after the packets were received
+	if bz := store.Get(host.PruningSequenceStartKey(portID, channelID)); bz != nil && sequence < sdk.BigEndianToUint64(bz) {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketReceiptVerification, "packet receipt for sequence %d has been pruned", sequence)
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitmentAbsence verifies a proof of the absence of an
+// outgoing packet commitment at the specified port, specified channel, and
+// specified sequence.
+func (cs ClientState) VerifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	path := host.PacketCommitmentKey(portID, channelID, sequence)
+
+	data := store.Get(path)
+	if data != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketCommitmentVerification, "expected no packet commitment")
+	}
+
 	return nil
 }
 
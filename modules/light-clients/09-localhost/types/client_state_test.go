@@ -108,7 +108,7 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 			clientState: clientState,
 			malleate: func() {
 				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
-				suite.store.Set(host.ClientStateKey(), bz)
+				suite.store.Set(host.FullClientStateKey(exported.Localhost), bz)
 			},
 			counterparty: clientState,
 			expPass:      true,
@@ -118,7 +118,7 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 			clientState: clientState,
 			malleate: func() {
 				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
-				suite.store.Set(host.ClientStateKey(), bz)
+				suite.store.Set(host.FullClientStateKey(exported.Localhost), bz)
 			},
 			counterparty: invalidClient,
 			expPass:      false,
@@ -140,7 +140,7 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 			tc.malleate()
 
 			err := tc.clientState.VerifyClientState(
-				suite.store, suite.cdc, clienttypes.NewHeight(0, 10), nil, "", []byte{}, tc.counterparty,
+				suite.store, suite.cdc, clienttypes.NewHeight(0, 10), nil, exported.Localhost, []byte{}, tc.counterparty,
 			)
 
 			if tc.expPass {
@@ -399,7 +399,7 @@ func (suite *LocalhostTestSuite) TestVerifyPacketAcknowledgement() {
 			clientState: types.NewClientState("chainID", clientHeight),
 			malleate: func() {
 				suite.store.Set(
-					host.PacketAcknowledgementKey(testPortID, testChannelID, testSequence), []byte("acknowledgement"),
+					host.PacketAcknowledgementKey(testPortID, testChannelID, testSequence), channeltypes.CommitAcknowledgement([]byte("acknowledgement")),
 				)
 			},
 			ack:     []byte("acknowledgement"),
@@ -460,6 +460,31 @@ func (suite *LocalhostTestSuite) TestVerifyPacketReceiptAbsence() {
 		suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, nil, testPortID, testChannelID, testSequence,
 	)
 	suite.Require().Error(err, "receipt exists in store")
+
+	suite.store.Delete(host.PacketReceiptKey(testPortID, testChannelID, testSequence))
+	suite.store.Set(host.PruningSequenceStartKey(testPortID, testChannelID), sdk.Uint64ToBigEndian(testSequence+1))
+
+	err = clientState.VerifyPacketReceiptAbsence(
+		suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, nil, testPortID, testChannelID, testSequence,
+	)
+	suite.Require().Error(err, "receipt has been pruned")
+}
+
+func (suite *LocalhostTestSuite) TestVerifyPacketCommitmentAbsence() {
+	clientState := types.NewClientState("chainID", clientHeight)
+
+	err := clientState.VerifyPacketCommitmentAbsence(
+		suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, nil, testPortID, testChannelID, testSequence,
+	)
+
+	suite.Require().NoError(err, "commitment absence failed")
+
+	suite.store.Set(host.PacketCommitmentKey(testPortID, testChannelID, testSequence), []byte("commitment"))
+
+	err = clientState.VerifyPacketCommitmentAbsence(
+		suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, nil, testPortID, testChannelID, testSequence,
+	)
+	suite.Require().Error(err, "commitment exists in store")
 }
 
 func (suite *LocalhostTestSuite) TestVerifyNextSeqRecv() {
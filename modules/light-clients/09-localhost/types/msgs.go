@@ -0,0 +1,25 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// SentinelProof is the proof supplied in messages verified by the localhost client. The localhost
+// client verifies directly against the IBC store of the running chain and ignores proofs, but
+// message validation requires a non-empty proof.
+var SentinelProof = []byte{0x01}
+
+// NewMsgRecvPacket returns a MsgRecvPacket for a packet sent over the localhost connection.
+// Since the packet commitment is verified against the IBC store, the packet may be received
+// in the same block or transaction in which it was sent. The proof height must be non-zero,
+// the latest height of the localhost client may be used.
+func NewMsgRecvPacket(packet channeltypes.Packet, proofHeight clienttypes.Height, signer string) *channeltypes.MsgRecvPacket {
+	return channeltypes.NewMsgRecvPacket(packet, SentinelProof, proofHeight, signer)
+}
+
+// NewMsgAcknowledgement returns a MsgAcknowledgement for a packet sent over the localhost
+// connection, with the acknowledgement written on receipt of the packet.
+func NewMsgAcknowledgement(packet channeltypes.Packet, ack []byte, proofHeight clienttypes.Height, signer string) *channeltypes.MsgAcknowledgement {
+	return channeltypes.NewMsgAcknowledgement(packet, ack, SentinelProof, proofHeight, signer)
+}
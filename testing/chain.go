@@ -26,6 +26,7 @@ import (
 	tmversion "github.com/tendermint/tendermint/version"
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channelkeeper "github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
@@ -540,8 +541,13 @@ func (chain *TestChain) CreatePortCapability(scopedKeeper capabilitykeeper.Scope
 }
 
 // GetPortCapability returns the port capability for the given portID. The capability must
-// exist, otherwise testing will fail.
+// exist, otherwise testing will fail. Ports statically owned by a module registered on the
+// IBC router have no capability, in which case nil is returned.
 func (chain *TestChain) GetPortCapability(portID string) *capabilitytypes.Capability {
+	if _, ok := chain.App.GetIBCKeeper().PortKeeper.StaticPortOwner(portID); ok {
+		return nil
+	}
+
 	cap, ok := chain.App.GetScopedIBCKeeper().GetCapability(chain.GetContext(), host.PortPath(portID))
 	require.True(chain.T, ok)
 
@@ -566,14 +572,32 @@ func (chain *TestChain) CreateChannelCapability(scopedKeeper capabilitykeeper.Sc
 }
 
 // GetChannelCapability returns the channel capability for the given portID and channelID.
-// The capability must exist, otherwise testing will fail.
+// The capability must exist, otherwise testing will fail. Channels on ports statically owned
+// by a module registered on the IBC router have no capability, in which case nil is returned.
 func (chain *TestChain) GetChannelCapability(portID, channelID string) *capabilitytypes.Capability {
+	if _, ok := chain.App.GetIBCKeeper().PortKeeper.StaticPortOwner(portID); ok {
+		return nil
+	}
+
 	cap, ok := chain.App.GetScopedIBCKeeper().GetCapability(chain.GetContext(), host.ChannelCapabilityPath(portID, channelID))
 	require.True(chain.T, ok)
 
 	return cap
 }
 
+// GetChannelKeeper returns the channel keeper of the chain for use by the module owning the given portID.
+// The channel keeper scoped to the owning module when the SimApp is wired is returned for ports statically
+// owned by a module registered on the IBC router, as channels on these ports are not authenticated using
+// capabilities.
+func (chain *TestChain) GetChannelKeeper(portID string) channelkeeper.Keeper {
+	if module, ok := chain.App.GetIBCKeeper().PortKeeper.StaticPortOwner(portID); ok {
+		require.Equal(chain.T, simapp.MockStaticPort, module)
+		return chain.GetSimApp().StaticMockChannelKeeper
+	}
+
+	return chain.App.GetIBCKeeper().ChannelKeeper
+}
+
 // GetTimeoutHeight is a convenience function which returns a IBC packet timeout height
 // to be used for testing. It returns the current IBC height + 100 blocks
 func (chain *TestChain) GetTimeoutHeight() clienttypes.Height {
@@ -1,6 +1,7 @@
 package ibctesting
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -57,62 +58,132 @@ func ParseChannelIDFromEvents(events sdk.Events) (string, error) {
 	return "", fmt.Errorf("channel identifier event attribute not found")
 }
 
-// ParsePacketFromEvents parses events emitted from a MsgRecvPacket and returns the
-// acknowledgement.
-func ParsePacketFromEvents(events sdk.Events) (channeltypes.Packet, error) {
-	for _, ev := range events {
-		if ev.Type == channeltypes.EventTypeSendPacket {
-			packet := channeltypes.Packet{}
-			for _, attr := range ev.Attributes {
-				switch string(attr.Key) {
-				case channeltypes.AttributeKeyData:
-					packet.Data = attr.Value
+// PacketEventAttributes contains the packet and packet metadata parsed from a packet event.
+type PacketEventAttributes struct {
+	Packet          channeltypes.Packet
+	ChannelOrdering channeltypes.Order
+	ConnectionID    string
+	// Acknowledgement is only set for write acknowledgement events
+	Acknowledgement []byte
+}
 
-				case channeltypes.AttributeKeySequence:
-					seq, err := strconv.ParseUint(string(attr.Value), 10, 64)
-					if err != nil {
-						return channeltypes.Packet{}, err
-					}
+// ParsePacketEventAttributes parses the attributes of a send_packet, recv_packet,
+// write_acknowledgement, acknowledge_packet or timeout_packet event. The hex encoded
+// packet data and acknowledgement attributes take precedence over the deprecated
+// attributes. An error is returned for any other event type.
+func ParsePacketEventAttributes(event sdk.Event) (PacketEventAttributes, error) {
+	switch event.Type {
+	case channeltypes.EventTypeSendPacket, channeltypes.EventTypeRecvPacket, channeltypes.EventTypeWriteAck,
+		channeltypes.EventTypeAcknowledgePacket, channeltypes.EventTypeTimeoutPacket:
+	default:
+		return PacketEventAttributes{}, fmt.Errorf("event type %s is not a packet event", event.Type)
+	}
 
-					packet.Sequence = seq
+	var (
+		attributes PacketEventAttributes
+		hexData    bool
+		hexAck     bool
+	)
+
+	for _, attr := range event.Attributes {
+		switch string(attr.Key) {
+		case channeltypes.AttributeKeyData:
+			if !hexData {
+				attributes.Packet.Data = attr.Value
+			}
 
-				case channeltypes.AttributeKeySrcPort:
-					packet.SourcePort = string(attr.Value)
+		case channeltypes.AttributeKeyDataHex:
+			data, err := hex.DecodeString(string(attr.Value))
+			if err != nil {
+				return PacketEventAttributes{}, err
+			}
 
-				case channeltypes.AttributeKeySrcChannel:
-					packet.SourceChannel = string(attr.Value)
+			attributes.Packet.Data = data
+			hexData = true
 
-				case channeltypes.AttributeKeyDstPort:
-					packet.DestinationPort = string(attr.Value)
+		case channeltypes.AttributeKeyAck:
+			if !hexAck {
+				attributes.Acknowledgement = attr.Value
+			}
 
-				case channeltypes.AttributeKeyDstChannel:
-					packet.DestinationChannel = string(attr.Value)
+		case channeltypes.AttributeKeyAckHex:
+			ack, err := hex.DecodeString(string(attr.Value))
+			if err != nil {
+				return PacketEventAttributes{}, err
+			}
 
-				case channeltypes.AttributeKeyTimeoutHeight:
-					height, err := clienttypes.ParseHeight(string(attr.Value))
-					if err != nil {
-						return channeltypes.Packet{}, err
-					}
+			attributes.Acknowledgement = ack
+			hexAck = true
 
-					packet.TimeoutHeight = height
+		case channeltypes.AttributeKeySequence:
+			seq, err := strconv.ParseUint(string(attr.Value), 10, 64)
+			if err != nil {
+				return PacketEventAttributes{}, err
+			}
 
-				case channeltypes.AttributeKeyTimeoutTimestamp:
-					timestamp, err := strconv.ParseUint(string(attr.Value), 10, 64)
-					if err != nil {
-						return channeltypes.Packet{}, err
-					}
+			attributes.Packet.Sequence = seq
 
-					packet.TimeoutTimestamp = timestamp
+		case channeltypes.AttributeKeySrcPort:
+			attributes.Packet.SourcePort = string(attr.Value)
 
-				default:
-					continue
-				}
+		case channeltypes.AttributeKeySrcChannel:
+			attributes.Packet.SourceChannel = string(attr.Value)
+
+		case channeltypes.AttributeKeyDstPort:
+			attributes.Packet.DestinationPort = string(attr.Value)
+
+		case channeltypes.AttributeKeyDstChannel:
+			attributes.Packet.DestinationChannel = string(attr.Value)
+
+		case channeltypes.AttributeKeyTimeoutHeight:
+			height, err := clienttypes.ParseHeight(string(attr.Value))
+			if err != nil {
+				return PacketEventAttributes{}, err
+			}
+
+			attributes.Packet.TimeoutHeight = height
+
+		case channeltypes.AttributeKeyTimeoutTimestamp:
+			timestamp, err := strconv.ParseUint(string(attr.Value), 10, 64)
+			if err != nil {
+				return PacketEventAttributes{}, err
 			}
 
-			return packet, nil
+			attributes.Packet.TimeoutTimestamp = timestamp
+
+		case channeltypes.AttributeKeyChannelOrdering:
+			order, ok := channeltypes.Order_value[string(attr.Value)]
+			if !ok {
+				return PacketEventAttributes{}, fmt.Errorf("invalid channel ordering %s", attr.Value)
+			}
+
+			attributes.ChannelOrdering = channeltypes.Order(order)
+
+		case channeltypes.AttributeKeyConnection:
+			attributes.ConnectionID = string(attr.Value)
+
+		default:
+			continue
 		}
 	}
-	return channeltypes.Packet{}, fmt.Errorf("acknowledgement event attribute not found")
+
+	return attributes, nil
+}
+
+// ParsePacketFromEvents parses events emitted from a send packet and returns the
+// packet.
+func ParsePacketFromEvents(events sdk.Events) (channeltypes.Packet, error) {
+	for _, ev := range events {
+		if ev.Type == channeltypes.EventTypeSendPacket {
+			attributes, err := ParsePacketEventAttributes(ev)
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
+
+			return attributes.Packet, nil
+		}
+	}
+	return channeltypes.Packet{}, fmt.Errorf("send packet event not found")
 }
 
 // ParseAckFromEvents parses events emitted from a MsgRecvPacket and returns the
@@ -120,10 +191,13 @@ func ParsePacketFromEvents(events sdk.Events) (channeltypes.Packet, error) {
 func ParseAckFromEvents(events sdk.Events) ([]byte, error) {
 	for _, ev := range events {
 		if ev.Type == channeltypes.EventTypeWriteAck {
-			for _, attr := range ev.Attributes {
-				if string(attr.Key) == channeltypes.AttributeKeyAck {
-					return attr.Value, nil
-				}
+			attributes, err := ParsePacketEventAttributes(ev)
+			if err != nil {
+				return nil, err
+			}
+
+			if attributes.Acknowledgement != nil {
+				return attributes.Acknowledgement, nil
 			}
 		}
 	}
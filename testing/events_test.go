@@ -0,0 +1,139 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channelkeeper "github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestParsePacketEventAttributes(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+
+	// packet data which is not valid utf8 is only preserved by the hex encoded attribute
+	packet := channeltypes.NewPacket([]byte{0xff, 0x00, 0x01}, 1, ibctesting.MockPort, "channel-0", ibctesting.MockPort, "channel-1", clienttypes.NewHeight(1, 100), 200)
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(ibctesting.MockPort, "channel-1"), []string{"connection-3"}, ibctesting.DefaultChannelVersion)
+	ack := []byte{0xfe, 0x01}
+
+	testCases := []struct {
+		name      string
+		eventType string
+		emit      func(ctx sdk.Context)
+		expAck    []byte
+	}{
+		{
+			"send packet",
+			channeltypes.EventTypeSendPacket,
+			func(ctx sdk.Context) {
+				channelkeeper.EmitSendPacketEvent(ctx, packet, channel, packet.GetTimeoutHeight())
+			},
+			nil,
+		},
+		{
+			"recv packet",
+			channeltypes.EventTypeRecvPacket,
+			func(ctx sdk.Context) {
+				channelkeeper.EmitRecvPacketEvent(ctx, packet, channel)
+			},
+			nil,
+		},
+		{
+			"write acknowledgement",
+			channeltypes.EventTypeWriteAck,
+			func(ctx sdk.Context) {
+				channelkeeper.EmitWriteAcknowledgementEvent(ctx, packet, channel, ack)
+			},
+			ack,
+		},
+		{
+			"acknowledge packet",
+			channeltypes.EventTypeAcknowledgePacket,
+			func(ctx sdk.Context) {
+				channelkeeper.EmitAcknowledgePacketEvent(ctx, packet, channel)
+			},
+			nil,
+		},
+		{
+			"timeout packet",
+			channeltypes.EventTypeTimeoutPacket,
+			func(ctx sdk.Context) {
+				channelkeeper.EmitTimeoutPacketEvent(ctx, packet, channel)
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := chain.GetContext().WithEventManager(sdk.NewEventManager())
+			tc.emit(ctx)
+
+			events := ctx.EventManager().Events()
+			var found bool
+			for _, event := range events {
+				if event.Type != tc.eventType {
+					continue
+				}
+
+				found = true
+
+				attributes, err := ibctesting.ParsePacketEventAttributes(event)
+				require.NoError(t, err)
+				require.Equal(t, packet, attributes.Packet)
+				require.Equal(t, channeltypes.ORDERED, attributes.ChannelOrdering)
+				require.Equal(t, "connection-3", attributes.ConnectionID)
+				require.Equal(t, tc.expAck, attributes.Acknowledgement)
+			}
+
+			require.True(t, found)
+
+			// attribute values are deterministic
+			ctx = chain.GetContext().WithEventManager(sdk.NewEventManager())
+			tc.emit(ctx)
+			require.Equal(t, events, ctx.EventManager().Events())
+		})
+	}
+
+	_, err := ibctesting.ParsePacketEventAttributes(sdk.NewEvent(channeltypes.EventTypeChannelOpenInit))
+	require.Error(t, err)
+}
+
+func TestParsePacketAndAckFromEvents(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	coord.Setup(path)
+
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	ctx := chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	channelkeeper.EmitSendPacketEvent(ctx, packet, path.EndpointA.GetChannel(), timeoutHeight)
+
+	parsedPacket, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	require.NoError(t, err)
+	require.Equal(t, packet, parsedPacket)
+
+	ctx = chainB.GetContext().WithEventManager(sdk.NewEventManager())
+	channelkeeper.EmitWriteAcknowledgementEvent(ctx, packet, path.EndpointB.GetChannel(), ibctesting.MockAcknowledgement)
+
+	ack, err := ibctesting.ParseAckFromEvents(ctx.EventManager().Events())
+	require.NoError(t, err)
+	require.Equal(t, ibctesting.MockAcknowledgement, ack)
+
+	_, err = ibctesting.ParsePacketFromEvents(sdk.Events{})
+	require.Error(t, err)
+
+	_, err = ibctesting.ParseAckFromEvents(sdk.Events{})
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package ibctesting
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// SetupICAPath registers an interchain account for the provided owner on the connection of EndpointA and completes
+// the channel handshake with the interchain accounts host submodule on EndpointB. The connections of the path must
+// already be open. The endpoints are configured with the controller port identifier of the owner, the host port
+// identifier, ORDERED channels and the default interchain accounts metadata of the path connections. The interchain
+// account is registered through the controller keeper, such that the channel capability is claimed by the mock
+// authentication module of the SimApp.
+func SetupICAPath(path *Path, owner string) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	version := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelID = ""
+
+	controller := path.EndpointA.Chain
+	channelSequence := controller.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(controller.GetContext())
+
+	if err := controller.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(controller.GetContext(), path.EndpointA.ConnectionID, owner, version); err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	controller.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
+// GetICAAddress returns the address of the interchain account registered by the provided owner on the connection of
+// the endpoint of the path belonging to the chain, as stored by the interchain accounts controller submodule.
+// An empty string is returned if no interchain account is registered for the owner.
+func (chain *TestChain) GetICAAddress(path *Path, owner string) string {
+	endpoint, err := path.endpointOf(chain)
+	require.NoError(chain.T, err)
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(chain.T, err)
+
+	address, _ := chain.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(chain.GetContext(), endpoint.ConnectionID, portID)
+	return address
+}
+
+// SendICATx serializes the provided messages using the encoding of the interchain account channel of the owner and
+// sends them to the host chain for execution by the interchain account, using the channel capability of the mock
+// authentication module. The packet times out after the provided duration relative to the current block time of the
+// chain. The packet is relayed to the counterparty endpoint of the path and the acknowledgement written by the host
+// chain is relayed back and returned. An error is returned if the packet cannot be sent or relayed, or if no
+// acknowledgement is written.
+func (chain *TestChain) SendICATx(path *Path, owner string, msgs []sdk.Msg, timeout time.Duration) (channeltypes.Acknowledgement, error) {
+	_, ack, err := chain.SendICATxWithResult(path, owner, msgs, timeout)
+	return ack, err
+}
+
+// SendICATxWithResult performs the same steps as SendICATx and additionally returns the result of the transaction
+// receiving the packet on the counterparty chain, such that the events emitted by the host chain can be inspected.
+func (chain *TestChain) SendICATxWithResult(path *Path, owner string, msgs []sdk.Msg, timeout time.Duration) (*sdk.Result, channeltypes.Acknowledgement, error) {
+	endpoint, err := path.endpointOf(chain)
+	if err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	controllerKeeper := chain.GetSimApp().ICAControllerKeeper
+
+	channelID, found := controllerKeeper.GetOpenActiveChannel(chain.GetContext(), endpoint.ConnectionID, portID)
+	if !found {
+		return nil, channeltypes.Acknowledgement{}, fmt.Errorf("no open active channel for port %s on connection %s", portID, endpoint.ConnectionID)
+	}
+
+	appVersion, found := chain.App.GetIBCKeeper().ChannelKeeper.GetAppVersion(chain.GetContext(), portID, channelID)
+	if !found {
+		return nil, channeltypes.Acknowledgement{}, fmt.Errorf("no version found for channel %s on port %s", channelID, portID)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(appVersion)
+	if err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(chain.App.AppCodec(), msgs, metadata.Encoding)
+	if err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	chanCap, found := chain.GetSimApp().ScopedICAMockKeeper.GetCapability(chain.GetContext(), host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return nil, channeltypes.Acknowledgement{}, fmt.Errorf("no channel capability found for channel %s on port %s", channelID, portID)
+	}
+
+	ctx := chain.GetContext()
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	if _, err := controllerKeeper.SendTx(ctx, chanCap, endpoint.ConnectionID, portID, packetData, timeoutTimestamp); err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	packet, err := ParsePacketFromEvents(ctx.EventManager().Events())
+	if err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	// commit the packet commitment for proof verification
+	chain.NextBlock()
+	chain.Coordinator.IncrementTime()
+
+	res, bz, err := path.RelayPacketWithResult(packet)
+	if err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	if bz == nil {
+		return nil, channeltypes.Acknowledgement{}, fmt.Errorf("no acknowledgement written for packet with sequence %d", packet.GetSequence())
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return nil, channeltypes.Acknowledgement{}, err
+	}
+
+	return res, ack, nil
+}
+
+// endpointOf returns the endpoint of the path belonging to the provided chain.
+func (path *Path) endpointOf(chain *TestChain) (*Endpoint, error) {
+	switch chain {
+	case path.EndpointA.Chain:
+		return path.EndpointA, nil
+	case path.EndpointB.Chain:
+		return path.EndpointB, nil
+	default:
+		return nil, fmt.Errorf("chain %s is not an endpoint of the path", chain.ChainID)
+	}
+}
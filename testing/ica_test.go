@@ -0,0 +1,57 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestSendICATx(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 3)
+	controller, hostChain := coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2))
+	owner := controller.SenderAccount.GetAddress().String()
+
+	path := ibctesting.NewPath(controller, hostChain)
+	coord.SetupConnections(path)
+
+	require.Empty(t, controller.GetICAAddress(path, owner))
+	require.NoError(t, ibctesting.SetupICAPath(path, owner))
+
+	icaAddr := controller.GetICAAddress(path, owner)
+	hostAddr, found := hostChain.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(hostChain.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	require.True(t, found)
+	require.Equal(t, hostAddr, icaAddr)
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.NoError(t, hostChain.GetSimApp().BankKeeper.SendCoins(hostChain.GetContext(), hostChain.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(icaAddr), amount))
+
+	msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), hostChain.SenderAccount.GetAddress(), amount)
+
+	// no messages are allowed by the default host params
+	ack, err := controller.SendICATx(path, owner, []sdk.Msg{msg}, time.Hour)
+	require.NoError(t, err)
+	require.False(t, ack.Success())
+
+	params := icahosttypes.DefaultParams()
+	params.AllowMessages = []string{sdk.MsgTypeURL(msg)}
+	hostChain.GetSimApp().ICAHostKeeper.SetParams(hostChain.GetContext(), params)
+
+	ack, err = controller.SendICATx(path, owner, []sdk.Msg{msg}, time.Hour)
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+	require.True(t, hostChain.GetSimApp().BankKeeper.GetAllBalances(hostChain.GetContext(), sdk.MustAccAddressFromBech32(icaAddr)).IsZero())
+
+	// no interchain account is registered for other owners
+	_, err = controller.SendICATx(path, hostChain.SenderAccount.GetAddress().String(), []sdk.Msg{msg}, time.Hour)
+	require.Error(t, err)
+
+	// the chain must be an endpoint of the path
+	_, err = coord.GetChain(ibctesting.GetChainID(3)).SendICATx(path, owner, []sdk.Msg{msg}, time.Hour)
+	require.Error(t, err)
+}
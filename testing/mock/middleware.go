@@ -0,0 +1,289 @@
+package mock
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware is a configurable mock IBC middleware. It records every callback invoked on it, may be configured to
+// fail specific callbacks and otherwise passes the callbacks through to the wrapped application. If no application is
+// wrapped, IBCMiddleware acts as the base application of the stack: it accepts the proposed channel versions, returns
+// MockAcknowledgement for received packets and does not claim channel capabilities.
+//
+// The failure flags may be set after the middleware has been added to the IBC router, as the router holds a pointer
+// to the middleware.
+type IBCMiddleware struct {
+	// Name identifies the middleware in the callback invocations recorded by the Recorder
+	Name string
+	// Recorder records the callbacks invoked on the middleware, recording is disabled if nil
+	Recorder *CallbackRecorder
+
+	FailOnChanOpenInit          bool
+	FailOnChanOpenTry           bool
+	FailOnChanOpenAck           bool
+	FailOnChanOpenConfirm       bool
+	FailOnChanCloseInit         bool
+	FailOnChanCloseConfirm      bool
+	FailOnRecvPacket            bool
+	FailOnAcknowledgementPacket bool
+	FailOnTimeoutPacket         bool
+
+	// Err is the error returned by the callbacks configured to fail, MockApplicationCallbackError is returned if nil.
+	// OnRecvPacket returns an error acknowledgement containing the error.
+	Err error
+
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+}
+
+// NewIBCMiddleware creates a new mock IBCMiddleware identified by the provided name. The provided application may be nil,
+// in which case the middleware acts as the base application of the stack. The ICS4Wrapper is used to pass through packets
+// sent by the application and may be nil if the middleware is not used to send packets.
+func NewIBCMiddleware(name string, app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, recorder *CallbackRecorder) *IBCMiddleware {
+	return &IBCMiddleware{
+		Name:        name,
+		Recorder:    recorder,
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im *IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string,
+	channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version string,
+) (string, error) {
+	im.record(CallbackInvocation{
+		Callback:       CallbackOnChanOpenInit,
+		Order:          order,
+		ConnectionHops: connectionHops,
+		PortID:         portID,
+		ChannelID:      channelID,
+		Counterparty:   counterparty,
+		Version:        version,
+	})
+
+	if im.FailOnChanOpenInit {
+		return "", im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = Version
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im *IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string,
+	channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, counterpartyVersion string,
+) (string, error) {
+	im.record(CallbackInvocation{
+		Callback:       CallbackOnChanOpenTry,
+		Order:          order,
+		ConnectionHops: connectionHops,
+		PortID:         portID,
+		ChannelID:      channelID,
+		Counterparty:   counterparty,
+		Version:        counterpartyVersion,
+	})
+
+	if im.FailOnChanOpenTry {
+		return "", im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im *IBCMiddleware) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	im.record(CallbackInvocation{
+		Callback:              CallbackOnChanOpenAck,
+		PortID:                portID,
+		ChannelID:             channelID,
+		CounterpartyChannelID: counterpartyChannelID,
+		Version:               counterpartyVersion,
+	})
+
+	if im.FailOnChanOpenAck {
+		return im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im *IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	im.record(CallbackInvocation{
+		Callback:  CallbackOnChanOpenConfirm,
+		PortID:    portID,
+		ChannelID: channelID,
+	})
+
+	if im.FailOnChanOpenConfirm {
+		return im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+	}
+
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im *IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	im.record(CallbackInvocation{
+		Callback:  CallbackOnChanCloseInit,
+		PortID:    portID,
+		ChannelID: channelID,
+	})
+
+	if im.FailOnChanCloseInit {
+		return im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnChanCloseInit(ctx, portID, channelID)
+	}
+
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im *IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	im.record(CallbackInvocation{
+		Callback:  CallbackOnChanCloseConfirm,
+		PortID:    portID,
+		ChannelID: channelID,
+	})
+
+	if im.FailOnChanCloseConfirm {
+		return im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+	}
+
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im *IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	im.record(CallbackInvocation{
+		Callback: CallbackOnRecvPacket,
+		Packet:   packet,
+		Relayer:  relayer,
+	})
+
+	if im.FailOnRecvPacket {
+		return channeltypes.NewErrorAcknowledgementWithCodespace(im.err())
+	}
+
+	if im.app != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	return MockAcknowledgement
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im *IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	im.record(CallbackInvocation{
+		Callback:        CallbackOnAcknowledgementPacket,
+		Packet:          packet,
+		Acknowledgement: acknowledgement,
+		Relayer:         relayer,
+	})
+
+	if im.FailOnAcknowledgementPacket {
+		return im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im *IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	im.record(CallbackInvocation{
+		Callback: CallbackOnTimeoutPacket,
+		Packet:   packet,
+		Relayer:  relayer,
+	})
+
+	if im.FailOnTimeoutPacket {
+		return im.err()
+	}
+
+	if im.app != nil {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	return nil
+}
+
+// SendPacket implements the ICS4Wrapper interface.
+func (im *IBCMiddleware) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (im *IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (im *IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ResetFailures disables the failure of all callbacks and resets the returned error.
+func (im *IBCMiddleware) ResetFailures() {
+	*im = IBCMiddleware{
+		Name:        im.Name,
+		Recorder:    im.Recorder,
+		app:         im.app,
+		ics4Wrapper: im.ics4Wrapper,
+	}
+}
+
+// record records the provided callback invocation under the name of the middleware
+func (im *IBCMiddleware) record(invocation CallbackInvocation) {
+	invocation.Module = im.Name
+	im.Recorder.record(invocation)
+}
+
+// err returns the error returned by the callbacks configured to fail
+func (im *IBCMiddleware) err() error {
+	if im.Err != nil {
+		return im.Err
+	}
+
+	return MockApplicationCallbackError
+}
@@ -0,0 +1,100 @@
+package mock_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/testing/mock"
+)
+
+func TestIBCMiddlewareStack(t *testing.T) {
+	var (
+		ctx          sdk.Context
+		counterparty = channeltypes.NewCounterparty(mock.PortID, "channel-1")
+		packet       = channeltypes.NewPacket(mock.MockPacketData, 1, mock.PortID, "channel-0", mock.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	)
+
+	recorder := mock.NewCallbackRecorder()
+	base := mock.NewIBCMiddleware("base", nil, nil, recorder)
+	middleware := mock.NewIBCMiddleware("middleware", base, nil, recorder)
+
+	version, err := middleware.OnChanOpenInit(ctx, channeltypes.UNORDERED, []string{"connection-0"}, mock.PortID, "channel-0", nil, counterparty, "")
+	require.NoError(t, err)
+	require.Equal(t, mock.Version, version)
+
+	version, err = middleware.OnChanOpenTry(ctx, channeltypes.UNORDERED, []string{"connection-0"}, mock.PortID, "channel-0", nil, counterparty, "counterparty-version")
+	require.NoError(t, err)
+	require.Equal(t, "counterparty-version", version)
+
+	require.NoError(t, middleware.OnChanOpenAck(ctx, mock.PortID, "channel-0", "channel-1", mock.Version))
+	require.NoError(t, middleware.OnChanOpenConfirm(ctx, mock.PortID, "channel-0"))
+	require.NoError(t, middleware.OnChanCloseInit(ctx, mock.PortID, "channel-0"))
+	require.NoError(t, middleware.OnChanCloseConfirm(ctx, mock.PortID, "channel-0"))
+	require.Equal(t, mock.MockAcknowledgement, middleware.OnRecvPacket(ctx, packet, sdk.AccAddress("relayer")))
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, mock.MockAcknowledgement.Acknowledgement(), sdk.AccAddress("relayer")))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, sdk.AccAddress("relayer")))
+
+	var expCallbacks []string
+	for _, callback := range []string{
+		mock.CallbackOnChanOpenInit, mock.CallbackOnChanOpenTry, mock.CallbackOnChanOpenAck, mock.CallbackOnChanOpenConfirm,
+		mock.CallbackOnChanCloseInit, mock.CallbackOnChanCloseConfirm, mock.CallbackOnRecvPacket,
+		mock.CallbackOnAcknowledgementPacket, mock.CallbackOnTimeoutPacket,
+	} {
+		expCallbacks = append(expCallbacks, "middleware/"+callback, "base/"+callback)
+	}
+	require.Equal(t, expCallbacks, recorder.Callbacks())
+
+	invocations := recorder.Invocations()
+	require.Equal(t, []string{"connection-0"}, invocations[2].ConnectionHops)
+	require.Equal(t, counterparty, invocations[2].Counterparty)
+	require.Equal(t, "counterparty-version", invocations[2].Version)
+	require.Equal(t, "channel-1", invocations[4].CounterpartyChannelID)
+	require.Equal(t, packet, invocations[12].Packet)
+	require.Equal(t, mock.MockAcknowledgement.Acknowledgement(), invocations[14].Acknowledgement)
+	require.Equal(t, sdk.AccAddress("relayer"), invocations[16].Relayer)
+
+	recorder.Reset()
+	require.Empty(t, recorder.Callbacks())
+}
+
+func TestIBCMiddlewareFailures(t *testing.T) {
+	var (
+		ctx          sdk.Context
+		counterparty = channeltypes.NewCounterparty(mock.PortID, "channel-1")
+		packet       = channeltypes.NewPacket(mock.MockPacketData, 1, mock.PortID, "channel-0", mock.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	)
+
+	recorder := mock.NewCallbackRecorder()
+	base := mock.NewIBCMiddleware("base", nil, nil, recorder)
+	middleware := mock.NewIBCMiddleware("middleware", base, nil, recorder)
+
+	// failures of the base application are returned through the middleware
+	base.FailOnChanOpenTry = true
+	_, err := middleware.OnChanOpenTry(ctx, channeltypes.UNORDERED, []string{"connection-0"}, mock.PortID, "channel-0", nil, counterparty, mock.Version)
+	require.ErrorIs(t, err, mock.MockApplicationCallbackError)
+	require.Equal(t, []string{"middleware/OnChanOpenTry", "base/OnChanOpenTry"}, recorder.Callbacks())
+
+	// a failing middleware does not call the underlying application
+	recorder.Reset()
+	expErr := fmt.Errorf("custom error")
+	middleware.FailOnChanOpenInit = true
+	middleware.Err = expErr
+	_, err = middleware.OnChanOpenInit(ctx, channeltypes.UNORDERED, []string{"connection-0"}, mock.PortID, "channel-0", nil, counterparty, mock.Version)
+	require.ErrorIs(t, err, expErr)
+	require.Equal(t, []string{"middleware/OnChanOpenInit"}, recorder.Callbacks())
+
+	middleware.FailOnRecvPacket = true
+	ack := middleware.OnRecvPacket(ctx, packet, sdk.AccAddress("relayer"))
+	require.False(t, ack.Success())
+	require.Equal(t, channeltypes.NewErrorAcknowledgementWithCodespace(expErr), ack)
+
+	middleware.ResetFailures()
+	require.Equal(t, "middleware", middleware.Name)
+	require.Equal(t, recorder, middleware.Recorder)
+	require.Equal(t, mock.MockAcknowledgement, middleware.OnRecvPacket(ctx, packet, sdk.AccAddress("relayer")))
+}
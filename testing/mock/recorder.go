@@ -0,0 +1,90 @@
+package mock
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// Names of the IBC application callbacks recorded by the CallbackRecorder.
+const (
+	CallbackOnChanOpenInit          = "OnChanOpenInit"
+	CallbackOnChanOpenTry           = "OnChanOpenTry"
+	CallbackOnChanOpenAck           = "OnChanOpenAck"
+	CallbackOnChanOpenConfirm       = "OnChanOpenConfirm"
+	CallbackOnChanCloseInit         = "OnChanCloseInit"
+	CallbackOnChanCloseConfirm      = "OnChanCloseConfirm"
+	CallbackOnRecvPacket            = "OnRecvPacket"
+	CallbackOnAcknowledgementPacket = "OnAcknowledgementPacket"
+	CallbackOnTimeoutPacket         = "OnTimeoutPacket"
+)
+
+// CallbackInvocation contains the arguments of a single IBC application callback invoked on a mock IBC middleware.
+// Only the fields applicable to the callback are set.
+type CallbackInvocation struct {
+	// Module is the name of the mock IBC middleware the callback was invoked on
+	Module string
+	// Callback is the name of the invoked callback, e.g. CallbackOnChanOpenTry
+	Callback string
+
+	Order                 channeltypes.Order
+	ConnectionHops        []string
+	PortID                string
+	ChannelID             string
+	Counterparty          channeltypes.Counterparty
+	CounterpartyChannelID string
+	// Version is the version provided to OnChanOpenInit or the counterparty version provided to OnChanOpenTry and OnChanOpenAck
+	Version         string
+	Packet          channeltypes.Packet
+	Acknowledgement []byte
+	Relayer         sdk.AccAddress
+}
+
+// String returns the module and callback name of the invocation, e.g. "icahost/OnChanOpenTry".
+func (ci CallbackInvocation) String() string {
+	return fmt.Sprintf("%s/%s", ci.Module, ci.Callback)
+}
+
+// CallbackRecorder records the IBC application callbacks invoked on one or more mock IBC middlewares in order of invocation.
+// Sharing a recorder between the mock IBC middlewares of an application stack allows for asserting the order in which the
+// callbacks travel through the stack. Callbacks are recorded even if the state changes of the transaction are reverted.
+type CallbackRecorder struct {
+	invocations []CallbackInvocation
+}
+
+// NewCallbackRecorder returns a new, empty CallbackRecorder.
+func NewCallbackRecorder() *CallbackRecorder {
+	return &CallbackRecorder{}
+}
+
+// Invocations returns the recorded callback invocations in order of invocation.
+func (r *CallbackRecorder) Invocations() []CallbackInvocation {
+	return r.invocations
+}
+
+// Callbacks returns the module and callback names of the recorded callback invocations in order of invocation,
+// see CallbackInvocation.String.
+func (r *CallbackRecorder) Callbacks() []string {
+	callbacks := make([]string, len(r.invocations))
+	for i, invocation := range r.invocations {
+		callbacks[i] = invocation.String()
+	}
+
+	return callbacks
+}
+
+// Reset removes all recorded callback invocations.
+func (r *CallbackRecorder) Reset() {
+	r.invocations = nil
+}
+
+// record appends the provided callback invocation. Recording on a nil CallbackRecorder is a no-op.
+func (r *CallbackRecorder) record(invocation CallbackInvocation) {
+	if r == nil {
+		return
+	}
+
+	r.invocations = append(r.invocations, invocation)
+}
@@ -3,7 +3,11 @@ package ibctesting
 import (
 	"bytes"
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
@@ -90,3 +94,122 @@ func (path *Path) RelayPacket(packet channeltypes.Packet) error {
 
 	return fmt.Errorf("packet commitment does not exist on either endpoint for provided packet")
 }
+
+// RelayPacketWithResult relays the packet from the endpoint which sent it to its counterparty. The client on the
+// receiving chain is updated before the packet is received and the client on the sending chain is updated before
+// the acknowledgement written on the receiving chain is relayed back to the sender. The result of the MsgRecvPacket
+// transaction and the acknowledgement bytes are returned. If the receiving application does not write an
+// acknowledgement synchronously, the returned acknowledgement is nil and no acknowledgement is relayed.
+// An error is returned if the sending endpoint does not store a commitment matching the provided packet.
+func (path *Path) RelayPacketWithResult(packet channeltypes.Packet) (*sdk.Result, []byte, error) {
+	source, err := path.packetSource(packet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	destination := source.Counterparty
+	if err := destination.UpdateClient(); err != nil {
+		return nil, nil, err
+	}
+
+	// RecvPacketWithResult updates the client on the sending chain
+	res, err := destination.RecvPacketWithResult(packet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, found := destination.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(destination.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()); !found {
+		return res, nil, nil
+	}
+
+	ack, err := ParseAckFromEvents(res.GetEvents())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := source.AcknowledgePacket(packet, ack); err != nil {
+		return nil, nil, err
+	}
+
+	return res, ack, nil
+}
+
+// TimeoutPacket times out the packet on the endpoint which sent it. Blocks are committed on the receiving chain,
+// advancing its time where necessary, until the timeout height or timeout timestamp of the packet has passed. The
+// client on the sending chain is then updated and a MsgTimeout is submitted. An error is returned if the sending
+// endpoint does not store a commitment matching the provided packet or if the timeout height of the packet is on
+// a revision the receiving chain cannot reach.
+func (path *Path) TimeoutPacket(packet channeltypes.Packet) error {
+	source, err := path.packetSource(packet)
+	if err != nil {
+		return err
+	}
+
+	destination := source.Counterparty
+	revision := clienttypes.ParseChainID(destination.Chain.ChainID)
+
+	timeoutHeight := packet.GetTimeoutHeight()
+	if !timeoutHeight.IsZero() && timeoutHeight.GetRevisionNumber() > revision {
+		return fmt.Errorf("packet timeout height %s cannot be reached by chain %s on revision %d", timeoutHeight, destination.Chain.ChainID, revision)
+	}
+
+	for !isPacketTimedOut(destination.Chain, packet) {
+		if timeoutTimestamp := packet.GetTimeoutTimestamp(); timeoutTimestamp != 0 {
+			if now := uint64(destination.Chain.CurrentHeader.Time.UnixNano()); now < timeoutTimestamp {
+				destination.Chain.Coordinator.IncrementTimeBy(time.Duration(timeoutTimestamp - now))
+			}
+		}
+
+		destination.Chain.Coordinator.CommitBlock(destination.Chain)
+	}
+
+	if err := source.UpdateClient(); err != nil {
+		return err
+	}
+
+	return source.TimeoutPacket(packet)
+}
+
+// packetSource returns the endpoint of the path which sent the provided packet. Both endpoints may use the same
+// port and channel identifiers, in which case the endpoint storing a commitment for the packet is returned. An error
+// is returned if the packet was not sent on the channel of either endpoint or if the stored packet commitment does
+// not match the provided packet.
+func (path *Path) packetSource(packet channeltypes.Packet) (*Endpoint, error) {
+	var channelFound bool
+	for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+		if endpoint.ChannelConfig.PortID != packet.GetSourcePort() || endpoint.ChannelID != packet.GetSourceChannel() {
+			continue
+		}
+
+		channelFound = true
+		commitment := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(endpoint.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if len(commitment) == 0 {
+			continue
+		}
+
+		if !bytes.Equal(commitment, channeltypes.CommitPacket(endpoint.Chain.App.AppCodec(), packet)) {
+			return nil, fmt.Errorf("packet commitment stored on chain %s for port %s, channel %s and sequence %d does not match the provided packet", endpoint.Chain.ChainID, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		}
+
+		return endpoint, nil
+	}
+
+	if !channelFound {
+		return nil, fmt.Errorf("packet source port %s and channel %s do not match the channel of either endpoint", packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
+	return nil, fmt.Errorf("packet commitment not found for port %s, channel %s and sequence %d", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// isPacketTimedOut returns true if the latest header of the provided chain, which is used to update the client
+// tracking the chain, has reached the timeout height or timeout timestamp of the packet.
+func isPacketTimedOut(chain *TestChain, packet channeltypes.Packet) bool {
+	height := chain.LastHeader.GetHeight()
+	timeoutHeight := packet.GetTimeoutHeight()
+	if !timeoutHeight.IsZero() && height.GTE(timeoutHeight) {
+		return true
+	}
+
+	timeoutTimestamp := packet.GetTimeoutTimestamp()
+	return timeoutTimestamp != 0 && uint64(chain.LastHeader.GetTime().UnixNano()) >= timeoutTimestamp
+}
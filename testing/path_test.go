@@ -0,0 +1,89 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/cosmos/ibc-go/v4/testing/mock"
+)
+
+func TestRelayPacketWithResult(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	coord.Setup(path)
+
+	// packets are relayed from the endpoint which sent them
+	for i, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		packet := channeltypes.NewPacket(mock.MockPacketData, 1, endpoint.ChannelConfig.PortID, endpoint.ChannelID, endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, clienttypes.ZeroHeight(), uint64(coord.CurrentTime.Add(time.Hour).UnixNano()))
+		require.NoError(t, endpoint.SendPacket(packet), i)
+
+		res, ack, err := path.RelayPacketWithResult(packet)
+		require.NoError(t, err, i)
+		require.NotNil(t, res, i)
+		require.Equal(t, mock.MockAcknowledgement.Acknowledgement(), ack, i)
+
+		// the acknowledgement has been relayed back to the sender
+		commitment := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(endpoint.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		require.Nil(t, commitment, i)
+
+		_, _, err = path.RelayPacketWithResult(packet)
+		require.Error(t, err, i)
+	}
+
+	// asynchronous acknowledgements are not relayed
+	packet := channeltypes.NewPacket(mock.MockAsyncPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(coord.CurrentTime.Add(time.Hour).UnixNano()))
+	require.NoError(t, path.EndpointA.SendPacket(packet))
+
+	res, ack, err := path.RelayPacketWithResult(packet)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Nil(t, ack)
+
+	// a packet which does not match the stored commitment is rejected
+	packet = channeltypes.NewPacket(mock.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(coord.CurrentTime.Add(time.Hour).UnixNano()))
+	require.NoError(t, path.EndpointA.SendPacket(packet))
+
+	packet.Data = mock.MockFailPacketData
+	_, _, err = path.RelayPacketWithResult(packet)
+	require.ErrorContains(t, err, "does not match the provided packet")
+
+	// a packet which was not sent on the path is rejected
+	packet.SourceChannel = "channel-100"
+	_, _, err = path.RelayPacketWithResult(packet)
+	require.ErrorContains(t, err, "do not match the channel of either endpoint")
+}
+
+func TestTimeoutPacket(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	coord.Setup(path)
+
+	testCases := []struct {
+		name             string
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	}{
+		{"timeout height", path.EndpointB.Chain.GetTimeoutHeight(), 0},
+		{"timeout timestamp", clienttypes.ZeroHeight(), uint64(coord.CurrentTime.Add(time.Hour).UnixNano())},
+	}
+
+	for i, tc := range testCases {
+		packet := channeltypes.NewPacket(mock.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, tc.timeoutHeight, tc.timeoutTimestamp)
+		require.NoError(t, path.EndpointA.SendPacket(packet), tc.name)
+
+		require.NoError(t, path.TimeoutPacket(packet), tc.name)
+
+		commitment := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(path.EndpointA.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		require.Nil(t, commitment, tc.name)
+	}
+
+	// a timeout height on a future revision cannot be reached
+	packet := channeltypes.NewPacket(mock.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(100, 1), 0)
+	require.NoError(t, path.EndpointA.SendPacket(packet))
+	require.Error(t, path.TimeoutPacket(packet))
+}
@@ -0,0 +1,81 @@
+package simapp
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
+)
+
+// errorAckFormat matches the error string of the deterministic error acknowledgements returned by
+// channeltypes.NewErrorAcknowledgementWithCodespace, optionally extended by the application
+var errorAckFormat = regexp.MustCompile(`^ABCI code: \d+: codespace: [^:\s]+: `)
+
+// TestIBCModulesErrorAcknowledgementDeterminism forces a failure in the OnRecvPacket callback of every module registered
+// on the IBC router of two app instances. The errors returned on each instance have different error messages, but share
+// the same ABCI code and codespace. The error acknowledgements returned by each module must be byte-identical across the
+// app instances, as acknowledgements are written into state.
+func TestIBCModulesErrorAcknowledgementDeterminism(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(app *SimApp, instance int)
+	}{
+		{
+			"malformed packet data",
+			func(app *SimApp, instance int) {},
+		},
+		{
+			"mock middleware failure",
+			func(app *SimApp, instance int) {
+				for _, middleware := range []*ibcmock.IBCMiddleware{app.ICAAuthMiddleware, app.ICAControllerMiddleware, app.ICAHostMiddleware} {
+					middleware.FailOnRecvPacket = true
+					middleware.Err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "injected failure of app instance %d", instance)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			acks := make([]map[string][]byte, 2)
+			for instance := range acks {
+				app := Setup(false)
+				ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+				tc.malleate(app, instance)
+
+				acks[instance] = make(map[string][]byte)
+				for _, module := range app.IBCKeeper.Router.Modules() {
+					cbs, ok := app.IBCKeeper.Router.GetRoute(module)
+					require.True(t, ok)
+
+					data := []byte(fmt.Sprintf("malformed packet data of app instance %d", instance))
+					packet := channeltypes.NewPacket(data, 1, module, "channel-0", module, "channel-0", clienttypes.NewHeight(0, 100), 0)
+
+					ack := cbs.OnRecvPacket(ctx, packet, sdk.AccAddress("relayer"))
+					require.NotNil(t, ack, module)
+					require.False(t, ack.Success(), module)
+
+					errorAck, ok := ack.(channeltypes.Acknowledgement)
+					require.True(t, ok, module)
+					require.Regexp(t, errorAckFormat, errorAck.GetError(), module)
+
+					acks[instance][module] = ack.Acknowledgement()
+				}
+			}
+
+			require.NotEmpty(t, acks[0])
+			require.Equal(t, acks[0], acks[1])
+		})
+	}
+}
@@ -85,19 +85,30 @@ import (
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host"
+	icahostclient "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/client"
 	icahostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icalogger "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/logger"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibcfee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
 	ibcfeekeeper "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/keeper"
 	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ratelimiting "github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting"
+	ratelimitingclient "github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/client"
+	ratelimitingkeeper "github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/keeper"
+	ratelimitingtypes "github.com/cosmos/ibc-go/v4/modules/apps/rate-limiting/types"
 	transfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer"
+	transferclient "github.com/cosmos/ibc-go/v4/modules/apps/transfer/client"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v4/modules/core"
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client"
 	ibcclientclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/client"
 	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel"
+	ibcchannelclient "github.com/cosmos/ibc-go/v4/modules/core/04-channel/client"
+	ibcchannelkeeper "github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
@@ -117,6 +128,9 @@ const appName = "SimApp"
 // IBC application testing ports
 const (
 	MockFeePort string = ibcmock.ModuleName + ibcfeetypes.ModuleName
+
+	// MockStaticPort is the port prefix statically owned by the mock module registered without capabilities
+	MockStaticPort string = ibcmock.ModuleName + "static"
 )
 
 var (
@@ -136,7 +150,14 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.CreateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			ibcchannelclient.PruneAcknowledgementsProposalHandler,
+			icahostclient.UpdateAllowMessagesProposalHandler,
+			icahostclient.UpdateAddressBlocklistProposalHandler,
+			transferclient.UpdateDenomEnabledProposalHandler,
+			ratelimitingclient.AddRateLimitProposalHandler,
+			ratelimitingclient.UpdateRateLimitProposalHandler,
+			ratelimitingclient.RemoveRateLimitProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -151,6 +172,7 @@ var (
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
+		ratelimiting.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -208,6 +230,7 @@ type SimApp struct {
 	ICAHostKeeper       icahostkeeper.Keeper
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	RateLimitKeeper     ratelimitingkeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
 
 	// make scoped keepers public for test purposes
@@ -225,6 +248,18 @@ type SimApp struct {
 	ICAAuthModule ibcmock.IBCModule
 	FeeMockModule ibcmock.IBCModule
 
+	// StaticMockModule is routed to by the IBC Router as the static owner of the ports prefixed with MockStaticPort
+	StaticMockModule ibcmock.IBCModule
+	// StaticMockChannelKeeper is the channel keeper scoped to the static owner of the ports prefixed with MockStaticPort
+	StaticMockChannelKeeper ibcchannelkeeper.Keeper
+
+	// mock IBC middlewares of the interchain accounts stacks, recording the callbacks travelling through the stacks
+	// in the ICACallbackRecorder and allowing for the failure of specific callbacks
+	ICACallbackRecorder     *ibcmock.CallbackRecorder
+	ICAAuthMiddleware       *ibcmock.IBCMiddleware
+	ICAControllerMiddleware *ibcmock.IBCMiddleware
+	ICAHostMiddleware       *ibcmock.IBCMiddleware
+
 	// the module manager
 	mm *module.Manager
 
@@ -264,7 +299,7 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, ibcfeetypes.StoreKey,
+		authzkeeper.StoreKey, ibcfeetypes.StoreKey, ratelimitingtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -344,18 +379,6 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
-	// register the proposal types
-	govRouter := govtypes.NewRouter()
-	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
-	app.GovKeeper = govkeeper.NewKeeper(
-		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
-	)
-
 	// IBC Fee Module keeper
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		appCodec, keys[ibcfeetypes.StoreKey], app.GetSubspace(ibcfeetypes.ModuleName),
@@ -368,31 +391,63 @@ func NewSimApp(
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
 		app.IBCFeeKeeper, // use ics29 fee as ics4Wrapper in middleware stack
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, &app.IBCKeeper.PortKeeper,
 		scopedICAControllerKeeper, app.MsgServiceRouter(),
 	)
+	app.ICAControllerKeeper.SetFeeKeeper(app.IBCFeeKeeper)
+
+	// configure the ICA host logger level and message verbosity from the environment, if set
+	if err := icalogger.ConfigureFromEnv(); err != nil {
+		tmos.Exit(err.Error())
+	}
 
 	// ICA Host keeper
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter(),
 	)
+	app.ICAHostKeeper.SetFeeKeeper(app.IBCFeeKeeper)
+	app.ICAHostKeeper.SetBankKeeper(app.BankKeeper)
 
-	// Create IBC Router
-	ibcRouter := porttypes.NewRouter()
-
-	// Middleware Stacks
+	// Create Rate Limit Keeper and pass IBCFeeKeeper as expected ICS4Wrapper
+	// since fee middleware sits below the rate limiting middleware in the transfer stack.
+	app.RateLimitKeeper = ratelimitingkeeper.NewKeeper(
+		appCodec, keys[ratelimitingtypes.StoreKey],
+		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
+		app.BankKeeper,
+	)
 
-	// Create Transfer Keeper and pass IBCFeeKeeper as expected Channel and PortKeeper
-	// since fee middleware will wrap the IBCKeeper for underlying application.
+	// Create Transfer Keeper and pass RateLimitKeeper as expected ICS4Wrapper
+	// since rate limiting middleware will wrap the fee middleware for underlying application.
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
+		app.RateLimitKeeper, // ISC4 Wrapper: rate limiting IBC middleware
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 
+	// register the proposal types
+	govRouter := govtypes.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ibcchanneltypes.RouterKey, ibcchannel.NewChannelProposalHandler(app.IBCKeeper.ChannelKeeper)).
+		AddRoute(icahosttypes.RouterKey, icahost.NewHostProposalHandler(app.ICAHostKeeper)).
+		AddRoute(ibctransfertypes.RouterKey, transfer.NewProposalHandler(app.TransferKeeper)).
+		AddRoute(ratelimitingtypes.RouterKey, ratelimiting.NewProposalHandler(app.RateLimitKeeper))
+	app.GovKeeper = govkeeper.NewKeeper(
+		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
+		&stakingKeeper, govRouter,
+	)
+
+	// Create IBC Router
+	ibcRouter := porttypes.NewRouter()
+
+	// Middleware Stacks
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -406,18 +461,20 @@ func NewSimApp(
 
 	// Create Transfer Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// transferKeeper.SendPacket -> fee.SendPacket -> channel.SendPacket
+	// transferKeeper.SendPacket -> rateLimit.SendPacket -> fee.SendPacket -> channel.SendPacket
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-	// channel.RecvPacket -> fee.OnRecvPacket -> transfer.OnRecvPacket
+	// channel.RecvPacket -> fee.OnRecvPacket -> rateLimit.OnRecvPacket -> transfer.OnRecvPacket
 
 	// transfer stack contains (from top to bottom):
 	// - IBC Fee Middleware
+	// - Rate Limiting Middleware
 	// - Transfer
 
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = ratelimiting.NewIBCMiddleware(transferStack, app.RateLimitKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	// Add transfer stack to IBC Router
@@ -428,18 +485,27 @@ func NewSimApp(
 	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
 
 	// initialize ICA module with mock module as the authentication module on the controller side
+	// the mock IBC middlewares are placed at the top of the stack and between the controller and the authentication module:
+	// channel.OnChanOpenInit -> mockController.OnChanOpenInit -> fee.OnChanOpenInit -> icaController.OnChanOpenInit -> mockAuth.OnChanOpenInit -> icaAuth.OnChanOpenInit
+	app.ICACallbackRecorder = ibcmock.NewCallbackRecorder()
+
 	var icaControllerStack porttypes.IBCModule
 	icaControllerStack = ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp("", scopedICAMockKeeper))
 	app.ICAAuthModule = icaControllerStack.(ibcmock.IBCModule)
-	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
-	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
+	app.ICAAuthMiddleware = ibcmock.NewIBCMiddleware("icaauth", icaControllerStack, nil, app.ICACallbackRecorder)
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAAuthMiddleware, app.ICAControllerKeeper)
+	feeICAControllerStack := ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
+	app.ICAControllerMiddleware = ibcmock.NewIBCMiddleware(icacontrollertypes.SubModuleName, feeICAControllerStack, feeICAControllerStack, app.ICACallbackRecorder)
+	icaControllerStack = app.ICAControllerMiddleware
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
-	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
+	// channel.RecvPacket -> mockHost.OnRecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
 
 	var icaHostStack porttypes.IBCModule
 	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
-	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
+	feeICAHostStack := ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
+	app.ICAHostMiddleware = ibcmock.NewIBCMiddleware(icahosttypes.SubModuleName, feeICAHostStack, feeICAHostStack, app.ICACallbackRecorder)
+	icaHostStack = app.ICAHostMiddleware
 
 	// Add host, controller & ica auth modules to IBC router
 	ibcRouter.
@@ -466,6 +532,15 @@ func NewSimApp(
 	feeWithMockModule := ibcfee.NewIBCMiddleware(feeMockModule, app.IBCFeeKeeper)
 	ibcRouter.AddRoute(MockFeePort, feeWithMockModule)
 
+	// Create Static Mock Module stack for testing
+	// the mock module statically owns the ports prefixed with MockStaticPort, channels on these ports
+	// are not authenticated using capabilities and the mock module does not claim any capabilities
+	app.StaticMockModule = ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp(MockStaticPort, scopedIBCMockKeeper))
+	ibcRouter.AddStaticRoute(MockStaticPort, MockStaticPort, app.StaticMockModule)
+
+	// the static owner sends packets using a channel keeper scoped to it, which must be created before the router is set
+	app.StaticMockChannelKeeper = app.IBCKeeper.ChannelKeeper.ScopeToModule(MockStaticPort)
+
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -510,6 +585,7 @@ func NewSimApp(
 		// IBC modules
 		transfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		ratelimiting.NewAppModule(app.RateLimitKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		mockModule,
 	)
@@ -523,13 +599,13 @@ func NewSimApp(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName, authtypes.ModuleName,
 		banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ratelimitingtypes.ModuleName, ibcmock.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName,
-		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ratelimitingtypes.ModuleName, ibcmock.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -541,7 +617,7 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
-		icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		icatypes.ModuleName, ibcfeetypes.ModuleName, ratelimitingtypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -571,6 +647,7 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
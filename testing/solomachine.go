@@ -4,12 +4,14 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	"github.com/stretchr/testify/require"
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -29,16 +31,29 @@ type Solomachine struct {
 	PrivateKeys []cryptotypes.PrivKey // keys used for signing
 	PublicKeys  []cryptotypes.PubKey  // keys used for generating solo machine pub key
 	PublicKey   cryptotypes.PubKey    // key used for verification
+	Threshold   uint64                // number of keys signing, only used for multisig public keys
 	Sequence    uint64
 	Time        uint64
 	Diversifier string
+
+	// AminoMultisig encodes multisig signatures as amino multisignatures, as produced by
+	// legacy amino signing tooling, instead of protobuf MultiSignatureData.
+	AminoMultisig bool
 }
 
 // NewSolomachine returns a new solomachine instance with an `nKeys` amount of
 // generated private/public key pairs and a sequence starting at 1. If nKeys
 // is greater than 1 then a multisig public key is used.
 func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, nKeys uint64) *Solomachine {
-	privKeys, pubKeys, pk := GenerateKeys(t, nKeys)
+	return NewThresholdSolomachine(t, cdc, clientID, diversifier, nKeys, nKeys)
+}
+
+// NewThresholdSolomachine returns a new solomachine instance with an `nKeys` amount of
+// generated private/public key pairs and a sequence starting at 1. If nKeys is greater
+// than 1 then a `threshold`-of-`nKeys` multisig public key is used and signatures are
+// generated with the first `threshold` private keys only.
+func NewThresholdSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, threshold, nKeys uint64) *Solomachine {
+	privKeys, pubKeys, pk := GenerateThresholdKeys(t, threshold, nKeys)
 
 	return &Solomachine{
 		t:           t,
@@ -47,6 +62,7 @@ func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier s
 		PrivateKeys: privKeys,
 		PublicKeys:  pubKeys,
 		PublicKey:   pk,
+		Threshold:   threshold,
 		Sequence:    1,
 		Time:        10,
 		Diversifier: diversifier,
@@ -62,7 +78,16 @@ func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier s
 // interface, if needed. The same is true for the amino based Multisignature
 // public key.
 func GenerateKeys(t *testing.T, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.PubKey, cryptotypes.PubKey) {
+	return GenerateThresholdKeys(t, n, n)
+}
+
+// GenerateThresholdKeys generates a new set of secp256k1 private keys and public keys.
+// If the number of keys is greater than one then the public key returned represents
+// a `threshold`-of-`n` multisig public key.
+func GenerateThresholdKeys(t *testing.T, threshold, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.PubKey, cryptotypes.PubKey) {
 	require.NotEqual(t, uint64(0), n, "generation of zero keys is not allowed")
+	require.NotEqual(t, uint64(0), threshold, "threshold of zero is not allowed")
+	require.LessOrEqual(t, threshold, n, "threshold cannot be greater than the number of keys")
 
 	privKeys := make([]cryptotypes.PrivKey, n)
 	pubKeys := make([]cryptotypes.PubKey, n)
@@ -74,7 +99,7 @@ func GenerateKeys(t *testing.T, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.
 	var pk cryptotypes.PubKey
 	if len(privKeys) > 1 {
 		// generate multi sig pk
-		pk = kmultisig.NewLegacyAminoPubKey(int(n), pubKeys)
+		pk = kmultisig.NewLegacyAminoPubKey(int(threshold), pubKeys)
 	} else {
 		pk = privKeys[0].PubKey()
 	}
@@ -109,7 +134,7 @@ func (solo *Solomachine) GetHeight() exported.Height {
 // necessary signature to construct a valid solo machine header.
 func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
 	// generate new private keys and signature for header
-	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+	newPrivKeys, newPubKeys, newPubKey := GenerateThresholdKeys(solo.t, solo.Threshold, uint64(len(solo.PrivateKeys)))
 
 	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
 	require.NoError(solo.t, err)
@@ -214,30 +239,51 @@ func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {
 
 // GenerateSignature uses the stored private keys to generate a signature
 // over the sign bytes with each key. If the amount of keys is greater than
-// 1 then a multisig data type is returned.
+// 1 then a multisig data type is returned containing signatures of the first
+// `Threshold` keys. If AminoMultisig is set the multisig data is amino encoded.
 func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
-	sigs := make([]signing.SignatureData, len(solo.PrivateKeys))
-	for i, key := range solo.PrivateKeys {
+	numSigs := len(solo.PrivateKeys)
+	if numSigs > 1 {
+		numSigs = int(solo.Threshold)
+	}
+
+	sigs := make([]signing.SignatureData, numSigs)
+	for i, key := range solo.PrivateKeys[:numSigs] {
 		sig, err := key.Sign(signBytes)
 		require.NoError(solo.t, err)
 
-		sigs[i] = &signing.SingleSignatureData{
+		singleSigData := &signing.SingleSignatureData{
 			Signature: sig,
 		}
+		if solo.AminoMultisig {
+			singleSigData.SignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+		}
+
+		sigs[i] = singleSigData
 	}
 
 	var sigData signing.SignatureData
-	if len(sigs) == 1 {
+	if len(solo.PrivateKeys) == 1 {
 		// single public key
 		sigData = sigs[0]
 	} else {
 		// generate multi signature data
-		multiSigData := multisig.NewMultisig(len(sigs))
+		multiSigData := multisig.NewMultisig(len(solo.PrivateKeys))
 		for i, sig := range sigs {
 			multisig.AddSignature(multiSigData, sig, i)
 		}
 
 		sigData = multiSigData
+
+		if solo.AminoMultisig {
+			aminoSig, err := legacytx.MultiSignatureDataToAminoMultisignature(legacy.Cdc, multiSigData)
+			require.NoError(solo.t, err)
+
+			sigData = &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: legacy.Cdc.MustMarshal(aminoSig),
+			}
+		}
 	}
 
 	protoSigData := signing.SignatureDataToProto(sigData)
@@ -35,6 +35,8 @@ const (
 	TransferPort = ibctransfertypes.ModuleName
 	MockPort     = mock.ModuleName
 	MockFeePort  = simapp.MockFeePort
+	// MockStaticPort is statically owned by the mock module, channels on the port do not use capabilities
+	MockStaticPort = simapp.MockStaticPort
 
 	// used for testing proposals
 	Title       = "title"